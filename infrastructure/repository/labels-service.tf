@@ -28,11 +28,15 @@ variable "service_labels" {
     "auditmanager",
     "autoscaling",
     "autoscalingplans",
+    "b2bi",
     "backup",
     "batch",
+    "bedrock",
+    "bedrockagent",
     "braket",
     "budgets",
     "chime",
+    "cleanrooms",
     "cloud9",
     "cloudcontrolapi",
     "clouddirectory",
@@ -67,6 +71,7 @@ variable "service_labels" {
     "dataexchange",
     "datapipeline",
     "datasync",
+    "datazone",
     "dax",
     "detective",
     "devicefarm",
@@ -93,6 +98,7 @@ variable "service_labels" {
     "emrcontainers",
     "events",
     "firehose",
+    "fis",
     "fms",
     "forecastservice",
     "frauddetector",
@@ -151,6 +157,8 @@ variable "service_labels" {
     "neptune",
     "networkfirewall",
     "networkmanager",
+    "opensearch",
+    "opensearchserverless",
     "opsworks",
     "opsworkscm",
     "organizations",
@@ -160,6 +168,7 @@ variable "service_labels" {
     "pinpoint",
     "pinpointemail",
     "pinpointsmsvoice",
+    "pipes",
     "polly",
     "pricing",
     "qldb",
@@ -167,11 +176,13 @@ variable "service_labels" {
     "ram",
     "rds",
     "redshift",
+    "redshiftserverless",
     "resourcegroups",
     "resourcegroupstaggingapi",
     "robomaker",
     "route53",
     "route53domains",
+    "route53profiles",
     "route53recoverycontrolconfig",
     "route53recoveryreadiness",
     "route53resolver",
@@ -180,9 +191,11 @@ variable "service_labels" {
     "s3outposts",
     "sagemaker",
     "savingsplans",
+    "scheduler",
     "schemas",
     "secretsmanager",
     "securityhub",
+    "securitylake",
     "serverlessrepo",
     "servicecatalog",
     "servicediscovery",
@@ -210,6 +223,8 @@ variable "service_labels" {
     "timestreamwrite",
     "transfer",
     "translate",
+    "verifiedpermissions",
+    "vpclattice",
     "waf",
     "wafv2",
     "workdocs",
@@ -842,6 +842,16 @@ provider "aws" {
 `, key1)
 }
 
+// ConfigPreflightChecks returns a provider configuration that enables preflight checks.
+func ConfigPreflightChecks() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  preflight_checks = true
+}
+`
+}
+
 // ConfigNamedRegionalProvider creates a new provider named configuration with a region.
 //
 // This can be used to build multiple provider configuration testing.
@@ -3,6 +3,7 @@ package acctest
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -193,6 +194,49 @@ func TestAccAcctestProvider_endpoints(t *testing.T) {
 	})
 }
 
+func TestAccAcctestProvider_endpointURLBase(t *testing.T) {
+	var providers []*schema.Provider
+
+	base := "http://localhost:4566"
+	override := []string{"sqs", "http://localhost:9324"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointURLBaseConfig(base, override),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointURLBase(&providers, base, override),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAcctestProvider_endpointURLInvalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: FactoriesInternal(nil),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEndpointURLBaseConfig("ftp://localhost:4566", []string{"sqs", "http://localhost:9324"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must use the http or https scheme`),
+			},
+			{
+				Config:      testAccEndpointURLBaseConfig("http://localhost:4566", []string{"sqs", "ftp://localhost:9324"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must use the http or https scheme`),
+			},
+		},
+	})
+}
+
 func TestAccAcctestProvider_fipsEndpoint(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(ResourcePrefix)
 	resourceName := "aws_s3_bucket.test"
@@ -875,6 +919,55 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 	}
 }
 
+func testAccCheckEndpointURLBase(providers *[]*schema.Provider, base string, override []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		overrideServiceKey, err := conns.ServiceForHCLKey(override[0])
+
+		if err != nil {
+			return err
+		}
+
+		for _, provo := range *providers {
+			if provo == nil || provo.Meta() == nil || provo.Meta().(*conns.AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provo.Meta().(*conns.AWSClient)
+
+			for _, serviceKey := range conns.ServiceKeys() {
+				serviceUpper, err := conns.ServiceProviderNameUpper(serviceKey)
+
+				if err != nil {
+					return err
+				}
+
+				providerClientField := reflect.Indirect(reflect.ValueOf(providerClient)).FieldByName(fmt.Sprintf("%sConn", serviceUpper))
+
+				if !providerClientField.IsValid() {
+					return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", serviceKey)
+				}
+
+				actualEndpoint := reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
+				expectedEndpoint := base
+
+				if serviceKey == overrideServiceKey {
+					expectedEndpoint = override[1]
+				}
+
+				if actualEndpoint != expectedEndpoint {
+					return fmt.Errorf("expected endpoint (%s) value (%s), got: %s", serviceKey, expectedEndpoint, actualEndpoint)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccEndpointsConfig(endpoints string) string {
 	//lintignore:AT004
 	return ConfigCompose(
@@ -893,6 +986,25 @@ provider "aws" {
 `, endpoints))
 }
 
+func testAccEndpointURLBaseConfig(base string, override []string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+
+  endpoints {
+    endpoint_url_base = %[1]q
+    %[2]s             = %[3]q
+  }
+}
+`, base, override[0], override[1]))
+}
+
 func testAccFIPSEndpointConfig(endpoint, rName string) string {
 	//lintignore:AT004
 	return ConfigCompose(
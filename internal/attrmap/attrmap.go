@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/jsonutil"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -18,6 +19,7 @@ type attributeInfo struct {
 	tfComputed       bool
 	tfOptional       bool
 	isIAMPolicy      bool
+	isJSON           bool
 }
 
 type AttributeMap map[string]attributeInfo
@@ -76,6 +78,14 @@ func (m AttributeMap) ApiAttributesToResourceData(apiAttributes map[string]strin
 					}
 
 					tfAttributeValue = policy
+				} else if attributeInfo.isJSON {
+					json, err := jsonutil.NormalizeString(tfAttributeValue.(string))
+
+					if err != nil {
+						return fmt.Errorf("%s (%s) is invalid JSON: %w", tfAttributeName, v, err)
+					}
+
+					tfAttributeValue = json
 				}
 			default:
 				return fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -127,6 +137,14 @@ func (m AttributeMap) ResourceDataToApiAttributesCreate(d *schema.ResourceData)
 				}
 
 				apiAttributeValue = policy
+			} else if attributeInfo.isJSON {
+				json, err := jsonutil.NormalizeString(apiAttributeValue)
+
+				if err != nil {
+					return nil, fmt.Errorf("%s (%s) is invalid JSON: %w", tfAttributeName, apiAttributeValue, err)
+				}
+
+				apiAttributeValue = json
 			}
 		default:
 			return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -172,6 +190,14 @@ func (m AttributeMap) ResourceDataToApiAttributesUpdate(d *schema.ResourceData)
 					}
 
 					apiAttributeValue = policy
+				} else if attributeInfo.isJSON {
+					json, err := jsonutil.NormalizeString(apiAttributeValue)
+
+					if err != nil {
+						return nil, fmt.Errorf("%s (%s) is invalid JSON: %w", tfAttributeName, apiAttributeValue, err)
+					}
+
+					apiAttributeValue = json
 				}
 			default:
 				return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -201,6 +227,22 @@ func (m AttributeMap) ApiAttributeNames() []string {
 func (m AttributeMap) WithIAMPolicyAttribute(tfAttributeName string) AttributeMap {
 	if attributeInfo, ok := m[tfAttributeName]; ok {
 		attributeInfo.isIAMPolicy = true
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+// WithJSONAttributes marks the specified Terraform attributes as holding JSON documents.
+// JSON documents are normalized when read from and written to AWS so that
+// differences in key ordering or whitespace do not cause spurious diffs.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithJSONAttributes(tfAttributeNames ...string) AttributeMap {
+	for _, tfAttributeName := range tfAttributeNames {
+		if attributeInfo, ok := m[tfAttributeName]; ok {
+			attributeInfo.isJSON = true
+			m[tfAttributeName] = attributeInfo
+		}
 	}
 
 	return m
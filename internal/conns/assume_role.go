@@ -0,0 +1,84 @@
+package conns
+
+import (
+	"context"
+	"log"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// chainAssumeRoleCredentialsProvider returns a credentials provider that assumes each of the
+// specified IAM roles in turn, starting from the credentials in the specified AWS configuration.
+// Each role is assumed with the credentials obtained by assuming the previous one.
+func chainAssumeRoleCredentialsProvider(ctx context.Context, awsConfig aws_sdkv2.Config, c *awsbase.Config, roleChain []*awsbase.AssumeRole) (aws_sdkv2.CredentialsProvider, error) {
+	credentialsProvider := awsConfig.Credentials
+
+	for _, ar := range roleChain {
+		if ar == nil || ar.RoleARN == "" {
+			continue
+		}
+
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", ar.RoleARN, ar.SessionName, ar.ExternalID)
+
+		cfg := awsConfig.Copy()
+		cfg.Credentials = credentialsProvider
+
+		client := sts.NewFromConfig(cfg, func(opts *sts.Options) {
+			if c.StsRegion != "" {
+				opts.Region = c.StsRegion
+			}
+			if c.StsEndpoint != "" {
+				opts.EndpointResolver = sts.EndpointResolverFromURL(c.StsEndpoint)
+			}
+		})
+
+		appCreds := stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(opts *stscreds.AssumeRoleOptions) {
+			expandAssumeRoleOptions(opts, ar)
+		})
+
+		if _, err := appCreds.Retrieve(ctx); err != nil {
+			hop := *c
+			hop.AssumeRole = ar
+
+			return nil, hop.NewCannotAssumeRoleError(err)
+		}
+
+		credentialsProvider = aws_sdkv2.NewCredentialsCache(appCreds)
+	}
+
+	return credentialsProvider, nil
+}
+
+func expandAssumeRoleOptions(opts *stscreds.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	opts.RoleSessionName = ar.SessionName
+	opts.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		opts.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		opts.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, policyARN := range ar.PolicyARNs {
+		opts.PolicyARNs = append(opts.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(policyARN),
+		})
+	}
+
+	for k, v := range ar.Tags {
+		opts.Tags = append(opts.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	if len(ar.TransitiveTagKeys) > 0 {
+		opts.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+}
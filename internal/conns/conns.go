@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -14,7 +16,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/amplifybackend"
 	"github.com/aws/aws-sdk-go/service/apigateway"
@@ -36,11 +37,15 @@ import (
 	"github.com/aws/aws-sdk-go/service/augmentedairuntime"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/b2bi"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
 	"github.com/aws/aws-sdk-go/service/braket"
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
 	"github.com/aws/aws-sdk-go/service/cloud9"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/clouddirectory"
@@ -77,6 +82,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/datazone"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
@@ -124,7 +130,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/health"
 	"github.com/aws/aws-sdk-go/service/healthlake"
-	"github.com/aws/aws-sdk-go/service/honeycode"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
@@ -167,7 +172,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -187,7 +191,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/mgn"
 	"github.com/aws/aws-sdk-go/service/migrationhub"
 	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
-	"github.com/aws/aws-sdk-go/service/mobile"
 	"github.com/aws/aws-sdk-go/service/mobileanalytics"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/mturk"
@@ -196,6 +199,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
@@ -207,6 +212,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/aws/aws-sdk-go/service/pinpointemail"
 	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/pipes"
 	"github.com/aws/aws-sdk-go/service/polly"
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
@@ -219,12 +225,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/rdsdataservice"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
 	"github.com/aws/aws-sdk-go/service/rekognition"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/robomaker"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
@@ -236,9 +244,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemakerfeaturestoreruntime"
 	"github.com/aws/aws-sdk-go/service/sagemakerruntime"
 	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/aws/aws-sdk-go/service/scheduler"
 	"github.com/aws/aws-sdk-go/service/schemas"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/securitylake"
 	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
@@ -271,6 +281,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/aws/aws-sdk-go/service/wafv2"
@@ -293,7 +305,6 @@ const (
 	Account                       = "account"
 	ACM                           = "acm"
 	ACMPCA                        = "acmpca"
-	AlexaForBusiness              = "alexaforbusiness"
 	AMP                           = "amp"
 	Amplify                       = "amplify"
 	AmplifyBackend                = "amplifybackend"
@@ -316,11 +327,15 @@ const (
 	AugmentedAIRuntime            = "augmentedairuntime"
 	AutoScaling                   = "autoscaling"
 	AutoScalingPlans              = "autoscalingplans"
+	B2BI                          = "b2bi"
 	Backup                        = "backup"
 	Batch                         = "batch"
+	Bedrock                       = "bedrock"
+	BedrockAgent                  = "bedrockagent"
 	Braket                        = "braket"
 	Budgets                       = "budgets"
 	Chime                         = "chime"
+	CleanRooms                    = "cleanrooms"
 	Cloud9                        = "cloud9"
 	CloudControl                  = "cloudcontrol"
 	CloudDirectory                = "clouddirectory"
@@ -358,6 +373,7 @@ const (
 	DataExchange                  = "dataexchange"
 	DataPipeline                  = "datapipeline"
 	DataSync                      = "datasync"
+	DataZone                      = "datazone"
 	DAX                           = "dax"
 	Detective                     = "detective"
 	DeviceFarm                    = "devicefarm"
@@ -407,7 +423,6 @@ const (
 	GuardDuty                     = "guardduty"
 	Health                        = "health"
 	HealthLake                    = "healthlake"
-	Honeycode                     = "honeycode"
 	IAM                           = "iam"
 	IdentityStore                 = "identitystore"
 	ImageBuilder                  = "imagebuilder"
@@ -451,7 +466,6 @@ const (
 	LookoutForVision              = "lookoutforvision"
 	LookoutMetrics                = "lookoutmetrics"
 	MachineLearning               = "machinelearning"
-	Macie                         = "macie"
 	Macie2                        = "macie2"
 	ManagedBlockchain             = "managedblockchain"
 	MarketplaceCatalog            = "marketplacecatalog"
@@ -470,7 +484,6 @@ const (
 	Mgn                           = "mgn"
 	MigrationHub                  = "migrationhub"
 	MigrationHubConfig            = "migrationhubconfig"
-	Mobile                        = "mobile"
 	MobileAnalytics               = "mobileanalytics"
 	MQ                            = "mq"
 	MTurk                         = "mturk"
@@ -479,6 +492,8 @@ const (
 	NetworkFirewall               = "networkfirewall"
 	NetworkManager                = "networkmanager"
 	NimbleStudio                  = "nimblestudio"
+	OpenSearch                    = "opensearch"
+	OpenSearchServerless          = "opensearchserverless"
 	OpsWorks                      = "opsworks"
 	OpsWorksCM                    = "opsworkscm"
 	Organizations                 = "organizations"
@@ -490,6 +505,7 @@ const (
 	Pinpoint                      = "pinpoint"
 	PinpointEmail                 = "pinpointemail"
 	PinpointSMSVoice              = "pinpointsmsvoice"
+	Pipes                         = "pipes"
 	Polly                         = "polly"
 	Pricing                       = "pricing"
 	Proton                        = "proton"
@@ -501,12 +517,14 @@ const (
 	RDSData                       = "rdsdata"
 	Redshift                      = "redshift"
 	RedshiftData                  = "redshiftdata"
+	RedshiftServerless            = "redshiftserverless"
 	Rekognition                   = "rekognition"
 	ResourceGroups                = "resourcegroups"
 	ResourceGroupsTaggingAPI      = "resourcegroupstaggingapi"
 	RoboMaker                     = "robomaker"
 	Route53                       = "route53"
 	Route53Domains                = "route53domains"
+	Route53Profiles               = "route53profiles"
 	Route53RecoveryControlConfig  = "route53recoverycontrolconfig"
 	Route53RecoveryReadiness      = "route53recoveryreadiness"
 	Route53Resolver               = "route53resolver"
@@ -518,9 +536,11 @@ const (
 	SageMakerFeatureStoreRuntime  = "sagemakerfeaturestoreruntime"
 	SageMakerRuntime              = "sagemakerruntime"
 	SavingsPlans                  = "savingsplans"
+	Scheduler                     = "scheduler"
 	Schemas                       = "schemas"
 	SecretsManager                = "secretsmanager"
 	SecurityHub                   = "securityhub"
+	SecurityLake                  = "securitylake"
 	ServerlessRepo                = "serverlessrepo"
 	ServiceCatalog                = "servicecatalog"
 	ServiceDiscovery              = "servicediscovery"
@@ -553,6 +573,8 @@ const (
 	TranscribeStreaming           = "transcribestreaming"
 	Transfer                      = "transfer"
 	Translate                     = "translate"
+	VerifiedPermissions           = "verifiedpermissions"
+	VPCLattice                    = "vpclattice"
 	WAF                           = "waf"
 	WAFRegional                   = "wafregional"
 	WAFV2                         = "wafv2"
@@ -583,7 +605,6 @@ func init() {
 	serviceData[Account] = &ServiceDatum{AWSClientName: "Account", AWSServiceName: account.ServiceName, AWSEndpointsID: account.EndpointsID, AWSServiceID: account.ServiceID, ProviderNameUpper: "Account", HCLKeys: []string{"account"}}
 	serviceData[ACM] = &ServiceDatum{AWSClientName: "ACM", AWSServiceName: acm.ServiceName, AWSEndpointsID: acm.EndpointsID, AWSServiceID: acm.ServiceID, ProviderNameUpper: "ACM", HCLKeys: []string{"acm"}}
 	serviceData[ACMPCA] = &ServiceDatum{AWSClientName: "ACMPCA", AWSServiceName: acmpca.ServiceName, AWSEndpointsID: acmpca.EndpointsID, AWSServiceID: acmpca.ServiceID, ProviderNameUpper: "ACMPCA", HCLKeys: []string{"acmpca"}}
-	serviceData[AlexaForBusiness] = &ServiceDatum{AWSClientName: "AlexaForBusiness", AWSServiceName: alexaforbusiness.ServiceName, AWSEndpointsID: alexaforbusiness.EndpointsID, AWSServiceID: alexaforbusiness.ServiceID, ProviderNameUpper: "AlexaForBusiness", HCLKeys: []string{"alexaforbusiness"}}
 	serviceData[AMP] = &ServiceDatum{AWSClientName: "PrometheusService", AWSServiceName: prometheusservice.ServiceName, AWSEndpointsID: prometheusservice.EndpointsID, AWSServiceID: prometheusservice.ServiceID, ProviderNameUpper: "AMP", HCLKeys: []string{"amp", "prometheus", "prometheusservice"}}
 	serviceData[Amplify] = &ServiceDatum{AWSClientName: "Amplify", AWSServiceName: amplify.ServiceName, AWSEndpointsID: amplify.EndpointsID, AWSServiceID: amplify.ServiceID, ProviderNameUpper: "Amplify", HCLKeys: []string{"amplify"}}
 	serviceData[AmplifyBackend] = &ServiceDatum{AWSClientName: "AmplifyBackend", AWSServiceName: amplifybackend.ServiceName, AWSEndpointsID: amplifybackend.EndpointsID, AWSServiceID: amplifybackend.ServiceID, ProviderNameUpper: "AmplifyBackend", HCLKeys: []string{"amplifybackend"}}
@@ -606,11 +627,15 @@ func init() {
 	serviceData[AugmentedAIRuntime] = &ServiceDatum{AWSClientName: "AugmentedAIRuntime", AWSServiceName: augmentedairuntime.ServiceName, AWSEndpointsID: augmentedairuntime.EndpointsID, AWSServiceID: augmentedairuntime.ServiceID, ProviderNameUpper: "AugmentedAIRuntime", HCLKeys: []string{"augmentedairuntime"}}
 	serviceData[AutoScaling] = &ServiceDatum{AWSClientName: "AutoScaling", AWSServiceName: autoscaling.ServiceName, AWSEndpointsID: autoscaling.EndpointsID, AWSServiceID: autoscaling.ServiceID, ProviderNameUpper: "AutoScaling", HCLKeys: []string{"autoscaling"}}
 	serviceData[AutoScalingPlans] = &ServiceDatum{AWSClientName: "AutoScalingPlans", AWSServiceName: autoscalingplans.ServiceName, AWSEndpointsID: autoscalingplans.EndpointsID, AWSServiceID: autoscalingplans.ServiceID, ProviderNameUpper: "AutoScalingPlans", HCLKeys: []string{"autoscalingplans"}}
+	serviceData[B2BI] = &ServiceDatum{AWSClientName: "B2bi", AWSServiceName: b2bi.ServiceName, AWSEndpointsID: b2bi.EndpointsID, AWSServiceID: b2bi.ServiceID, ProviderNameUpper: "B2BI", HCLKeys: []string{"b2bi"}}
 	serviceData[Backup] = &ServiceDatum{AWSClientName: "Backup", AWSServiceName: backup.ServiceName, AWSEndpointsID: backup.EndpointsID, AWSServiceID: backup.ServiceID, ProviderNameUpper: "Backup", HCLKeys: []string{"backup"}}
 	serviceData[Batch] = &ServiceDatum{AWSClientName: "Batch", AWSServiceName: batch.ServiceName, AWSEndpointsID: batch.EndpointsID, AWSServiceID: batch.ServiceID, ProviderNameUpper: "Batch", HCLKeys: []string{"batch"}}
+	serviceData[Bedrock] = &ServiceDatum{AWSClientName: "Bedrock", AWSServiceName: bedrock.ServiceName, AWSEndpointsID: bedrock.EndpointsID, AWSServiceID: bedrock.ServiceID, ProviderNameUpper: "Bedrock", HCLKeys: []string{"bedrock"}}
+	serviceData[BedrockAgent] = &ServiceDatum{AWSClientName: "BedrockAgent", AWSServiceName: bedrockagent.ServiceName, AWSEndpointsID: bedrockagent.EndpointsID, AWSServiceID: bedrockagent.ServiceID, ProviderNameUpper: "BedrockAgent", HCLKeys: []string{"bedrockagent"}}
 	serviceData[Braket] = &ServiceDatum{AWSClientName: "Braket", AWSServiceName: braket.ServiceName, AWSEndpointsID: braket.EndpointsID, AWSServiceID: braket.ServiceID, ProviderNameUpper: "Braket", HCLKeys: []string{"braket"}}
 	serviceData[Budgets] = &ServiceDatum{AWSClientName: "Budgets", AWSServiceName: budgets.ServiceName, AWSEndpointsID: budgets.EndpointsID, AWSServiceID: budgets.ServiceID, ProviderNameUpper: "Budgets", HCLKeys: []string{"budgets"}}
 	serviceData[Chime] = &ServiceDatum{AWSClientName: "Chime", AWSServiceName: chime.ServiceName, AWSEndpointsID: chime.EndpointsID, AWSServiceID: chime.ServiceID, ProviderNameUpper: "Chime", HCLKeys: []string{"chime"}}
+	serviceData[CleanRooms] = &ServiceDatum{AWSClientName: "CleanRooms", AWSServiceName: cleanrooms.ServiceName, AWSEndpointsID: cleanrooms.EndpointsID, AWSServiceID: cleanrooms.ServiceID, ProviderNameUpper: "CleanRooms", HCLKeys: []string{"cleanrooms"}}
 	serviceData[Cloud9] = &ServiceDatum{AWSClientName: "Cloud9", AWSServiceName: cloud9.ServiceName, AWSEndpointsID: cloud9.EndpointsID, AWSServiceID: cloud9.ServiceID, ProviderNameUpper: "Cloud9", HCLKeys: []string{"cloud9"}}
 	serviceData[CloudControl] = &ServiceDatum{AWSClientName: "CloudControlApi", AWSServiceName: cloudcontrolapi.ServiceName, AWSEndpointsID: cloudcontrolapi.EndpointsID, AWSServiceID: cloudcontrolapi.ServiceID, ProviderNameUpper: "CloudControl", HCLKeys: []string{"cloudcontrolapi", "cloudcontrol"}}
 	serviceData[CloudDirectory] = &ServiceDatum{AWSClientName: "CloudDirectory", AWSServiceName: clouddirectory.ServiceName, AWSEndpointsID: clouddirectory.EndpointsID, AWSServiceID: clouddirectory.ServiceID, ProviderNameUpper: "CloudDirectory", HCLKeys: []string{"clouddirectory"}}
@@ -646,6 +671,7 @@ func init() {
 	serviceData[DataExchange] = &ServiceDatum{AWSClientName: "DataExchange", AWSServiceName: dataexchange.ServiceName, AWSEndpointsID: dataexchange.EndpointsID, AWSServiceID: dataexchange.ServiceID, ProviderNameUpper: "DataExchange", HCLKeys: []string{"dataexchange"}}
 	serviceData[DataPipeline] = &ServiceDatum{AWSClientName: "DataPipeline", AWSServiceName: datapipeline.ServiceName, AWSEndpointsID: datapipeline.EndpointsID, AWSServiceID: datapipeline.ServiceID, ProviderNameUpper: "DataPipeline", HCLKeys: []string{"datapipeline"}}
 	serviceData[DataSync] = &ServiceDatum{AWSClientName: "DataSync", AWSServiceName: datasync.ServiceName, AWSEndpointsID: datasync.EndpointsID, AWSServiceID: datasync.ServiceID, ProviderNameUpper: "DataSync", HCLKeys: []string{"datasync"}}
+	serviceData[DataZone] = &ServiceDatum{AWSClientName: "DataZone", AWSServiceName: datazone.ServiceName, AWSEndpointsID: datazone.EndpointsID, AWSServiceID: datazone.ServiceID, ProviderNameUpper: "DataZone", HCLKeys: []string{"datazone"}}
 	serviceData[DAX] = &ServiceDatum{AWSClientName: "DAX", AWSServiceName: dax.ServiceName, AWSEndpointsID: dax.EndpointsID, AWSServiceID: dax.ServiceID, ProviderNameUpper: "DAX", HCLKeys: []string{"dax"}}
 	serviceData[Detective] = &ServiceDatum{AWSClientName: "Detective", AWSServiceName: detective.ServiceName, AWSEndpointsID: detective.EndpointsID, AWSServiceID: detective.ServiceID, ProviderNameUpper: "Detective", HCLKeys: []string{"detective"}}
 	serviceData[DeviceFarm] = &ServiceDatum{AWSClientName: "DeviceFarm", AWSServiceName: devicefarm.ServiceName, AWSEndpointsID: devicefarm.EndpointsID, AWSServiceID: devicefarm.ServiceID, ProviderNameUpper: "DeviceFarm", HCLKeys: []string{"devicefarm"}}
@@ -695,7 +721,6 @@ func init() {
 	serviceData[GuardDuty] = &ServiceDatum{AWSClientName: "GuardDuty", AWSServiceName: guardduty.ServiceName, AWSEndpointsID: guardduty.EndpointsID, AWSServiceID: guardduty.ServiceID, ProviderNameUpper: "GuardDuty", HCLKeys: []string{"guardduty"}}
 	serviceData[Health] = &ServiceDatum{AWSClientName: "Health", AWSServiceName: health.ServiceName, AWSEndpointsID: health.EndpointsID, AWSServiceID: health.ServiceID, ProviderNameUpper: "Health", HCLKeys: []string{"health"}}
 	serviceData[HealthLake] = &ServiceDatum{AWSClientName: "HealthLake", AWSServiceName: healthlake.ServiceName, AWSEndpointsID: healthlake.EndpointsID, AWSServiceID: healthlake.ServiceID, ProviderNameUpper: "HealthLake", HCLKeys: []string{"healthlake"}}
-	serviceData[Honeycode] = &ServiceDatum{AWSClientName: "Honeycode", AWSServiceName: honeycode.ServiceName, AWSEndpointsID: honeycode.EndpointsID, AWSServiceID: honeycode.ServiceID, ProviderNameUpper: "Honeycode", HCLKeys: []string{"honeycode"}}
 	serviceData[IAM] = &ServiceDatum{AWSClientName: "IAM", AWSServiceName: iam.ServiceName, AWSEndpointsID: iam.EndpointsID, AWSServiceID: iam.ServiceID, ProviderNameUpper: "IAM", HCLKeys: []string{"iam"}}
 	serviceData[IdentityStore] = &ServiceDatum{AWSClientName: "IdentityStore", AWSServiceName: identitystore.ServiceName, AWSEndpointsID: identitystore.EndpointsID, AWSServiceID: identitystore.ServiceID, ProviderNameUpper: "IdentityStore", HCLKeys: []string{"identitystore"}}
 	serviceData[ImageBuilder] = &ServiceDatum{AWSClientName: "ImageBuilder", AWSServiceName: imagebuilder.ServiceName, AWSEndpointsID: imagebuilder.EndpointsID, AWSServiceID: imagebuilder.ServiceID, ProviderNameUpper: "ImageBuilder", HCLKeys: []string{"imagebuilder"}}
@@ -738,7 +763,6 @@ func init() {
 	serviceData[LookoutForVision] = &ServiceDatum{AWSClientName: "LookoutForVision", AWSServiceName: lookoutforvision.ServiceName, AWSEndpointsID: lookoutforvision.EndpointsID, AWSServiceID: lookoutforvision.ServiceID, ProviderNameUpper: "LookoutForVision", HCLKeys: []string{"lookoutforvision"}}
 	serviceData[LookoutMetrics] = &ServiceDatum{AWSClientName: "LookoutMetrics", AWSServiceName: lookoutmetrics.ServiceName, AWSEndpointsID: lookoutmetrics.EndpointsID, AWSServiceID: lookoutmetrics.ServiceID, ProviderNameUpper: "LookoutMetrics", HCLKeys: []string{"lookoutmetrics"}}
 	serviceData[MachineLearning] = &ServiceDatum{AWSClientName: "MachineLearning", AWSServiceName: machinelearning.ServiceName, AWSEndpointsID: machinelearning.EndpointsID, AWSServiceID: machinelearning.ServiceID, ProviderNameUpper: "MachineLearning", HCLKeys: []string{"machinelearning"}}
-	serviceData[Macie] = &ServiceDatum{AWSClientName: "Macie", AWSServiceName: macie.ServiceName, AWSEndpointsID: macie.EndpointsID, AWSServiceID: macie.ServiceID, ProviderNameUpper: "Macie", HCLKeys: []string{"macie"}}
 	serviceData[Macie2] = &ServiceDatum{AWSClientName: "Macie2", AWSServiceName: macie2.ServiceName, AWSEndpointsID: macie2.EndpointsID, AWSServiceID: macie2.ServiceID, ProviderNameUpper: "Macie2", HCLKeys: []string{"macie2"}}
 	serviceData[ManagedBlockchain] = &ServiceDatum{AWSClientName: "ManagedBlockchain", AWSServiceName: managedblockchain.ServiceName, AWSEndpointsID: managedblockchain.EndpointsID, AWSServiceID: managedblockchain.ServiceID, ProviderNameUpper: "ManagedBlockchain", HCLKeys: []string{"managedblockchain"}}
 	serviceData[MarketplaceCatalog] = &ServiceDatum{AWSClientName: "MarketplaceCatalog", AWSServiceName: marketplacecatalog.ServiceName, AWSEndpointsID: marketplacecatalog.EndpointsID, AWSServiceID: marketplacecatalog.ServiceID, ProviderNameUpper: "MarketplaceCatalog", HCLKeys: []string{"marketplacecatalog"}}
@@ -757,7 +781,6 @@ func init() {
 	serviceData[Mgn] = &ServiceDatum{AWSClientName: "Mgn", AWSServiceName: mgn.ServiceName, AWSEndpointsID: mgn.EndpointsID, AWSServiceID: mgn.ServiceID, ProviderNameUpper: "Mgn", HCLKeys: []string{"mgn"}}
 	serviceData[MigrationHub] = &ServiceDatum{AWSClientName: "MigrationHub", AWSServiceName: migrationhub.ServiceName, AWSEndpointsID: migrationhub.EndpointsID, AWSServiceID: migrationhub.ServiceID, ProviderNameUpper: "MigrationHub", HCLKeys: []string{"migrationhub"}}
 	serviceData[MigrationHubConfig] = &ServiceDatum{AWSClientName: "MigrationHubConfig", AWSServiceName: migrationhubconfig.ServiceName, AWSEndpointsID: migrationhubconfig.EndpointsID, AWSServiceID: migrationhubconfig.ServiceID, ProviderNameUpper: "MigrationHubConfig", HCLKeys: []string{"migrationhubconfig"}}
-	serviceData[Mobile] = &ServiceDatum{AWSClientName: "Mobile", AWSServiceName: mobile.ServiceName, AWSEndpointsID: mobile.EndpointsID, AWSServiceID: mobile.ServiceID, ProviderNameUpper: "Mobile", HCLKeys: []string{"mobile"}}
 	serviceData[MobileAnalytics] = &ServiceDatum{AWSClientName: "MobileAnalytics", AWSServiceName: mobileanalytics.ServiceName, AWSEndpointsID: mobileanalytics.EndpointsID, AWSServiceID: mobileanalytics.ServiceID, ProviderNameUpper: "MobileAnalytics", HCLKeys: []string{"mobileanalytics"}}
 	serviceData[MQ] = &ServiceDatum{AWSClientName: "MQ", AWSServiceName: mq.ServiceName, AWSEndpointsID: mq.EndpointsID, AWSServiceID: mq.ServiceID, ProviderNameUpper: "MQ", HCLKeys: []string{"mq"}}
 	serviceData[MTurk] = &ServiceDatum{AWSClientName: "MTurk", AWSServiceName: mturk.ServiceName, AWSEndpointsID: mturk.EndpointsID, AWSServiceID: mturk.ServiceID, ProviderNameUpper: "MTurk", HCLKeys: []string{"mturk"}}
@@ -766,6 +789,8 @@ func init() {
 	serviceData[NetworkFirewall] = &ServiceDatum{AWSClientName: "NetworkFirewall", AWSServiceName: networkfirewall.ServiceName, AWSEndpointsID: networkfirewall.EndpointsID, AWSServiceID: networkfirewall.ServiceID, ProviderNameUpper: "NetworkFirewall", HCLKeys: []string{"networkfirewall"}}
 	serviceData[NetworkManager] = &ServiceDatum{AWSClientName: "NetworkManager", AWSServiceName: networkmanager.ServiceName, AWSEndpointsID: networkmanager.EndpointsID, AWSServiceID: networkmanager.ServiceID, ProviderNameUpper: "NetworkManager", HCLKeys: []string{"networkmanager"}}
 	serviceData[NimbleStudio] = &ServiceDatum{AWSClientName: "NimbleStudio", AWSServiceName: nimblestudio.ServiceName, AWSEndpointsID: nimblestudio.EndpointsID, AWSServiceID: nimblestudio.ServiceID, ProviderNameUpper: "NimbleStudio", HCLKeys: []string{"nimblestudio"}}
+	serviceData[OpenSearch] = &ServiceDatum{AWSClientName: "OpenSearchService", AWSServiceName: opensearchservice.ServiceName, AWSEndpointsID: opensearchservice.EndpointsID, AWSServiceID: opensearchservice.ServiceID, ProviderNameUpper: "OpenSearch", HCLKeys: []string{"opensearch", "opensearchservice"}}
+	serviceData[OpenSearchServerless] = &ServiceDatum{AWSClientName: "OpenSearchServerless", AWSServiceName: opensearchserverless.ServiceName, AWSEndpointsID: opensearchserverless.EndpointsID, AWSServiceID: opensearchserverless.ServiceID, ProviderNameUpper: "OpenSearchServerless", HCLKeys: []string{"opensearchserverless"}}
 	serviceData[OpsWorks] = &ServiceDatum{AWSClientName: "OpsWorks", AWSServiceName: opsworks.ServiceName, AWSEndpointsID: opsworks.EndpointsID, AWSServiceID: opsworks.ServiceID, ProviderNameUpper: "OpsWorks", HCLKeys: []string{"opsworks"}}
 	serviceData[OpsWorksCM] = &ServiceDatum{AWSClientName: "OpsWorksCM", AWSServiceName: opsworkscm.ServiceName, AWSEndpointsID: opsworkscm.EndpointsID, AWSServiceID: opsworkscm.ServiceID, ProviderNameUpper: "OpsWorksCM", HCLKeys: []string{"opsworkscm"}}
 	serviceData[Organizations] = &ServiceDatum{AWSClientName: "Organizations", AWSServiceName: organizations.ServiceName, AWSEndpointsID: organizations.EndpointsID, AWSServiceID: organizations.ServiceID, ProviderNameUpper: "Organizations", HCLKeys: []string{"organizations"}}
@@ -777,6 +802,7 @@ func init() {
 	serviceData[Pinpoint] = &ServiceDatum{AWSClientName: "Pinpoint", AWSServiceName: pinpoint.ServiceName, AWSEndpointsID: pinpoint.EndpointsID, AWSServiceID: pinpoint.ServiceID, ProviderNameUpper: "Pinpoint", HCLKeys: []string{"pinpoint"}}
 	serviceData[PinpointEmail] = &ServiceDatum{AWSClientName: "PinpointEmail", AWSServiceName: pinpointemail.ServiceName, AWSEndpointsID: pinpointemail.EndpointsID, AWSServiceID: pinpointemail.ServiceID, ProviderNameUpper: "PinpointEmail", HCLKeys: []string{"pinpointemail"}}
 	serviceData[PinpointSMSVoice] = &ServiceDatum{AWSClientName: "PinpointSMSVoice", AWSServiceName: pinpointsmsvoice.ServiceName, AWSEndpointsID: pinpointsmsvoice.EndpointsID, AWSServiceID: pinpointsmsvoice.ServiceID, ProviderNameUpper: "PinpointSMSVoice", HCLKeys: []string{"pinpointsmsvoice"}}
+	serviceData[Pipes] = &ServiceDatum{AWSClientName: "Pipes", AWSServiceName: pipes.ServiceName, AWSEndpointsID: pipes.EndpointsID, AWSServiceID: pipes.ServiceID, ProviderNameUpper: "Pipes", HCLKeys: []string{"pipes"}}
 	serviceData[Polly] = &ServiceDatum{AWSClientName: "Polly", AWSServiceName: polly.ServiceName, AWSEndpointsID: polly.EndpointsID, AWSServiceID: polly.ServiceID, ProviderNameUpper: "Polly", HCLKeys: []string{"polly"}}
 	serviceData[Pricing] = &ServiceDatum{AWSClientName: "Pricing", AWSServiceName: pricing.ServiceName, AWSEndpointsID: pricing.EndpointsID, AWSServiceID: pricing.ServiceID, ProviderNameUpper: "Pricing", HCLKeys: []string{"pricing"}}
 	serviceData[Proton] = &ServiceDatum{AWSClientName: "Proton", AWSServiceName: proton.ServiceName, AWSEndpointsID: proton.EndpointsID, AWSServiceID: proton.ServiceID, ProviderNameUpper: "Proton", HCLKeys: []string{"proton"}}
@@ -788,12 +814,14 @@ func init() {
 	serviceData[RDSData] = &ServiceDatum{AWSClientName: "RDSDataService", AWSServiceName: rdsdataservice.ServiceName, AWSEndpointsID: rdsdataservice.EndpointsID, AWSServiceID: rdsdataservice.ServiceID, ProviderNameUpper: "RDSData", HCLKeys: []string{"rdsdata", "rdsdataservice"}}
 	serviceData[Redshift] = &ServiceDatum{AWSClientName: "Redshift", AWSServiceName: redshift.ServiceName, AWSEndpointsID: redshift.EndpointsID, AWSServiceID: redshift.ServiceID, ProviderNameUpper: "Redshift", HCLKeys: []string{"redshift"}}
 	serviceData[RedshiftData] = &ServiceDatum{AWSClientName: "RedshiftData", AWSServiceName: redshiftdataapiservice.ServiceName, AWSEndpointsID: redshiftdataapiservice.EndpointsID, AWSServiceID: redshiftdataapiservice.ServiceID, ProviderNameUpper: "RedshiftData", HCLKeys: []string{"redshiftdata"}}
+	serviceData[RedshiftServerless] = &ServiceDatum{AWSClientName: "RedshiftServerless", AWSServiceName: redshiftserverless.ServiceName, AWSEndpointsID: redshiftserverless.EndpointsID, AWSServiceID: redshiftserverless.ServiceID, ProviderNameUpper: "RedshiftServerless", HCLKeys: []string{"redshiftserverless"}}
 	serviceData[Rekognition] = &ServiceDatum{AWSClientName: "Rekognition", AWSServiceName: rekognition.ServiceName, AWSEndpointsID: rekognition.EndpointsID, AWSServiceID: rekognition.ServiceID, ProviderNameUpper: "Rekognition", HCLKeys: []string{"rekognition"}}
 	serviceData[ResourceGroups] = &ServiceDatum{AWSClientName: "ResourceGroups", AWSServiceName: resourcegroups.ServiceName, AWSEndpointsID: resourcegroups.EndpointsID, AWSServiceID: resourcegroups.ServiceID, ProviderNameUpper: "ResourceGroups", HCLKeys: []string{"resourcegroups"}}
 	serviceData[ResourceGroupsTaggingAPI] = &ServiceDatum{AWSClientName: "ResourceGroupsTaggingAPI", AWSServiceName: resourcegroupstaggingapi.ServiceName, AWSEndpointsID: resourcegroupstaggingapi.EndpointsID, AWSServiceID: resourcegroupstaggingapi.ServiceID, ProviderNameUpper: "ResourceGroupsTaggingAPI", HCLKeys: []string{"resourcegroupstaggingapi", "resourcegroupstagging"}}
 	serviceData[RoboMaker] = &ServiceDatum{AWSClientName: "RoboMaker", AWSServiceName: robomaker.ServiceName, AWSEndpointsID: robomaker.EndpointsID, AWSServiceID: robomaker.ServiceID, ProviderNameUpper: "RoboMaker", HCLKeys: []string{"robomaker"}}
 	serviceData[Route53] = &ServiceDatum{AWSClientName: "Route53", AWSServiceName: route53.ServiceName, AWSEndpointsID: route53.EndpointsID, AWSServiceID: route53.ServiceID, ProviderNameUpper: "Route53", HCLKeys: []string{"route53"}}
 	serviceData[Route53Domains] = &ServiceDatum{AWSClientName: "Route53Domains", AWSServiceName: route53domains.ServiceName, AWSEndpointsID: route53domains.EndpointsID, AWSServiceID: route53domains.ServiceID, ProviderNameUpper: "Route53Domains", HCLKeys: []string{"route53domains"}}
+	serviceData[Route53Profiles] = &ServiceDatum{AWSClientName: "Route53Profiles", AWSServiceName: route53profiles.ServiceName, AWSEndpointsID: route53profiles.EndpointsID, AWSServiceID: route53profiles.ServiceID, ProviderNameUpper: "Route53Profiles", HCLKeys: []string{"route53profiles"}}
 	serviceData[Route53RecoveryControlConfig] = &ServiceDatum{AWSClientName: "Route53RecoveryControlConfig", AWSServiceName: route53recoverycontrolconfig.ServiceName, AWSEndpointsID: route53recoverycontrolconfig.EndpointsID, AWSServiceID: route53recoverycontrolconfig.ServiceID, ProviderNameUpper: "Route53RecoveryControlConfig", HCLKeys: []string{"route53recoverycontrolconfig"}}
 	serviceData[Route53RecoveryReadiness] = &ServiceDatum{AWSClientName: "Route53RecoveryReadiness", AWSServiceName: route53recoveryreadiness.ServiceName, AWSEndpointsID: route53recoveryreadiness.EndpointsID, AWSServiceID: route53recoveryreadiness.ServiceID, ProviderNameUpper: "Route53RecoveryReadiness", HCLKeys: []string{"route53recoveryreadiness"}}
 	serviceData[Route53Resolver] = &ServiceDatum{AWSClientName: "Route53Resolver", AWSServiceName: route53resolver.ServiceName, AWSEndpointsID: route53resolver.EndpointsID, AWSServiceID: route53resolver.ServiceID, ProviderNameUpper: "Route53Resolver", HCLKeys: []string{"route53resolver"}}
@@ -805,9 +833,11 @@ func init() {
 	serviceData[SageMakerFeatureStoreRuntime] = &ServiceDatum{AWSClientName: "SageMakerFeatureStoreRuntime", AWSServiceName: sagemakerfeaturestoreruntime.ServiceName, AWSEndpointsID: sagemakerfeaturestoreruntime.EndpointsID, AWSServiceID: sagemakerfeaturestoreruntime.ServiceID, ProviderNameUpper: "SageMakerFeatureStoreRuntime", HCLKeys: []string{"sagemakerfeaturestoreruntime"}}
 	serviceData[SageMakerRuntime] = &ServiceDatum{AWSClientName: "SageMakerRuntime", AWSServiceName: sagemakerruntime.ServiceName, AWSEndpointsID: sagemakerruntime.EndpointsID, AWSServiceID: sagemakerruntime.ServiceID, ProviderNameUpper: "SageMakerRuntime", HCLKeys: []string{"sagemakerruntime"}}
 	serviceData[SavingsPlans] = &ServiceDatum{AWSClientName: "SavingsPlans", AWSServiceName: savingsplans.ServiceName, AWSEndpointsID: savingsplans.EndpointsID, AWSServiceID: savingsplans.ServiceID, ProviderNameUpper: "SavingsPlans", HCLKeys: []string{"savingsplans"}}
+	serviceData[Scheduler] = &ServiceDatum{AWSClientName: "Scheduler", AWSServiceName: scheduler.ServiceName, AWSEndpointsID: scheduler.EndpointsID, AWSServiceID: scheduler.ServiceID, ProviderNameUpper: "Scheduler", HCLKeys: []string{"scheduler"}}
 	serviceData[Schemas] = &ServiceDatum{AWSClientName: "Schemas", AWSServiceName: schemas.ServiceName, AWSEndpointsID: schemas.EndpointsID, AWSServiceID: schemas.ServiceID, ProviderNameUpper: "Schemas", HCLKeys: []string{"schemas"}}
 	serviceData[SecretsManager] = &ServiceDatum{AWSClientName: "SecretsManager", AWSServiceName: secretsmanager.ServiceName, AWSEndpointsID: secretsmanager.EndpointsID, AWSServiceID: secretsmanager.ServiceID, ProviderNameUpper: "SecretsManager", HCLKeys: []string{"secretsmanager"}}
 	serviceData[SecurityHub] = &ServiceDatum{AWSClientName: "SecurityHub", AWSServiceName: securityhub.ServiceName, AWSEndpointsID: securityhub.EndpointsID, AWSServiceID: securityhub.ServiceID, ProviderNameUpper: "SecurityHub", HCLKeys: []string{"securityhub"}}
+	serviceData[SecurityLake] = &ServiceDatum{AWSClientName: "SecurityLake", AWSServiceName: securitylake.ServiceName, AWSEndpointsID: securitylake.EndpointsID, AWSServiceID: securitylake.ServiceID, ProviderNameUpper: "SecurityLake", HCLKeys: []string{"securitylake"}}
 	serviceData[ServerlessRepo] = &ServiceDatum{AWSClientName: "ServerlessApplicationRepository", AWSServiceName: serverlessapplicationrepository.ServiceName, AWSEndpointsID: serverlessapplicationrepository.EndpointsID, AWSServiceID: serverlessapplicationrepository.ServiceID, ProviderNameUpper: "ServerlessRepo", HCLKeys: []string{"serverlessrepo", "serverlessapprepo", "serverlessapplicationrepository"}}
 	serviceData[ServiceCatalog] = &ServiceDatum{AWSClientName: "ServiceCatalog", AWSServiceName: servicecatalog.ServiceName, AWSEndpointsID: servicecatalog.EndpointsID, AWSServiceID: servicecatalog.ServiceID, ProviderNameUpper: "ServiceCatalog", HCLKeys: []string{"servicecatalog"}}
 	serviceData[ServiceDiscovery] = &ServiceDatum{AWSClientName: "ServiceDiscovery", AWSServiceName: servicediscovery.ServiceName, AWSEndpointsID: servicediscovery.EndpointsID, AWSServiceID: servicediscovery.ServiceID, ProviderNameUpper: "ServiceDiscovery", HCLKeys: []string{"servicediscovery"}}
@@ -840,6 +870,8 @@ func init() {
 	serviceData[TranscribeStreaming] = &ServiceDatum{AWSClientName: "TranscribeStreamingService", AWSServiceName: transcribestreamingservice.ServiceName, AWSEndpointsID: transcribestreamingservice.EndpointsID, AWSServiceID: transcribestreamingservice.ServiceID, ProviderNameUpper: "TranscribeStreaming", HCLKeys: []string{"transcribestreaming", "transcribestreamingservice"}}
 	serviceData[Transfer] = &ServiceDatum{AWSClientName: "Transfer", AWSServiceName: transfer.ServiceName, AWSEndpointsID: transfer.EndpointsID, AWSServiceID: transfer.ServiceID, ProviderNameUpper: "Transfer", HCLKeys: []string{"transfer"}}
 	serviceData[Translate] = &ServiceDatum{AWSClientName: "Translate", AWSServiceName: translate.ServiceName, AWSEndpointsID: translate.EndpointsID, AWSServiceID: translate.ServiceID, ProviderNameUpper: "Translate", HCLKeys: []string{"translate"}}
+	serviceData[VerifiedPermissions] = &ServiceDatum{AWSClientName: "VerifiedPermissions", AWSServiceName: verifiedpermissions.ServiceName, AWSEndpointsID: verifiedpermissions.EndpointsID, AWSServiceID: verifiedpermissions.ServiceID, ProviderNameUpper: "VerifiedPermissions", HCLKeys: []string{"verifiedpermissions"}}
+	serviceData[VPCLattice] = &ServiceDatum{AWSClientName: "VPCLattice", AWSServiceName: vpclattice.ServiceName, AWSEndpointsID: vpclattice.EndpointsID, AWSServiceID: vpclattice.ServiceID, ProviderNameUpper: "VPCLattice", HCLKeys: []string{"vpclattice"}}
 	serviceData[WAF] = &ServiceDatum{AWSClientName: "WAF", AWSServiceName: waf.ServiceName, AWSEndpointsID: waf.EndpointsID, AWSServiceID: waf.ServiceID, ProviderNameUpper: "WAF", HCLKeys: []string{"waf"}}
 	serviceData[WAFRegional] = &ServiceDatum{AWSClientName: "WAFRegional", AWSServiceName: wafregional.ServiceName, AWSEndpointsID: wafregional.EndpointsID, AWSServiceID: wafregional.ServiceID, ProviderNameUpper: "WAFRegional", HCLKeys: []string{"wafregional"}}
 	serviceData[WAFV2] = &ServiceDatum{AWSClientName: "WAFV2", AWSServiceName: wafv2.ServiceName, AWSEndpointsID: wafv2.EndpointsID, AWSServiceID: wafv2.ServiceID, ProviderNameUpper: "WAFV2", HCLKeys: []string{"wafv2"}}
@@ -853,34 +885,44 @@ func init() {
 }
 
 type Config struct {
-	AccessKey                      string
-	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
-	DefaultTagsConfig              *tftags.DefaultConfig
-	EC2MetadataServiceEndpoint     string
-	EC2MetadataServiceEndpointMode string
-	Endpoints                      map[string]string
-	ForbiddenAccountIds            []string
-	HTTPProxy                      string
-	IgnoreTagsConfig               *tftags.IgnoreConfig
-	Insecure                       bool
-	MaxRetries                     int
-	Profile                        string
-	Region                         string
-	S3ForcePathStyle               bool
-	SecretKey                      string
-	SharedConfigFile               string
-	SharedCredentialsFile          string
-	SkipCredsValidation            bool
-	SkipGetEC2Platforms            bool
-	SkipMetadataApiCheck           bool
-	SkipRegionValidation           bool
-	SkipRequestingAccountId        bool
-	STSRegion                      string
-	TerraformVersion               string
-	Token                          string
-	UseDualStackEndpoint           bool
-	UseFIPSEndpoint                bool
+	AccessKey                        string
+	AllowedAccountIds                []string
+	AssumeRole                       *awsbase.AssumeRole
+	AssumeRoleChain                  []*awsbase.AssumeRole
+	DefaultTagsConfig                *tftags.DefaultConfig
+	EC2MetadataServiceEndpoint       string
+	EC2MetadataServiceEndpointMode   string
+	Endpoints                        map[string]string
+	ForbiddenAccountIds              []string
+	HTTPProxy                        string
+	IgnoreTagsConfig                 *tftags.IgnoreConfig
+	Insecure                         bool
+	Logging                          *LoggingConfig
+	MaxRetries                       int
+	PreflightChecks                  bool
+	Profile                          string
+	Region                           string
+	RetryMode                        string
+	Route53ChangeBatchWindow         time.Duration
+	S3BucketLockTimeout              time.Duration
+	S3ForcePathStyle                 bool
+	S3SkipEventualConsistencyRetries bool
+	SecretKey                        string
+	ServiceMaxRetries                map[string]int
+	SharedConfigFile                 string
+	SharedCredentialsFile            string
+	SkipCredsValidation              bool
+	SkipGetEC2Platforms              bool
+	SkipMetadataApiCheck             bool
+	SkipRegionValidation             bool
+	SkipRequestingAccountId          bool
+	SSO                              *SSOConfig
+	STSRegion                        string
+	TagsPropagationTimeouts          map[string]time.Duration
+	TerraformVersion                 string
+	Token                            string
+	UseDualStackEndpoint             bool
+	UseFIPSEndpoint                  bool
 }
 
 type AWSClient struct {
@@ -889,7 +931,6 @@ type AWSClient struct {
 	AccountID                         string
 	ACMConn                           *acm.ACM
 	ACMPCAConn                        *acmpca.ACMPCA
-	AlexaForBusinessConn              *alexaforbusiness.AlexaForBusiness
 	AMPConn                           *prometheusservice.PrometheusService
 	AmplifyBackendConn                *amplifybackend.AmplifyBackend
 	AmplifyConn                       *amplify.Amplify
@@ -912,11 +953,15 @@ type AWSClient struct {
 	AugmentedAIRuntimeConn            *augmentedairuntime.AugmentedAIRuntime
 	AutoScalingConn                   *autoscaling.AutoScaling
 	AutoScalingPlansConn              *autoscalingplans.AutoScalingPlans
+	B2BIConn                          *b2bi.B2bi
 	BackupConn                        *backup.Backup
 	BatchConn                         *batch.Batch
+	BedrockConn                       *bedrock.Bedrock
+	BedrockAgentConn                  *bedrockagent.BedrockAgent
 	BraketConn                        *braket.Braket
 	BudgetsConn                       *budgets.Budgets
 	ChimeConn                         *chime.Chime
+	CleanRoomsConn                    *cleanrooms.CleanRooms
 	Cloud9Conn                        *cloud9.Cloud9
 	CloudControlConn                  *cloudcontrolapi.CloudControlApi
 	CloudDirectoryConn                *clouddirectory.CloudDirectory
@@ -952,6 +997,7 @@ type AWSClient struct {
 	DataExchangeConn                  *dataexchange.DataExchange
 	DataPipelineConn                  *datapipeline.DataPipeline
 	DataSyncConn                      *datasync.DataSync
+	DataZoneConn                      *datazone.DataZone
 	DAXConn                           *dax.DAX
 	DefaultTagsConfig                 *tftags.DefaultConfig
 	DetectiveConn                     *detective.Detective
@@ -1003,7 +1049,6 @@ type AWSClient struct {
 	GuardDutyConn                     *guardduty.GuardDuty
 	HealthConn                        *health.Health
 	HealthLakeConn                    *healthlake.HealthLake
-	HoneycodeConn                     *honeycode.Honeycode
 	IAMConn                           *iam.IAM
 	IdentityStoreConn                 *identitystore.IdentityStore
 	IgnoreTagsConfig                  *tftags.IgnoreConfig
@@ -1048,7 +1093,6 @@ type AWSClient struct {
 	LookoutMetricsConn                *lookoutmetrics.LookoutMetrics
 	MachineLearningConn               *machinelearning.MachineLearning
 	Macie2Conn                        *macie2.Macie2
-	MacieConn                         *macie.Macie
 	ManagedBlockchainConn             *managedblockchain.ManagedBlockchain
 	MarketplaceCatalogConn            *marketplacecatalog.MarketplaceCatalog
 	MarketplaceCommerceAnalyticsConn  *marketplacecommerceanalytics.MarketplaceCommerceAnalytics
@@ -1068,7 +1112,6 @@ type AWSClient struct {
 	MigrationHubConfigConn            *migrationhubconfig.MigrationHubConfig
 	MigrationHubConn                  *migrationhub.MigrationHub
 	MobileAnalyticsConn               *mobileanalytics.MobileAnalytics
-	MobileConn                        *mobile.Mobile
 	MQConn                            *mq.MQ
 	MTurkConn                         *mturk.MTurk
 	MWAAConn                          *mwaa.MWAA
@@ -1076,6 +1119,8 @@ type AWSClient struct {
 	NetworkFirewallConn               *networkfirewall.NetworkFirewall
 	NetworkManagerConn                *networkmanager.NetworkManager
 	NimbleStudioConn                  *nimblestudio.NimbleStudio
+	OpenSearchConn                    *opensearchservice.OpenSearchService
+	OpenSearchServerlessConn          *opensearchserverless.OpenSearchServerless
 	OpsWorksCMConn                    *opsworkscm.OpsWorksCM
 	OpsWorksConn                      *opsworks.OpsWorks
 	OrganizationsConn                 *organizations.Organizations
@@ -1088,7 +1133,9 @@ type AWSClient struct {
 	PinpointConn                      *pinpoint.Pinpoint
 	PinpointEmailConn                 *pinpointemail.PinpointEmail
 	PinpointSMSVoiceConn              *pinpointsmsvoice.PinpointSMSVoice
+	PipesConn                         *pipes.Pipes
 	PollyConn                         *polly.Polly
+	PreflightChecks                   bool
 	PricingConn                       *pricing.Pricing
 	ProtonConn                        *proton.Proton
 	QLDBConn                          *qldb.QLDB
@@ -1099,19 +1146,25 @@ type AWSClient struct {
 	RDSDataConn                       *rdsdataservice.RDSDataService
 	RedshiftConn                      *redshift.Redshift
 	RedshiftDataConn                  *redshiftdataapiservice.RedshiftDataAPIService
+	RedshiftServerlessConn            *redshiftserverless.RedshiftServerless
 	Region                            string
 	RekognitionConn                   *rekognition.Rekognition
 	ResourceGroupsConn                *resourcegroups.ResourceGroups
 	ResourceGroupsTaggingAPIConn      *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 	ReverseDNSPrefix                  string
 	RoboMakerConn                     *robomaker.RoboMaker
+	Route53ChangeBatchWindow          time.Duration
 	Route53Conn                       *route53.Route53
 	Route53DomainsConn                *route53domains.Route53Domains
+	Route53ProfilesConn               *route53profiles.Route53Profiles
 	Route53RecoveryControlConfigConn  *route53recoverycontrolconfig.Route53RecoveryControlConfig
 	Route53RecoveryReadinessConn      *route53recoveryreadiness.Route53RecoveryReadiness
 	Route53ResolverConn               *route53resolver.Route53Resolver
+	S3BucketLockTimeout               time.Duration
+	S3SkipEventualConsistencyRetries  bool
 	S3Conn                            *s3.S3
 	S3ConnURICleaningDisabled         *s3.S3
+	S3ExpressConn                     *s3.S3
 	S3ControlConn                     *s3control.S3Control
 	S3OutpostsConn                    *s3outposts.S3Outposts
 	SageMakerConn                     *sagemaker.SageMaker
@@ -1119,9 +1172,11 @@ type AWSClient struct {
 	SageMakerFeatureStoreRuntimeConn  *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime
 	SageMakerRuntimeConn              *sagemakerruntime.SageMakerRuntime
 	SavingsPlansConn                  *savingsplans.SavingsPlans
+	SchedulerConn                     *scheduler.Scheduler
 	SchemasConn                       *schemas.Schemas
 	SecretsManagerConn                *secretsmanager.SecretsManager
 	SecurityHubConn                   *securityhub.SecurityHub
+	SecurityLakeConn                  *securitylake.SecurityLake
 	ServerlessRepoConn                *serverlessapplicationrepository.ServerlessApplicationRepository
 	ServiceCatalogConn                *servicecatalog.ServiceCatalog
 	ServiceDiscoveryConn              *servicediscovery.ServiceDiscovery
@@ -1148,6 +1203,7 @@ type AWSClient struct {
 	SupportedPlatforms                []string
 	SWFConn                           *swf.SWF
 	SyntheticsConn                    *synthetics.Synthetics
+	TagsPropagationTimeouts           map[string]time.Duration
 	TerraformVersion                  string
 	TextractConn                      *textract.Textract
 	TimestreamQueryConn               *timestreamquery.TimestreamQuery
@@ -1156,6 +1212,8 @@ type AWSClient struct {
 	TranscribeStreamingConn           *transcribestreamingservice.TranscribeStreamingService
 	TransferConn                      *transfer.Transfer
 	TranslateConn                     *translate.Translate
+	VerifiedPermissionsConn           *verifiedpermissions.VerifiedPermissions
+	VPCLatticeConn                    *vpclattice.VPCLattice
 	WAFConn                           *waf.WAF
 	WAFRegionalConn                   *wafregional.WAFRegional
 	WAFV2Conn                         *wafv2.WAFV2
@@ -1182,6 +1240,39 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
 
+// GlobalARN returns an ARN for a resource of a global (non-regional) service in the client's partition and account
+// e.g. arn:aws:iam::123456789012:RESOURCE
+func (client *AWSClient) GlobalARN(service, resource string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   service,
+		AccountID: client.AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// RegionalARN returns an ARN for a resource in the client's partition, region and account
+// e.g. arn:aws:glue:us-west-2:123456789012:RESOURCE
+func (client *AWSClient) RegionalARN(service, resource string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   service,
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// TagsPropagationTimeout returns how long to wait for tags applied on resource creation in the specified service to propagate,
+// either the timeout configured for the service or defaultTimeout.
+func (client *AWSClient) TagsPropagationTimeout(service string, defaultTimeout time.Duration) time.Duration {
+	if v, ok := client.TagsPropagationTimeouts[service]; ok {
+		return v
+	}
+
+	return defaultTimeout
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -1220,6 +1311,10 @@ func (c *Config) Client() (interface{}, error) {
 
 	if c.EC2MetadataServiceEndpoint != "" {
 		awsbaseConfig.EC2MetadataServiceEndpoint = c.EC2MetadataServiceEndpoint
+	}
+
+	// The endpoint mode selects the default IPv4 or IPv6 endpoint when no endpoint is configured.
+	if c.EC2MetadataServiceEndpointMode != "" {
 		awsbaseConfig.EC2MetadataServiceEndpointMode = c.EC2MetadataServiceEndpointMode
 	}
 
@@ -1231,10 +1326,19 @@ func (c *Config) Client() (interface{}, error) {
 		awsbaseConfig.SharedCredentialsFiles = []string{c.SharedCredentialsFile}
 	}
 
+	var ssoConfig *SSOConfig
+
+	if c.SSO != nil {
+		v := *c.SSO
+		v.Endpoint = c.Endpoints[SSO]
+		v.OIDCEndpoint = c.Endpoints[SSOOIDC]
+		ssoConfig = &v
+	}
+
 	ctx := context.Background()
-	cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
+	cfg, accountID, Partition, err := getCachedAwsConfig(ctx, &awsbaseConfig, c.AssumeRoleChain, ssoConfig)
 	if err != nil {
-		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		return nil, err
 	}
 
 	sess, err := awsbasev1.GetSession(&cfg, &awsbaseConfig)
@@ -1242,11 +1346,30 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, fmt.Errorf("error creating AWS SDK v1 session: %w", err)
 	}
 
-	accountID, Partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving account details: %w", err)
+	// The session is created with a static copy of the credentials.
+	// IAM Identity Center role credentials and chained role sessions, which AWS limits to one hour,
+	// expire during long applies, so share the refreshing provider instead.
+	if c.SSO != nil || len(c.AssumeRoleChain) > 0 {
+		sess.Config.Credentials = newV1Credentials(cfg.Credentials)
 	}
 
+	if err := instrumentHandlers(ctx, &sess.Handlers); err != nil {
+		return nil, err
+	}
+
+	if err := configureLoggingHandlers(&sess.Handlers, c.Logging); err != nil {
+		return nil, err
+	}
+
+	serviceMaxRetries := make(map[string]int, len(c.ServiceMaxRetries))
+	for serviceKey, maxRetries := range c.ServiceMaxRetries {
+		if v, ok := serviceData[serviceKey]; ok {
+			serviceMaxRetries[v.AWSServiceName] = maxRetries
+		}
+	}
+
+	configureRetryHandlers(&sess.Handlers, c.RetryMode, serviceMaxRetries)
+
 	if accountID == "" {
 		log.Println("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -1282,7 +1405,6 @@ func (c *Config) Client() (interface{}, error) {
 		AccountID:                         accountID,
 		ACMConn:                           acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACM])})),
 		ACMPCAConn:                        acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACMPCA])})),
-		AlexaForBusinessConn:              alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AlexaForBusiness])})),
 		AMPConn:                           prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AMP])})),
 		AmplifyBackendConn:                amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AmplifyBackend])})),
 		AmplifyConn:                       amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Amplify])})),
@@ -1305,11 +1427,15 @@ func (c *Config) Client() (interface{}, error) {
 		AugmentedAIRuntimeConn:            augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AugmentedAIRuntime])})),
 		AutoScalingConn:                   autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScaling])})),
 		AutoScalingPlansConn:              autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScalingPlans])})),
+		B2BIConn:                          b2bi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[B2BI])})),
 		BackupConn:                        backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Backup])})),
 		BatchConn:                         batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Batch])})),
+		BedrockConn:                       bedrock.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Bedrock])})),
+		BedrockAgentConn:                  bedrockagent.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[BedrockAgent])})),
 		BraketConn:                        braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Braket])})),
 		BudgetsConn:                       budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Budgets])})),
 		ChimeConn:                         chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Chime])})),
+		CleanRoomsConn:                    cleanrooms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CleanRooms])})),
 		Cloud9Conn:                        cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Cloud9])})),
 		CloudControlConn:                  cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudControl])})),
 		CloudDirectoryConn:                clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudDirectory])})),
@@ -1345,6 +1471,7 @@ func (c *Config) Client() (interface{}, error) {
 		DataExchangeConn:                  dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataExchange])})),
 		DataPipelineConn:                  datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataPipeline])})),
 		DataSyncConn:                      datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataSync])})),
+		DataZoneConn:                      datazone.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataZone])})),
 		DAXConn:                           dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DAX])})),
 		DefaultTagsConfig:                 c.DefaultTagsConfig,
 		DetectiveConn:                     detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Detective])})),
@@ -1395,7 +1522,6 @@ func (c *Config) Client() (interface{}, error) {
 		GuardDutyConn:                     guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GuardDuty])})),
 		HealthConn:                        health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Health])})),
 		HealthLakeConn:                    healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[HealthLake])})),
-		HoneycodeConn:                     honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Honeycode])})),
 		IAMConn:                           iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IAM])})),
 		IdentityStoreConn:                 identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IdentityStore])})),
 		IgnoreTagsConfig:                  c.IgnoreTagsConfig,
@@ -1440,7 +1566,6 @@ func (c *Config) Client() (interface{}, error) {
 		LookoutMetricsConn:                lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutMetrics])})),
 		MachineLearningConn:               machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MachineLearning])})),
 		Macie2Conn:                        macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie2])})),
-		MacieConn:                         macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie])})),
 		ManagedBlockchainConn:             managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ManagedBlockchain])})),
 		MarketplaceCatalogConn:            marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCatalog])})),
 		MarketplaceCommerceAnalyticsConn:  marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCommerceAnalytics])})),
@@ -1459,7 +1584,6 @@ func (c *Config) Client() (interface{}, error) {
 		MigrationHubConfigConn:            migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHubConfig])})),
 		MigrationHubConn:                  migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHub])})),
 		MobileAnalyticsConn:               mobileanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MobileAnalytics])})),
-		MobileConn:                        mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mobile])})),
 		MQConn:                            mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MQ])})),
 		MTurkConn:                         mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MTurk])})),
 		MWAAConn:                          mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MWAA])})),
@@ -1467,6 +1591,8 @@ func (c *Config) Client() (interface{}, error) {
 		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
 		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
 		NimbleStudioConn:                  nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NimbleStudio])})),
+		OpenSearchConn:                    opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpenSearch])})),
+		OpenSearchServerlessConn:          opensearchserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpenSearchServerless])})),
 		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorksCM])})),
 		OpsWorksConn:                      opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorks])})),
 		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
@@ -1479,7 +1605,9 @@ func (c *Config) Client() (interface{}, error) {
 		PinpointConn:                      pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pinpoint])})),
 		PinpointEmailConn:                 pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointEmail])})),
 		PinpointSMSVoiceConn:              pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointSMSVoice])})),
+		PipesConn:                         pipes.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pipes])})),
 		PollyConn:                         polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Polly])})),
+		PreflightChecks:                   c.PreflightChecks,
 		PricingConn:                       pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pricing])})),
 		ProtonConn:                        proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Proton])})),
 		QLDBConn:                          qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDB])})),
@@ -1490,16 +1618,21 @@ func (c *Config) Client() (interface{}, error) {
 		RDSDataConn:                       rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDSData])})),
 		RedshiftConn:                      redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Redshift])})),
 		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RedshiftData])})),
+		RedshiftServerlessConn:            redshiftserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RedshiftServerless])})),
 		Region:                            c.Region,
 		RekognitionConn:                   rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Rekognition])})),
 		ResourceGroupsConn:                resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroups])})),
 		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroupsTaggingAPI])})),
 		ReverseDNSPrefix:                  ReverseDNS(DNSSuffix),
 		RoboMakerConn:                     robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RoboMaker])})),
+		Route53ChangeBatchWindow:          c.Route53ChangeBatchWindow,
 		Route53DomainsConn:                route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Domains])})),
+		Route53ProfilesConn:               route53profiles.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Profiles])})),
 		Route53RecoveryControlConfigConn:  route53recoverycontrolconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig])})),
 		Route53RecoveryReadinessConn:      route53recoveryreadiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness])})),
 		Route53ResolverConn:               route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Resolver])})),
+		S3BucketLockTimeout:               c.S3BucketLockTimeout,
+		S3SkipEventualConsistencyRetries:  c.S3SkipEventualConsistencyRetries,
 		S3ControlConn:                     s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Control])})),
 		S3OutpostsConn:                    s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Outposts])})),
 		SageMakerConn:                     sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMaker])})),
@@ -1507,9 +1640,11 @@ func (c *Config) Client() (interface{}, error) {
 		SageMakerFeatureStoreRuntimeConn:  sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerFeatureStoreRuntime])})),
 		SageMakerRuntimeConn:              sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerRuntime])})),
 		SavingsPlansConn:                  savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SavingsPlans])})),
+		SchedulerConn:                     scheduler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Scheduler])})),
 		SchemasConn:                       schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Schemas])})),
 		SecretsManagerConn:                secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecretsManager])})),
 		SecurityHubConn:                   securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecurityHub])})),
+		SecurityLakeConn:                  securitylake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecurityLake])})),
 		ServerlessRepoConn:                serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServerlessRepo])})),
 		ServiceCatalogConn:                servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceCatalog])})),
 		ServiceDiscoveryConn:              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceDiscovery])})),
@@ -1534,6 +1669,7 @@ func (c *Config) Client() (interface{}, error) {
 		SupportConn:                       support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Support])})),
 		SWFConn:                           swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SWF])})),
 		SyntheticsConn:                    synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Synthetics])})),
+		TagsPropagationTimeouts:           c.TagsPropagationTimeouts,
 		TerraformVersion:                  c.TerraformVersion,
 		TextractConn:                      textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Textract])})),
 		TimestreamQueryConn:               timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamQuery])})),
@@ -1542,6 +1678,8 @@ func (c *Config) Client() (interface{}, error) {
 		TranscribeStreamingConn:           transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TranscribeStreaming])})),
 		TransferConn:                      transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transfer])})),
 		TranslateConn:                     translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Translate])})),
+		VerifiedPermissionsConn:           verifiedpermissions.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[VerifiedPermissions])})),
+		VPCLatticeConn:                    vpclattice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[VPCLattice])})),
 		WAFConn:                           waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAF])})),
 		WAFRegionalConn:                   wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFRegional])})),
 		WAFV2Conn:                         wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFV2])})),
@@ -1582,6 +1720,12 @@ func (c *Config) Client() (interface{}, error) {
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
 	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
 
+	// S3 directory buckets are managed through the S3 Express One Zone control plane.
+	client.S3ExpressConn = s3.New(sess.Copy(&aws.Config{
+		EndpointResolver: s3ExpressControlEndpointResolver(c.Endpoints[S3], DNSSuffix),
+		S3ForcePathStyle: aws.Bool(true),
+	}))
+
 	// Force "global" services to correct regions
 	switch Partition {
 	case endpoints.AwsPartitionID:
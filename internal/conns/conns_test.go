@@ -1,10 +1,12 @@
 package conns
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	mockdatav1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/mockdata"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
 )
@@ -83,6 +85,103 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientGlobalARN(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Service   string
+		Resource  string
+		Expected  string
+	}{
+		{
+			Name: "AWS Commercial",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Service:  "iam",
+			Resource: "role/test",
+			Expected: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+		},
+		{
+			Name: "AWS GovCloud (US)",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws-us-gov",
+				Region:    "us-gov-west-1", //lintignore:AWSAT003
+			},
+			Service:  "iam",
+			Resource: "role/test",
+			Expected: "arn:aws-us-gov:iam::123456789012:role/test", //lintignore:AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.AWSClient.GlobalARN(testCase.Service, testCase.Resource)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientRegionalARN(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Service   string
+		Resource  string
+		Expected  string
+	}{
+		{
+			Name: "AWS Commercial",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Service:  "glue",
+			Resource: "trigger/test",
+			Expected: "arn:aws:glue:us-west-2:123456789012:trigger/test", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "AWS China",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws-cn",
+				Region:    "cn-northwest-1", //lintignore:AWSAT003
+			},
+			Service:  "glue",
+			Resource: "trigger/test",
+			Expected: "arn:aws-cn:glue:cn-northwest-1:123456789012:trigger/test", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "AWS ISO (US)",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws-iso",
+				Region:    "us-iso-east-1", //lintignore:AWSAT003
+			},
+			Service:  "glue",
+			Resource: "trigger/test",
+			Expected: "arn:aws-iso:glue:us-iso-east-1:123456789012:trigger/test", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.AWSClient.RegionalARN(testCase.Service, testCase.Resource)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*servicemocks.MockEndpoint{
 		{
@@ -131,3 +230,168 @@ var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesRes
     </item>
   </accountAttributeSet>
 </DescribeAccountAttributesResponse>`
+
+func TestCredentialsCacheKey(t *testing.T) {
+	base := func() *awsbase.Config {
+		return &awsbase.Config{
+			AssumeRole: &awsbase.AssumeRole{
+				RoleARN:     "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+				SessionName: "test",
+				Tags:        map[string]string{"b": "2", "a": "1"},
+			},
+			Profile: "test",
+			Region:  "us-west-2", //lintignore:AWSAT003
+		}
+	}
+
+	key1, err := credentialsCacheKey(base(), nil, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	key2, err := credentialsCacheKey(base(), nil, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if key1 != key2 {
+		t.Errorf("expected identical configurations to have the same key, got %s and %s", key1, key2)
+	}
+
+	other := base()
+	other.AssumeRole.SessionName = "other"
+
+	key3, err := credentialsCacheKey(other, nil, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if key1 == key3 {
+		t.Errorf("expected configurations with different role session names to have different keys")
+	}
+
+	key4, err := credentialsCacheKey(base(), []*awsbase.AssumeRole{
+		{
+			RoleARN: "arn:aws:iam::123456789012:role/chained", //lintignore:AWSAT005
+		},
+	}, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if key1 == key4 {
+		t.Errorf("expected configurations with different assume role chains to have different keys")
+	}
+
+	key5, err := credentialsCacheKey(base(), nil, &SSOConfig{
+		AccountID:   "123456789012",
+		Region:      "us-west-2", //lintignore:AWSAT003
+		RoleName:    "test",
+		SessionName: "test",
+		StartURL:    "https://example.awsapps.com/start",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if key1 == key5 {
+		t.Errorf("expected configurations with and without SSO to have different keys")
+	}
+
+	other = base()
+	other.MaxRetries = 5
+	other.Region = "us-east-1" //lintignore:AWSAT003
+
+	key6, err := credentialsCacheKey(other, nil, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if key1 != key6 {
+		t.Errorf("expected configurations differing only in region and retries to have the same key, got %s and %s", key1, key6)
+	}
+
+	other = base()
+	other.Region = "us-gov-west-1" //lintignore:AWSAT003
+
+	key7, err := credentialsCacheKey(other, nil, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if key1 == key7 {
+		t.Errorf("expected configurations in different partitions to have different keys")
+	}
+}
+
+func TestGetCachedAwsConfig(t *testing.T) {
+	for _, k := range []string{"AWS_CA_BUNDLE", "AWS_PROFILE", "AWS_DEFAULT_PROFILE"} {
+		t.Setenv(k, "")
+	}
+
+	ctx := context.Background()
+	config := func(region string) *awsbase.Config {
+		return &awsbase.Config{
+			AccessKey:               "TestGetCachedAwsConfig",
+			Region:                  region,
+			SecretKey:               "SECRET",
+			SkipCredsValidation:     true,
+			SkipEC2MetadataApiCheck: true,
+			SkipRequestingAccountId: true,
+		}
+	}
+	entries := func() int {
+		credentialsCache.Lock()
+		defer credentialsCache.Unlock()
+
+		return len(credentialsCache.entries)
+	}
+
+	n := entries()
+
+	cfg, _, partition, err := getCachedAwsConfig(ctx, config("us-west-2"), nil, nil) //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := cfg.Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region: got %q, want %q", got, want)
+	}
+
+	cfg, _, partition2, err := getCachedAwsConfig(ctx, config("us-east-1"), nil, nil) //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := cfg.Region, "us-east-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("reused configuration Region: got %q, want %q", got, want)
+	}
+
+	if partition != partition2 {
+		t.Errorf("reused partition: got %q, want %q", partition2, partition)
+	}
+
+	if got, want := entries(), n+1; got != want {
+		t.Errorf("cache entries: got %d, want %d", got, want)
+	}
+
+	invalid := config("us-west-2") //lintignore:AWSAT003
+	invalid.EC2MetadataServiceEndpointMode = "invalid"
+
+	if _, _, _, err := getCachedAwsConfig(ctx, invalid, nil, nil); err == nil {
+		t.Fatalf("expected error, got none")
+	}
+
+	if got, want := entries(), n+1; got != want {
+		t.Errorf("cache entries after failure: got %d, want %d", got, want)
+	}
+}
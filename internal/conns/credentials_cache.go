@@ -0,0 +1,224 @@
+package conns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// credentialsCache caches AWS SDK for Go v2 configurations, and the account details
+// resolved with them, across provider instances in the same process.
+// Provider configurations with identical authentication parameters, e.g. many aliased
+// provider configurations assuming the same IAM role in different regions, then share a single set of credentials
+// instead of each calling STS AssumeRole and GetCallerIdentity during initialization.
+// Credentials obtained by assuming a role are refreshed by the SDK's credentials cache.
+// Entries are never evicted: a provider process serves a single Terraform command, and there is
+// at most one entry for each distinct set of authentication parameters in its configuration.
+var credentialsCache = struct {
+	sync.Mutex
+	entries map[string]*credentialsCacheEntry
+}{
+	entries: make(map[string]*credentialsCacheEntry),
+}
+
+// Placeholder static credentials used to load the configuration for IAM Identity Center credentials.
+const (
+	ssoBootstrapAccessKey = "sso-bootstrap"
+	ssoBootstrapSecretKey = "sso-bootstrap"
+)
+
+type credentialsCacheEntry struct {
+	sync.Mutex
+	accountID string
+	cfg       *aws_sdkv2.Config
+	partition string
+}
+
+// credentialsCacheKey returns a cache key derived from the specified configuration's authentication parameters,
+// the chain of IAM roles assumed after it and any IAM Identity Center (SSO) configuration.
+// The transport parameters of the HTTP client shared with the cached configuration are included.
+// Of the region, only its partition is included, as credentials are not valid outside their partition.
+// Secrets never leave the process; only their digest is used as a key.
+func credentialsCacheKey(c *awsbase.Config, roleChain []*awsbase.AssumeRole, sso *SSOConfig) (string, error) {
+	var partition string
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	b, err := json.Marshal(struct {
+		AccessKey                      string
+		AssumeRole                     *awsbase.AssumeRole
+		EC2MetadataServiceEndpoint     string
+		EC2MetadataServiceEndpointMode string
+		HTTPProxy                      string
+		IamEndpoint                    string
+		Insecure                       bool
+		Partition                      string
+		Profile                        string
+		RoleChain                      []*awsbase.AssumeRole
+		SecretKey                      string
+		SharedConfigFiles              []string
+		SharedCredentialsFiles         []string
+		SkipCredsValidation            bool
+		SkipEC2MetadataApiCheck        bool
+		SkipRequestingAccountId        bool
+		SSO                            *SSOConfig
+		StsEndpoint                    string
+		StsRegion                      string
+		Token                          string
+		UseDualStackEndpoint           bool
+		UseFIPSEndpoint                bool
+	}{
+		AccessKey:                      c.AccessKey,
+		AssumeRole:                     c.AssumeRole,
+		EC2MetadataServiceEndpoint:     c.EC2MetadataServiceEndpoint,
+		EC2MetadataServiceEndpointMode: c.EC2MetadataServiceEndpointMode,
+		HTTPProxy:                      c.HTTPProxy,
+		IamEndpoint:                    c.IamEndpoint,
+		Insecure:                       c.Insecure,
+		Partition:                      partition,
+		Profile:                        c.Profile,
+		RoleChain:                      roleChain,
+		SecretKey:                      c.SecretKey,
+		SharedConfigFiles:              c.SharedConfigFiles,
+		SharedCredentialsFiles:         c.SharedCredentialsFiles,
+		SkipCredsValidation:            c.SkipCredsValidation,
+		SkipEC2MetadataApiCheck:        c.SkipEC2MetadataApiCheck,
+		SkipRequestingAccountId:        c.SkipRequestingAccountId,
+		SSO:                            sso,
+		StsEndpoint:                    c.StsEndpoint,
+		StsRegion:                      c.StsRegion,
+		Token:                          c.Token,
+		UseDualStackEndpoint:           c.UseDualStackEndpoint,
+		UseFIPSEndpoint:                c.UseFIPSEndpoint,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// getCachedAwsConfig returns the AWS SDK for Go v2 configuration, account ID and partition for
+// the specified configuration, reusing those of a previous provider instance with the same authentication parameters.
+// A reused configuration is a copy in the specified configuration's region. Its retryer, which only applies to
+// AWS SDK for Go v2 requests made to obtain credentials, is that of the first provider instance.
+// Any roles in roleChain are assumed in order after the configuration's own assume role.
+// If sso is specified, the base credentials are obtained from IAM Identity Center.
+// Concurrent callers with the same configuration wait for the first to finish.
+func getCachedAwsConfig(ctx context.Context, c *awsbase.Config, roleChain []*awsbase.AssumeRole, sso *SSOConfig) (aws_sdkv2.Config, string, string, error) {
+	key, err := credentialsCacheKey(c, roleChain, sso)
+
+	if err != nil {
+		return aws_sdkv2.Config{}, "", "", fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	credentialsCache.Lock()
+	entry, ok := credentialsCache.entries[key]
+	if !ok {
+		entry = &credentialsCacheEntry{}
+		credentialsCache.entries[key] = entry
+	}
+	credentialsCache.Unlock()
+
+	entry.Lock()
+	defer entry.Unlock()
+
+	if entry.cfg != nil {
+		log.Printf("[DEBUG] Reusing AWS credentials and account details from a provider instance with the same authentication configuration")
+		cfg := entry.cfg.Copy()
+		cfg.Region = c.Region
+
+		return cfg, entry.accountID, entry.partition, nil
+	}
+
+	// Failures are not cached.
+	defer func() {
+		if entry.cfg == nil {
+			credentialsCache.Lock()
+			if credentialsCache.entries[key] == entry {
+				delete(credentialsCache.entries, key)
+			}
+			credentialsCache.Unlock()
+		}
+	}()
+
+	loadConfig := c
+
+	if sso != nil {
+		// Any configured assume role is assumed with the IAM Identity Center role credentials, ahead of the role chain.
+		ssoConfig := *c
+		ssoConfig.AssumeRole = nil
+
+		if c.AssumeRole != nil {
+			roleChain = append([]*awsbase.AssumeRole{c.AssumeRole}, roleChain...)
+		}
+
+		c = &ssoConfig
+
+		// The IAM Identity Center clients are built from the loaded configuration, so that they honor the
+		// provider's HTTP proxy, TLS and retry settings. The configuration is loaded with placeholder static
+		// credentials, which are never used: they are replaced by the IAM Identity Center credentials provider below.
+		bootstrapConfig := ssoConfig
+		bootstrapConfig.AccessKey = ssoBootstrapAccessKey
+		bootstrapConfig.SecretKey = ssoBootstrapSecretKey
+		bootstrapConfig.Token = ""
+		bootstrapConfig.SkipCredsValidation = true
+		loadConfig = &bootstrapConfig
+	}
+
+	var cfg aws_sdkv2.Config
+
+	// The AWS SDK for Go v2 only supports the "standard" and "adaptive" retry modes of AWS_RETRY_MODE.
+	// The retry mode of AWS SDK for Go v1 clients is configured explicitly by the provider's retry_mode argument.
+	err = withoutLegacyRetryModeEnv(func() error {
+		var err error
+		cfg, err = awsbase.GetAwsConfig(ctx, loadConfig)
+
+		return err
+	})
+
+	if err != nil {
+		return aws_sdkv2.Config{}, "", "", fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	if sso != nil {
+		cfg.Credentials, err = ssoCredentials(ctx, cfg, sso)
+
+		if err != nil {
+			return aws_sdkv2.Config{}, "", "", fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	if len(roleChain) > 0 {
+		cfg.Credentials, err = chainAssumeRoleCredentialsProvider(ctx, cfg, c, roleChain)
+
+		if err != nil {
+			return aws_sdkv2.Config{}, "", "", fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, c)
+
+	if err != nil {
+		return aws_sdkv2.Config{}, "", "", fmt.Errorf("error retrieving account details: %w", err)
+	}
+
+	entry.accountID = accountID
+	entry.cfg = &cfg
+	entry.partition = partition
+
+	return cfg, accountID, partition, nil
+}
@@ -0,0 +1,83 @@
+package conns
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// EndpointURLBaseHCLKey is the `endpoints` block attribute whose value is used as the
+// endpoint URL for every service that has no endpoint of its own configured.
+const EndpointURLBaseHCLKey = "endpoint_url_base"
+
+// ValidateEndpointURL returns an error if the specified value is not an absolute HTTP or HTTPS URL.
+// An empty value is valid and means that the default endpoint is used.
+// A value without a scheme is valid if it is a valid HTTPS URL once prefixed with "https://",
+// as the AWS SDK for Go uses HTTPS for such endpoints.
+func ValidateEndpointURL(v string) error {
+	if v == "" {
+		return nil
+	}
+
+	u, err := url.Parse(endpointURLWithScheme(v))
+
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %w", v, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%q must use the http or https scheme", v)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("%q must include a host", v)
+	}
+
+	return nil
+}
+
+// endpointURLWithScheme returns the specified endpoint URL prefixed with "https://" if it has no scheme.
+func endpointURLWithScheme(v string) string {
+	if !strings.Contains(v, "://") {
+		return "https://" + v
+	}
+
+	return v
+}
+
+// ResolveEndpoints returns the endpoint URL for each service, keyed by service key,
+// from per-service values keyed by HCL key and an optional base URL.
+// A service's HCL keys are considered in declaration order and the first non-empty value wins;
+// services without a value of their own use the base URL.
+func ResolveEndpoints(base string, hclEndpoints map[string]string) (map[string]string, error) {
+	if err := ValidateEndpointURL(base); err != nil {
+		return nil, fmt.Errorf("%s: %w", EndpointURLBaseHCLKey, err)
+	}
+
+	endpoints := make(map[string]string)
+
+	for _, serviceKey := range ServiceKeys() {
+		var endpoint string
+
+		for _, hclKey := range serviceData[serviceKey].HCLKeys {
+			if v := hclEndpoints[hclKey]; v != "" {
+				if err := ValidateEndpointURL(v); err != nil {
+					return nil, fmt.Errorf("%s: %w", hclKey, err)
+				}
+
+				endpoint = v
+				break
+			}
+		}
+
+		if endpoint == "" {
+			endpoint = base
+		}
+
+		if endpoint != "" {
+			endpoints[serviceKey] = endpoint
+		}
+	}
+
+	return endpoints, nil
+}
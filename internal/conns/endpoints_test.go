@@ -0,0 +1,163 @@
+package conns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateEndpointURL(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Value       string
+		ExpectError bool
+	}{
+		{
+			Name:  "empty",
+			Value: "",
+		},
+		{
+			Name:  "http",
+			Value: "http://localhost:4566",
+		},
+		{
+			Name:  "https with path",
+			Value: "https://example.com/aws",
+		},
+		{
+			Name:  "no scheme",
+			Value: "localhost:4566",
+		},
+		{
+			Name:  "no scheme with path",
+			Value: "example.com/aws",
+		},
+		{
+			Name:        "no scheme invalid",
+			Value:       "local host:4566",
+			ExpectError: true,
+		},
+		{
+			Name:        "unsupported scheme",
+			Value:       "ftp://localhost:4566",
+			ExpectError: true,
+		},
+		{
+			Name:        "no host",
+			Value:       "http://",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid",
+			Value:       "http://local host",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := ValidateEndpointURL(testCase.Value)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestResolveEndpoints(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Base         string
+		HCLEndpoints map[string]string
+		Expected     map[string]string
+		ExpectError  bool
+	}{
+		{
+			Name:     "none",
+			Expected: map[string]string{},
+		},
+		{
+			Name:         "service only",
+			HCLEndpoints: map[string]string{"s3": "http://s3.local"},
+			Expected:     map[string]string{S3: "http://s3.local"},
+		},
+		{
+			Name: "first HCL key wins",
+			HCLEndpoints: map[string]string{
+				"databasemigration": "http://ignored.local",
+				"dms":               "http://dms.local",
+			},
+			Expected: map[string]string{DMS: "http://dms.local"},
+		},
+		{
+			Name:         "base with override",
+			Base:         "http://localhost:4566",
+			HCLEndpoints: map[string]string{"sqs": "http://localhost:9324"},
+		},
+		{
+			Name:         "service without scheme",
+			HCLEndpoints: map[string]string{"s3": "s3.local"},
+			Expected:     map[string]string{S3: "s3.local"},
+		},
+		{
+			Name:        "invalid base",
+			Base:        "ftp://localhost:4566",
+			ExpectError: true,
+		},
+		{
+			Name:         "invalid service",
+			HCLEndpoints: map[string]string{"sqs": "tcp://localhost:9324"},
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := ResolveEndpoints(testCase.Base, testCase.HCLEndpoints)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.Base == "" {
+				if !reflect.DeepEqual(got, testCase.Expected) {
+					t.Errorf("got %v, expected %v", got, testCase.Expected)
+				}
+
+				return
+			}
+
+			for _, serviceKey := range ServiceKeys() {
+				expected := testCase.Base
+
+				if serviceKey == SQS {
+					expected = testCase.HCLEndpoints["sqs"]
+				}
+
+				if got[serviceKey] != expected {
+					t.Errorf("service %s: got %s, expected %s", serviceKey, got[serviceKey], expected)
+				}
+			}
+		})
+	}
+}
+
+func TestHCLKeysDoNotCollideWithEndpointURLBase(t *testing.T) {
+	for _, hclKey := range HCLKeys() {
+		if hclKey == EndpointURLBaseHCLKey {
+			t.Fatalf("HCL key %s collides with %s", hclKey, EndpointURLBaseHCLKey)
+		}
+	}
+}
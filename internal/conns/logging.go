@@ -0,0 +1,263 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Logging of AWS API calls to a file.
+// Each request and response made by the AWS SDK is dumped in HTTP wire format,
+// with credentials and other secret values redacted, to the file configured in the provider's `logging` block.
+// This is an alternative to TF_LOG=TRACE, which writes everything, unredacted, to stderr.
+
+const (
+	loggingHandlerNameRequest  = "terraform-provider-aws.logging.Request"
+	loggingHandlerNameResponse = "terraform-provider-aws.logging.Response"
+
+	maxLoggedBodySize = 1 << 20
+	redactedValue     = "[REDACTED]"
+)
+
+// LoggingConfig configures logging of AWS API calls.
+type LoggingConfig struct {
+	FilePath     string
+	IncludeBody  bool
+	RedactFields []string
+}
+
+// redactedHeaders are the HTTP headers whose values are always redacted.
+var redactedHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+}
+
+// redactedFieldSuffixes are matched, case-insensitively, against the end of request and response field names.
+// For example, "Password" matches Password, MasterUserPassword and NewPassword.
+var redactedFieldSuffixes = []string{
+	"AccessToken",
+	"AuthToken",
+	"CiphertextBlob",
+	"ClientSecret",
+	"IdToken",
+	"Passphrase",
+	"Password",
+	"Plaintext",
+	"PrivateKey",
+	"RefreshToken",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretKey",
+	"SecretString",
+	"SessionToken",
+}
+
+// redactedServiceFields are the field names, matched exactly, whose values are redacted in calls to a service, by service ID.
+// For example, SSM parameter values, including those of SecureString parameters.
+var redactedServiceFields = map[string][]string{
+	"SSM": {"Value"},
+}
+
+var (
+	logFiles   = make(map[string]*logFile)
+	logFilesMu sync.Mutex
+)
+
+// logFile serializes writes to a log file shared by all provider instances.
+type logFile struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (f *logFile) write(entry string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fmt.Fprint(f.file, entry)
+}
+
+// openLogFile returns the process-wide log file for the specified path, opening it for appending on first use.
+func openLogFile(path string) (*logFile, error) {
+	logFilesMu.Lock()
+	defer logFilesMu.Unlock()
+
+	if f, ok := logFiles[path]; ok {
+		return f, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening log file (%s): %w", path, err)
+	}
+
+	f := &logFile{file: file}
+	logFiles[path] = f
+
+	return f, nil
+}
+
+// redactor removes secret values from dumped requests and responses.
+type redactor struct {
+	headers  *regexp.Regexp
+	fields   *fieldRedactor
+	services map[string]*fieldRedactor
+}
+
+// fieldRedactor removes the values of matching fields from JSON, XML and form-encoded messages.
+type fieldRedactor struct {
+	json  *regexp.Regexp
+	query *regexp.Regexp
+	xml   *regexp.Regexp
+}
+
+func newFieldRedactor(names string) *fieldRedactor {
+	return &fieldRedactor{
+		// "Name": "value", "Name": 123, "Name": null, "Name": [...] or "Name": {...}
+		json: regexp.MustCompile(fmt.Sprintf(`(?i)("%s"\s*:\s*)(?:"(?:[^"\\]|\\.)*"|\[[^\[\]]*\]|\{[^{}]*\}|[^\s,}\]]+)`, names)),
+		// Name=value or Prefix.Name.1=value in query strings and form-encoded bodies.
+		query: regexp.MustCompile(fmt.Sprintf(`(?im)((?:^|[?&])(?:[A-Za-z0-9_.]*\.)?%s(?:\.[A-Za-z0-9_.]+)?=)[^&\s]*`, names)),
+		// <Name>value</Name>
+		xml: regexp.MustCompile(fmt.Sprintf(`(?is)(<(%s)>).*?(</%s>)`, names, names)),
+	}
+}
+
+func (r *fieldRedactor) redact(s string) string {
+	s = r.json.ReplaceAllString(s, `${1}"`+redactedValue+`"`)
+	s = r.query.ReplaceAllString(s, "${1}"+redactedValue)
+	s = r.xml.ReplaceAllString(s, "${1}"+redactedValue+"${3}")
+
+	return s
+}
+
+// quoteFieldNames returns a regular expression alternation matching any of the specified names literally.
+func quoteFieldNames(names []string) string {
+	quoted := make([]string, len(names))
+	for i, v := range names {
+		quoted[i] = regexp.QuoteMeta(v)
+	}
+
+	return strings.Join(quoted, "|")
+}
+
+func newRedactor(extraFields []string) *redactor {
+	names := fmt.Sprintf(`[A-Za-z0-9_]*(?:%s)`, quoteFieldNames(redactedFieldSuffixes))
+
+	if len(extraFields) > 0 {
+		names = fmt.Sprintf(`(?:%s|%s)`, names, quoteFieldNames(extraFields))
+	}
+
+	services := make(map[string]*fieldRedactor, len(redactedServiceFields))
+	for serviceID, fields := range redactedServiceFields {
+		services[serviceID] = newFieldRedactor(fmt.Sprintf(`(?:%s|%s)`, names, quoteFieldNames(fields)))
+	}
+
+	return &redactor{
+		headers:  regexp.MustCompile(fmt.Sprintf(`(?im)^(%s):[^\r\n]*`, quoteFieldNames(redactedHeaders))),
+		fields:   newFieldRedactor(names),
+		services: services,
+	}
+}
+
+// redact removes secret values from a dumped request or response of a call to the service with the specified ID.
+func (r *redactor) redact(serviceID, s string) string {
+	s = r.headers.ReplaceAllString(s, "${1}: "+redactedValue)
+
+	if fields, ok := r.services[serviceID]; ok {
+		return fields.redact(s)
+	}
+
+	return r.fields.redact(s)
+}
+
+// addLoggingHandlers writes each request attempt and its response to the specified log file.
+func addLoggingHandlers(handlers *request.Handlers, f *logFile, includeBody bool, redactor *redactor) {
+	// Send handlers run once per attempt, after the request is signed.
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: loggingHandlerNameRequest,
+		Fn: func(r *request.Request) {
+			dump, err := httputil.DumpRequestOut(r.HTTPRequest, includeBody && shouldLogBody(r.HTTPRequest.Header, r.HTTPRequest.ContentLength))
+
+			// DumpRequestOut consumes the request body.
+			r.ResetBody()
+
+			if err != nil {
+				dump = []byte(fmt.Sprintf("error dumping request: %s", err))
+			}
+
+			f.write(logEntry(r, "request", redactor.redact(r.ClientInfo.ServiceID, string(dump))))
+		},
+	})
+
+	handlers.Send.PushBackNamed(request.NamedHandler{
+		Name: loggingHandlerNameResponse,
+		Fn: func(r *request.Request) {
+			if r.HTTPResponse == nil {
+				if r.Error != nil {
+					f.write(logEntry(r, "error", r.Error.Error()))
+				}
+
+				return
+			}
+
+			// DumpResponse replaces the response body with an in-memory copy.
+			dump, err := httputil.DumpResponse(r.HTTPResponse, includeBody && shouldLogBody(r.HTTPResponse.Header, r.HTTPResponse.ContentLength))
+
+			if err != nil {
+				dump = []byte(fmt.Sprintf("error dumping response: %s", err))
+			}
+
+			f.write(logEntry(r, "response", redactor.redact(r.ClientInfo.ServiceID, string(dump))))
+		},
+	})
+}
+
+// shouldLogBody returns whether a message body is logged.
+// Binary and large bodies, e.g. S3 object contents, are not logged, nor are bodies of unknown length, e.g. streamed responses.
+func shouldLogBody(header http.Header, contentLength int64) bool {
+	contentType := header.Get("Content-Type")
+
+	if strings.HasPrefix(contentType, "application/octet-stream") || strings.HasPrefix(contentType, "binary/octet-stream") {
+		return false
+	}
+
+	return contentLength >= 0 && contentLength <= maxLoggedBodySize
+}
+
+func logEntry(r *request.Request, kind, dump string) string {
+	return fmt.Sprintf("---[ %s %s.%s (attempt %d) %s ]---\n%s\n\n",
+		time.Now().UTC().Format(time.RFC3339Nano),
+		r.ClientInfo.ServiceID,
+		r.Operation.Name,
+		r.RetryCount+1,
+		strings.ToUpper(kind),
+		strings.TrimRight(dump, "\r\n"),
+	)
+}
+
+// configureLoggingHandlers adds logging to all requests made with the specified session handlers, if logging is configured.
+// Service clients created from the session inherit its handlers.
+func configureLoggingHandlers(handlers *request.Handlers, c *LoggingConfig) error {
+	if c == nil || c.FilePath == "" {
+		return nil
+	}
+
+	f, err := openLogFile(c.FilePath)
+
+	if err != nil {
+		return err
+	}
+
+	addLoggingHandlers(handlers, f, c.IncludeBody, newRedactor(c.RedactFields))
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package conns
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestRedactorRedact(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ExtraFields []string
+		ServiceID   string
+		Input       string
+		Expected    string
+	}{
+		{
+			Name:     "authorization header",
+			Input:    "POST / HTTP/1.1\r\nAuthorization: AWS4-HMAC-SHA256 Credential=AKIAEXAMPLE\r\nX-Amz-Security-Token: token\r\nX-Amz-Target: Test\r\n",
+			Expected: "POST / HTTP/1.1\r\nAuthorization: [REDACTED]\r\nX-Amz-Security-Token: [REDACTED]\r\nX-Amz-Target: Test\r\n",
+		},
+		{
+			Name:     "JSON",
+			Input:    `{"Name":"test","SecretString":"s3cr3t \"quoted\"","MasterUserPassword" : "p@ss","NextToken":"abc"}`,
+			Expected: `{"Name":"test","SecretString":"[REDACTED]","MasterUserPassword" : "[REDACTED]","NextToken":"abc"}`,
+		},
+		{
+			Name:     "form-encoded body",
+			Input:    "Content-Type: application/x-www-form-urlencoded\r\n\r\nAction=CreateDBInstance&MasterUserPassword=p%40ss&DBName=test",
+			Expected: "Content-Type: application/x-www-form-urlencoded\r\n\r\nAction=CreateDBInstance&MasterUserPassword=[REDACTED]&DBName=test",
+		},
+		{
+			Name:     "query string",
+			Input:    "GET /?Action=Test&Parameters.member.1.Password=p%40ss&Version=1 HTTP/1.1",
+			Expected: "GET /?Action=Test&Parameters.member.1.Password=[REDACTED]&Version=1 HTTP/1.1",
+		},
+		{
+			Name:     "XML",
+			Input:    "<Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials>",
+			Expected: "<Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>[REDACTED]</SecretAccessKey><SessionToken>[REDACTED]</SessionToken></Credentials>",
+		},
+		{
+			Name:     "JSON non-string values",
+			Input:    `{"CiphertextBlob":null,"Plaintext" : ["a","b"],"SecretKey":{"Value":"k"},"Password":1234,"MaxResults":10}`,
+			Expected: `{"CiphertextBlob":"[REDACTED]","Plaintext" : "[REDACTED]","SecretKey":"[REDACTED]","Password":"[REDACTED]","MaxResults":10}`,
+		},
+		{
+			Name:     "KMS",
+			Input:    `{"CiphertextBlob":"AQIDAHg=","KeyId":"alias/test","Plaintext":"c2VjcmV0"}`,
+			Expected: `{"CiphertextBlob":"[REDACTED]","KeyId":"alias/test","Plaintext":"[REDACTED]"}`,
+		},
+		{
+			Name:      "SSM parameter value",
+			ServiceID: "SSM",
+			Input:     `{"Name":"test","Overwrite":true,"Type":"SecureString","Value":"s3cr3t"}`,
+			Expected:  `{"Name":"test","Overwrite":true,"Type":"SecureString","Value":"[REDACTED]"}`,
+		},
+		{
+			Name:     "tag value",
+			Input:    `{"Tags":[{"Key":"Name","Value":"test"}]}`,
+			Expected: `{"Tags":[{"Key":"Name","Value":"test"}]}`,
+		},
+		{
+			Name:        "extra field",
+			ExtraFields: []string{"ApiKey"},
+			Input:       `{"ApiKey":"key","Name":"test"}`,
+			Expected:    `{"ApiKey":"[REDACTED]","Name":"test"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := newRedactor(testCase.ExtraFields).redact(testCase.ServiceID, testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestShouldLogBody(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ContentType   string
+		ContentLength int64
+		Expected      bool
+	}{
+		{
+			Name:          "JSON",
+			ContentType:   "application/x-amz-json-1.1",
+			ContentLength: 100,
+			Expected:      true,
+		},
+		{
+			Name:          "empty",
+			ContentType:   "application/x-amz-json-1.1",
+			ContentLength: 0,
+			Expected:      true,
+		},
+		{
+			Name:          "maximum size",
+			ContentType:   "text/xml",
+			ContentLength: maxLoggedBodySize,
+			Expected:      true,
+		},
+		{
+			Name:          "too large",
+			ContentType:   "text/xml",
+			ContentLength: maxLoggedBodySize + 1,
+			Expected:      false,
+		},
+		{
+			Name:          "unknown length",
+			ContentType:   "application/x-amz-json-1.1",
+			ContentLength: -1,
+			Expected:      false,
+		},
+		{
+			Name:          "binary",
+			ContentType:   "binary/octet-stream",
+			ContentLength: 100,
+			Expected:      false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			header := http.Header{"Content-Type": []string{testCase.ContentType}}
+
+			if got := shouldLogBody(header, testCase.ContentLength); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAddLoggingHandlers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "aws.log")
+
+	f, err := openLogFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	handlers := defaults.Handlers()
+	handlers.Send.Clear()
+	handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+			Body:       io.NopCloser(strings.NewReader(`{"SecretString":"response-secret"}`)),
+		}
+	})
+	handlers.Unmarshal.Clear()
+	handlers.UnmarshalMeta.Clear()
+	handlers.UnmarshalError.Clear()
+	handlers.ValidateResponse.Clear()
+
+	addLoggingHandlers(&handlers, f, true, newRedactor(nil))
+
+	req := request.New(
+		aws.Config{Region: aws.String("us-west-2")},                                              //lintignore:AWSAT003
+		metadata.ClientInfo{ServiceID: "Test", Endpoint: "https://test.us-west-2.amazonaws.com"}, //lintignore:AWSAT003
+		handlers,
+		client.DefaultRetryer{},
+		&request.Operation{Name: "TestOperation", HTTPMethod: http.MethodPost, HTTPPath: "/"},
+		nil,
+		nil,
+	)
+	req.Config.Credentials = nil
+	req.Handlers.Sign.Clear()
+	req.HTTPRequest.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKIAEXAMPLE")
+	req.HTTPRequest.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.SetStringBody(`{"Name":"test","SecretString":"request-secret"}`)
+
+	if err := req.Send(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := string(b)
+
+	for _, want := range []string{"Test.TestOperation (attempt 1) REQUEST", "Test.TestOperation (attempt 1) RESPONSE", `"Name":"test"`, "Authorization: [REDACTED]"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("log does not contain %q:\n%s", want, got)
+		}
+	}
+
+	for _, notWant := range []string{"AKIAEXAMPLE", "request-secret", "response-secret"} {
+		if strings.Contains(got, notWant) {
+			t.Errorf("log contains %q:\n%s", notWant, got)
+		}
+	}
+
+	// The request body is still sent after being logged.
+	body, err := io.ReadAll(req.HTTPRequest.Body)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := string(body), `{"Name":"test","SecretString":"request-secret"}`; got != want {
+		t.Errorf("got request body %q, expected %q", got, want)
+	}
+}
@@ -1,8 +1,10 @@
 package conns
 
 import (
+	"fmt"
 	"log"
 	"sync"
+	"time"
 )
 
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
@@ -21,6 +23,33 @@ func (m *MutexKV) Lock(key string) {
 	log.Printf("[DEBUG] Locked %q", key)
 }
 
+// LockWithTimeout locks the mutex for the given key, waiting at most the specified duration.
+// An error is returned if the lock was not acquired in time, in which case the caller must not call Unlock.
+func (m *MutexKV) LockWithTimeout(key string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Locking %q", key)
+	mutex := m.get(key)
+	lockedCh := make(chan struct{})
+
+	go func() {
+		mutex.Lock()
+		close(lockedCh)
+	}()
+
+	select {
+	case <-lockedCh:
+		log.Printf("[DEBUG] Locked %q", key)
+		return nil
+	case <-time.After(timeout):
+		// Release the lock once it's eventually acquired.
+		go func() {
+			<-lockedCh
+			mutex.Unlock()
+		}()
+
+		return fmt.Errorf("timeout after %s waiting for lock %q", timeout, key)
+	}
+}
+
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *MutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
@@ -65,3 +65,21 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockWithTimeout(t *testing.T) {
+	mkv := NewMutexKV()
+
+	if err := mkv.LockWithTimeout("foo", 50*time.Millisecond); err != nil {
+		t.Fatalf("First lock was not taken: %s", err)
+	}
+
+	if err := mkv.LockWithTimeout("foo", 50*time.Millisecond); err == nil {
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockWithTimeout("foo", 50*time.Millisecond); err != nil {
+		t.Fatalf("Lock was not taken after unlock: %s", err)
+	}
+}
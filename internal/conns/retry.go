@@ -0,0 +1,328 @@
+package conns
+
+import (
+	"context"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Retry behavior of AWS API calls.
+// If no retry mode is configured requests are retried by the AWS SDK for Go v1's default retryer.
+// In "standard" mode requests are retried with the exponential backoff of the AWS SDKs' standard retry mode.
+// In "adaptive" mode each service client additionally uses a client-side rate limiter
+// that reduces the request rate after throttling errors and gradually restores it,
+// mirroring the adaptive retry mode of the AWS SDKs.
+
+const (
+	RetryModeAdaptive = "adaptive"
+	RetryModeStandard = "standard"
+)
+
+const (
+	envVarRetryMode = "AWS_RETRY_MODE"
+
+	// retryModeLegacy is the AWS SDKs' name for the AWS SDK for Go v1's default retry behavior.
+	// The AWS SDK for Go v2 does not support it and fails to load its configuration if AWS_RETRY_MODE is set to it.
+	retryModeLegacy = "legacy"
+)
+
+const (
+	retryHandlerNameAdaptiveAcquire = "terraform-provider-aws.retry.AdaptiveAcquire"
+	retryHandlerNameAdaptiveUpdate  = "terraform-provider-aws.retry.AdaptiveUpdate"
+	retryHandlerNameRetryer         = "terraform-provider-aws.retry.Retryer"
+)
+
+func RetryModes() []string {
+	return []string{
+		RetryModeAdaptive,
+		RetryModeStandard,
+	}
+}
+
+// RetryModeEnvDefaultFunc returns the retry mode set by the AWS_RETRY_MODE environment variable.
+// The "legacy" retry mode is ignored as it is the behavior when no retry mode is configured.
+func RetryModeEnvDefaultFunc() (interface{}, error) {
+	if v := os.Getenv(envVarRetryMode); v != "" && !strings.EqualFold(v, retryModeLegacy) {
+		return v, nil
+	}
+
+	return nil, nil
+}
+
+// retryModeEnvMu serializes loads of the AWS SDK for Go v2 configuration while a "legacy" AWS_RETRY_MODE is hidden.
+var retryModeEnvMu sync.Mutex
+
+// withoutLegacyRetryModeEnv calls f with a "legacy" AWS_RETRY_MODE hidden from the AWS SDK for Go v2.
+// The SDK parses the environment variable itself and fails to load its configuration for that value,
+// before any explicitly configured retryer is considered.
+// The variable is restored before returning, so the process environment seen by other provider
+// instances and later subprocesses is unchanged and does not depend on which provider is configured first.
+func withoutLegacyRetryModeEnv(f func() error) error {
+	retryModeEnvMu.Lock()
+	defer retryModeEnvMu.Unlock()
+
+	if v, ok := os.LookupEnv(envVarRetryMode); ok && strings.EqualFold(v, retryModeLegacy) {
+		log.Printf(`[DEBUG] Ignoring the environment variable "%s" (%q) while loading the AWS SDK for Go v2 configuration`, envVarRetryMode, v)
+
+		os.Unsetenv(envVarRetryMode)
+		defer os.Setenv(envVarRetryMode, v)
+	}
+
+	return f()
+}
+
+// configureRetryHandlers registers the request handlers implementing the configured
+// retry mode and per-service maximum retry counts.
+// serviceMaxRetries is keyed by AWS SDK service name.
+func configureRetryHandlers(handlers *request.Handlers, retryMode string, serviceMaxRetries map[string]int) {
+	standard := retryMode == RetryModeStandard || retryMode == RetryModeAdaptive
+
+	if standard || len(serviceMaxRetries) > 0 {
+		handlers.Validate.PushFrontNamed(request.NamedHandler{
+			Name: retryHandlerNameRetryer,
+			Fn: func(r *request.Request) {
+				// Retryers customized by a service, e.g. DynamoDB's shorter retry delays, are kept.
+				if v, ok := r.Retryer.(client.DefaultRetryer); ok && standard && v == (client.DefaultRetryer{NumMaxRetries: v.NumMaxRetries}) {
+					r.Retryer = standardRetryer{DefaultRetryer: v}
+				}
+
+				if n, ok := serviceMaxRetries[r.ClientInfo.ServiceName]; ok {
+					r.Retryer = maxRetriesRetryer{Retryer: r.Retryer, numMaxRetries: n}
+				}
+			},
+		})
+	}
+
+	if retryMode == RetryModeAdaptive {
+		limiters := newAdaptiveRateLimiters()
+
+		handlers.Sign.PushFrontNamed(request.NamedHandler{
+			Name: retryHandlerNameAdaptiveAcquire,
+			Fn: func(r *request.Request) {
+				if err := limiters.get(r.ClientInfo.ServiceName).acquire(r.Context()); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for send token", err)
+				}
+			},
+		})
+		handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+			Name: retryHandlerNameAdaptiveUpdate,
+			Fn: func(r *request.Request) {
+				limiters.get(r.ClientInfo.ServiceName).update(r.Error != nil && r.IsErrorThrottle())
+			},
+		})
+	}
+}
+
+const standardRetryerMaxBackoff = 20 * time.Second
+
+// standardRetryer retries the same errors as the AWS SDK for Go v1's default retryer.
+// Like the AWS SDKs' standard retry mode it waits a random duration of up to 2^attempt seconds,
+// capped at 20 seconds, before each retry.
+type standardRetryer struct {
+	client.DefaultRetryer
+}
+
+func (r standardRetryer) RetryRules(req *request.Request) time.Duration {
+	backoff := standardRetryerMaxBackoff
+
+	if attempt := req.RetryCount + 1; attempt < 5 {
+		backoff = time.Duration(1<<uint(attempt)) * time.Second
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
+
+// maxRetriesRetryer overrides the maximum number of retries of another retryer.
+type maxRetriesRetryer struct {
+	request.Retryer
+	numMaxRetries int
+}
+
+func (r maxRetriesRetryer) MaxRetries() int {
+	return r.numMaxRetries
+}
+
+type adaptiveRateLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*adaptiveRateLimiter
+}
+
+func newAdaptiveRateLimiters() *adaptiveRateLimiters {
+	return &adaptiveRateLimiters{
+		limiters: make(map[string]*adaptiveRateLimiter),
+	}
+}
+
+func (l *adaptiveRateLimiters) get(serviceName string) *adaptiveRateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.limiters[serviceName]
+	if !ok {
+		limiter = newAdaptiveRateLimiter(time.Now)
+		l.limiters[serviceName] = limiter
+	}
+
+	return limiter
+}
+
+const (
+	adaptiveBeta            = 0.7
+	adaptiveMinCapacity     = 1.0
+	adaptiveMinFillRate     = 0.5
+	adaptiveScaleConstant   = 0.4
+	adaptiveSmooth          = 0.8
+	adaptiveTxRateBucketLen = 0.5
+)
+
+// adaptiveRateLimiter is a token bucket whose fill rate follows the CUBIC congestion control algorithm.
+// The limiter is disabled until the first throttling error is seen.
+type adaptiveRateLimiter struct {
+	mu    sync.Mutex
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+
+	enabled          bool
+	fillRate         float64
+	maxCapacity      float64
+	currentCapacity  float64
+	lastTimestamp    float64
+	measuredTxRate   float64
+	lastTxRateBucket float64
+	requestCount     int64
+	lastMaxRate      float64
+	lastThrottleTime float64
+	timeWindow       float64
+}
+
+func newAdaptiveRateLimiter(now func() time.Time) *adaptiveRateLimiter {
+	l := &adaptiveRateLimiter{
+		now:   now,
+		sleep: sleepWithContext,
+	}
+	l.lastTxRateBucket = math.Floor(l.seconds())
+	l.lastThrottleTime = l.seconds()
+
+	return l
+}
+
+// acquire blocks until a send token is available or the context is done.
+func (l *adaptiveRateLimiter) acquire(ctx context.Context) error {
+	l.mu.Lock()
+
+	if !l.enabled {
+		l.mu.Unlock()
+		return nil
+	}
+
+	l.refill()
+
+	if l.currentCapacity < 1 {
+		wait := time.Duration((1 - l.currentCapacity) / l.fillRate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := l.sleep(ctx, wait); err != nil {
+			return err
+		}
+
+		l.mu.Lock()
+		l.refill()
+	}
+
+	l.currentCapacity--
+	l.mu.Unlock()
+
+	return nil
+}
+
+// update records the outcome of a request attempt and recalculates the fill rate.
+func (l *adaptiveRateLimiter) update(throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.updateMeasuredRate()
+
+	var calculatedRate float64
+
+	if throttled {
+		rateToUse := l.measuredTxRate
+		if l.enabled {
+			rateToUse = math.Min(rateToUse, l.fillRate)
+		}
+
+		l.lastMaxRate = rateToUse
+		l.calculateTimeWindow()
+		l.lastThrottleTime = l.seconds()
+		calculatedRate = rateToUse * adaptiveBeta
+		l.enabled = true
+	} else {
+		l.calculateTimeWindow()
+		calculatedRate = adaptiveScaleConstant*math.Pow(l.seconds()-l.lastThrottleTime-l.timeWindow, 3) + l.lastMaxRate
+	}
+
+	l.updateBucket(math.Min(calculatedRate, 2*l.measuredTxRate))
+}
+
+func (l *adaptiveRateLimiter) seconds() float64 {
+	return float64(l.now().UnixNano()) / float64(time.Second)
+}
+
+func (l *adaptiveRateLimiter) refill() {
+	timestamp := l.seconds()
+
+	if l.lastTimestamp == 0 {
+		l.lastTimestamp = timestamp
+		return
+	}
+
+	l.currentCapacity = math.Min(l.maxCapacity, l.currentCapacity+(timestamp-l.lastTimestamp)*l.fillRate)
+	l.lastTimestamp = timestamp
+}
+
+func (l *adaptiveRateLimiter) updateBucket(newRate float64) {
+	l.refill()
+	l.fillRate = math.Max(newRate, adaptiveMinFillRate)
+	l.maxCapacity = math.Max(newRate, adaptiveMinCapacity)
+	l.currentCapacity = math.Min(l.currentCapacity, l.maxCapacity)
+}
+
+func (l *adaptiveRateLimiter) updateMeasuredRate() {
+	timeBucket := math.Floor(l.seconds()/adaptiveTxRateBucketLen) * adaptiveTxRateBucketLen
+	l.requestCount++
+
+	if timeBucket > l.lastTxRateBucket {
+		currentRate := float64(l.requestCount) / (timeBucket - l.lastTxRateBucket)
+		l.measuredTxRate = currentRate*adaptiveSmooth + l.measuredTxRate*(1-adaptiveSmooth)
+		l.requestCount = 0
+		l.lastTxRateBucket = timeBucket
+	}
+}
+
+func (l *adaptiveRateLimiter) calculateTimeWindow() {
+	l.timeWindow = math.Cbrt(l.lastMaxRate * (1 - adaptiveBeta) / adaptiveScaleConstant)
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
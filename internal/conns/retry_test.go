@@ -0,0 +1,337 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestConfigureRetryHandlersServiceMaxRetries(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		ServiceName        string
+		ExpectedMaxRetries int
+	}{
+		{
+			Name:               "configured service",
+			ServiceName:        "dynamodb",
+			ExpectedMaxRetries: 50,
+		},
+		{
+			Name:               "other service",
+			ServiceName:        "ec2",
+			ExpectedMaxRetries: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			handlers := defaults.Handlers()
+
+			configureRetryHandlers(&handlers, RetryModeStandard, map[string]int{"dynamodb": 50})
+
+			req := request.New(
+				aws.Config{Region: aws.String("us-west-2")}, //lintignore:AWSAT003
+				metadata.ClientInfo{ServiceName: testCase.ServiceName},
+				handlers,
+				client.DefaultRetryer{NumMaxRetries: 3},
+				&request.Operation{Name: "TestOperation", HTTPMethod: http.MethodPost, HTTPPath: "/"},
+				nil,
+				nil,
+			)
+
+			req.Handlers.Validate.Run(req)
+
+			if got, want := req.MaxRetries(), testCase.ExpectedMaxRetries; got != want {
+				t.Errorf("got max retries %d, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigureRetryHandlersAdaptive(t *testing.T) {
+	noop := func(*request.Request) {}
+
+	handlers := defaults.Handlers()
+
+	configureRetryHandlers(&handlers, RetryModeAdaptive, nil)
+
+	if !handlers.Sign.Swap(retryHandlerNameAdaptiveAcquire, request.NamedHandler{Name: retryHandlerNameAdaptiveAcquire, Fn: noop}) {
+		t.Errorf("expected Sign handler %q", retryHandlerNameAdaptiveAcquire)
+	}
+
+	if !handlers.CompleteAttempt.Swap(retryHandlerNameAdaptiveUpdate, request.NamedHandler{Name: retryHandlerNameAdaptiveUpdate, Fn: noop}) {
+		t.Errorf("expected CompleteAttempt handler %q", retryHandlerNameAdaptiveUpdate)
+	}
+
+	handlers = defaults.Handlers()
+
+	configureRetryHandlers(&handlers, RetryModeStandard, nil)
+
+	if handlers.Sign.Swap(retryHandlerNameAdaptiveAcquire, request.NamedHandler{Name: retryHandlerNameAdaptiveAcquire, Fn: noop}) {
+		t.Errorf("unexpected Sign handler %q in standard mode", retryHandlerNameAdaptiveAcquire)
+	}
+}
+
+func TestConfigureRetryHandlersRetryer(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		RetryMode         string
+		ServiceMaxRetries map[string]int
+		Retryer           request.Retryer
+		ExpectedStandard  bool
+		ExpectedDelay     time.Duration
+		ExpectedRetries   int
+	}{
+		{
+			Name:            "no retry mode",
+			Retryer:         client.DefaultRetryer{NumMaxRetries: 3},
+			ExpectedRetries: 3,
+		},
+		{
+			Name:             "standard",
+			RetryMode:        RetryModeStandard,
+			Retryer:          client.DefaultRetryer{NumMaxRetries: 3},
+			ExpectedStandard: true,
+			ExpectedRetries:  3,
+		},
+		{
+			Name:             "adaptive",
+			RetryMode:        RetryModeAdaptive,
+			Retryer:          client.DefaultRetryer{NumMaxRetries: 3},
+			ExpectedStandard: true,
+			ExpectedRetries:  3,
+		},
+		{
+			Name:            "standard service retryer",
+			RetryMode:       RetryModeStandard,
+			Retryer:         client.DefaultRetryer{NumMaxRetries: 10, MinRetryDelay: 50 * time.Millisecond},
+			ExpectedDelay:   50 * time.Millisecond,
+			ExpectedRetries: 10,
+		},
+		{
+			Name:              "service max retries service retryer",
+			ServiceMaxRetries: map[string]int{"dynamodb": 50},
+			Retryer:           client.DefaultRetryer{NumMaxRetries: 10, MinRetryDelay: 50 * time.Millisecond},
+			ExpectedDelay:     50 * time.Millisecond,
+			ExpectedRetries:   50,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			handlers := defaults.Handlers()
+
+			configureRetryHandlers(&handlers, testCase.RetryMode, testCase.ServiceMaxRetries)
+
+			req := request.New(
+				aws.Config{Region: aws.String("us-west-2")}, //lintignore:AWSAT003
+				metadata.ClientInfo{ServiceName: "dynamodb"},
+				handlers,
+				testCase.Retryer,
+				&request.Operation{Name: "TestOperation", HTTPMethod: http.MethodPost, HTTPPath: "/"},
+				nil,
+				nil,
+			)
+
+			req.Handlers.Validate.Run(req)
+
+			retryer := req.Retryer
+			if v, ok := retryer.(maxRetriesRetryer); ok {
+				retryer = v.Retryer
+			}
+
+			if _, got := retryer.(standardRetryer); got != testCase.ExpectedStandard {
+				t.Errorf("got standard retryer %t, expected %t", got, testCase.ExpectedStandard)
+			}
+
+			if v, ok := retryer.(client.DefaultRetryer); ok {
+				if got, want := v.MinRetryDelay, testCase.ExpectedDelay; got != want {
+					t.Errorf("got min retry delay %s, expected %s", got, want)
+				}
+			}
+
+			if got, want := req.MaxRetries(), testCase.ExpectedRetries; got != want {
+				t.Errorf("got max retries %d, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func TestStandardRetryerRetryRules(t *testing.T) {
+	retryer := standardRetryer{DefaultRetryer: client.DefaultRetryer{NumMaxRetries: 25}}
+
+	for retryCount, maxDelay := range []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 20 * time.Second, 20 * time.Second} {
+		for i := 0; i < 100; i++ {
+			if got := retryer.RetryRules(&request.Request{RetryCount: retryCount}); got < 0 || got >= maxDelay {
+				t.Fatalf("retry %d: got delay %s, expected less than %s", retryCount, got, maxDelay)
+			}
+		}
+	}
+}
+
+func TestConfigClientRetryModeEnvVar(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		EnvValue         string
+		ExpectedStandard bool
+	}{
+		{
+			Name:     "legacy",
+			EnvValue: "legacy",
+		},
+		{
+			Name:             "standard",
+			EnvValue:         "standard",
+			ExpectedStandard: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			for _, k := range []string{"AWS_CA_BUNDLE", "AWS_CONFIG_FILE", "AWS_PROFILE", "AWS_SHARED_CREDENTIALS_FILE"} {
+				t.Setenv(k, "")
+			}
+			t.Setenv(envVarRetryMode, testCase.EnvValue)
+
+			retryMode, err := RetryModeEnvDefaultFunc()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			config := &Config{
+				AccessKey:               "StaticAccessKey",
+				MaxRetries:              25,
+				Region:                  "us-west-2", //lintignore:AWSAT003
+				SecretKey:               "StaticSecretKey",
+				SkipCredsValidation:     true,
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+			}
+
+			if retryMode != nil {
+				config.RetryMode = retryMode.(string)
+			}
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// The process environment is shared with other provider instances and subprocesses.
+			if got, ok := os.LookupEnv(envVarRetryMode); !ok || got != testCase.EnvValue {
+				t.Errorf("%s: got %q after configuring the provider, expected %q", envVarRetryMode, got, testCase.EnvValue)
+			}
+
+			client := raw.(*AWSClient)
+
+			req, _ := client.STSConn.GetCallerIdentityRequest(nil)
+			req.Handlers.Validate.Run(req)
+
+			if _, got := req.Retryer.(standardRetryer); got != testCase.ExpectedStandard {
+				t.Errorf("STS: got standard retryer %t, expected %t", got, testCase.ExpectedStandard)
+			}
+
+			if got, want := req.MaxRetries(), 25; got != want {
+				t.Errorf("STS: got max retries %d, expected %d", got, want)
+			}
+
+			// DynamoDB's own retryer is kept in every retry mode.
+			req, _ = client.DynamoDBConn.ListTablesRequest(nil)
+			req.Handlers.Validate.Run(req)
+
+			if _, ok := req.Retryer.(standardRetryer); ok {
+				t.Errorf("DynamoDB: unexpected standard retryer")
+			}
+		})
+	}
+}
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	now := time.Unix(1000, 0)
+	var slept time.Duration
+
+	limiter := newAdaptiveRateLimiter(func() time.Time { return now })
+	limiter.sleep = func(_ context.Context, d time.Duration) error {
+		slept += d
+		now = now.Add(d)
+		return nil
+	}
+
+	// Not enabled until the first throttling error.
+	for i := 0; i < 10; i++ {
+		if err := limiter.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		limiter.update(false)
+		now = now.Add(100 * time.Millisecond)
+	}
+
+	if limiter.enabled {
+		t.Fatal("expected limiter to be disabled before throttling")
+	}
+
+	if slept != 0 {
+		t.Errorf("got %s sleep before throttling, expected none", slept)
+	}
+
+	limiter.update(true)
+
+	if !limiter.enabled {
+		t.Fatal("expected limiter to be enabled after throttling")
+	}
+
+	throttledRate := limiter.fillRate
+
+	if throttledRate > limiter.measuredTxRate {
+		t.Errorf("got fill rate %f after throttling, expected at most measured rate %f", throttledRate, limiter.measuredTxRate)
+	}
+
+	// Sending faster than the fill rate must wait for tokens.
+	for i := 0; i < 5; i++ {
+		if err := limiter.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if slept == 0 {
+		t.Error("expected to wait for send tokens after throttling")
+	}
+
+	// The fill rate recovers after a period without throttling.
+	for i := 0; i < 100; i++ {
+		now = now.Add(100 * time.Millisecond)
+		if err := limiter.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		limiter.update(false)
+	}
+
+	if limiter.fillRate <= throttledRate {
+		t.Errorf("got fill rate %f, expected recovery above %f", limiter.fillRate, throttledRate)
+	}
+}
+
+func TestAdaptiveRateLimiterCanceled(t *testing.T) {
+	limiter := newAdaptiveRateLimiter(time.Now)
+	limiter.update(true)
+	limiter.currentCapacity = 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := limiter.acquire(ctx); err == nil {
+		t.Error("expected error for canceled context")
+	}
+}
@@ -0,0 +1,33 @@
+package conns
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+const (
+	s3ExpressSigningName = "s3express"
+)
+
+// s3ExpressControlEndpointResolver returns an endpoint resolver for the regional
+// S3 Express One Zone control plane, used to manage directory buckets.
+// The AWS SDK for Go v1 does not resolve S3 Express endpoints itself, and requests
+// to the control plane must be signed for the "s3express" service.
+// A configured S3 endpoint, e.g. for an S3-compatible API, is used unchanged.
+func s3ExpressControlEndpointResolver(endpoint, dnsSuffix string) endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		url := endpoint
+
+		if url == "" {
+			url = fmt.Sprintf("https://s3express-control.%s.%s", region, dnsSuffix)
+		}
+
+		return endpoints.ResolvedEndpoint{
+			URL:           url,
+			SigningMethod: "v4",
+			SigningName:   s3ExpressSigningName,
+			SigningRegion: region,
+		}, nil
+	})
+}
@@ -0,0 +1,57 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestS3ExpressControlEndpointResolver(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Endpoint    string
+		DNSSuffix   string
+		Region      string
+		ExpectedURL string
+	}{
+		{
+			Name:        "default",
+			DNSSuffix:   "amazonaws.com",
+			Region:      "us-west-2", //lintignore:AWSAT003
+			ExpectedURL: "https://s3express-control.us-west-2.amazonaws.com",
+		},
+		{
+			Name:        "partition DNS suffix",
+			DNSSuffix:   "amazonaws.com.cn",
+			Region:      "cn-north-1", //lintignore:AWSAT003
+			ExpectedURL: "https://s3express-control.cn-north-1.amazonaws.com.cn",
+		},
+		{
+			Name:        "configured endpoint",
+			Endpoint:    "http://localhost:4566",
+			DNSSuffix:   "amazonaws.com",
+			Region:      "us-west-2", //lintignore:AWSAT003
+			ExpectedURL: "http://localhost:4566",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			resolved, err := s3ExpressControlEndpointResolver(testCase.Endpoint, testCase.DNSSuffix).EndpointFor("s3", testCase.Region)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := resolved.URL, testCase.ExpectedURL; got != want {
+				t.Errorf("got URL %q, expected %q", got, want)
+			}
+
+			if got, want := resolved.SigningName, s3ExpressSigningName; got != want {
+				t.Errorf("got signing name %q, expected %q", got, want)
+			}
+
+			if got, want := resolved.SigningRegion, testCase.Region; got != want {
+				t.Errorf("got signing region %q, expected %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	"github.com/aws/aws-sdk-go-v2/service/ssooidc"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// SSOConfig configures AWS credentials obtained from AWS IAM Identity Center (successor to AWS SSO).
+type SSOConfig struct {
+	AccountID   string
+	Region      string
+	RoleName    string
+	SessionName string
+	StartURL    string
+
+	// Custom endpoints of the IAM Identity Center portal (sso) and OIDC (ssooidc) APIs.
+	Endpoint     string
+	OIDCEndpoint string
+}
+
+// ssoCredentialsProvider returns a credentials provider that exchanges the cached IAM Identity Center
+// access token for role credentials.
+// The IAM Identity Center clients are built from the specified configuration, so that they use its
+// HTTP client and retryer, in the IAM Identity Center region.
+// When a session name is configured the token is read from the sso-session's cache file and
+// refreshed using its refresh token once it expires, so `aws sso login` is only needed when the
+// session itself has expired.
+func ssoCredentialsProvider(cfg aws_sdkv2.Config, c *SSOConfig) (aws_sdkv2.CredentialsProvider, error) {
+	// IAM Identity Center requests are authorized by the access token, not signed with AWS credentials.
+	cfg.Credentials = nil
+	cfg.Region = c.Region
+
+	var optFns []func(*ssocreds.Options)
+
+	if c.SessionName != "" {
+		cachedTokenFilepath, err := ssocreds.StandardCachedTokenFilepath(c.SessionName)
+
+		if err != nil {
+			return nil, fmt.Errorf("error determining SSO token cache file for session (%s): %w", c.SessionName, err)
+		}
+
+		client := ssooidc.NewFromConfig(cfg, func(opts *ssooidc.Options) {
+			if c.OIDCEndpoint != "" {
+				log.Printf("[INFO] SSO OIDC client: setting custom endpoint: %s", c.OIDCEndpoint)
+				opts.BaseEndpoint = aws_sdkv2.String(c.OIDCEndpoint)
+			}
+		})
+		tokenProvider := ssocreds.NewSSOTokenProvider(client, cachedTokenFilepath)
+
+		optFns = append(optFns, func(opts *ssocreds.Options) {
+			opts.SSOTokenProvider = tokenProvider
+		})
+	}
+
+	client := sso.NewFromConfig(cfg, func(opts *sso.Options) {
+		if c.Endpoint != "" {
+			log.Printf("[INFO] SSO client: setting custom endpoint: %s", c.Endpoint)
+			opts.BaseEndpoint = aws_sdkv2.String(c.Endpoint)
+		}
+	})
+
+	return aws_sdkv2.NewCredentialsCache(ssocreds.New(client, c.AccountID, c.RoleName, c.StartURL, optFns...)), nil
+}
+
+// ssoCredentials returns a refreshing credentials provider for role credentials from AWS IAM Identity Center,
+// after checking that it can retrieve them.
+func ssoCredentials(ctx context.Context, cfg aws_sdkv2.Config, c *SSOConfig) (aws_sdkv2.CredentialsProvider, error) {
+	log.Printf("[INFO] Retrieving SSO credentials for role %s in account %s (Session: %q)", c.RoleName, c.AccountID, c.SessionName)
+
+	credentialsProvider, err := ssoCredentialsProvider(cfg, c)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := credentialsProvider.Retrieve(ctx); err != nil {
+		return nil, fmt.Errorf("error retrieving SSO credentials for role (%s) in account (%s): %w", c.RoleName, c.AccountID, err)
+	}
+
+	return credentialsProvider, nil
+}
+
+// v1CredentialsProvider adapts an AWS SDK for Go v2 credentials provider to AWS SDK for Go v1,
+// so that AWS SDK for Go v1 clients share the v2 provider's credentials and their refresh.
+type v1CredentialsProvider struct {
+	provider aws_sdkv2.CredentialsProvider
+	creds    aws_sdkv2.Credentials
+}
+
+var _ credentials.ProviderWithContext = &v1CredentialsProvider{}
+
+func newV1Credentials(provider aws_sdkv2.CredentialsProvider) *credentials.Credentials {
+	return credentials.NewCredentials(&v1CredentialsProvider{provider: provider})
+}
+
+func (p *v1CredentialsProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+func (p *v1CredentialsProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	creds, err := p.provider.Retrieve(ctx)
+
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	p.creds = creds
+
+	return credentials.Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		ProviderName:    creds.Source,
+	}, nil
+}
+
+func (p *v1CredentialsProvider) IsExpired() bool {
+	return p.creds.Expired()
+}
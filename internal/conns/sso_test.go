@@ -0,0 +1,451 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+type testExpiringCredentialsProvider struct {
+	retrievals int
+	expires    time.Time
+}
+
+func (p *testExpiringCredentialsProvider) Retrieve(_ context.Context) (aws_sdkv2.Credentials, error) {
+	p.retrievals++
+
+	return aws_sdkv2.Credentials{
+		AccessKeyID:     fmt.Sprintf("AKID%d", p.retrievals),
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN",
+		CanExpire:       true,
+		Expires:         p.expires,
+	}, nil
+}
+
+func TestV1CredentialsRefresh(t *testing.T) {
+	provider := &testExpiringCredentialsProvider{expires: time.Now().Add(time.Hour)}
+	creds := newV1Credentials(provider)
+
+	v, err := creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := v.AccessKeyID, "AKID1"; got != want {
+		t.Errorf("AccessKeyID: got %q, want %q", got, want)
+	}
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := provider.retrievals, 1; got != want {
+		t.Errorf("retrievals before expiry: got %d, want %d", got, want)
+	}
+
+	// Simulate the role session ending.
+	provider.expires = time.Now().Add(-time.Minute)
+	creds.Expire()
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	v, err = creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := v.AccessKeyID, "AKID3"; got != want {
+		t.Errorf("AccessKeyID after expiry: got %q, want %q", got, want)
+	}
+}
+
+// newTestSSOEndpoint returns a fake IAM Identity Center endpoint that refreshes the REFRESHTOKEN refresh token
+// and returns role credentials for the refreshed access token, counting requests to each API.
+func newTestSSOEndpoint(t *testing.T, oidcRequests, ssoRequests *int) *httptest.Server {
+	endpoint := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/token":
+			*oidcRequests++
+
+			var input struct {
+				GrantType    string `json:"grantType"`
+				RefreshToken string `json:"refreshToken"`
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil || input.GrantType != "refresh_token" || input.RefreshToken != "REFRESHTOKEN" {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+
+			fmt.Fprint(w, `{"accessToken":"REFRESHEDTOKEN","expiresIn":3600,"refreshToken":"REFRESHTOKEN2","tokenType":"Bearer"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/federation/credentials":
+			*ssoRequests++
+
+			query := r.URL.Query()
+
+			if r.Header.Get("x-amz-sso_bearer_token") != "REFRESHEDTOKEN" || query.Get("account_id") != "111122223333" || query.Get("role_name") != "SampleRole" {
+				http.Error(w, `{"message":"Session token not found or invalid"}`, http.StatusUnauthorized)
+				return
+			}
+
+			fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"SSOACCESSKEY","secretAccessKey":"SSOSECRETKEY","sessionToken":"SSOSESSIONTOKEN","expiration":%d}}`, time.Now().Add(time.Hour).UnixNano()/int64(time.Millisecond))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(endpoint.Close)
+
+	return endpoint
+}
+
+// newTestTunnelProxy returns an HTTP proxy that tunnels every CONNECT to the specified endpoint, whichever AWS hostname it is for.
+func newTestTunnelProxy(t *testing.T, endpoint *httptest.Server) *httptest.Server {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT expected", http.StatusMethodNotAllowed)
+			return
+		}
+
+		upstream, err := net.Dial("tcp", endpoint.Listener.Addr().String())
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		conn, _, err := w.(http.Hijacker).Hijack()
+
+		if err != nil {
+			upstream.Close()
+			return
+		}
+
+		fmt.Fprint(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+
+		go func() {
+			defer upstream.Close()
+			defer conn.Close()
+
+			io.Copy(upstream, conn)
+		}()
+
+		go io.Copy(conn, upstream)
+	}))
+	t.Cleanup(proxy.Close)
+
+	return proxy
+}
+
+// testSSOHome sets up a home directory, clear of AWS environment variables, with an empty shared credentials file
+// and the specified shared config file, and caches an expired access token for the my-sso session,
+// as if `aws sso login` was run but the access token has since expired.
+// It returns the paths of the shared config, shared credentials and cached token files.
+func testSSOHome(t *testing.T, sharedConfig string) (string, string, string) {
+	home := t.TempDir()
+	configFile := filepath.Join(home, "config")
+	credentialsFile := filepath.Join(home, "credentials")
+
+	t.Setenv("HOME", home)
+
+	for _, k := range []string{"AWS_ACCESS_KEY_ID", "AWS_CA_BUNDLE", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE", "AWS_DEFAULT_PROFILE", "AWS_ROLE_ARN", "AWS_WEB_IDENTITY_TOKEN_FILE"} {
+		t.Setenv(k, "")
+	}
+
+	if err := os.WriteFile(configFile, []byte(sharedConfig), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := os.WriteFile(credentialsFile, nil, 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cachedTokenFilepath, err := ssocreds.StandardCachedTokenFilepath("my-sso")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.HasPrefix(cachedTokenFilepath, home) {
+		t.Fatalf("SSO token cache file (%s) is not in test home directory (%s)", cachedTokenFilepath, home)
+	}
+
+	cachedToken := fmt.Sprintf(`{
+  "accessToken": "EXPIREDTOKEN",
+  "expiresAt": %q,
+  "refreshToken": "REFRESHTOKEN",
+  "clientId": "CLIENTID",
+  "clientSecret": "CLIENTSECRET",
+  "registrationExpiresAt": %q,
+  "region": "us-east-1",
+  "startUrl": "https://my-sso-portal.awsapps.com/start"
+}`, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), time.Now().Add(24*time.Hour).UTC().Format(time.RFC3339))
+
+	if err := os.MkdirAll(filepath.Dir(cachedTokenFilepath), 0700); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := os.WriteFile(cachedTokenFilepath, []byte(cachedToken), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return configFile, credentialsFile, cachedTokenFilepath
+}
+
+// testCheckSSOClient checks that the client's credentials are the IAM Identity Center role credentials
+// and that the expired access token was refreshed and cached.
+func testCheckSSOClient(t *testing.T, raw interface{}, oidcRequests, ssoRequests int, cachedTokenFilepath string) {
+	v, err := raw.(*AWSClient).STSConn.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := v.AccessKeyID, "SSOACCESSKEY"; got != want {
+		t.Errorf("AccessKeyID: got %q, want %q", got, want)
+	}
+
+	if got, want := v.SessionToken, "SSOSESSIONTOKEN"; got != want {
+		t.Errorf("SessionToken: got %q, want %q", got, want)
+	}
+
+	if oidcRequests == 0 {
+		t.Errorf("expected the expired SSO access token to be refreshed")
+	}
+
+	if ssoRequests == 0 {
+		t.Errorf("expected role credentials to be retrieved from IAM Identity Center")
+	}
+
+	b, err := os.ReadFile(cachedTokenFilepath)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.Contains(string(b), "REFRESHEDTOKEN") {
+		t.Errorf("expected the refreshed SSO access token to be cached, got %s", b)
+	}
+}
+
+// TestConfigClientSSOSessionProfile configures the provider with a profile that references an sso-session section
+// and an expired cached token, with requests to IAM Identity Center tunnelled to a fake endpoint through the provider's HTTP proxy.
+func TestConfigClientSSOSessionProfile(t *testing.T) {
+	var oidcRequests, ssoRequests int
+
+	endpoint := newTestSSOEndpoint(t, &oidcRequests, &ssoRequests)
+	proxy := newTestTunnelProxy(t, endpoint)
+
+	configFile, credentialsFile, cachedTokenFilepath := testSSOHome(t, `[profile customprofile]
+sso_session    = my-sso
+sso_account_id = 111122223333
+sso_role_name  = SampleRole
+region         = us-west-2
+
+[sso-session my-sso]
+sso_region              = us-east-1
+sso_start_url           = https://my-sso-portal.awsapps.com/start
+sso_registration_scopes = sso:account:access
+`)
+
+	config := &Config{
+		HTTPProxy:               proxy.URL,
+		Insecure:                true,
+		MaxRetries:              1,
+		Profile:                 "customprofile",
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SharedConfigFile:        configFile,
+		SharedCredentialsFile:   credentialsFile,
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCheckSSOClient(t, raw, oidcRequests, ssoRequests, cachedTokenFilepath)
+}
+
+// TestConfigClientSSO configures the provider's sso block with a session whose cached token has expired,
+// with requests to IAM Identity Center either tunnelled to a fake endpoint through the provider's HTTP proxy
+// or sent to it as a custom endpoint.
+func TestConfigClientSSO(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ProxyEndpoint bool
+		Endpoints     bool
+	}{
+		{
+			Name:          "http proxy",
+			ProxyEndpoint: true,
+		},
+		{
+			Name:      "custom endpoints",
+			Endpoints: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var oidcRequests, ssoRequests int
+
+			endpoint := newTestSSOEndpoint(t, &oidcRequests, &ssoRequests)
+			configFile, credentialsFile, cachedTokenFilepath := testSSOHome(t, "")
+
+			config := &Config{
+				Insecure:              true,
+				MaxRetries:            1,
+				Region:                "us-west-2", //lintignore:AWSAT003
+				SharedConfigFile:      configFile,
+				SharedCredentialsFile: credentialsFile,
+				SSO: &SSOConfig{
+					AccountID:   "111122223333",
+					Region:      "us-east-1", //lintignore:AWSAT003
+					RoleName:    "SampleRole",
+					SessionName: "my-sso",
+					StartURL:    "https://my-sso-portal.awsapps.com/start",
+				},
+				SkipCredsValidation:     true,
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+			}
+
+			if testCase.ProxyEndpoint {
+				config.HTTPProxy = newTestTunnelProxy(t, endpoint).URL
+			}
+
+			if testCase.Endpoints {
+				config.Endpoints = map[string]string{
+					SSO:     endpoint.URL,
+					SSOOIDC: endpoint.URL,
+				}
+			}
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			testCheckSSOClient(t, raw, oidcRequests, ssoRequests, cachedTokenFilepath)
+		})
+	}
+}
+
+// TestConfigClientAssumeRoleChainRefresh configures the provider with two chained roles whose sessions
+// have already expired, so that each credentials retrieval by an AWS SDK for Go v1 client assumes the roles again.
+func TestConfigClientAssumeRoleChainRefresh(t *testing.T) {
+	var assumeRoleRequests int
+
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("Action") != "AssumeRole" {
+			http.Error(w, "AssumeRole expected", http.StatusBadRequest)
+			return
+		}
+
+		assumeRoleRequests++
+
+		roleName := r.PostForm.Get("RoleArn")
+		roleName = roleName[strings.LastIndex(roleName, "/")+1:]
+
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>%[1]s%[2]d</AccessKeyId>
+      <SecretAccessKey>SECRET</SecretAccessKey>
+      <SessionToken>TOKEN</SessionToken>
+      <Expiration>%[3]s</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::111122223333:assumed-role/%[1]s/session</Arn>
+      <AssumedRoleId>AROA%[2]d:session</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>%[2]d</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`, roleName, assumeRoleRequests, time.Now().Add(-time.Minute).UTC().Format(time.RFC3339))
+	}))
+	defer endpoint.Close()
+
+	for _, k := range []string{"AWS_CA_BUNDLE", "AWS_CONFIG_FILE", "AWS_PROFILE", "AWS_SHARED_CREDENTIALS_FILE"} {
+		t.Setenv(k, "")
+	}
+
+	config := &Config{
+		AccessKey: "StaticAccessKey",
+		AssumeRoleChain: []*awsbase.AssumeRole{
+			{RoleARN: "arn:aws:iam::111122223333:role/first", SessionName: "session"},  //lintignore:AWSAT005
+			{RoleARN: "arn:aws:iam::111122223333:role/second", SessionName: "session"}, //lintignore:AWSAT005
+		},
+		Endpoints: map[string]string{
+			STS: endpoint.URL,
+		},
+		MaxRetries:              1,
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SecretKey:               "StaticSecretKey",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	creds := raw.(*AWSClient).STSConn.Config.Credentials
+
+	v1, err := creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	requests := assumeRoleRequests
+
+	v2, err := creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, v := range []string{v1.AccessKeyID, v2.AccessKeyID} {
+		if !strings.HasPrefix(v, "second") {
+			t.Errorf("AccessKeyID: got %q, want credentials for the last role in the chain", v)
+		}
+	}
+
+	if v1.AccessKeyID == v2.AccessKeyID {
+		t.Errorf("AccessKeyID: got %q after the role session expired, want refreshed credentials", v2.AccessKeyID)
+	}
+
+	if assumeRoleRequests <= requests {
+		t.Errorf("expected the expired role chain to be assumed again")
+	}
+}
@@ -0,0 +1,178 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing of AWS API calls.
+// Every AWS SDK request emits an OpenTelemetry span, exported via OTLP over HTTP,
+// when the standard OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variable is set.
+// The exporter is otherwise configured with the standard OTEL_EXPORTER_OTLP_* environment variables.
+
+const (
+	tracerName = "github.com/hashicorp/terraform-provider-aws/internal/conns"
+
+	tracingHandlerNameAttempt = "terraform-provider-aws.tracing.Attempt"
+	tracingHandlerNameEnd     = "terraform-provider-aws.tracing.End"
+	tracingHandlerNameStart   = "terraform-provider-aws.tracing.Start"
+
+	attributeKeyAWSAttempt    = attribute.Key("aws.attempt")
+	attributeKeyAWSErrorCode  = attribute.Key("aws.error_code")
+	attributeKeyAWSOperation  = attribute.Key("aws.operation")
+	attributeKeyAWSRegion     = attribute.Key("aws.region")
+	attributeKeyAWSRequestID  = attribute.Key("aws.request_id")
+	attributeKeyAWSRetryCount = attribute.Key("aws.retry_count")
+	attributeKeyAWSService    = attribute.Key("aws.service")
+)
+
+var (
+	tracerProvider     *sdktrace.TracerProvider
+	tracerProviderErr  error
+	tracerProviderOnce sync.Once
+)
+
+// tracingEnabled returns whether or not an OTLP endpoint is configured.
+func tracingEnabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// getTracerProvider returns the process-wide tracer provider, creating it on first use.
+// A single tracer provider is shared by all provider instances so that spans are batched together.
+func getTracerProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	tracerProviderOnce.Do(func() {
+		exporter, err := otlptracehttp.New(ctx)
+
+		if err != nil {
+			tracerProviderErr = fmt.Errorf("error creating OTLP trace exporter: %w", err)
+			return
+		}
+
+		tracerProvider = sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(resource.NewWithAttributes(
+				semconv.SchemaURL,
+				semconv.ServiceNameKey.String("terraform-provider-aws"),
+				semconv.ServiceVersionKey.String(version.ProviderVersion),
+			)),
+		)
+	})
+
+	return tracerProvider, tracerProviderErr
+}
+
+// ShutdownTracing exports any buffered spans and stops the tracer provider.
+// It should be called before the provider process exits.
+func ShutdownTracing(ctx context.Context) error {
+	if tracerProvider == nil {
+		return nil
+	}
+
+	return tracerProvider.Shutdown(ctx)
+}
+
+// addTracingHandlers instruments requests made with the specified handlers.
+// A span covers the whole operation, including any retries, and so its duration is the operation's latency.
+func addTracingHandlers(handlers *request.Handlers, tracer trace.Tracer) {
+	// Validate handlers run once per operation, before the first attempt.
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: tracingHandlerNameStart,
+		Fn: func(r *request.Request) {
+			ctx, _ := tracer.Start(r.Context(), fmt.Sprintf("%s.%s", r.ClientInfo.ServiceID, r.Operation.Name),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("aws-api"),
+					semconv.RPCServiceKey.String(r.ClientInfo.ServiceID),
+					semconv.RPCMethodKey.String(r.Operation.Name),
+					attributeKeyAWSOperation.String(r.Operation.Name),
+					attributeKeyAWSRegion.String(aws.StringValue(r.Config.Region)),
+					attributeKeyAWSService.String(r.ClientInfo.ServiceID),
+				),
+			)
+
+			r.SetContext(ctx)
+		},
+	})
+
+	// CompleteAttempt handlers run after each attempt, before any retry.
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: tracingHandlerNameAttempt,
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				return
+			}
+
+			attributes := []attribute.KeyValue{
+				attributeKeyAWSAttempt.Int(r.RetryCount + 1),
+			}
+
+			if v, ok := r.Error.(awserr.Error); ok {
+				attributes = append(attributes, attributeKeyAWSErrorCode.String(v.Code()))
+			}
+
+			trace.SpanFromContext(r.Context()).AddEvent("attempt failed", trace.WithAttributes(attributes...))
+		},
+	})
+
+	// Complete handlers run once per operation, after the last attempt.
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: tracingHandlerNameEnd,
+		Fn: func(r *request.Request) {
+			span := trace.SpanFromContext(r.Context())
+
+			span.SetAttributes(
+				attributeKeyAWSRequestID.String(r.RequestID),
+				attributeKeyAWSRetryCount.Int(r.RetryCount),
+			)
+
+			if r.HTTPResponse != nil {
+				span.SetAttributes(semconv.HTTPStatusCodeKey.Int(r.HTTPResponse.StatusCode))
+			}
+
+			if r.Error != nil {
+				if v, ok := r.Error.(awserr.Error); ok {
+					span.SetAttributes(attributeKeyAWSErrorCode.String(v.Code()))
+				}
+
+				span.RecordError(r.Error)
+				span.SetStatus(codes.Error, r.Error.Error())
+			}
+
+			span.End()
+		},
+	})
+}
+
+// instrumentHandlers adds tracing to all requests made with the specified session handlers, if tracing is enabled.
+// Service clients created from the session inherit its handlers.
+func instrumentHandlers(ctx context.Context, handlers *request.Handlers) error {
+	if !tracingEnabled() {
+		return nil
+	}
+
+	tp, err := getTracerProvider(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Tracing AWS API calls with OpenTelemetry")
+	addTracingHandlers(handlers, tp.Tracer(tracerName, trace.WithInstrumentationVersion(version.ProviderVersion)))
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package conns
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAddTracingHandlers(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Errors             []error
+		ExpectedErrorCode  string
+		ExpectedRetryCount int64
+		ExpectedStatusCode codes.Code
+	}{
+		{
+			Name: "success",
+		},
+		{
+			Name: "retried",
+			Errors: []error{
+				awserr.New("ThrottlingException", "Rate exceeded", nil),
+			},
+			ExpectedRetryCount: 1,
+		},
+		{
+			Name: "failure",
+			Errors: []error{
+				awserr.New("ValidationException", "Invalid", nil),
+			},
+			ExpectedErrorCode:  "ValidationException",
+			ExpectedStatusCode: codes.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+			handlers := defaults.Handlers()
+			handlers.Send.Clear()
+			handlers.Send.PushBack(func(r *request.Request) {
+				r.HTTPResponse = &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}
+				r.RequestID = "test-request-id"
+
+				if attempt := r.RetryCount; attempt < len(testCase.Errors) {
+					r.HTTPResponse.StatusCode = http.StatusBadRequest
+					r.Error = testCase.Errors[attempt]
+				}
+			})
+			handlers.Unmarshal.Clear()
+			handlers.UnmarshalMeta.Clear()
+			handlers.UnmarshalError.Clear()
+			handlers.ValidateResponse.Clear()
+
+			addTracingHandlers(&handlers, tp.Tracer(tracerName))
+
+			req := request.New(
+				aws.Config{Region: aws.String("us-west-2")},                                              //lintignore:AWSAT003
+				metadata.ClientInfo{ServiceID: "Test", Endpoint: "https://test.us-west-2.amazonaws.com"}, //lintignore:AWSAT003
+				handlers,
+				client.DefaultRetryer{NumMaxRetries: 1, MinRetryDelay: 1, MinThrottleDelay: 1, MaxRetryDelay: 1, MaxThrottleDelay: 1},
+				&request.Operation{Name: "TestOperation", HTTPMethod: http.MethodPost, HTTPPath: "/"},
+				nil,
+				nil,
+			)
+			req.Config.Credentials = nil
+			req.Handlers.Sign.Clear()
+			req.Retryable = nil
+
+			// The request is made retryable by error code alone, independent of the HTTP status.
+			req.Handlers.Retry.PushBack(func(r *request.Request) {
+				if v, ok := r.Error.(awserr.Error); ok {
+					r.Retryable = aws.Bool(v.Code() == "ThrottlingException")
+				}
+			})
+
+			_ = req.Send()
+
+			spans := exporter.GetSpans()
+
+			if got, want := len(spans), 1; got != want {
+				t.Fatalf("got %d spans, expected %d", got, want)
+			}
+
+			span := spans[0]
+
+			if got, want := span.Name, "Test.TestOperation"; got != want {
+				t.Errorf("got span name %q, expected %q", got, want)
+			}
+
+			if got, want := span.Status.Code, testCase.ExpectedStatusCode; got != want {
+				t.Errorf("got span status %s, expected %s", got, want)
+			}
+
+			attributes := make(map[string]interface{})
+			for _, v := range span.Attributes {
+				attributes[string(v.Key)] = v.Value.AsInterface()
+			}
+
+			if got, want := attributes[string(attributeKeyAWSRequestID)], "test-request-id"; got != want {
+				t.Errorf("got request ID %v, expected %v", got, want)
+			}
+
+			if got, want := attributes[string(attributeKeyAWSRetryCount)], testCase.ExpectedRetryCount; got != want {
+				t.Errorf("got retry count %v, expected %v", got, want)
+			}
+
+			if got, want := attributes[string(attributeKeyAWSRegion)], "us-west-2"; got != want { //lintignore:AWSAT003
+				t.Errorf("got region %v, expected %v", got, want)
+			}
+
+			if testCase.ExpectedErrorCode != "" {
+				if got, want := attributes[string(attributeKeyAWSErrorCode)], testCase.ExpectedErrorCode; got != want {
+					t.Errorf("got error code %v, expected %v", got, want)
+				}
+			}
+
+			var attemptsFailed int
+			for _, v := range span.Events {
+				if v.Name == "attempt failed" {
+					attemptsFailed++
+				}
+			}
+
+			if got, want := attemptsFailed, len(testCase.Errors); got != want {
+				t.Errorf("got %d failed attempt events, expected %d", got, want)
+			}
+		})
+	}
+}
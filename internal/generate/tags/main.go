@@ -885,11 +885,15 @@ func init() {
 	awsServiceNames["augmentedairuntime"] = "AugmentedAiruntime"
 	awsServiceNames["autoscaling"] = "AutoScaling"
 	awsServiceNames["autoscalingplans"] = "AutoScalingPlans"
+	awsServiceNames["b2bi"] = "B2bi"
 	awsServiceNames["backup"] = "Backup"
 	awsServiceNames["batch"] = "Batch"
+	awsServiceNames["bedrock"] = "Bedrock"
+	awsServiceNames["bedrockagent"] = "BedrockAgent"
 	awsServiceNames["braket"] = "Braket"
 	awsServiceNames["budgets"] = "Budgets"
 	awsServiceNames["chime"] = "Chime"
+	awsServiceNames["cleanrooms"] = "CleanRooms"
 	awsServiceNames["cloud9"] = "Cloud9"
 	awsServiceNames["cloudcontrolapi"] = "CloudControlApi"
 	awsServiceNames["clouddirectory"] = "CloudDirectory"
@@ -929,6 +933,7 @@ func init() {
 	awsServiceNames["dataexchange"] = "DataExchange"
 	awsServiceNames["datapipeline"] = "DataPipeline"
 	awsServiceNames["datasync"] = "DataSync"
+	awsServiceNames["datazone"] = "DataZone"
 	awsServiceNames["dax"] = "DAX"
 	awsServiceNames["detective"] = "Detective"
 	awsServiceNames["devicefarm"] = "DeviceFarm"
@@ -1050,6 +1055,8 @@ func init() {
 	awsServiceNames["networkfirewall"] = "NetworkFirewall"
 	awsServiceNames["networkmanager"] = "NetworkManager"
 	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opensearchserverless"] = "OpenSearchServerless"
+	awsServiceNames["opensearchservice"] = "OpenSearchService"
 	awsServiceNames["opsworks"] = "OpsWorks"
 	awsServiceNames["opsworkscm"] = "OpsWorksCM"
 	awsServiceNames["organizations"] = "Organizations"
@@ -1061,6 +1068,7 @@ func init() {
 	awsServiceNames["pinpoint"] = "Pinpoint"
 	awsServiceNames["pinpointemail"] = "PinpointEmail"
 	awsServiceNames["pinpointsmsvoice"] = "PinpointSMSVoice"
+	awsServiceNames["pipes"] = "Pipes"
 	awsServiceNames["polly"] = "Polly"
 	awsServiceNames["pricing"] = "Pricing"
 	awsServiceNames["prometheusservice"] = "PrometheusService"
@@ -1074,12 +1082,14 @@ func init() {
 	awsServiceNames["rdsutils"] = "RDSUtils"
 	awsServiceNames["redshift"] = "Redshift"
 	awsServiceNames["redshiftdata"] = "RedshiftData"
+	awsServiceNames["redshiftserverless"] = "RedshiftServerless"
 	awsServiceNames["rekognition"] = "Rekognition"
 	awsServiceNames["resourcegroups"] = "ResourceGroups"
 	awsServiceNames["resourcegroupstaggingapi"] = "ResourceGroupsTaggingAPI"
 	awsServiceNames["robomaker"] = "RoboMaker"
 	awsServiceNames["route53"] = "Route53"
 	awsServiceNames["route53domains"] = "Route53Domains"
+	awsServiceNames["route53profiles"] = "Route53Profiles"
 	awsServiceNames["route53recoverycontrolconfig"] = "Route53RecoveryControlConfig"
 	awsServiceNames["route53recoveryreadiness"] = "Route53RecoveryReadiness"
 	awsServiceNames["route53resolver"] = "Route53Resolver"
@@ -1093,9 +1103,11 @@ func init() {
 	awsServiceNames["sagemakerfeaturestoreruntime"] = "SageMakerFeatureStoreRuntime"
 	awsServiceNames["sagemakerruntime"] = "SageMakerRuntime"
 	awsServiceNames["savingsplans"] = "SavingsPlans"
+	awsServiceNames["scheduler"] = "Scheduler"
 	awsServiceNames["schemas"] = "Schemas"
 	awsServiceNames["secretsmanager"] = "SecretsManager"
 	awsServiceNames["securityhub"] = "SecurityHub"
+	awsServiceNames["securitylake"] = "SecurityLake"
 	awsServiceNames["serverlessapplicationrepository"] = "ServerlessApplicationRepository"
 	awsServiceNames["servicecatalog"] = "ServiceCatalog"
 	awsServiceNames["servicediscovery"] = "ServiceDiscovery"
@@ -1129,6 +1141,8 @@ func init() {
 	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
 	awsServiceNames["transfer"] = "Transfer"
 	awsServiceNames["translate"] = "Translate"
+	awsServiceNames["verifiedpermissions"] = "VerifiedPermissions"
+	awsServiceNames["vpclattice"] = "VPCLattice"
 	awsServiceNames["waf"] = "WAF"
 	awsServiceNames["wafregional"] = "WAFRegional"
 	awsServiceNames["wafv2"] = "WAFV2"
@@ -0,0 +1,295 @@
+// Package importblock discovers existing AWS resources and renders Terraform import blocks,
+// with skeleton resource configuration, for them.
+// Resources already managed by Terraform are excluded when a Terraform state is provided.
+//
+// Service packages register a ListFunc for the resources they support discovering.
+// Registration happens from init() so that every service package linked into the provider binary participates.
+package importblock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Resource is a discovered resource.
+type Resource struct {
+	// Type is the Terraform resource type, e.g. aws_glue_catalog_database.
+	Type string
+
+	// ID is the identifier passed to the resource's importer.
+	ID string
+
+	// Name is used to derive the resource's name (label) in the generated configuration.
+	Name string
+
+	// Arguments are the resource's required arguments, rendered as skeleton configuration.
+	// Values can be string, int64, []string, map[string]interface{} (a nested block)
+	// or []map[string]interface{} (a repeated nested block).
+	Arguments map[string]interface{}
+}
+
+// Filter restricts the resources that are discovered.
+type Filter struct {
+	// NamePrefix, if set, only matches resources whose name begins with the prefix.
+	NamePrefix string
+}
+
+// Match returns whether or not the specified resource name matches the filter.
+func (f Filter) Match(name string) bool {
+	return strings.HasPrefix(name, f.NamePrefix)
+}
+
+// ListFunc discovers the resources of a service.
+type ListFunc func(ctx context.Context, client *conns.AWSClient, filter Filter) ([]Resource, error)
+
+var (
+	listFuncs   = make(map[string][]ListFunc)
+	listFuncsMu sync.Mutex
+)
+
+// Register registers a ListFunc for the specified service.
+func Register(service string, f ListFunc) {
+	listFuncsMu.Lock()
+	defer listFuncsMu.Unlock()
+
+	listFuncs[service] = append(listFuncs[service], f)
+}
+
+// Services returns the sorted names of all services that have registered a ListFunc.
+func Services() []string {
+	listFuncsMu.Lock()
+	defer listFuncsMu.Unlock()
+
+	services := make([]string, 0, len(listFuncs))
+
+	for service := range listFuncs {
+		services = append(services, service)
+	}
+
+	sort.Strings(services)
+
+	return services
+}
+
+// List discovers the resources of the specified services.
+// All registered services are listed if none are specified.
+func List(ctx context.Context, client *conns.AWSClient, services []string, filter Filter) ([]Resource, error) {
+	if len(services) == 0 {
+		services = Services()
+	}
+
+	var resources []Resource
+
+	for _, service := range services {
+		listFuncsMu.Lock()
+		fs, ok := listFuncs[service]
+		listFuncsMu.Unlock()
+
+		if !ok {
+			return nil, fmt.Errorf("import block generation is not supported for service (%s), supported services: %s", service, strings.Join(Services(), ", "))
+		}
+
+		for _, f := range fs {
+			r, err := f(ctx, client, filter)
+
+			if err != nil {
+				return nil, fmt.Errorf("error listing %s resources: %w", service, err)
+			}
+
+			resources = append(resources, r...)
+		}
+	}
+
+	return resources, nil
+}
+
+// Write renders an import block and skeleton resource configuration for each resource.
+func Write(w io.Writer, resources []Resource) error {
+	addresses := make(map[string]bool)
+
+	for i, r := range resources {
+		name := uniqueResourceName(addresses, r.Type, ResourceName(r.Name))
+
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(w, "import {\n  to = %s.%s\n  id = %s\n}\n\n", r.Type, name, quote(r.ID)); err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "resource %q %q {\n", r.Type, name); err != nil {
+			return err
+		}
+
+		if err := writeBody(w, r.Arguments, 1); err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintln(w, "}"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeBody(w io.Writer, arguments map[string]interface{}, depth int) error {
+	indent := strings.Repeat("  ", depth)
+
+	var attributes, blocks []string
+	width := 0
+
+	for k, v := range arguments {
+		switch v.(type) {
+		case map[string]interface{}, []map[string]interface{}:
+			blocks = append(blocks, k)
+			continue
+		}
+
+		attributes = append(attributes, k)
+
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+
+	sort.Strings(attributes)
+	sort.Strings(blocks)
+
+	for _, k := range attributes {
+		var value string
+
+		switch v := arguments[k].(type) {
+		case string:
+			value = quote(v)
+		case int64:
+			value = strconv.FormatInt(v, 10)
+		case []string:
+			quoted := make([]string, len(v))
+			for i, s := range v {
+				quoted[i] = quote(s)
+			}
+			value = "[" + strings.Join(quoted, ", ") + "]"
+		default:
+			return fmt.Errorf("unsupported type (%T) for argument (%s)", v, k)
+		}
+
+		if _, err := fmt.Fprintf(w, "%s%-*s = %s\n", indent, width, k, value); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range blocks {
+		var bodies []map[string]interface{}
+
+		switch v := arguments[k].(type) {
+		case map[string]interface{}:
+			bodies = []map[string]interface{}{v}
+		case []map[string]interface{}:
+			bodies = v
+		}
+
+		for _, body := range bodies {
+			if _, err := fmt.Fprintf(w, "\n%s%s {\n", indent, k); err != nil {
+				return err
+			}
+
+			if err := writeBody(w, body, depth+1); err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(w, "%s}\n", indent); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// uniqueResourceName returns the specified name, with a counter suffix appended if needed,
+// such that the resource's address is not already in use. The address is then marked as used.
+func uniqueResourceName(addresses map[string]bool, resourceType, name string) string {
+	unique := name
+
+	for n := 2; addresses[resourceType+"."+unique]; n++ {
+		unique = fmt.Sprintf("%s_%d", name, n)
+	}
+
+	addresses[resourceType+"."+unique] = true
+
+	return unique
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// ResourceName returns a valid Terraform resource name derived from the specified value.
+func ResourceName(v string) string {
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(v, "_"), "_")
+
+	if name == "" {
+		return "this"
+	}
+
+	if c := name[0]; !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_') {
+		name = "r_" + name
+	}
+
+	return name
+}
+
+// Options configures Generate.
+type Options struct {
+	Profile  string
+	Region   string
+	Services []string
+	Filter   Filter
+
+	// State, if set, excludes the resources it contains.
+	State State
+}
+
+// Generate discovers resources, using credentials from the standard AWS credential sources,
+// and writes import blocks and skeleton resource configuration for those not in the options' state.
+func Generate(ctx context.Context, w io.Writer, opts Options) error {
+	config := conns.Config{
+		Endpoints:           make(map[string]string),
+		MaxRetries:          25,
+		Profile:             opts.Profile,
+		Region:              opts.Region,
+		SkipGetEC2Platforms: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		return err
+	}
+
+	resources, err := List(ctx, raw.(*conns.AWSClient), opts.Services, opts.Filter)
+
+	if err != nil {
+		return err
+	}
+
+	return Write(w, opts.State.Exclude(resources))
+}
+
+// quote renders a string as an HCL quoted string literal.
+// Unlike Go's %q, template sequences such as ${ and %{ are escaped and only escapes valid in HCL are used.
+func quote(s string) string {
+	return string(hclwrite.TokensForValue(cty.StringVal(s)).Bytes())
+}
@@ -0,0 +1,277 @@
+package importblock
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func TestResourceName(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Expected string
+	}{
+		{Value: "my-bucket", Expected: "my-bucket"},
+		{Value: "my.bucket.example.com", Expected: "my_bucket_example_com"},
+		{Value: "123-bucket", Expected: "r_123-bucket"},
+		{Value: "..", Expected: "this"},
+		{Value: "", Expected: "this"},
+	}
+
+	for _, testCase := range testCases {
+		if got := ResourceName(testCase.Value); got != testCase.Expected {
+			t.Errorf("ResourceName(%q) = %q, expected %q", testCase.Value, got, testCase.Expected)
+		}
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	filter := Filter{NamePrefix: "prod-"}
+
+	if !filter.Match("prod-logs") {
+		t.Errorf("expected prod-logs to match")
+	}
+
+	if filter.Match("dev-logs") {
+		t.Errorf("expected dev-logs not to match")
+	}
+
+	if !(Filter{}).Match("anything") {
+		t.Errorf("expected empty filter to match")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	resources := []Resource{
+		{
+			Type: "aws_s3_bucket_versioning",
+			ID:   "example.com",
+			Name: "example.com",
+			Arguments: map[string]interface{}{
+				"bucket": "example.com",
+				"versioning_configuration": map[string]interface{}{
+					"status": "Enabled",
+				},
+			},
+		},
+		{
+			Type: "aws_glue_catalog_table",
+			ID:   "123456789012:db:tbl",
+			Name: "db_tbl",
+			Arguments: map[string]interface{}{
+				"database_name": "db",
+				"name":          "tbl",
+			},
+		},
+		{
+			Type: "aws_glue_catalog_table",
+			ID:   "123456789012:db_tbl:x",
+			Name: "db_tbl",
+			Arguments: map[string]interface{}{
+				"name": "x",
+			},
+		},
+		{
+			Type: "aws_glue_catalog_table",
+			ID:   "123456789012:db:tbl_2",
+			Name: "db_tbl_2",
+			Arguments: map[string]interface{}{
+				"name": "tbl_2",
+			},
+		},
+	}
+
+	expected := `import {
+  to = aws_s3_bucket_versioning.example_com
+  id = "example.com"
+}
+
+resource "aws_s3_bucket_versioning" "example_com" {
+  bucket = "example.com"
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+import {
+  to = aws_glue_catalog_table.db_tbl
+  id = "123456789012:db:tbl"
+}
+
+resource "aws_glue_catalog_table" "db_tbl" {
+  database_name = "db"
+  name          = "tbl"
+}
+
+import {
+  to = aws_glue_catalog_table.db_tbl_2
+  id = "123456789012:db_tbl:x"
+}
+
+resource "aws_glue_catalog_table" "db_tbl_2" {
+  name = "x"
+}
+
+import {
+  to = aws_glue_catalog_table.db_tbl_2_2
+  id = "123456789012:db:tbl_2"
+}
+
+resource "aws_glue_catalog_table" "db_tbl_2_2" {
+  name = "tbl_2"
+}
+`
+
+	var buf bytes.Buffer
+
+	if err := Write(&buf, resources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := buf.String(); got != expected {
+		t.Errorf("Got:\n\n%s\n\nExpected:\n\n%s", got, expected)
+	}
+}
+
+func TestWriteRepeatedBlocks(t *testing.T) {
+	resources := []Resource{
+		{
+			Type: "aws_s3_bucket_cors_configuration",
+			ID:   "example",
+			Name: "example",
+			Arguments: map[string]interface{}{
+				"bucket": "example",
+				"cors_rule": []map[string]interface{}{
+					{
+						"allowed_methods": []string{"GET"},
+						"allowed_origins": []string{"*"},
+					},
+					{
+						"allowed_methods": []string{"PUT", "POST"},
+						"allowed_origins": []string{"https://example.com"},
+					},
+				},
+			},
+		},
+	}
+
+	expected := `import {
+  to = aws_s3_bucket_cors_configuration.example
+  id = "example"
+}
+
+resource "aws_s3_bucket_cors_configuration" "example" {
+  bucket = "example"
+
+  cors_rule {
+    allowed_methods = ["GET"]
+    allowed_origins = ["*"]
+  }
+
+  cors_rule {
+    allowed_methods = ["PUT", "POST"]
+    allowed_origins = ["https://example.com"]
+  }
+}
+`
+
+	var buf bytes.Buffer
+
+	if err := Write(&buf, resources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := buf.String(); got != expected {
+		t.Errorf("Got:\n\n%s\n\nExpected:\n\n%s", got, expected)
+	}
+}
+
+func TestWriteTemplateSequences(t *testing.T) {
+	resources := []Resource{
+		{
+			Type: "aws_iam_policy",
+			ID:   "arn:aws:iam::123456789012:policy/${aws:username}", //lintignore:AWSAT005
+			Name: "example",
+			Arguments: map[string]interface{}{
+				"description": "%{if} \"quoted\"\ttabbed\x00",
+				"policy":      `{"Resource":"arn:aws:s3:::bucket/${aws:username}/*"}`, //lintignore:AWSAT005
+				"tags_all":    []string{"${a}", "$b", "%{c}"},
+			},
+		},
+	}
+
+	expected := `import {
+  to = aws_iam_policy.example
+  id = "arn:aws:iam::123456789012:policy/$${aws:username}"
+}
+
+resource "aws_iam_policy" "example" {
+  description = "%%{if} \"quoted\"\ttabbed\u0000"
+  policy      = "{\"Resource\":\"arn:aws:s3:::bucket/$${aws:username}/*\"}"
+  tags_all    = ["$${a}", "$b", "%%{c}"]
+}
+`
+
+	var buf bytes.Buffer
+
+	if err := Write(&buf, resources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := buf.String(); got != expected {
+		t.Errorf("Got:\n\n%s\n\nExpected:\n\n%s", got, expected)
+	}
+
+	// The generated configuration parses back to the original values.
+	file, diags := hclsyntax.ParseConfig(buf.Bytes(), "generated.tf", hcl.InitialPos)
+
+	if diags.HasErrors() {
+		t.Fatalf("unexpected error parsing generated configuration: %s", diags)
+	}
+
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+	})
+
+	if diags.HasErrors() {
+		t.Fatalf("unexpected error decoding generated configuration: %s", diags)
+	}
+
+	attributes, diags := content.Blocks[0].Body.JustAttributes()
+
+	if diags.HasErrors() {
+		t.Fatalf("unexpected error decoding generated configuration: %s", diags)
+	}
+
+	for _, k := range []string{"description", "policy"} {
+		v, diags := attributes[k].Expr.Value(nil)
+
+		if diags.HasErrors() {
+			t.Fatalf("unexpected error evaluating %s: %s", k, diags)
+		}
+
+		if got, want := v.AsString(), resources[0].Arguments[k].(string); got != want {
+			t.Errorf("%s: got %q, want %q", k, got, want)
+		}
+	}
+}
+
+func TestWriteUnsupportedType(t *testing.T) {
+	resources := []Resource{
+		{
+			Type:      "aws_s3_bucket",
+			ID:        "example",
+			Name:      "example",
+			Arguments: map[string]interface{}{"force_destroy": true},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	if err := Write(&buf, resources); err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -0,0 +1,87 @@
+package importblock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// State is the set of resources already managed by Terraform, by resource type and ID.
+type State map[string]map[string]bool
+
+// Contains returns whether or not the specified resource is already managed by Terraform.
+// Resources are matched on their type and their ID in state, which for the supported resources is their import ID.
+func (s State) Contains(r Resource) bool {
+	return s[r.Type][r.ID]
+}
+
+// Exclude returns the specified resources that are not already managed by Terraform.
+func (s State) Exclude(resources []Resource) []Resource {
+	var unmanaged []Resource
+
+	for _, r := range resources {
+		if s.Contains(r) {
+			continue
+		}
+
+		unmanaged = append(unmanaged, r)
+	}
+
+	return unmanaged
+}
+
+type stateModule struct {
+	Resources []struct {
+		Mode   string                 `json:"mode"`
+		Type   string                 `json:"type"`
+		Values map[string]interface{} `json:"values"`
+	} `json:"resources"`
+	ChildModules []stateModule `json:"child_modules"`
+}
+
+// ReadState reads the managed resources, including those in child modules, from the JSON representation
+// of a Terraform state, i.e. the output of `terraform show -json`.
+func ReadState(r io.Reader) (State, error) {
+	var v struct {
+		Values *struct {
+			RootModule stateModule `json:"root_module"`
+		} `json:"values"`
+	}
+
+	if err := json.NewDecoder(r).Decode(&v); err != nil {
+		return nil, fmt.Errorf("error reading Terraform state: %w", err)
+	}
+
+	state := make(State)
+
+	// An empty state has no values.
+	if v.Values != nil {
+		state.add(v.Values.RootModule)
+	}
+
+	return state, nil
+}
+
+func (s State) add(module stateModule) {
+	for _, r := range module.Resources {
+		if r.Mode != "managed" {
+			continue
+		}
+
+		id, ok := r.Values["id"].(string)
+
+		if !ok || id == "" {
+			continue
+		}
+
+		if s[r.Type] == nil {
+			s[r.Type] = make(map[string]bool)
+		}
+
+		s[r.Type][id] = true
+	}
+
+	for _, child := range module.ChildModules {
+		s.add(child)
+	}
+}
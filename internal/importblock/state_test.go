@@ -0,0 +1,90 @@
+package importblock
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadState(t *testing.T) {
+	state, err := ReadState(strings.NewReader(`{
+  "format_version": "1.0",
+  "terraform_version": "1.5.0",
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "aws_s3_bucket.logs",
+          "mode": "managed",
+          "type": "aws_s3_bucket",
+          "name": "logs",
+          "values": {"id": "prod-logs", "bucket": "prod-logs"}
+        },
+        {
+          "address": "data.aws_s3_bucket.assets",
+          "mode": "data",
+          "type": "aws_s3_bucket",
+          "name": "assets",
+          "values": {"id": "prod-assets", "bucket": "prod-assets"}
+        }
+      ],
+      "child_modules": [
+        {
+          "address": "module.catalog",
+          "resources": [
+            {
+              "address": "module.catalog.aws_glue_catalog_database.sales",
+              "mode": "managed",
+              "type": "aws_glue_catalog_database",
+              "name": "sales",
+              "values": {"id": "123456789012:sales", "name": "sales"}
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resources := []Resource{
+		{Type: "aws_s3_bucket", ID: "prod-logs"},
+		{Type: "aws_s3_bucket_versioning", ID: "prod-logs"},
+		{Type: "aws_s3_bucket", ID: "prod-assets"},
+		{Type: "aws_glue_catalog_database", ID: "123456789012:sales"},
+		{Type: "aws_glue_catalog_database", ID: "123456789012:marketing"},
+	}
+
+	expected := []Resource{
+		{Type: "aws_s3_bucket_versioning", ID: "prod-logs"},
+		{Type: "aws_s3_bucket", ID: "prod-assets"},
+		{Type: "aws_glue_catalog_database", ID: "123456789012:marketing"},
+	}
+
+	if got := state.Exclude(resources); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Exclude() = %v, expected %v", got, expected)
+	}
+}
+
+func TestReadStateEmpty(t *testing.T) {
+	state, err := ReadState(strings.NewReader(`{"format_version": "1.0"}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resources := []Resource{{Type: "aws_s3_bucket", ID: "prod-logs"}}
+
+	if got := state.Exclude(resources); !reflect.DeepEqual(got, resources) {
+		t.Errorf("Exclude() = %v, expected %v", got, resources)
+	}
+}
+
+func TestReadStateInvalid(t *testing.T) {
+	if _, err := ReadState(strings.NewReader(`not json`)); err == nil {
+		t.Errorf("expected error, got none")
+	}
+}
@@ -0,0 +1,82 @@
+// Package jsonutil contains helpers for comparing and normalizing JSON documents held in string attributes.
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// BytesEqual compares two arrays of JSON bytes and returns true if the unmarshaled objects represented by the bytes
+// are equal according to `reflect.DeepEqual`.
+func BytesEqual(b1, b2 []byte) bool {
+	var o1 interface{}
+	if err := json.Unmarshal(b1, &o1); err != nil {
+		return false
+	}
+
+	var o2 interface{}
+	if err := json.Unmarshal(b2, &o2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o1, o2)
+}
+
+// StringsEquivalent returns true if the two JSON strings are semantically equal,
+// ignoring whitespace and object key ordering.
+func StringsEquivalent(s1, s2 string) bool {
+	b1 := bytes.NewBufferString("")
+	if err := json.Compact(b1, []byte(s1)); err != nil {
+		return false
+	}
+
+	b2 := bytes.NewBufferString("")
+	if err := json.Compact(b2, []byte(s2)); err != nil {
+		return false
+	}
+
+	return BytesEqual(b1.Bytes(), b2.Bytes())
+}
+
+// SuppressEquivalentDiffs is a schema.SchemaDiffSuppressFunc that suppresses diffs
+// between semantically equal JSON documents. Two empty values are considered equal.
+func SuppressEquivalentDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if strings.TrimSpace(old) == "" && strings.TrimSpace(new) == "" {
+		return true
+	}
+
+	return StringsEquivalent(old, new)
+}
+
+// NormalizeString returns the JSON document in normalized form, with insignificant
+// whitespace removed and object keys sorted. An empty document is returned unchanged.
+func NormalizeString(s string) (string, error) {
+	if strings.TrimSpace(s) == "" {
+		return "", nil
+	}
+
+	return structure.NormalizeJsonString(s)
+}
+
+// NormalizeStateFunc is a schema.SchemaStateFunc that stores JSON documents in normalized form.
+// Invalid JSON is stored unchanged so that validation can report it.
+func NormalizeStateFunc(v interface{}) string {
+	s, ok := v.(string)
+
+	if !ok {
+		return ""
+	}
+
+	json, err := NormalizeString(s)
+
+	if err != nil {
+		return s
+	}
+
+	return json
+}
@@ -0,0 +1,213 @@
+package jsonutil
+
+import (
+	"testing"
+)
+
+func TestBytesEqualQuotedAndUnquoted(t *testing.T) {
+	unquoted := `{"test": "test"}`
+	quoted := "{\"test\": \"test\"}"
+
+	if !BytesEqual([]byte(unquoted), []byte(quoted)) {
+		t.Errorf("Expected BytesEqual to return true for %s == %s", unquoted, quoted)
+	}
+
+	unquotedDiff := `{"test": "test"}`
+	quotedDiff := "{\"test\": \"tested\"}"
+
+	if BytesEqual([]byte(unquotedDiff), []byte(quotedDiff)) {
+		t.Errorf("Expected BytesEqual to return false for %s == %s", unquotedDiff, quotedDiff)
+	}
+}
+
+func TestBytesEqualWhitespaceAndNoWhitespace(t *testing.T) {
+	noWhitespace := `{"test":"test"}`
+	whitespace := `
+{
+  "test": "test"
+}`
+
+	if !BytesEqual([]byte(noWhitespace), []byte(whitespace)) {
+		t.Errorf("Expected BytesEqual to return true for %s == %s", noWhitespace, whitespace)
+	}
+
+	noWhitespaceDiff := `{"test":"test"}`
+	whitespaceDiff := `
+{
+  "test": "tested"
+}`
+
+	if BytesEqual([]byte(noWhitespaceDiff), []byte(whitespaceDiff)) {
+		t.Errorf("Expected BytesEqual to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
+	}
+}
+
+func TestStringsEquivalentWhitespaceAndNoWhitespace(t *testing.T) {
+	noWhitespace := `{"test":"test"}`
+	whitespace := `
+{
+  "test": "test"
+}`
+
+	if !StringsEquivalent(noWhitespace, whitespace) {
+		t.Errorf("Expected StringsEquivalent to return true for %s == %s", noWhitespace, whitespace)
+	}
+
+	noWhitespaceDiff := `{"test":"test"}`
+	whitespaceDiff := `
+{
+  "test": "tested"
+}`
+
+	if StringsEquivalent(noWhitespaceDiff, whitespaceDiff) {
+		t.Errorf("Expected StringsEquivalent to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
+	}
+}
+
+func TestSuppressEquivalentDiffs(t *testing.T) {
+	testCases := []struct {
+		name       string
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			name:       "both empty",
+			equivalent: true,
+		},
+		{
+			name: "old empty",
+			new:  `{"redrivePermission":"allowAll"}`,
+		},
+		{
+			name: "new empty",
+			old:  `{"redrivePermission":"allowAll"}`,
+		},
+		{
+			name:       "key ordering",
+			old:        `{"redrivePermission":"byQueue","sourceQueueArns":["arn:aws:sqs:us-west-2:123456789012:a"]}`,
+			new:        `{"sourceQueueArns":["arn:aws:sqs:us-west-2:123456789012:a"],"redrivePermission":"byQueue"}`,
+			equivalent: true,
+		},
+		{
+			name:       "whitespace",
+			old:        `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:a","maxReceiveCount":3}`,
+			new:        "{\n  \"maxReceiveCount\": 3,\n  \"deadLetterTargetArn\": \"arn:aws:sqs:us-west-2:123456789012:a\"\n}\n",
+			equivalent: true,
+		},
+		{
+			name: "different values",
+			old:  `{"redrivePermission":"allowAll"}`,
+			new:  `{"redrivePermission":"denyAll"}`,
+		},
+		{
+			name: "array ordering",
+			old:  `{"sourceQueueArns":["a","b"]}`,
+			new:  `{"sourceQueueArns":["b","a"]}`,
+		},
+		{
+			name: "invalid JSON",
+			old:  `{"redrivePermission":"allowAll"}`,
+			new:  `{"redrivePermission":`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			if got, want := SuppressEquivalentDiffs("test_property", testCase.old, testCase.new, nil), testCase.equivalent; got != want {
+				t.Errorf("SuppressEquivalentDiffs(%q, %q) = %v, want %v", testCase.old, testCase.new, got, want)
+			}
+		})
+	}
+}
+
+func TestNormalizeStateFunc(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "not a string",
+			input:    42,
+			expected: "",
+		},
+		{
+			name:     "whitespace",
+			input:    "{\n  \"redrivePermission\": \"allowAll\"\n}\n",
+			expected: `{"redrivePermission":"allowAll"}`,
+		},
+		{
+			name:     "invalid JSON",
+			input:    `{"redrivePermission":`,
+			expected: `{"redrivePermission":`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			if got, want := NormalizeStateFunc(testCase.input), testCase.expected; got != want {
+				t.Errorf("NormalizeStateFunc(%v) = %q, want %q", testCase.input, got, want)
+			}
+		})
+	}
+}
+
+func TestNormalizeString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "empty",
+			expected: "",
+		},
+		{
+			name:     "blank",
+			input:    "  \n",
+			expected: "",
+		},
+		{
+			name:     "key ordering",
+			input:    `{"sourceQueueArns":["arn"],"redrivePermission":"byQueue"}`,
+			expected: `{"redrivePermission":"byQueue","sourceQueueArns":["arn"]}`,
+		},
+		{
+			name:  "invalid JSON",
+			input: `{"redrivePermission":`,
+			err:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := NormalizeString(testCase.input)
+
+			if testCase.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("NormalizeString(%q) = %q, want %q", testCase.input, got, testCase.expected)
+			}
+		})
+	}
+}
@@ -27,10 +27,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
@@ -55,6 +59,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
@@ -79,6 +84,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
@@ -103,7 +109,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
@@ -114,19 +119,25 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53profiles"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -134,9 +145,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
@@ -156,6 +169,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
@@ -206,15 +221,17 @@ func Provider() *schema.Provider {
 				},
 			},
 			"ec2_metadata_service_endpoint": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 				Description: "Address of the EC2 metadata service endpoint to use. " +
 					"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
 			},
 			"ec2_metadata_service_endpoint_mode": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Description: "Protocol to use with EC2 metadata service endpoint." +
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2MetadataServiceEndpointMode_Values(), false),
+				Description: "Protocol to use with EC2 metadata service endpoint. " +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
 			"endpoints": endpointsSchema(),
@@ -262,6 +279,7 @@ func Provider() *schema.Provider {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"logging": loggingSchema(),
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -270,6 +288,13 @@ func Provider() *schema.Provider {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"preflight_checks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Validate constraints that can only be checked against AWS, such as name availability " +
+					"and service quota headroom, during plan. Requires additional read permissions.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -288,6 +313,30 @@ func Provider() *schema.Provider {
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				InputDefault: "us-east-1", // lintignore:AWSAT003
 			},
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  conns.RetryModeEnvDefaultFunc,
+				ValidateFunc: validation.StringInSlice(conns.RetryModes(), false),
+				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.\n" +
+					"In `adaptive` mode a client-side rate limiter additionally reduces the request rate\n" +
+					"of a service after throttling errors.",
+			},
+			"route53_change_batch_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1s",
+				ValidateFunc: verify.ValidDuration,
+				Description: "How long Route 53 record changes for the same hosted zone are collected " +
+					"into a single change batch after a change is submitted, e.g. `500ms`. `0s` disables batching. Defaults to `1s`.",
+			},
+			"s3_bucket_lock_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+				Description: "How long to wait for concurrent writes to the same S3 bucket's configuration " +
+					"and for S3 to resolve conflicting operations on the bucket, e.g. `5m`. Defaults to `5m`.",
+			},
 			"s3_force_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -297,6 +346,13 @@ func Provider() *schema.Provider {
 					"use virtual hosted bucket addressing when possible\n" +
 					"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 			},
+			"s3_skip_eventual_consistency_retries": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Skip retries that wait for new S3 buckets and bucket configurations to become visible. " +
+					"Used for S3 API implementations, such as emulators, that are immediately consistent.",
+			},
 			"secret_key": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -304,6 +360,16 @@ func Provider() *schema.Provider {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_max_retries": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				Description: "The maximum number of times an AWS API request is retried, per service.\n" +
+					"Keys are the service names used in the `endpoints` block. Overrides `max_retries`.",
+			},
 			"shared_config_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -336,7 +402,8 @@ func Provider() *schema.Provider {
 				Optional: true,
 				Default:  false,
 				Description: "Skip the AWS Metadata API check. " +
-					"Used for AWS API implementations that do not have a metadata api endpoint.",
+					"Used for AWS API implementations that do not have a metadata api endpoint. " +
+					"Disables all use of the EC2 metadata service, including for credentials and account information.",
 			},
 			"skip_region_validation": {
 				Type:     schema.TypeBool,
@@ -352,6 +419,17 @@ func Provider() *schema.Provider {
 				Description: "Skip requesting the account ID. " +
 					"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"sso": ssoSchema(),
+			"tags_propagation_timeouts": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidDuration,
+				},
+				Description: "How long to wait for tags applied when a resource is created to be returned by the service, per service, e.g. `5m`.\n" +
+					"Keys are the service names used in the `endpoints` block.",
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -457,6 +535,8 @@ func Provider() *schema.Provider {
 			"aws_datapipeline_pipeline":            datapipeline.DataSourcePipeline(),
 			"aws_datapipeline_pipeline_definition": datapipeline.DataSourcePipelineDefinition(),
 
+			"aws_datazone_environment_blueprint": datazone.DataSourceEnvironmentBlueprint(),
+
 			"aws_docdb_engine_version":        docdb.DataSourceEngineVersion(),
 			"aws_docdb_orderable_db_instance": docdb.DataSourceOrderableDBInstance(),
 
@@ -480,6 +560,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_snapshot_ids":                           ec2.DataSourceEBSSnapshotIDs(),
 			"aws_ebs_volume":                                 ec2.DataSourceEBSVolume(),
 			"aws_ebs_volumes":                                ec2.DataSourceEBSVolumes(),
+			"aws_ec2_capacity_block_offering":                ec2.DataSourceCapacityBlockOffering(),
 			"aws_ec2_client_vpn_endpoint":                    ec2.DataSourceClientVPNEndpoint(),
 			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
 			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
@@ -584,6 +665,7 @@ func Provider() *schema.Provider {
 
 			"aws_globalaccelerator_accelerator": globalaccelerator.DataSourceAccelerator(),
 
+			"aws_glue_catalog_tables":                   glue.DataSourceCatalogTables(),
 			"aws_glue_connection":                       glue.DataSourceConnection(),
 			"aws_glue_data_catalog_encryption_settings": glue.DataSourceDataCatalogEncryptionSettings(),
 			"aws_glue_script":                           glue.DataSourceScript(),
@@ -667,6 +749,8 @@ func Provider() *schema.Provider {
 			"aws_neptune_engine_version":        neptune.DataSourceEngineVersion(),
 			"aws_neptune_orderable_db_instance": neptune.DataSourceOrderableDBInstance(),
 
+			"aws_networkmanager_core_network_policy_document": networkmanager.DataSourceCoreNetworkPolicyDocument(),
+
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
 			"aws_organizations_organization":             organizations.DataSourceOrganization(),
@@ -714,12 +798,13 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
 			"aws_route53_resolver_rules":    route53resolver.DataSourceRules(),
 
-			"aws_canonical_user_id": s3.DataSourceCanonicalUserID(),
-			"aws_s3_bucket":         s3.DataSourceBucket(),
-			"aws_s3_object":         s3.DataSourceObject(),
-			"aws_s3_objects":        s3.DataSourceObjects(),
-			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
-			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
+			"aws_canonical_user_id":               s3.DataSourceCanonicalUserID(),
+			"aws_s3_bucket":                       s3.DataSourceBucket(),
+			"aws_s3_bucket_website_configuration": s3.DataSourceBucketWebsiteConfiguration(),
+			"aws_s3_object":                       s3.DataSourceObject(),
+			"aws_s3_objects":                      s3.DataSourceObjects(),
+			"aws_s3_bucket_object":                s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
+			"aws_s3_bucket_objects":               s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
 
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
@@ -901,20 +986,35 @@ func Provider() *schema.Provider {
 
 			"aws_autoscalingplans_scaling_plan": autoscalingplans.ResourceScalingPlan(),
 
-			"aws_backup_global_settings":          backup.ResourceGlobalSettings(),
-			"aws_backup_plan":                     backup.ResourcePlan(),
-			"aws_backup_region_settings":          backup.ResourceRegionSettings(),
-			"aws_backup_selection":                backup.ResourceSelection(),
-			"aws_backup_vault":                    backup.ResourceVault(),
-			"aws_backup_vault_lock_configuration": backup.ResourceVaultLockConfiguration(),
-			"aws_backup_vault_notifications":      backup.ResourceVaultNotifications(),
-			"aws_backup_vault_policy":             backup.ResourceVaultPolicy(),
+			"aws_b2bi_capability":  b2bi.ResourceCapability(),
+			"aws_b2bi_partnership": b2bi.ResourcePartnership(),
+			"aws_b2bi_profile":     b2bi.ResourceProfile(),
+			"aws_b2bi_transformer": b2bi.ResourceTransformer(),
+
+			"aws_backup_global_settings":           backup.ResourceGlobalSettings(),
+			"aws_backup_plan":                      backup.ResourcePlan(),
+			"aws_backup_region_settings":           backup.ResourceRegionSettings(),
+			"aws_backup_restore_testing_plan":      backup.ResourceRestoreTestingPlan(),
+			"aws_backup_restore_testing_selection": backup.ResourceRestoreTestingSelection(),
+			"aws_backup_selection":                 backup.ResourceSelection(),
+			"aws_backup_vault":                     backup.ResourceVault(),
+			"aws_backup_vault_lock_configuration":  backup.ResourceVaultLockConfiguration(),
+			"aws_backup_vault_notifications":       backup.ResourceVaultNotifications(),
+			"aws_backup_vault_policy":              backup.ResourceVaultPolicy(),
 
 			"aws_batch_compute_environment": batch.ResourceComputeEnvironment(),
 			"aws_batch_job_definition":      batch.ResourceJobDefinition(),
 			"aws_batch_job_queue":           batch.ResourceJobQueue(),
 			"aws_batch_scheduling_policy":   batch.ResourceSchedulingPolicy(),
 
+			"aws_bedrock_custom_model":                 bedrock.ResourceCustomModel(),
+			"aws_bedrock_provisioned_model_throughput": bedrock.ResourceProvisionedModelThroughput(),
+
+			"aws_bedrockagent_agent":              bedrockagent.ResourceAgent(),
+			"aws_bedrockagent_agent_action_group": bedrockagent.ResourceAgentActionGroup(),
+			"aws_bedrockagent_agent_alias":        bedrockagent.ResourceAgentAlias(),
+			"aws_bedrockagent_knowledge_base":     bedrockagent.ResourceKnowledgeBase(),
+
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
 
@@ -926,6 +1026,12 @@ func Provider() *schema.Provider {
 			"aws_chime_voice_connector_termination":             chime.ResourceVoiceConnectorTermination(),
 			"aws_chime_voice_connector_termination_credentials": chime.ResourceVoiceConnectorTerminationCredentials(),
 
+			"aws_cleanrooms_collaboration":                  cleanrooms.ResourceCollaboration(),
+			"aws_cleanrooms_configured_table":               cleanrooms.ResourceConfiguredTable(),
+			"aws_cleanrooms_configured_table_analysis_rule": cleanrooms.ResourceConfiguredTableAnalysisRule(),
+			"aws_cleanrooms_configured_table_association":   cleanrooms.ResourceConfiguredTableAssociation(),
+			"aws_cleanrooms_membership":                     cleanrooms.ResourceMembership(),
+
 			"aws_cloud9_environment_ec2":        cloud9.ResourceEnvironmentEC2(),
 			"aws_cloud9_environment_membership": cloud9.ResourceEnvironmentMembership(),
 
@@ -937,11 +1043,13 @@ func Provider() *schema.Provider {
 			"aws_cloudformation_type":               cloudformation.ResourceType(),
 
 			"aws_cloudfront_cache_policy":                   cloudfront.ResourceCachePolicy(),
+			"aws_cloudfront_continuous_deployment_policy":   cloudfront.ResourceContinuousDeploymentPolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.ResourceDistribution(),
 			"aws_cloudfront_field_level_encryption_config":  cloudfront.ResourceFieldLevelEncryptionConfig(),
 			"aws_cloudfront_field_level_encryption_profile": cloudfront.ResourceFieldLevelEncryptionProfile(),
 			"aws_cloudfront_function":                       cloudfront.ResourceFunction(),
 			"aws_cloudfront_key_group":                      cloudfront.ResourceKeyGroup(),
+			"aws_cloudfront_key_value_store":                cloudfront.ResourceKeyValueStore(),
 			"aws_cloudfront_monitoring_subscription":        cloudfront.ResourceMonitoringSubscription(),
 			"aws_cloudfront_origin_access_identity":         cloudfront.ResourceOriginAccessIdentity(),
 			"aws_cloudfront_origin_request_policy":          cloudfront.ResourceOriginRequestPolicy(),
@@ -1051,6 +1159,7 @@ func Provider() *schema.Provider {
 			"aws_datapipeline_pipeline_definition": datapipeline.ResourcePipelineDefinition(),
 
 			"aws_datasync_agent":                            datasync.ResourceAgent(),
+			"aws_datasync_location_azure_blob":              datasync.ResourceLocationAzureBlob(),
 			"aws_datasync_location_efs":                     datasync.ResourceLocationEFS(),
 			"aws_datasync_location_fsx_lustre_file_system":  datasync.ResourceLocationFSxLustreFileSystem(),
 			"aws_datasync_location_fsx_windows_file_system": datasync.ResourceLocationFSxWindowsFileSystem(),
@@ -1060,6 +1169,12 @@ func Provider() *schema.Provider {
 			"aws_datasync_location_smb":                     datasync.ResourceLocationSMB(),
 			"aws_datasync_task":                             datasync.ResourceTask(),
 
+			"aws_datazone_domain":                              datazone.ResourceDomain(),
+			"aws_datazone_environment":                         datazone.ResourceEnvironment(),
+			"aws_datazone_environment_blueprint_configuration": datazone.ResourceEnvironmentBlueprintConfiguration(),
+			"aws_datazone_environment_profile":                 datazone.ResourceEnvironmentProfile(),
+			"aws_datazone_project":                             datazone.ResourceProject(),
+
 			"aws_dax_cluster":         dax.ResourceCluster(),
 			"aws_dax_parameter_group": dax.ResourceParameterGroup(),
 			"aws_dax_subnet_group":    dax.ResourceSubnetGroup(),
@@ -1116,7 +1231,9 @@ func Provider() *schema.Provider {
 
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
+			"aws_dynamodb_resource_policy":               dynamodb.ResourceResourcePolicy(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_import":                  dynamodb.ResourceTableImport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
@@ -1138,18 +1255,22 @@ func Provider() *schema.Provider {
 			"aws_ebs_snapshot_import":                             ec2.ResourceEBSSnapshotImport(),
 			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
 			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_block_reservation":                  ec2.ResourceCapacityBlockReservation(),
 			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
 			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
 			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                         ec2.ResourceClientVPNEndpoint(),
 			"aws_ec2_client_vpn_network_association":              ec2.ResourceClientVPNNetworkAssociation(),
 			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
+			"aws_ec2_default_credit_specification":                ec2.ResourceDefaultCreditSpecification(),
 			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
 			"aws_ec2_host":                                        ec2.ResourceHost(),
+			"aws_ec2_instance_connect_endpoint":                   ec2.ResourceInstanceConnectEndpoint(),
 			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_replace_root_volume_task":                    ec2.ResourceReplaceRootVolumeTask(),
 			"aws_ec2_subnet_cidr_reservation":                     ec2.ResourceSubnetCIDRReservation(),
 			"aws_ec2_tag":                                         ec2.ResourceTag(),
 			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
@@ -1159,6 +1280,8 @@ func Provider() *schema.Provider {
 			"aws_ec2_transit_gateway":                             ec2.ResourceTransitGateway(),
 			"aws_ec2_transit_gateway_peering_attachment":          ec2.ResourceTransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment_accepter": ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_policy_table":                ec2.ResourceTransitGatewayPolicyTable(),
+			"aws_ec2_transit_gateway_policy_table_association":    ec2.ResourceTransitGatewayPolicyTableAssociation(),
 			"aws_ec2_transit_gateway_prefix_list_reference":       ec2.ResourceTransitGatewayPrefixListReference(),
 			"aws_ec2_transit_gateway_route":                       ec2.ResourceTransitGatewayRoute(),
 			"aws_ec2_transit_gateway_route_table":                 ec2.ResourceTransitGatewayRouteTable(),
@@ -1194,6 +1317,7 @@ func Provider() *schema.Provider {
 			"aws_subnet":                                          ec2.ResourceSubnet(),
 			"aws_volume_attachment":                               ec2.ResourceVolumeAttachment(),
 			"aws_vpc":                                             ec2.ResourceVPC(),
+			"aws_vpc_byoip_cidr_advertisement":                    ec2.ResourceVPCByoipCidrAdvertisement(),
 			"aws_vpc_dhcp_options":                                ec2.ResourceVPCDHCPOptions(),
 			"aws_vpc_dhcp_options_association":                    ec2.ResourceVPCDHCPOptionsAssociation(),
 			"aws_vpc_endpoint":                                    ec2.ResourceVPCEndpoint(),
@@ -1215,6 +1339,8 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                  ec2.ResourceSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                 ec2.ResourceSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
@@ -1247,17 +1373,21 @@ func Provider() *schema.Provider {
 			"aws_efs_file_system_policy": efs.ResourceFileSystemPolicy(),
 			"aws_efs_mount_target":       efs.ResourceMountTarget(),
 
-			"aws_eks_addon":                    eks.ResourceAddon(),
-			"aws_eks_cluster":                  eks.ResourceCluster(),
-			"aws_eks_fargate_profile":          eks.ResourceFargateProfile(),
-			"aws_eks_identity_provider_config": eks.ResourceIdentityProviderConfig(),
-			"aws_eks_node_group":               eks.ResourceNodeGroup(),
+			"aws_eks_access_entry":              eks.ResourceAccessEntry(),
+			"aws_eks_access_policy_association": eks.ResourceAccessPolicyAssociation(),
+			"aws_eks_addon":                     eks.ResourceAddon(),
+			"aws_eks_cluster":                   eks.ResourceCluster(),
+			"aws_eks_fargate_profile":           eks.ResourceFargateProfile(),
+			"aws_eks_identity_provider_config":  eks.ResourceIdentityProviderConfig(),
+			"aws_eks_node_group":                eks.ResourceNodeGroup(),
+			"aws_eks_pod_identity_association":  eks.ResourcePodIdentityAssociation(),
 
 			"aws_elasticache_cluster":                  elasticache.ResourceCluster(),
 			"aws_elasticache_global_replication_group": elasticache.ResourceGlobalReplicationGroup(),
 			"aws_elasticache_parameter_group":          elasticache.ResourceParameterGroup(),
 			"aws_elasticache_replication_group":        elasticache.ResourceReplicationGroup(),
 			"aws_elasticache_security_group":           elasticache.ResourceSecurityGroup(),
+			"aws_elasticache_serverless_cache":         elasticache.ResourceServerlessCache(),
 			"aws_elasticache_subnet_group":             elasticache.ResourceSubnetGroup(),
 			"aws_elasticache_user":                     elasticache.ResourceUser(),
 			"aws_elasticache_user_group":               elasticache.ResourceUserGroup(),
@@ -1307,6 +1437,9 @@ func Provider() *schema.Provider {
 
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
 
+			"aws_fis_experiment_template":          fis.ResourceExperimentTemplate(),
+			"aws_fis_target_account_configuration": fis.ResourceTargetAccountConfiguration(),
+
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
 			"aws_fms_policy":        fms.ResourcePolicy(),
 
@@ -1333,12 +1466,15 @@ func Provider() *schema.Provider {
 			"aws_globalaccelerator_endpoint_group": globalaccelerator.ResourceEndpointGroup(),
 			"aws_globalaccelerator_listener":       globalaccelerator.ResourceListener(),
 
+			"aws_glue_blueprint":                        glue.ResourceBlueprint(),
 			"aws_glue_catalog_database":                 glue.ResourceCatalogDatabase(),
 			"aws_glue_catalog_table":                    glue.ResourceCatalogTable(),
 			"aws_glue_classifier":                       glue.ResourceClassifier(),
 			"aws_glue_connection":                       glue.ResourceConnection(),
 			"aws_glue_crawler":                          glue.ResourceCrawler(),
+			"aws_glue_custom_entity_type":               glue.ResourceCustomEntityType(),
 			"aws_glue_data_catalog_encryption_settings": glue.ResourceDataCatalogEncryptionSettings(),
+			"aws_glue_data_quality_ruleset":             glue.ResourceDataQualityRuleset(),
 			"aws_glue_dev_endpoint":                     glue.ResourceDevEndpoint(),
 			"aws_glue_job":                              glue.ResourceJob(),
 			"aws_glue_ml_transform":                     glue.ResourceMLTransform(),
@@ -1348,6 +1484,7 @@ func Provider() *schema.Provider {
 			"aws_glue_resource_policy":                  glue.ResourceResourcePolicy(),
 			"aws_glue_schema":                           glue.ResourceSchema(),
 			"aws_glue_security_configuration":           glue.ResourceSecurityConfiguration(),
+			"aws_glue_table_optimizer":                  glue.ResourceTableOptimizer(),
 			"aws_glue_trigger":                          glue.ResourceTrigger(),
 			"aws_glue_user_defined_function":            glue.ResourceUserDefinedFunction(),
 			"aws_glue_workflow":                         glue.ResourceWorkflow(),
@@ -1411,11 +1548,14 @@ func Provider() *schema.Provider {
 
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
+			"aws_msk_replicator":               kafka.ResourceReplicator(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
 
+			"aws_mskconnect_connector":            kafkaconnect.ResourceConnector(),
 			"aws_mskconnect_custom_plugin":        kafkaconnect.ResourceCustomPlugin(),
 			"aws_mskconnect_worker_configuration": kafkaconnect.ResourceWorkerConfiguration(),
 
+			"aws_kinesis_resource_policy": kinesis.ResourceResourcePolicy(),
 			"aws_kinesis_stream":          kinesis.ResourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
 
@@ -1442,6 +1582,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
 			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_function_url":                   lambda.ResourceFunctionURL(),
 			"aws_lambda_invocation":                     lambda.ResourceInvocation(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
@@ -1463,9 +1604,6 @@ func Provider() *schema.Provider {
 			"aws_lightsail_static_ip":             lightsail.ResourceStaticIP(),
 			"aws_lightsail_static_ip_attachment":  lightsail.ResourceStaticIPAttachment(),
 
-			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
-			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
-
 			"aws_macie2_account":                    macie2.ResourceAccount(),
 			"aws_macie2_classification_job":         macie2.ResourceClassificationJob(),
 			"aws_macie2_custom_data_identifier":     macie2.ResourceCustomDataIdentifier(),
@@ -1508,6 +1646,15 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
 			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
 
+			"aws_networkmanager_core_network_policy_attachment": networkmanager.ResourceCoreNetworkPolicyAttachment(),
+
+			"aws_opensearch_domain": opensearch.ResourceDomain(),
+
+			"aws_opensearchserverless_access_policy":   opensearchserverless.ResourceAccessPolicy(),
+			"aws_opensearchserverless_collection":      opensearchserverless.ResourceCollection(),
+			"aws_opensearchserverless_security_policy": opensearchserverless.ResourceSecurityPolicy(),
+			"aws_opensearchserverless_vpc_endpoint":    opensearchserverless.ResourceVPCEndpoint(),
+
 			"aws_opsworks_application":      opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":     opsworks.ResourceCustomLayer(),
 			"aws_opsworks_ganglia_layer":    opsworks.ResourceGangliaLayer(),
@@ -1544,6 +1691,8 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
+			"aws_pipes_pipe": pipes.ResourcePipe(),
+
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
@@ -1569,11 +1718,13 @@ func Provider() *schema.Provider {
 			"aws_db_security_group":             rds.ResourceSecurityGroup(),
 			"aws_db_snapshot":                   rds.ResourceSnapshot(),
 			"aws_db_subnet_group":               rds.ResourceSubnetGroup(),
+			"aws_rds_blue_green_deployment":     rds.ResourceBlueGreenDeployment(),
 			"aws_rds_cluster":                   rds.ResourceCluster(),
 			"aws_rds_cluster_endpoint":          rds.ResourceClusterEndpoint(),
 			"aws_rds_cluster_instance":          rds.ResourceClusterInstance(),
 			"aws_rds_cluster_parameter_group":   rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":  rds.ResourceClusterRoleAssociation(),
+			"aws_rds_export_task":               rds.ResourceExportTask(),
 			"aws_rds_global_cluster":            rds.ResourceGlobalCluster(),
 
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
@@ -1586,8 +1737,13 @@ func Provider() *schema.Provider {
 			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
 			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
 
+			"aws_redshiftserverless_scheduled_action":            redshiftserverless.ResourceScheduledAction(),
+			"aws_redshiftserverless_snapshot_copy_configuration": redshiftserverless.ResourceSnapshotCopyConfiguration(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
+			"aws_route53_cidr_collection":               route53.ResourceCIDRCollection(),
+			"aws_route53_cidr_location":                 route53.ResourceCIDRLocation(),
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
 			"aws_route53_hosted_zone_dnssec":            route53.ResourceHostedZoneDNSSEC(),
@@ -1598,6 +1754,10 @@ func Provider() *schema.Provider {
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
 
+			"aws_route53profiles_association":          route53profiles.ResourceAssociation(),
+			"aws_route53profiles_profile":              route53profiles.ResourceProfile(),
+			"aws_route53profiles_resource_association": route53profiles.ResourceResourceAssociation(),
+
 			"aws_route53recoverycontrolconfig_cluster":         route53recoverycontrolconfig.ResourceCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":   route53recoverycontrolconfig.ResourceControlPanel(),
 			"aws_route53recoverycontrolconfig_routing_control": route53recoverycontrolconfig.ResourceRoutingControl(),
@@ -1625,6 +1785,7 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_cors_configuration":                s3.ResourceBucketCorsConfiguration(),
 			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
 			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
+			"aws_s3_bucket_logging":                           s3.ResourceBucketLogging(),
 			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
 			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
 			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
@@ -1633,20 +1794,25 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_replication_configuration":         s3.ResourceBucketReplicationConfiguration(),
 			"aws_s3_bucket_versioning":                        s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":             s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_bucket":                         s3.ResourceDirectoryBucket(),
 			"aws_s3_object":                                   s3.ResourceObject(),
 			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                            s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
 
-			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
-			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
-			"aws_s3_account_public_access_block":              s3control.ResourceAccountPublicAccessBlock(),
-			"aws_s3control_bucket":                            s3control.ResourceBucket(),
-			"aws_s3control_bucket_lifecycle_configuration":    s3control.ResourceBucketLifecycleConfiguration(),
-			"aws_s3control_bucket_policy":                     s3control.ResourceBucketPolicy(),
-			"aws_s3control_multi_region_access_point":         s3control.ResourceMultiRegionAccessPoint(),
-			"aws_s3control_multi_region_access_point_policy":  s3control.ResourceMultiRegionAccessPointPolicy(),
-			"aws_s3control_object_lambda_access_point":        s3control.ResourceObjectLambdaAccessPoint(),
-			"aws_s3control_object_lambda_access_point_policy": s3control.ResourceObjectLambdaAccessPointPolicy(),
+			"aws_s3_access_point":                                  s3control.ResourceAccessPoint(),
+			"aws_s3control_access_grant":                           s3control.ResourceAccessGrant(),
+			"aws_s3control_access_grants_instance":                 s3control.ResourceAccessGrantsInstance(),
+			"aws_s3control_access_grants_instance_resource_policy": s3control.ResourceAccessGrantsInstanceResourcePolicy(),
+			"aws_s3control_access_grants_location":                 s3control.ResourceAccessGrantsLocation(),
+			"aws_s3control_access_point_policy":                    s3control.ResourceAccessPointPolicy(),
+			"aws_s3_account_public_access_block":                   s3control.ResourceAccountPublicAccessBlock(),
+			"aws_s3control_bucket":                                 s3control.ResourceBucket(),
+			"aws_s3control_bucket_lifecycle_configuration":         s3control.ResourceBucketLifecycleConfiguration(),
+			"aws_s3control_bucket_policy":                          s3control.ResourceBucketPolicy(),
+			"aws_s3control_multi_region_access_point":              s3control.ResourceMultiRegionAccessPoint(),
+			"aws_s3control_multi_region_access_point_policy":       s3control.ResourceMultiRegionAccessPointPolicy(),
+			"aws_s3control_object_lambda_access_point":             s3control.ResourceObjectLambdaAccessPoint(),
+			"aws_s3control_object_lambda_access_point_policy":      s3control.ResourceObjectLambdaAccessPointPolicy(),
 
 			"aws_s3outposts_endpoint": s3outposts.ResourceEndpoint(),
 
@@ -1674,6 +1840,9 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_workforce":                                 sagemaker.ResourceWorkforce(),
 			"aws_sagemaker_workteam":                                  sagemaker.ResourceWorkteam(),
 
+			"aws_scheduler_schedule":       scheduler.ResourceSchedule(),
+			"aws_scheduler_schedule_group": scheduler.ResourceScheduleGroup(),
+
 			"aws_schemas_discoverer": schemas.ResourceDiscoverer(),
 			"aws_schemas_registry":   schemas.ResourceRegistry(),
 			"aws_schemas_schema":     schemas.ResourceSchema(),
@@ -1695,6 +1864,10 @@ func Provider() *schema.Provider {
 			"aws_securityhub_standards_subscription":     securityhub.ResourceStandardsSubscription(),
 			"aws_securityhub_finding_aggregator":         securityhub.ResourceFindingAggregator(),
 
+			"aws_securitylake_aws_log_source": securitylake.ResourceAWSLogSource(),
+			"aws_securitylake_data_lake":      securitylake.ResourceDataLake(),
+			"aws_securitylake_subscriber":     securitylake.ResourceSubscriber(),
+
 			"aws_serverlessapplicationrepository_cloudformation_stack": serverlessrepo.ResourceCloudFormationStack(),
 
 			"aws_servicecatalog_budget_resource_association":     servicecatalog.ResourceBudgetResourceAssociation(),
@@ -1737,9 +1910,10 @@ func Provider() *schema.Provider {
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
-			"aws_shield_protection":                          shield.ResourceProtection(),
-			"aws_shield_protection_group":                    shield.ResourceProtectionGroup(),
-			"aws_shield_protection_health_check_association": shield.ResourceProtectionHealthCheckAssociation(),
+			"aws_shield_application_layer_automatic_response": shield.ResourceApplicationLayerAutomaticResponse(),
+			"aws_shield_protection":                           shield.ResourceProtection(),
+			"aws_shield_protection_group":                     shield.ResourceProtectionGroup(),
+			"aws_shield_protection_health_check_association":  shield.ResourceProtectionHealthCheckAssociation(),
 
 			"aws_signer_signing_job":                signer.ResourceSigningJob(),
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
@@ -1747,14 +1921,16 @@ func Provider() *schema.Provider {
 
 			"aws_simpledb_domain": simpledb.ResourceDomain(),
 
-			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
-			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
-			"aws_sns_topic":                sns.ResourceTopic(),
-			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
-			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
+			"aws_sns_platform_application":         sns.ResourcePlatformApplication(),
+			"aws_sns_sms_preferences":              sns.ResourceSMSPreferences(),
+			"aws_sns_topic":                        sns.ResourceTopic(),
+			"aws_sns_topic_data_protection_policy": sns.ResourceTopicDataProtectionPolicy(),
+			"aws_sns_topic_policy":                 sns.ResourceTopicPolicy(),
+			"aws_sns_topic_subscription":           sns.ResourceTopicSubscription(),
 
-			"aws_sqs_queue":        sqs.ResourceQueue(),
-			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue":              sqs.ResourceQueue(),
+			"aws_sqs_queue_policy":       sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue_redrive_task": sqs.ResourceQueueRedriveTask(),
 
 			"aws_ssm_activation":                ssm.ResourceActivation(),
 			"aws_ssm_association":               ssm.ResourceAssociation(),
@@ -1790,10 +1966,26 @@ func Provider() *schema.Provider {
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
 
-			"aws_transfer_access":  transfer.ResourceAccess(),
-			"aws_transfer_server":  transfer.ResourceServer(),
-			"aws_transfer_ssh_key": transfer.ResourceSSHKey(),
-			"aws_transfer_user":    transfer.ResourceUser(),
+			"aws_transfer_access":    transfer.ResourceAccess(),
+			"aws_transfer_connector": transfer.ResourceConnector(),
+			"aws_transfer_server":    transfer.ResourceServer(),
+			"aws_transfer_ssh_key":   transfer.ResourceSSHKey(),
+			"aws_transfer_user":      transfer.ResourceUser(),
+
+			"aws_verifiedpermissions_identity_source": verifiedpermissions.ResourceIdentitySource(),
+			"aws_verifiedpermissions_policy":          verifiedpermissions.ResourcePolicy(),
+			"aws_verifiedpermissions_policy_store":    verifiedpermissions.ResourcePolicyStore(),
+			"aws_verifiedpermissions_policy_template": verifiedpermissions.ResourcePolicyTemplate(),
+			"aws_verifiedpermissions_schema":          verifiedpermissions.ResourceSchema(),
+
+			"aws_vpclattice_auth_policy":                         vpclattice.ResourceAuthPolicy(),
+			"aws_vpclattice_listener":                            vpclattice.ResourceListener(),
+			"aws_vpclattice_listener_rule":                       vpclattice.ResourceListenerRule(),
+			"aws_vpclattice_service":                             vpclattice.ResourceService(),
+			"aws_vpclattice_service_network":                     vpclattice.ResourceServiceNetwork(),
+			"aws_vpclattice_service_network_service_association": vpclattice.ResourceServiceNetworkServiceAssociation(),
+			"aws_vpclattice_service_network_vpc_association":     vpclattice.ResourceServiceNetworkVPCAssociation(),
+			"aws_vpclattice_target_group":                        vpclattice.ResourceTargetGroup(),
 
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
@@ -1828,6 +2020,7 @@ func Provider() *schema.Provider {
 			"aws_wafv2_web_acl":                       wafv2.ResourceWebACL(),
 			"aws_wafv2_web_acl_association":           wafv2.ResourceWebACLAssociation(),
 			"aws_wafv2_web_acl_logging_configuration": wafv2.ResourceWebACLLoggingConfiguration(),
+			"aws_wafv2_web_acl_rule":                  wafv2.ResourceWebACLRule(),
 
 			"aws_worklink_fleet": worklink.ResourceFleet(),
 			"aws_worklink_website_certificate_authority_association": worklink.ResourceWebsiteCertificateAuthorityAssociation(),
@@ -1857,52 +2050,138 @@ func Provider() *schema.Provider {
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := conns.Config{
-		AccessKey:                      d.Get("access_key").(string),
-		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
-		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
-		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
-		Endpoints:                      make(map[string]string),
-		HTTPProxy:                      d.Get("http_proxy").(string),
-		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                       d.Get("insecure").(bool),
-		MaxRetries:                     d.Get("max_retries").(int),
-		Profile:                        d.Get("profile").(string),
-		Region:                         d.Get("region").(string),
-		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
-		SecretKey:                      d.Get("secret_key").(string),
-		SharedConfigFile:               d.Get("shared_config_file").(string),
-		SharedCredentialsFile:          d.Get("shared_credentials_file").(string),
-		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
-		SkipMetadataApiCheck:           d.Get("skip_metadata_api_check").(bool),
-		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
-		TerraformVersion:               terraformVersion,
-		Token:                          d.Get("token").(string),
-		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
-		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		AccessKey:                        d.Get("access_key").(string),
+		DefaultTagsConfig:                expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		EC2MetadataServiceEndpoint:       d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode:   d.Get("ec2_metadata_service_endpoint_mode").(string),
+		Endpoints:                        make(map[string]string),
+		HTTPProxy:                        d.Get("http_proxy").(string),
+		IgnoreTagsConfig:                 expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		Insecure:                         d.Get("insecure").(bool),
+		MaxRetries:                       d.Get("max_retries").(int),
+		PreflightChecks:                  d.Get("preflight_checks").(bool),
+		Profile:                          d.Get("profile").(string),
+		Region:                           d.Get("region").(string),
+		RetryMode:                        d.Get("retry_mode").(string),
+		S3ForcePathStyle:                 d.Get("s3_force_path_style").(bool),
+		S3SkipEventualConsistencyRetries: d.Get("s3_skip_eventual_consistency_retries").(bool),
+		SecretKey:                        d.Get("secret_key").(string),
+		SharedConfigFile:                 d.Get("shared_config_file").(string),
+		SharedCredentialsFile:            d.Get("shared_credentials_file").(string),
+		SkipCredsValidation:              d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:              d.Get("skip_get_ec2_platforms").(bool),
+		SkipMetadataApiCheck:             d.Get("skip_metadata_api_check").(bool),
+		SkipRegionValidation:             d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:          d.Get("skip_requesting_account_id").(bool),
+		TerraformVersion:                 terraformVersion,
+		Token:                            d.Get("token").(string),
+		UseDualStackEndpoint:             d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:                  d.Get("use_fips_endpoint").(bool),
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
 		config.AssumeRole = expandAssumeRole(l[0].(map[string]interface{}))
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID)
+
+		for _, tfMapRaw := range l[1:] {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(tfMap)
+			log.Printf("[INFO] chained assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+			config.AssumeRoleChain = append(config.AssumeRoleChain, assumeRole)
+		}
+	}
+
+	if v, ok := d.GetOk("route53_change_batch_window"); ok {
+		window, err := time.ParseDuration(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing route53_change_batch_window (%s): %w", v.(string), err)
+		}
+
+		config.Route53ChangeBatchWindow = window
+	}
+
+	if v, ok := d.GetOk("s3_bucket_lock_timeout"); ok {
+		timeout, err := time.ParseDuration(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing s3_bucket_lock_timeout (%s): %w", v.(string), err)
+		}
+
+		config.S3BucketLockTimeout = timeout
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
+		hclEndpoints := make(map[string]string)
 
 		for _, hclKey := range conns.HCLKeys() {
-			var serviceKey string
-			var err error
-			if serviceKey, err = conns.ServiceForHCLKey(hclKey); err != nil {
-				return nil, fmt.Errorf("failed to assign endpoint (%s): %w", hclKey, err)
+			if v, ok := endpoints[hclKey].(string); ok && v != "" {
+				hclEndpoints[hclKey] = v
+			}
+		}
+
+		serviceEndpoints, err := conns.ResolveEndpoints(endpoints[conns.EndpointURLBaseHCLKey].(string), hclEndpoints)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign endpoints: %w", err)
+		}
+
+		for serviceKey, endpoint := range serviceEndpoints {
+			if config.Endpoints[serviceKey] == "" {
+				config.Endpoints[serviceKey] = endpoint
+			}
+		}
+	}
+
+	if l, ok := d.Get("logging").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.Logging = expandLogging(l[0].(map[string]interface{}))
+		log.Printf("[INFO] logging AWS API calls to %s", config.Logging.FilePath)
+	}
+
+	if l, ok := d.Get("sso").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.SSO = expandSSO(l[0].(map[string]interface{}))
+		log.Printf("[INFO] sso configuration set: (Account ID: %q, Role Name: %q, Session: %q)", config.SSO.AccountID, config.SSO.RoleName, config.SSO.SessionName)
+	}
+
+	if v, ok := d.GetOk("service_max_retries"); ok {
+		config.ServiceMaxRetries = make(map[string]int)
+
+		for hclKey, maxRetries := range v.(map[string]interface{}) {
+			serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid service_max_retries key (%s): %w", hclKey, err)
+			}
+
+			config.ServiceMaxRetries[serviceKey] = maxRetries.(int)
+		}
+	}
+
+	if v, ok := d.GetOk("tags_propagation_timeouts"); ok {
+		config.TagsPropagationTimeouts = make(map[string]time.Duration)
+
+		for hclKey, v := range v.(map[string]interface{}) {
+			serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid tags_propagation_timeouts key (%s): %w", hclKey, err)
 			}
 
-			if config.Endpoints[serviceKey] == "" && endpoints[hclKey].(string) != "" {
-				config.Endpoints[serviceKey] = endpoints[hclKey].(string)
+			timeout, err := time.ParseDuration(v.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing tags_propagation_timeouts (%s): %w", hclKey, err)
 			}
+
+			config.TagsPropagationTimeouts[serviceKey] = timeout
 		}
 	}
 
@@ -1923,9 +2202,9 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Roles to assume, in order. Each role after the first is assumed using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1990,15 +2269,105 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func loggingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"file_path": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The path of the file to which AWS API requests and responses are appended.",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"include_body": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether request and response bodies are logged.",
+				},
+				"redact_fields": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Additional request and response field names whose values are redacted.",
+				},
+			},
+		},
+	}
+}
+
+func ssoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The AWS account ID assigned to the IAM Identity Center user.",
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The AWS region that hosts the IAM Identity Center user portal.",
+				},
+				"role_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the IAM Identity Center permission set role to use.",
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the sso-session whose cached access token is used and automatically refreshed.",
+				},
+				"start_url": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The URL of the IAM Identity Center user portal.",
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+			},
+		},
+	}
+}
+
+const (
+	ec2MetadataServiceEndpointModeIPv4 = "IPv4"
+	ec2MetadataServiceEndpointModeIPv6 = "IPv6"
+)
+
+func ec2MetadataServiceEndpointMode_Values() []string {
+	return []string{
+		ec2MetadataServiceEndpointModeIPv4,
+		ec2MetadataServiceEndpointModeIPv6,
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
+	endpointsAttributes[conns.EndpointURLBaseHCLKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "",
+		Description:  "Use this to override the default endpoint URL of every service without an endpoint of its own",
+		ValidateFunc: validEndpointURL,
+	}
+
 	for _, serviceKey := range conns.HCLKeys() {
 		endpointsAttributes[serviceKey] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "",
-			Description: "Use this to override the default service endpoint URL",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "",
+			Description:  "Use this to override the default service endpoint URL",
+			ValidateFunc: validEndpointURL,
 		}
 	}
 
@@ -2011,6 +2380,14 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func validEndpointURL(v interface{}, k string) (ws []string, errors []error) {
+	if err := conns.ValidateEndpointURL(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
 func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 	assumeRole := awsbase.AssumeRole{}
 
@@ -2075,6 +2452,52 @@ func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 	return &assumeRole
 }
 
+func expandLogging(m map[string]interface{}) *conns.LoggingConfig {
+	logging := conns.LoggingConfig{}
+
+	if v, ok := m["file_path"].(string); ok && v != "" {
+		logging.FilePath = v
+	}
+
+	if v, ok := m["include_body"].(bool); ok {
+		logging.IncludeBody = v
+	}
+
+	if v, ok := m["redact_fields"].(*schema.Set); ok && v.Len() > 0 {
+		for _, fieldRaw := range v.List() {
+			logging.RedactFields = append(logging.RedactFields, fieldRaw.(string))
+		}
+	}
+
+	return &logging
+}
+
+func expandSSO(m map[string]interface{}) *conns.SSOConfig {
+	sso := conns.SSOConfig{}
+
+	if v, ok := m["account_id"].(string); ok && v != "" {
+		sso.AccountID = v
+	}
+
+	if v, ok := m["region"].(string); ok && v != "" {
+		sso.Region = v
+	}
+
+	if v, ok := m["role_name"].(string); ok && v != "" {
+		sso.RoleName = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		sso.SessionName = v
+	}
+
+	if v, ok := m["start_url"].(string); ok && v != "" {
+		sso.StartURL = v
+	}
+
+	return &sso
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
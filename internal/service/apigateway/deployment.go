@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -124,13 +123,7 @@ func resourceDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("invoke_url", buildInvokeURL(meta.(*conns.AWSClient), restApiId, stageName))
 
-	executionArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "execute-api",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("%s/%s", restApiId, stageName),
-	}.String()
+	executionArn := meta.(*conns.AWSClient).RegionalARN("execute-api", fmt.Sprintf("%s/%s", restApiId, stageName))
 	d.Set("execution_arn", executionArn)
 
 	if err := d.Set("created_date", out.CreatedDate.Format(time.RFC3339)); err != nil {
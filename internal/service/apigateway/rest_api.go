@@ -409,13 +409,7 @@ func resourceRestAPIRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("binary_media_types", api.BinaryMediaTypes)
 
-	execution_arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "execute-api",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  d.Id(),
-	}.String()
+	execution_arn := meta.(*conns.AWSClient).RegionalARN("execute-api", d.Id())
 	d.Set("execution_arn", execution_arn)
 
 	if api.MinimumCompressionSize == nil {
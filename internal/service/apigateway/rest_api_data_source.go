@@ -134,13 +134,7 @@ func dataSourceRestAPIRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
-	executionArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "execute-api",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  d.Id(),
-	}.String()
+	executionArn := meta.(*conns.AWSClient).RegionalARN("execute-api", d.Id())
 	d.Set("execution_arn", executionArn)
 
 	resourceParams := &apigateway.GetResourcesInput{
@@ -255,13 +255,7 @@ func resourceStageRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("invoke_url", buildInvokeURL(meta.(*conns.AWSClient), restApiId, stageName))
 
-	executionArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "execute-api",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("%s/%s", restApiId, stageName),
-	}.String()
+	executionArn := meta.(*conns.AWSClient).RegionalARN("execute-api", fmt.Sprintf("%s/%s", restApiId, stageName))
 	d.Set("execution_arn", executionArn)
 
 	return nil
@@ -304,13 +304,7 @@ func resourceAPIRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("description", resp.Description)
 	d.Set("disable_execute_api_endpoint", resp.DisableExecuteApiEndpoint)
-	executionArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "execute-api",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  d.Id(),
-	}.String()
+	executionArn := meta.(*conns.AWSClient).RegionalARN("execute-api", d.Id())
 	d.Set("execution_arn", executionArn)
 	d.Set("name", resp.Name)
 	d.Set("protocol_type", resp.ProtocolType)
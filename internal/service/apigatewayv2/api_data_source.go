@@ -135,13 +135,7 @@ func dataSourceAPIRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("description", api.Description)
 	d.Set("disable_execute_api_endpoint", api.DisableExecuteApiEndpoint)
-	executionArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "execute-api",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  d.Id(),
-	}.String()
+	executionArn := meta.(*conns.AWSClient).RegionalARN("execute-api", d.Id())
 	d.Set("execution_arn", executionArn)
 	d.Set("name", api.Name)
 	d.Set("protocol_type", api.ProtocolType)
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -103,13 +102,7 @@ func resourceApplicationRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error getting AppConfig Application (%s): empty response", d.Id())
 	}
 
-	arn := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("application/%s", aws.StringValue(output.Id)),
-		Service:   "appconfig",
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("appconfig", fmt.Sprintf("application/%s", aws.StringValue(output.Id)))
 
 	d.Set("arn", arn)
 	d.Set("name", output.Name)
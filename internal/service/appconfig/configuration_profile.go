@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -65,6 +64,13 @@ func ResourceConfigurationProfile() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ConfigurationProfileTypeFreeform,
+				ValidateFunc: validation.StringInSlice(ConfigurationProfileType_Values(), false),
+			},
 			"validator": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -107,6 +113,7 @@ func resourceConfigurationProfileCreate(d *schema.ResourceData, meta interface{}
 		LocationUri:   aws.String(d.Get("location_uri").(string)),
 		Name:          aws.String(name),
 		Tags:          Tags(tags.IgnoreAWS()),
+		Type:          aws.String(d.Get("type").(string)),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -175,18 +182,13 @@ func resourceConfigurationProfileRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", output.Name)
 
 	d.Set("retrieval_role_arn", output.RetrievalRoleArn)
+	d.Set("type", output.Type)
 
 	if err := d.Set("validator", flattenValidators(output.Validators)); err != nil {
 		return fmt.Errorf("error setting validator: %w", err)
 	}
 
-	arn := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("application/%s/configurationprofile/%s", appID, confProfID),
-		Service:   "appconfig",
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("appconfig", fmt.Sprintf("application/%s/configurationprofile/%s", appID, confProfID))
 
 	d.Set("arn", arn)
 
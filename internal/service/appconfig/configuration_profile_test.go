@@ -36,6 +36,7 @@ func TestAccAppConfigConfigurationProfile_basic(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "configuration_profile_id", regexp.MustCompile(`[a-z0-9]{4,7}`)),
 					resource.TestCheckResourceAttr(resourceName, "location_uri", "hosted"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", tfappconfig.ConfigurationProfileTypeFreeform),
 					resource.TestCheckResourceAttr(resourceName, "validator.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
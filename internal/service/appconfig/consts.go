@@ -0,0 +1,13 @@
+package appconfig
+
+const (
+	ConfigurationProfileTypeFeatureFlags = "AWS.AppConfig.FeatureFlags"
+	ConfigurationProfileTypeFreeform     = "AWS.Freeform"
+)
+
+func ConfigurationProfileType_Values() []string {
+	return []string{
+		ConfigurationProfileTypeFeatureFlags,
+		ConfigurationProfileTypeFreeform,
+	}
+}
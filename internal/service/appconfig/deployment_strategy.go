@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -143,13 +142,7 @@ func resourceDeploymentStrategyRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", output.Name)
 	d.Set("replicate_to", output.ReplicateTo)
 
-	arn := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("deploymentstrategy/%s", d.Id()),
-		Service:   "appconfig",
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("appconfig", fmt.Sprintf("deploymentstrategy/%s", d.Id()))
 	d.Set("arn", arn)
 
 	tags, err := ListTags(conn, arn)
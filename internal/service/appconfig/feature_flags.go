@@ -0,0 +1,444 @@
+package appconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	featureFlagsContentType = "application/json"
+	featureFlagsVersion     = "1"
+
+	featureFlagAttributeTypeBoolean     = "boolean"
+	featureFlagAttributeTypeNumber      = "number"
+	featureFlagAttributeTypeNumberArray = "number[]"
+	featureFlagAttributeTypeString      = "string"
+	featureFlagAttributeTypeStringArray = "string[]"
+
+	// featureFlagEnabledKey is the reserved key holding a flag's state in the "values" section.
+	featureFlagEnabledKey = "enabled"
+)
+
+func featureFlagAttributeType_Values() []string {
+	return []string{
+		featureFlagAttributeTypeBoolean,
+		featureFlagAttributeTypeNumber,
+		featureFlagAttributeTypeNumberArray,
+		featureFlagAttributeTypeString,
+		featureFlagAttributeTypeStringArray,
+	}
+}
+
+var featureFlagKeyRegexp = regexp.MustCompile(`^[a-z][a-zA-Z\d_-]{0,63}$`)
+
+func featureFlagSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"content", "flag"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enum": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringMatch(featureFlagKeyRegexp, "must start with a lowercase letter and contain only alphanumeric characters, hyphens or underscores"),
+							},
+							"maximum": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validateFeatureFlagNumber,
+							},
+							"minimum": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validateFeatureFlagNumber,
+							},
+							"pattern": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							"required": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(featureFlagAttributeType_Values(), false),
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"values": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"description": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(0, 1024),
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringMatch(featureFlagKeyRegexp, "must start with a lowercase letter and contain only alphanumeric characters, hyphens or underscores"),
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+		},
+	}
+}
+
+func validateFeatureFlagNumber(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a number", k, value))
+	}
+
+	return
+}
+
+// featureFlagsDocument is the AWS.AppConfig.FeatureFlags configuration schema.
+type featureFlagsDocument struct {
+	Flags   map[string]featureFlag            `json:"flags"`
+	Values  map[string]map[string]interface{} `json:"values"`
+	Version string                            `json:"version"`
+}
+
+type featureFlag struct {
+	Attributes  map[string]featureFlagAttribute `json:"attributes,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Name        string                          `json:"name"`
+}
+
+type featureFlagAttribute struct {
+	Constraints featureFlagConstraints `json:"constraints"`
+}
+
+type featureFlagConstraints struct {
+	Elements *featureFlagConstraints `json:"elements,omitempty"`
+	Enum     []interface{}           `json:"enum,omitempty"`
+	Maximum  *float64                `json:"maximum,omitempty"`
+	Minimum  *float64                `json:"minimum,omitempty"`
+	Pattern  string                  `json:"pattern,omitempty"`
+	Required bool                    `json:"required,omitempty"`
+	Type     string                  `json:"type"`
+}
+
+// expandFeatureFlags builds the feature flags JSON document from the "flag" configuration blocks.
+// Attribute values are checked against their constraints so that invalid flags are reported at plan time.
+func expandFeatureFlags(tfList []interface{}) (string, error) {
+	doc := featureFlagsDocument{
+		Flags:   make(map[string]featureFlag),
+		Values:  make(map[string]map[string]interface{}),
+		Version: featureFlagsVersion,
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+
+		if _, ok := doc.Flags[key]; ok {
+			return "", fmt.Errorf("duplicate flag key (%s)", key)
+		}
+
+		flag := featureFlag{
+			Description: tfMap["description"].(string),
+			Name:        tfMap["name"].(string),
+		}
+		values := map[string]interface{}{
+			featureFlagEnabledKey: tfMap["enabled"].(bool),
+		}
+
+		for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			attributeKey := tfMap["key"].(string)
+
+			if attributeKey == featureFlagEnabledKey {
+				return "", fmt.Errorf("flag (%s) attribute key %q is reserved", key, featureFlagEnabledKey)
+			}
+
+			if _, ok := flag.Attributes[attributeKey]; ok {
+				return "", fmt.Errorf("flag (%s) has duplicate attribute key (%s)", key, attributeKey)
+			}
+
+			constraints, value, err := expandFeatureFlagAttribute(tfMap)
+
+			if err != nil {
+				return "", fmt.Errorf("flag (%s) attribute (%s): %w", key, attributeKey, err)
+			}
+
+			if flag.Attributes == nil {
+				flag.Attributes = make(map[string]featureFlagAttribute)
+			}
+
+			flag.Attributes[attributeKey] = featureFlagAttribute{Constraints: constraints}
+
+			if value != nil {
+				values[attributeKey] = value
+			}
+		}
+
+		doc.Flags[key] = flag
+		doc.Values[key] = values
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandFeatureFlagAttribute(tfMap map[string]interface{}) (featureFlagConstraints, interface{}, error) {
+	attributeType := tfMap["type"].(string)
+	isArray := attributeType == featureFlagAttributeTypeNumberArray || attributeType == featureFlagAttributeTypeStringArray
+	elementType := attributeType
+
+	switch attributeType {
+	case featureFlagAttributeTypeNumberArray:
+		elementType = featureFlagAttributeTypeNumber
+	case featureFlagAttributeTypeStringArray:
+		elementType = featureFlagAttributeTypeString
+	}
+
+	element := featureFlagConstraints{
+		Pattern: tfMap["pattern"].(string),
+		Type:    elementType,
+	}
+
+	if element.Pattern != "" && elementType != featureFlagAttributeTypeString {
+		return featureFlagConstraints{}, nil, fmt.Errorf("pattern can only be set for %q or %q attributes", featureFlagAttributeTypeString, featureFlagAttributeTypeStringArray)
+	}
+
+	for _, k := range []string{"minimum", "maximum"} {
+		v := tfMap[k].(string)
+
+		if v == "" {
+			continue
+		}
+
+		if elementType != featureFlagAttributeTypeNumber {
+			return featureFlagConstraints{}, nil, fmt.Errorf("%s can only be set for %q or %q attributes", k, featureFlagAttributeTypeNumber, featureFlagAttributeTypeNumberArray)
+		}
+
+		f, err := strconv.ParseFloat(v, 64)
+
+		if err != nil {
+			return featureFlagConstraints{}, nil, fmt.Errorf("%s (%s) must be a number", k, v)
+		}
+
+		if k == "minimum" {
+			element.Minimum = &f
+		} else {
+			element.Maximum = &f
+		}
+	}
+
+	if element.Minimum != nil && element.Maximum != nil && *element.Minimum > *element.Maximum {
+		return featureFlagConstraints{}, nil, fmt.Errorf("minimum (%v) must not be greater than maximum (%v)", *element.Minimum, *element.Maximum)
+	}
+
+	for _, v := range tfMap["enum"].([]interface{}) {
+		if elementType == featureFlagAttributeTypeBoolean {
+			return featureFlagConstraints{}, nil, fmt.Errorf("enum cannot be set for %q attributes", featureFlagAttributeTypeBoolean)
+		}
+
+		value, err := element.parse(v.(string))
+
+		if err != nil {
+			return featureFlagConstraints{}, nil, fmt.Errorf("enum: %w", err)
+		}
+
+		element.Enum = append(element.Enum, value)
+	}
+
+	var value interface{}
+	scalar, hasScalar := tfMap["value"].(string)
+	hasScalar = hasScalar && scalar != ""
+	list := tfMap["values"].([]interface{})
+
+	if isArray {
+		if hasScalar {
+			return featureFlagConstraints{}, nil, fmt.Errorf("use values instead of value for %q attributes", attributeType)
+		}
+
+		if len(list) > 0 {
+			elements := make([]interface{}, 0, len(list))
+
+			for _, v := range list {
+				value, err := element.check(v.(string))
+
+				if err != nil {
+					return featureFlagConstraints{}, nil, err
+				}
+
+				elements = append(elements, value)
+			}
+
+			value = elements
+		}
+	} else {
+		if len(list) > 0 {
+			return featureFlagConstraints{}, nil, fmt.Errorf("use value instead of values for %q attributes", attributeType)
+		}
+
+		if hasScalar {
+			v, err := element.check(scalar)
+
+			if err != nil {
+				return featureFlagConstraints{}, nil, err
+			}
+
+			value = v
+		}
+	}
+
+	required := tfMap["required"].(bool)
+
+	if required && value == nil {
+		return featureFlagConstraints{}, nil, fmt.Errorf("a value is required")
+	}
+
+	if !isArray {
+		element.Required = required
+
+		return element, value, nil
+	}
+
+	return featureFlagConstraints{
+		Elements: &element,
+		Required: required,
+		Type:     attributeType,
+	}, value, nil
+}
+
+// parse converts a configured string to the JSON type of a scalar constraint.
+func (c featureFlagConstraints) parse(s string) (interface{}, error) {
+	switch c.Type {
+	case featureFlagAttributeTypeBoolean:
+		v, err := strconv.ParseBool(s)
+
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid boolean", s)
+		}
+
+		return v, nil
+	case featureFlagAttributeTypeNumber:
+		v, err := strconv.ParseFloat(s, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid number", s)
+		}
+
+		return v, nil
+	default:
+		return s, nil
+	}
+}
+
+// check parses a configured value and verifies that it satisfies a scalar constraint.
+func (c featureFlagConstraints) check(s string) (interface{}, error) {
+	value, err := c.parse(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Pattern != "" {
+		re, err := regexp.Compile(c.Pattern)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern (%s): %w", c.Pattern, err)
+		}
+
+		if !re.MatchString(s) {
+			return nil, fmt.Errorf("value %q does not match pattern (%s)", s, c.Pattern)
+		}
+	}
+
+	if v, ok := value.(float64); ok {
+		if c.Minimum != nil && v < *c.Minimum {
+			return nil, fmt.Errorf("value %v is less than minimum (%v)", v, *c.Minimum)
+		}
+
+		if c.Maximum != nil && v > *c.Maximum {
+			return nil, fmt.Errorf("value %v is greater than maximum (%v)", v, *c.Maximum)
+		}
+	}
+
+	if len(c.Enum) > 0 {
+		found := false
+
+		for _, e := range c.Enum {
+			if e == value {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("value %q is not one of the allowed enum values", s)
+		}
+	}
+
+	return value, nil
+}
@@ -0,0 +1,193 @@
+package appconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpandFeatureFlags(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         []interface{}
+		Expected      string
+		ExpectedError string
+	}{
+		{
+			Name: "flag without attributes",
+			Input: []interface{}{
+				testFeatureFlag("foo", true),
+			},
+			Expected: `{"flags":{"foo":{"name":"foo"}},"values":{"foo":{"enabled":true}},"version":"1"}`,
+		},
+		{
+			Name: "typed attributes",
+			Input: []interface{}{
+				testFeatureFlag("foo", false,
+					testFeatureFlagAttribute("limit", "number", map[string]interface{}{"value": "5", "minimum": "0", "maximum": "10", "required": true}),
+					testFeatureFlagAttribute("color", "string", map[string]interface{}{"value": "red", "enum": []interface{}{"red", "blue"}}),
+					testFeatureFlagAttribute("beta", "boolean", map[string]interface{}{"value": "true"}),
+					testFeatureFlagAttribute("tiers", "string[]", map[string]interface{}{"values": []interface{}{"gold", "silver"}, "pattern": "^[a-z]+$"}),
+				),
+			},
+			Expected: `{"flags":{"foo":{"attributes":{"beta":{"constraints":{"type":"boolean"}},"color":{"constraints":{"enum":["red","blue"],"type":"string"}},"limit":{"constraints":{"maximum":10,"minimum":0,"required":true,"type":"number"}},"tiers":{"constraints":{"elements":{"pattern":"^[a-z]+$","type":"string"},"type":"string[]"}}},"name":"foo"}},"values":{"foo":{"beta":true,"color":"red","enabled":false,"limit":5,"tiers":["gold","silver"]}},"version":"1"}`,
+		},
+		{
+			Name: "duplicate flag key",
+			Input: []interface{}{
+				testFeatureFlag("foo", true),
+				testFeatureFlag("foo", false),
+			},
+			ExpectedError: "duplicate flag key (foo)",
+		},
+		{
+			Name: "duplicate attribute key",
+			Input: []interface{}{
+				testFeatureFlag("foo", true,
+					testFeatureFlagAttribute("bar", "string", nil),
+					testFeatureFlagAttribute("bar", "number", nil),
+				),
+			},
+			ExpectedError: "duplicate attribute key (bar)",
+		},
+		{
+			Name: "reserved attribute key",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("enabled", "boolean", nil)),
+			},
+			ExpectedError: `attribute key "enabled" is reserved`,
+		},
+		{
+			Name: "required value missing",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "string", map[string]interface{}{"required": true})),
+			},
+			ExpectedError: "a value is required",
+		},
+		{
+			Name: "invalid number",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "number", map[string]interface{}{"value": "ten"})),
+			},
+			ExpectedError: `"ten" is not a valid number`,
+		},
+		{
+			Name: "value above maximum",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "number", map[string]interface{}{"value": "11", "maximum": "10"})),
+			},
+			ExpectedError: "greater than maximum",
+		},
+		{
+			Name: "array element below minimum",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "number[]", map[string]interface{}{"values": []interface{}{"1", "-1"}, "minimum": "0"})),
+			},
+			ExpectedError: "less than minimum",
+		},
+		{
+			Name: "minimum greater than maximum",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "number", map[string]interface{}{"minimum": "5", "maximum": "1"})),
+			},
+			ExpectedError: "must not be greater than maximum",
+		},
+		{
+			Name: "pattern mismatch",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "string", map[string]interface{}{"value": "ABC", "pattern": "^[a-z]+$"})),
+			},
+			ExpectedError: "does not match pattern",
+		},
+		{
+			Name: "value not in enum",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "string", map[string]interface{}{"value": "green", "enum": []interface{}{"red", "blue"}})),
+			},
+			ExpectedError: "not one of the allowed enum values",
+		},
+		{
+			Name: "pattern on number",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "number", map[string]interface{}{"pattern": "^1$"})),
+			},
+			ExpectedError: "pattern can only be set",
+		},
+		{
+			Name: "maximum on string",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "string", map[string]interface{}{"maximum": "1"})),
+			},
+			ExpectedError: "maximum can only be set",
+		},
+		{
+			Name: "scalar value for array",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "string[]", map[string]interface{}{"value": "a"})),
+			},
+			ExpectedError: "use values instead of value",
+		},
+		{
+			Name: "list value for scalar",
+			Input: []interface{}{
+				testFeatureFlag("foo", true, testFeatureFlagAttribute("bar", "string", map[string]interface{}{"values": []interface{}{"a"}})),
+			},
+			ExpectedError: "use value instead of values",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandFeatureFlags(testCase.Input)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.ExpectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testFeatureFlag(key string, enabled bool, attributes ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"attribute":   attributes,
+		"description": "",
+		"enabled":     enabled,
+		"key":         key,
+		"name":        key,
+	}
+}
+
+func testFeatureFlagAttribute(key, attributeType string, constraints map[string]interface{}) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"enum":     []interface{}{},
+		"key":      key,
+		"maximum":  "",
+		"minimum":  "",
+		"pattern":  "",
+		"required": false,
+		"type":     attributeType,
+		"value":    "",
+		"values":   []interface{}{},
+	}
+
+	for k, v := range constraints {
+		tfMap[k] = v
+	}
+
+	return tfMap
+}
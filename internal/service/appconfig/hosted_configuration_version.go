@@ -1,6 +1,7 @@
 package appconfig
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,14 +43,17 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[a-z0-9]{4,7}`), ""),
 			},
 			"content": {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"content", "flag"},
 			},
 			"content_type": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 255),
 			},
@@ -60,12 +63,47 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"flag": featureFlagSchema(),
 			"version_number": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceHostedConfigurationVersionCustomizeDiff,
+	}
+}
+
+func resourceHostedConfigurationVersionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("flag") {
+		return nil
+	}
+
+	tfList, ok := diff.Get("flag").([]interface{})
+
+	if !ok || len(tfList) == 0 {
+		if !diff.NewValueKnown("content_type") {
+			return nil
+		}
+
+		if v := diff.Get("content_type").(string); v == "" {
+			return fmt.Errorf("content_type is required when content is set")
+		}
+
+		return nil
+	}
+
+	if _, err := expandFeatureFlags(tfList); err != nil {
+		return fmt.Errorf("invalid feature flags: %w", err)
+	}
+
+	if diff.NewValueKnown("content_type") {
+		if v := diff.Get("content_type").(string); v != "" && v != featureFlagsContentType {
+			return fmt.Errorf("content_type must be %q when flag is set", featureFlagsContentType)
+		}
 	}
+
+	return nil
 }
 
 func resourceHostedConfigurationVersionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -81,6 +119,17 @@ func resourceHostedConfigurationVersionCreate(d *schema.ResourceData, meta inter
 		ContentType:            aws.String(d.Get("content_type").(string)),
 	}
 
+	if v, ok := d.GetOk("flag"); ok && len(v.([]interface{})) > 0 {
+		content, err := expandFeatureFlags(v.([]interface{}))
+
+		if err != nil {
+			return fmt.Errorf("error creating AppConfig HostedConfigurationVersion for Application (%s): invalid feature flags: %w", appID, err)
+		}
+
+		input.Content = []byte(content)
+		input.ContentType = aws.String(featureFlagsContentType)
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -134,13 +183,7 @@ func resourceHostedConfigurationVersionRead(d *schema.ResourceData, meta interfa
 	d.Set("description", output.Description)
 	d.Set("version_number", output.VersionNumber)
 
-	arn := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("application/%s/configurationprofile/%s/hostedconfigurationversion/%d", appID, confProfID, versionNumber),
-		Service:   "appconfig",
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("appconfig", fmt.Sprintf("application/%s/configurationprofile/%s/hostedconfigurationversion/%d", appID, confProfID, versionNumber))
 
 	d.Set("arn", arn)
 
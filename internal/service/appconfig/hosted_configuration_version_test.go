@@ -70,6 +70,56 @@ func TestAccAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigHostedConfigurationVersion_featureFlags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appconfig.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAppConfigHostedConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedConfigurationVersionFeatureFlagsConfig(rName, "5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`"values":\{"checkout":\{"enabled":true,"limit":5,"tiers":\["gold","silver"\]\}\}`)),
+					resource.TestCheckResourceAttr(resourceName, "flag.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "flag.0.key", "checkout"),
+					resource.TestCheckResourceAttr(resourceName, "flag.0.attribute.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"flag"},
+			},
+		},
+	})
+}
+
+func TestAccAppConfigHostedConfigurationVersion_featureFlagsInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appconfig.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAppConfigHostedConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHostedConfigurationVersionFeatureFlagsConfig(rName, "50"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value 50 is greater than maximum \(10\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckAppConfigHostedConfigurationVersionDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigConn
 
@@ -162,3 +212,46 @@ resource "aws_appconfig_hosted_configuration_version" "test" {
 }
 `, rName))
 }
+
+func testAccHostedConfigurationVersionFeatureFlagsConfig(rName, limit string) string {
+	return fmt.Sprintf(`
+resource "aws_appconfig_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+  type           = "AWS.AppConfig.FeatureFlags"
+}
+
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  description              = %[1]q
+
+  flag {
+    key     = "checkout"
+    name    = "New checkout flow"
+    enabled = true
+
+    attribute {
+      key      = "limit"
+      type     = "number"
+      minimum  = "0"
+      maximum  = "10"
+      required = true
+      value    = %[2]q
+    }
+
+    attribute {
+      key    = "tiers"
+      type   = "string[]"
+      enum   = ["gold", "silver", "bronze"]
+      values = ["gold", "silver"]
+    }
+  }
+}
+`, rName, limit)
+}
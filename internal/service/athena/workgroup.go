@@ -6,7 +6,6 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -94,7 +93,7 @@ func ResourceWorkGroup() *schema.Resource {
 												"kms_key_arn": {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidARNForService("kms", ""),
 												},
 											},
 										},
@@ -212,15 +211,9 @@ func resourceWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading Athena WorkGroup (%s): %w", d.Id(), err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "athena",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("workgroup/%s", d.Id()),
-	}
+	arn := meta.(*conns.AWSClient).RegionalARN("athena", fmt.Sprintf("workgroup/%s", d.Id()))
 
-	d.Set("arn", arn.String())
+	d.Set("arn", arn)
 	d.Set("description", resp.WorkGroup.Description)
 
 	if err := d.Set("configuration", flattenAthenaWorkGroupConfiguration(resp.WorkGroup.Configuration)); err != nil {
@@ -236,7 +229,7 @@ func resourceWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("force_destroy", false)
 	}
 
-	tags, err := ListTags(conn, arn.String())
+	tags, err := ListTags(conn, arn)
 
 	if err != nil {
 		return fmt.Errorf("error listing tags for resource (%s): %w", arn, err)
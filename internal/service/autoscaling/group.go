@@ -42,6 +42,7 @@ func ResourceGroup() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -553,6 +554,21 @@ func ResourceGroup() *schema.Resource {
 										Default:      90,
 										ValidateFunc: validation.IntBetween(0, 100),
 									},
+									"scale_in_protected_instances": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(autoscaling.ScaleInProtectedInstances_Values(), false),
+									},
+									"skip_matching": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"standby_instances": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(autoscaling.StandbyInstances_Values(), false),
+									},
 								},
 							},
 						},
@@ -565,6 +581,11 @@ func ResourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateAutoScalingGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -1305,9 +1326,17 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 		if shouldRefreshInstances {
-			if err := autoScalingGroupRefreshInstances(conn, d.Id(), instanceRefresh); err != nil {
+			instanceRefreshID, err := autoScalingGroupRefreshInstances(conn, d.Id(), instanceRefresh)
+
+			if err != nil {
 				return fmt.Errorf("failed to start instance refresh of Auto Scaling Group %s: %w", d.Id(), err)
 			}
+
+			if m, ok := instanceRefresh[0].(map[string]interface{}); ok && m["wait_for_completion"].(bool) {
+				if _, err := waitInstanceRefreshSuccessful(conn, d.Id(), instanceRefreshID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Instance Refresh (%s) on Auto Scaling Group (%s) to complete: %w", instanceRefreshID, d.Id(), err)
+				}
+			}
 		}
 	}
 
@@ -2235,13 +2264,27 @@ func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscal
 		refreshPreferences.MinHealthyPercentage = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := m["scale_in_protected_instances"].(string); ok && v != "" {
+		refreshPreferences.ScaleInProtectedInstances = aws.String(v)
+	}
+
+	if v, ok := m["skip_matching"].(bool); ok && v {
+		refreshPreferences.SkipMatching = aws.Bool(v)
+	}
+
+	if v, ok := m["standby_instances"].(string); ok && v != "" {
+		refreshPreferences.StandbyInstances = aws.String(v)
+	}
+
 	return refreshPreferences
 }
 
-func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName string, refreshConfig []interface{}) error {
+func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName string, refreshConfig []interface{}) (string, error) {
 	input := CreateGroupInstanceRefreshInput(asgName, refreshConfig)
+	var output *autoscaling.StartInstanceRefreshOutput
 	err := resource.Retry(instanceRefreshStartedTimeout, func() *resource.RetryError {
-		_, err := conn.StartInstanceRefresh(input)
+		var err error
+		output, err = conn.StartInstanceRefresh(input)
 		if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeInstanceRefreshInProgressFault) {
 			cancelErr := cancelAutoscalingInstanceRefresh(conn, asgName)
 			if cancelErr != nil {
@@ -2255,13 +2298,13 @@ func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName str
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		_, err = conn.StartInstanceRefresh(input)
+		output, err = conn.StartInstanceRefresh(input)
 	}
 	if err != nil {
-		return fmt.Errorf("error starting Instance Refresh: %w", err)
+		return "", fmt.Errorf("error starting Instance Refresh: %w", err)
 	}
 
-	return nil
+	return aws.StringValue(output.InstanceRefreshId), nil
 }
 
 func cancelAutoscalingInstanceRefresh(conn *autoscaling.AutoScaling, asgName string) error {
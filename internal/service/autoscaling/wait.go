@@ -1,10 +1,13 @@
 package autoscaling
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -42,3 +45,27 @@ func waitInstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instan
 
 	return nil, err
 }
+
+func waitInstanceRefreshSuccessful(conn *autoscaling.AutoScaling, asgName, instanceRefreshId string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusInProgress,
+		},
+		Target:  []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: statusInstanceRefresh(conn, asgName, instanceRefreshId),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		if reason := aws.StringValue(v.StatusReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
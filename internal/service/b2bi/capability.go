@@ -0,0 +1,357 @@
+package b2bi
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCapability() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCapabilityCreate,
+		Read:   resourceCapabilityRead,
+		Update: resourceCapabilityUpdate,
+		Delete: resourceCapabilityDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edi": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input_location":  s3LocationSchema(),
+									"output_location": s3LocationSchema(),
+									"transformer_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": ediTypeSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instructions_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: s3LocationSchema().Elem.(*schema.Resource).Schema,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      b2bi.CapabilityTypeEdi,
+				ValidateFunc: validation.StringInSlice(b2bi.CapabilityType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func s3LocationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"key": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceCapabilityCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreateCapabilityInput{
+		Configuration: expandCapabilityConfiguration(d.Get("configuration").([]interface{})),
+		Name:          aws.String(name),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("instructions_documents"); ok && len(v.([]interface{})) > 0 {
+		input.InstructionsDocuments = expandS3Locations(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating B2BI Capability: %s", input)
+	output, err := conn.CreateCapability(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating B2BI Capability (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CapabilityId))
+
+	return resourceCapabilityRead(d, meta)
+}
+
+func resourceCapabilityRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	capability, err := FindCapabilityByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Capability (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading B2BI Capability (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", capability.CapabilityArn)
+	if err := d.Set("configuration", flattenCapabilityConfiguration(capability.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("created_at", aws.TimeValue(capability.CreatedAt).Format(time.RFC3339))
+	if err := d.Set("instructions_documents", flattenS3Locations(capability.InstructionsDocuments)); err != nil {
+		return fmt.Errorf("error setting instructions_documents: %w", err)
+	}
+	d.Set("name", capability.Name)
+	d.Set("type", capability.Type)
+
+	tags, err := ListTags(conn, aws.StringValue(capability.CapabilityArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for B2BI Capability (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCapabilityUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	if d.HasChanges("configuration", "instructions_documents", "name") {
+		input := &b2bi.UpdateCapabilityInput{
+			CapabilityId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("configuration") {
+			input.Configuration = expandCapabilityConfiguration(d.Get("configuration").([]interface{}))
+		}
+
+		if d.HasChange("instructions_documents") {
+			input.InstructionsDocuments = expandS3Locations(d.Get("instructions_documents").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating B2BI Capability: %s", input)
+		_, err := conn.UpdateCapability(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating B2BI Capability (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating B2BI Capability (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCapabilityRead(d, meta)
+}
+
+func resourceCapabilityDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	log.Printf("[DEBUG] Deleting B2BI Capability: %s", d.Id())
+	_, err := conn.DeleteCapability(&b2bi.DeleteCapabilityInput{
+		CapabilityId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting B2BI Capability (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCapabilityConfiguration(tfList []interface{}) *b2bi.CapabilityConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &b2bi.CapabilityConfiguration{}
+
+	if v, ok := tfMap["edi"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		edi := &b2bi.EdiConfiguration{}
+
+		if v, ok := tfMap["input_location"].([]interface{}); ok && len(v) > 0 {
+			edi.InputLocation = expandS3Location(v[0])
+		}
+
+		if v, ok := tfMap["output_location"].([]interface{}); ok && len(v) > 0 {
+			edi.OutputLocation = expandS3Location(v[0])
+		}
+
+		if v, ok := tfMap["transformer_id"].(string); ok && v != "" {
+			edi.TransformerId = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].([]interface{}); ok {
+			edi.Type = expandEdiType(v)
+		}
+
+		apiObject.Edi = edi
+	}
+
+	return apiObject
+}
+
+func flattenCapabilityConfiguration(apiObject *b2bi.CapabilityConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Edi; v != nil {
+		tfMap["edi"] = []interface{}{
+			map[string]interface{}{
+				"input_location":  flattenS3Locations([]*b2bi.S3Location{v.InputLocation}),
+				"output_location": flattenS3Locations([]*b2bi.S3Location{v.OutputLocation}),
+				"transformer_id":  aws.StringValue(v.TransformerId),
+				"type":            flattenEdiType(v.Type),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandS3Location(tfMapRaw interface{}) *b2bi.S3Location {
+	tfMap, ok := tfMapRaw.(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &b2bi.S3Location{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Locations(tfList []interface{}) []*b2bi.S3Location {
+	var apiObjects []*b2bi.S3Location
+
+	for _, tfMapRaw := range tfList {
+		apiObject := expandS3Location(tfMapRaw)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenS3Locations(apiObjects []*b2bi.S3Location) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"bucket_name": aws.StringValue(apiObject.BucketName),
+			"key":         aws.StringValue(apiObject.Key),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,403 @@
+package b2bi_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccB2BICapability_basic(t *testing.T) {
+	resourceName := "aws_b2bi_capability.test"
+	transformerResourceName := "aws_b2bi_transformer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapabilityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexp.MustCompile(`capability/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.input_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.input_location.0.bucket_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.input_location.0.key", "input/"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.output_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.output_location.0.bucket_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.output_location.0.key", "output/"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.transformer_id", transformerResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.type.0.x12_details.0.transaction_set", "X12_110"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.type.0.x12_details.0.version", "VERSION_4010"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "instructions_documents.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "edi"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccB2BICapability_update(t *testing.T) {
+	resourceName := "aws_b2bi_capability.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapabilityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.output_location.0.key", "output/"),
+					resource.TestCheckResourceAttr(resourceName, "instructions_documents.#", "0"),
+				),
+			},
+			{
+				Config: testAccCapabilityConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.output_location.0.key", "processed/"),
+					resource.TestCheckResourceAttr(resourceName, "instructions_documents.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instructions_documents.0.bucket_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "instructions_documents.0.key", "instructions.json"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BICapability_disappears(t *testing.T) {
+	resourceName := "aws_b2bi_capability.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapabilityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfb2bi.ResourceCapability(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccB2BICapability_tags(t *testing.T) {
+	resourceName := "aws_b2bi_capability.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapabilityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapabilityConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCapabilityConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapabilityDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_b2bi_capability" {
+			continue
+		}
+
+		_, err := tfb2bi.FindCapabilityByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("B2BI Capability %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCapabilityExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No B2BI Capability ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+		_, err := tfb2bi.FindCapabilityByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCapabilityConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "b2bi.amazonaws.com"
+      }
+      Action = [
+        "s3:GetObject",
+        "s3:GetObjectAttributes",
+        "s3:ListBucket",
+        "s3:PutObject",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_b2bi_transformer" "test" {
+  name             = %[1]q
+  file_format      = "JSON"
+  mapping_template = "$"
+  status           = "active"
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCapabilityConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfigBase(rName), fmt.Sprintf(`
+resource "aws_b2bi_capability" "test" {
+  name = %[1]q
+
+  configuration {
+    edi {
+      transformer_id = aws_b2bi_transformer.test.id
+
+      input_location {
+        bucket_name = aws_s3_bucket.test.id
+        key         = "input/"
+      }
+
+      output_location {
+        bucket_name = aws_s3_bucket.test.id
+        key         = "output/"
+      }
+
+      type {
+        x12_details {
+          transaction_set = "X12_110"
+          version         = "VERSION_4010"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName))
+}
+
+func testAccCapabilityConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfigBase(rName), fmt.Sprintf(`
+resource "aws_b2bi_capability" "test" {
+  name = "%[1]s-updated"
+
+  configuration {
+    edi {
+      transformer_id = aws_b2bi_transformer.test.id
+
+      input_location {
+        bucket_name = aws_s3_bucket.test.id
+        key         = "input/"
+      }
+
+      output_location {
+        bucket_name = aws_s3_bucket.test.id
+        key         = "processed/"
+      }
+
+      type {
+        x12_details {
+          transaction_set = "X12_110"
+          version         = "VERSION_4010"
+        }
+      }
+    }
+  }
+
+  instructions_documents {
+    bucket_name = aws_s3_bucket.test.id
+    key         = "instructions.json"
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName))
+}
+
+func testAccCapabilityConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfigBase(rName), fmt.Sprintf(`
+resource "aws_b2bi_capability" "test" {
+  name = %[1]q
+
+  configuration {
+    edi {
+      transformer_id = aws_b2bi_transformer.test.id
+
+      input_location {
+        bucket_name = aws_s3_bucket.test.id
+        key         = "input/"
+      }
+
+      output_location {
+        bucket_name = aws_s3_bucket.test.id
+        key         = "output/"
+      }
+
+      type {
+        x12_details {
+          transaction_set = "X12_110"
+          version         = "VERSION_4010"
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccCapabilityConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfigBase(rName), fmt.Sprintf(`
+resource "aws_b2bi_capability" "test" {
+  name = %[1]q
+
+  configuration {
+    edi {
+      transformer_id = aws_b2bi_transformer.test.id
+
+      input_location {
+        bucket_name = aws_s3_bucket.test.id
+        key         = "input/"
+      }
+
+      output_location {
+        bucket_name = aws_s3_bucket.test.id
+        key         = "output/"
+      }
+
+      type {
+        x12_details {
+          transaction_set = "X12_110"
+          version         = "VERSION_4010"
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,211 @@
+package b2bi
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePartnership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePartnershipCreate,
+		Read:   resourcePartnershipRead,
+		Update: resourcePartnershipUpdate,
+		Delete: resourcePartnershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 254),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"phone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(7, 22),
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"trading_partner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePartnershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreatePartnershipInput{
+		Capabilities: flex.ExpandStringSet(d.Get("capabilities").(*schema.Set)),
+		Email:        aws.String(d.Get("email").(string)),
+		Name:         aws.String(name),
+		ProfileId:    aws.String(d.Get("profile_id").(string)),
+	}
+
+	if v, ok := d.GetOk("phone"); ok {
+		input.Phone = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating B2BI Partnership: %s", input)
+	output, err := conn.CreatePartnership(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating B2BI Partnership (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.PartnershipId))
+
+	return resourcePartnershipRead(d, meta)
+}
+
+func resourcePartnershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	partnership, err := FindPartnershipByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Partnership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading B2BI Partnership (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", partnership.PartnershipArn)
+	d.Set("capabilities", aws.StringValueSlice(partnership.Capabilities))
+	d.Set("created_at", aws.TimeValue(partnership.CreatedAt).Format(time.RFC3339))
+	d.Set("email", partnership.Email)
+	d.Set("name", partnership.Name)
+	d.Set("phone", partnership.Phone)
+	d.Set("profile_id", partnership.ProfileId)
+	d.Set("trading_partner_id", partnership.TradingPartnerId)
+
+	tags, err := ListTags(conn, aws.StringValue(partnership.PartnershipArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for B2BI Partnership (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePartnershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	if d.HasChanges("capabilities", "name") {
+		input := &b2bi.UpdatePartnershipInput{
+			PartnershipId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("capabilities") {
+			input.Capabilities = flex.ExpandStringSet(d.Get("capabilities").(*schema.Set))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating B2BI Partnership: %s", input)
+		_, err := conn.UpdatePartnership(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating B2BI Partnership (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating B2BI Partnership (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourcePartnershipRead(d, meta)
+}
+
+func resourcePartnershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	log.Printf("[DEBUG] Deleting B2BI Partnership: %s", d.Id())
+	_, err := conn.DeletePartnership(&b2bi.DeletePartnershipInput{
+		PartnershipId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting B2BI Partnership (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package b2bi_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccB2BIPartnership_basic(t *testing.T) {
+	resourceName := "aws_b2bi_partnership.test"
+	capabilityResourceName := "aws_b2bi_capability.test"
+	profileResourceName := "aws_b2bi_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPartnershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnershipConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexp.MustCompile(`partnership/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "capabilities.*", capabilityResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "email", "partner@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "phone", "5555555555"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_id", profileResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "trading_partner_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPartnershipConfig_name(rName, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BIPartnership_disappears(t *testing.T) {
+	resourceName := "aws_b2bi_partnership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPartnershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnershipConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfb2bi.ResourcePartnership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccB2BIPartnership_tags(t *testing.T) {
+	resourceName := "aws_b2bi_partnership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPartnershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnershipConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPartnershipConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPartnershipConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPartnershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_b2bi_partnership" {
+			continue
+		}
+
+		_, err := tfb2bi.FindPartnershipByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("B2BI Partnership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPartnershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No B2BI Partnership ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+		_, err := tfb2bi.FindPartnershipByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPartnershipConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfig_basic(rName), fmt.Sprintf(`
+resource "aws_b2bi_profile" "test" {
+  name          = %[1]q
+  business_name = "Example Corp"
+  logging       = "DISABLED"
+  phone         = "5555555555"
+}
+`, rName))
+}
+
+func testAccPartnershipConfig_basic(rName string) string {
+	return testAccPartnershipConfig_name(rName, rName)
+}
+
+func testAccPartnershipConfig_name(rName, name string) string {
+	return acctest.ConfigCompose(testAccPartnershipConfigBase(rName), fmt.Sprintf(`
+resource "aws_b2bi_partnership" "test" {
+  name         = %[1]q
+  capabilities = [aws_b2bi_capability.test.id]
+  email        = "partner@example.com"
+  phone        = "5555555555"
+  profile_id   = aws_b2bi_profile.test.id
+}
+`, name))
+}
+
+func testAccPartnershipConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPartnershipConfigBase(rName), fmt.Sprintf(`
+resource "aws_b2bi_partnership" "test" {
+  name         = %[1]q
+  capabilities = [aws_b2bi_capability.test.id]
+  email        = "partner@example.com"
+  profile_id   = aws_b2bi_profile.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPartnershipConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPartnershipConfigBase(rName), fmt.Sprintf(`
+resource "aws_b2bi_partnership" "test" {
+  name         = %[1]q
+  capabilities = [aws_b2bi_capability.test.id]
+  email        = "partner@example.com"
+  profile_id   = aws_b2bi_profile.test.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,216 @@
+package b2bi
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProfileCreate,
+		Read:   resourceProfileRead,
+		Update: resourceProfileUpdate,
+		Delete: resourceProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"business_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(5, 254),
+			},
+			"log_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logging": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(b2bi.Logging_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"phone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(7, 22),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreateProfileInput{
+		BusinessName: aws.String(d.Get("business_name").(string)),
+		Logging:      aws.String(d.Get("logging").(string)),
+		Name:         aws.String(name),
+		Phone:        aws.String(d.Get("phone").(string)),
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		input.Email = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating B2BI Profile: %s", input)
+	output, err := conn.CreateProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating B2BI Profile (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProfileId))
+
+	return resourceProfileRead(d, meta)
+}
+
+func resourceProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	profile, err := FindProfileByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading B2BI Profile (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", profile.ProfileArn)
+	d.Set("business_name", profile.BusinessName)
+	d.Set("created_at", aws.TimeValue(profile.CreatedAt).Format(time.RFC3339))
+	d.Set("email", profile.Email)
+	d.Set("log_group_name", profile.LogGroupName)
+	d.Set("logging", profile.Logging)
+	d.Set("name", profile.Name)
+	d.Set("phone", profile.Phone)
+
+	tags, err := ListTags(conn, aws.StringValue(profile.ProfileArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for B2BI Profile (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	if d.HasChanges("business_name", "email", "name", "phone") {
+		input := &b2bi.UpdateProfileInput{
+			ProfileId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("business_name") {
+			input.BusinessName = aws.String(d.Get("business_name").(string))
+		}
+
+		if d.HasChange("email") {
+			input.Email = aws.String(d.Get("email").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("phone") {
+			input.Phone = aws.String(d.Get("phone").(string))
+		}
+
+		log.Printf("[DEBUG] Updating B2BI Profile: %s", input)
+		_, err := conn.UpdateProfile(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating B2BI Profile (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating B2BI Profile (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceProfileRead(d, meta)
+}
+
+func resourceProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	log.Printf("[DEBUG] Deleting B2BI Profile: %s", d.Id())
+	_, err := conn.DeleteProfile(&b2bi.DeleteProfileInput{
+		ProfileId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting B2BI Profile (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package b2bi_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccB2BITransformer_basic(t *testing.T) {
+	resourceName := "aws_b2bi_transformer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransformerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexp.MustCompile(`transformer/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.0.x12_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.0.x12_details.0.transaction_set", "X12_110"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.0.x12_details.0.version", "VERSION_4010"),
+					resource.TestCheckResourceAttr(resourceName, "file_format", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "mapping_template", "$"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccB2BITransformer_status(t *testing.T) {
+	resourceName := "aws_b2bi_transformer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransformerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_status(rName, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+				),
+			},
+			{
+				Config: testAccTransformerConfig_status(rName, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BITransformer_disappears(t *testing.T) {
+	resourceName := "aws_b2bi_transformer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransformerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfb2bi.ResourceTransformer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccB2BITransformer_tags(t *testing.T) {
+	resourceName := "aws_b2bi_transformer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, b2bi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransformerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransformerConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTransformerConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransformerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_b2bi_transformer" {
+			continue
+		}
+
+		_, err := tfb2bi.FindTransformerByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("B2BI Transformer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTransformerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No B2BI Transformer ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+		_, err := tfb2bi.FindTransformerByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccTransformerConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_transformer" "test" {
+  name             = %[1]q
+  file_format      = "JSON"
+  mapping_template = "$"
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTransformerConfig_status(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_transformer" "test" {
+  name             = %[1]q
+  file_format      = "JSON"
+  mapping_template = "$"
+  status           = %[2]q
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+}
+`, rName, status)
+}
+
+func testAccTransformerConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_transformer" "test" {
+  name             = %[1]q
+  file_format      = "JSON"
+  mapping_template = "$"
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccTransformerConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_transformer" "test" {
+  name             = %[1]q
+  file_format      = "JSON"
+  mapping_template = "$"
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,80 @@
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// Maximum retention period, in days, of continuous backups.
+	continuousBackupMaxRetentionDays = 35
+
+	// Minimum number of days a recovery point must remain in cold storage before it can be deleted.
+	coldStorageMinRetentionDays = 90
+)
+
+// resourceTypeFeature describes the lifecycle features AWS Backup supports for a resource type.
+type resourceTypeFeature struct {
+	coldStorage      bool
+	continuousBackup bool
+}
+
+// resourceTypeFeatures lists the lifecycle features supported by each AWS Backup resource type.
+// Reference: https://docs.aws.amazon.com/aws-backup/latest/devguide/backup-feature-availability.html#features-by-resource
+var resourceTypeFeatures = map[string]resourceTypeFeature{
+	"Aurora":                 {continuousBackup: true},
+	"CloudFormation":         {coldStorage: true},
+	"DocumentDB":             {},
+	"DynamoDB":               {coldStorage: true},
+	"EBS":                    {coldStorage: true},
+	"EC2":                    {},
+	"EFS":                    {coldStorage: true},
+	"FSx":                    {},
+	"Neptune":                {},
+	"RDS":                    {continuousBackup: true},
+	"Redshift":               {},
+	"S3":                     {continuousBackup: true},
+	"SAP HANA on Amazon EC2": {coldStorage: true, continuousBackup: true},
+	"Storage Gateway":        {},
+	"Timestream":             {coldStorage: true},
+	"VirtualMachine":         {coldStorage: true},
+}
+
+func resourceTypesSupporting(f func(resourceTypeFeature) bool) []string {
+	var resourceTypes []string
+
+	for k, v := range resourceTypeFeatures {
+		if f(v) {
+			resourceTypes = append(resourceTypes, k)
+		}
+	}
+
+	sort.Strings(resourceTypes)
+
+	return resourceTypes
+}
+
+// validateLifecycle checks a backup rule or copy action lifecycle against the
+// retention and cold storage constraints enforced by AWS Backup.
+func validateLifecycle(continuousBackup bool, coldStorageAfter, deleteAfter int) error {
+	if continuousBackup {
+		if deleteAfter > continuousBackupMaxRetentionDays {
+			return fmt.Errorf("delete_after (%d) must be at most %d days when enable_continuous_backup is true", deleteAfter, continuousBackupMaxRetentionDays)
+		}
+
+		if coldStorageAfter > 0 {
+			resourceTypes := resourceTypesSupporting(func(v resourceTypeFeature) bool { return v.continuousBackup })
+
+			return fmt.Errorf("cold_storage_after cannot be set when enable_continuous_backup is true: continuous backups of %s cannot be transitioned to cold storage", strings.Join(resourceTypes, ", "))
+		}
+	}
+
+	if coldStorageAfter > 0 && deleteAfter > 0 && deleteAfter < coldStorageAfter+coldStorageMinRetentionDays {
+		resourceTypes := resourceTypesSupporting(func(v resourceTypeFeature) bool { return v.coldStorage })
+
+		return fmt.Errorf("delete_after (%d) must be at least %d days greater than cold_storage_after (%d) for resource types that support cold storage (%s)", deleteAfter, coldStorageMinRetentionDays, coldStorageAfter, strings.Join(resourceTypes, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package backup
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestValidateLifecycle(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		ContinuousBackup bool
+		ColdStorageAfter int
+		DeleteAfter      int
+		ExpectedErr      *regexp.Regexp
+	}{
+		{
+			TestName: "empty",
+		},
+		{
+			TestName:    "delete after only",
+			DeleteAfter: 365,
+		},
+		{
+			TestName:         "cold storage after only",
+			ColdStorageAfter: 30,
+		},
+		{
+			TestName:         "cold storage minimum retention",
+			ColdStorageAfter: 30,
+			DeleteAfter:      120,
+		},
+		{
+			TestName:         "cold storage retention too short",
+			ColdStorageAfter: 30,
+			DeleteAfter:      119,
+			ExpectedErr:      regexp.MustCompile(`must be at least 90 days greater than cold_storage_after \(30\) for resource types that support cold storage \(CloudFormation, DynamoDB, EBS, EFS`),
+		},
+		{
+			TestName:         "continuous backup",
+			ContinuousBackup: true,
+			DeleteAfter:      35,
+		},
+		{
+			TestName:         "continuous backup default retention",
+			ContinuousBackup: true,
+		},
+		{
+			TestName:         "continuous backup retention too long",
+			ContinuousBackup: true,
+			DeleteAfter:      36,
+			ExpectedErr:      regexp.MustCompile(`delete_after \(36\) must be at most 35 days when enable_continuous_backup is true`),
+		},
+		{
+			TestName:         "continuous backup cold storage",
+			ContinuousBackup: true,
+			ColdStorageAfter: 1,
+			DeleteAfter:      35,
+			ExpectedErr:      regexp.MustCompile(`cold_storage_after cannot be set when enable_continuous_backup is true: continuous backups of Aurora, RDS, S3`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := validateLifecycle(testCase.ContinuousBackup, testCase.ColdStorageAfter, testCase.DeleteAfter)
+
+			if testCase.ExpectedErr == nil {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %q, got none", testCase.ExpectedErr)
+			}
+
+			if !testCase.ExpectedErr.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %s", testCase.ExpectedErr, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package backup
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -161,10 +163,55 @@ func ResourcePlan() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePlanCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourcePlanCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, vRule := range diff.Get("rule").(*schema.Set).List() {
+		mRule, ok := vRule.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		ruleName := mRule["rule_name"].(string)
+		enableContinuousBackup := mRule["enable_continuous_backup"].(bool)
+
+		if err := validatePlanLifecycle(enableContinuousBackup, mRule["lifecycle"].([]interface{})); err != nil {
+			return fmt.Errorf("rule (%s) lifecycle: %w", ruleName, err)
+		}
+
+		for _, vCopyAction := range mRule["copy_action"].(*schema.Set).List() {
+			mCopyAction, ok := vCopyAction.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			// Copies of continuous backups are snapshot backups and are not subject to the continuous backup retention limit.
+			if err := validatePlanLifecycle(false, mCopyAction["lifecycle"].([]interface{})); err != nil {
+				return fmt.Errorf("rule (%s) copy_action (%s) lifecycle: %w", ruleName, mCopyAction["destination_vault_arn"].(string), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePlanLifecycle(continuousBackup bool, l []interface{}) error {
+	if len(l) == 0 || l[0] == nil {
+		return validateLifecycle(continuousBackup, 0, 0)
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return validateLifecycle(continuousBackup, m["cold_storage_after"].(int), m["delete_after"].(int))
+}
+
 func resourcePlanCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).BackupConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -622,6 +622,44 @@ func TestAccBackupPlan_enableContinuousBackup(t *testing.T) {
 	})
 }
 
+func TestAccBackupPlan_EnableContinuousBackup_lifecycleValidation(t *testing.T) {
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, backup.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlanEnableContinuousBackupLifecycleConfig(rName, 0, 36),
+				ExpectError: regexp.MustCompile(`delete_after \(36\) must be at most 35 days when enable_continuous_backup is true`),
+			},
+			{
+				Config:      testAccPlanEnableContinuousBackupLifecycleConfig(rName, 7, 35),
+				ExpectError: regexp.MustCompile(`cold_storage_after cannot be set when enable_continuous_backup is true`),
+			},
+		},
+	})
+}
+
+func TestAccBackupPlan_Lifecycle_coldStorageValidation(t *testing.T) {
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, backup.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlanRuleCopyActionConfig(rName, 30, 60),
+				ExpectError: regexp.MustCompile(`delete_after \(60\) must be at least 90 days greater than cold_storage_after \(30\)`),
+			},
+		},
+	})
+}
+
 func TestAccBackupPlan_disappears(t *testing.T) {
 	var plan backup.GetBackupPlanOutput
 	resourceName := "aws_backup_plan.test"
@@ -1157,3 +1195,27 @@ resource "aws_backup_plan" "test" {
 }
 `, rName)
 }
+
+func testAccPlanEnableContinuousBackupLifecycleConfig(rName string, coldStorageAfter, deleteAfter int) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_plan" "test" {
+  name = %[1]q
+
+  rule {
+    rule_name                = %[1]q
+    target_vault_name        = aws_backup_vault.test.name
+    schedule                 = "cron(0 12 * * ? *)"
+    enable_continuous_backup = true
+
+    lifecycle {
+      cold_storage_after = %[2]d
+      delete_after       = %[3]d
+    }
+  }
+}
+`, rName, coldStorageAfter, deleteAfter)
+}
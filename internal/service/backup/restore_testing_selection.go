@@ -0,0 +1,354 @@
+package backup
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRestoreTestingSelection() *schema.Resource {
+	keyValueSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceRestoreTestingSelectionCreate,
+		Read:   resourceRestoreTestingSelectionRead,
+		Update: resourceRestoreTestingSelectionUpdate,
+		Delete: resourceRestoreTestingSelectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+				),
+			},
+			"protected_resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"protected_resource_conditions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"string_equals":     keyValueSchema,
+						"string_not_equals": keyValueSchema,
+					},
+				},
+			},
+			"protected_resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"restore_metadata_overrides": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"restore_testing_plan_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"validation_window_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 168),
+			},
+		},
+	}
+}
+
+func resourceRestoreTestingSelectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	name := d.Get("name").(string)
+	planName := d.Get("restore_testing_plan_name").(string)
+	id := RestoreTestingSelectionCreateResourceID(planName, name)
+	input := &backup.CreateRestoreTestingSelectionInput{
+		RestoreTestingPlanName: aws.String(planName),
+		RestoreTestingSelection: &backup.RestoreTestingSelectionForCreate{
+			IamRoleArn:                  aws.String(d.Get("iam_role_arn").(string)),
+			ProtectedResourceType:       aws.String(d.Get("protected_resource_type").(string)),
+			RestoreTestingSelectionName: aws.String(name),
+		},
+	}
+
+	if v, ok := d.GetOk("protected_resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.RestoreTestingSelection.ProtectedResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("protected_resource_conditions"); ok {
+		input.RestoreTestingSelection.ProtectedResourceConditions = expandProtectedResourceConditions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("restore_metadata_overrides"); ok && len(v.(map[string]interface{})) > 0 {
+		input.RestoreTestingSelection.RestoreMetadataOverrides = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("validation_window_hours"); ok {
+		input.RestoreTestingSelection.ValidationWindowHours = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating Backup Restore Testing Selection: %s", input)
+	_, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateRestoreTestingSelection(input)
+		},
+		func(err error) (bool, error) {
+			// InvalidParameterValueException: IAM Role arn:aws:iam::123456789012:role/XXX cannot be assumed by AWS Backup
+			if tfawserr.ErrMessageContains(err, backup.ErrCodeInvalidParameterValueException, "cannot be assumed") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Backup Restore Testing Selection (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRestoreTestingSelectionRead(d, meta)
+}
+
+func resourceRestoreTestingSelectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	planName, name, err := RestoreTestingSelectionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	selection, err := FindRestoreTestingSelectionByTwoPartKey(conn, planName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Restore Testing Selection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Backup Restore Testing Selection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("iam_role_arn", selection.IamRoleArn)
+	d.Set("name", selection.RestoreTestingSelectionName)
+	d.Set("protected_resource_arns", aws.StringValueSlice(selection.ProtectedResourceArns))
+	if err := d.Set("protected_resource_conditions", flattenProtectedResourceConditions(selection.ProtectedResourceConditions)); err != nil {
+		return fmt.Errorf("error setting protected_resource_conditions: %w", err)
+	}
+	d.Set("protected_resource_type", selection.ProtectedResourceType)
+	d.Set("restore_metadata_overrides", aws.StringValueMap(selection.RestoreMetadataOverrides))
+	d.Set("restore_testing_plan_name", selection.RestoreTestingPlanName)
+	d.Set("validation_window_hours", selection.ValidationWindowHours)
+
+	return nil
+}
+
+func resourceRestoreTestingSelectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	planName, name, err := RestoreTestingSelectionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &backup.UpdateRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelection:     &backup.RestoreTestingSelectionForUpdate{},
+		RestoreTestingSelectionName: aws.String(name),
+	}
+
+	if d.HasChange("iam_role_arn") {
+		input.RestoreTestingSelection.IamRoleArn = aws.String(d.Get("iam_role_arn").(string))
+	}
+
+	if d.HasChange("protected_resource_arns") {
+		input.RestoreTestingSelection.ProtectedResourceArns = flex.ExpandStringSet(d.Get("protected_resource_arns").(*schema.Set))
+	}
+
+	if d.HasChange("protected_resource_conditions") {
+		input.RestoreTestingSelection.ProtectedResourceConditions = expandProtectedResourceConditions(d.Get("protected_resource_conditions").([]interface{}))
+	}
+
+	if d.HasChange("restore_metadata_overrides") {
+		input.RestoreTestingSelection.RestoreMetadataOverrides = flex.ExpandStringMap(d.Get("restore_metadata_overrides").(map[string]interface{}))
+	}
+
+	if d.HasChange("validation_window_hours") {
+		input.RestoreTestingSelection.ValidationWindowHours = aws.Int64(int64(d.Get("validation_window_hours").(int)))
+	}
+
+	log.Printf("[DEBUG] Updating Backup Restore Testing Selection: %s", input)
+	_, err = conn.UpdateRestoreTestingSelection(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Backup Restore Testing Selection (%s): %w", d.Id(), err)
+	}
+
+	return resourceRestoreTestingSelectionRead(d, meta)
+}
+
+func resourceRestoreTestingSelectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	planName, name, err := RestoreTestingSelectionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Backup Restore Testing Selection: %s", d.Id())
+	_, err = conn.DeleteRestoreTestingSelection(&backup.DeleteRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelectionName: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Restore Testing Selection (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const restoreTestingSelectionResourceIDSeparator = ":"
+
+func RestoreTestingSelectionCreateResourceID(planName, selectionName string) string {
+	parts := []string{planName, selectionName}
+	id := strings.Join(parts, restoreTestingSelectionResourceIDSeparator)
+
+	return id
+}
+
+func RestoreTestingSelectionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, restoreTestingSelectionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected restore-testing-plan-name%[2]srestore-testing-selection-name", id, restoreTestingSelectionResourceIDSeparator)
+}
+
+func expandProtectedResourceConditions(tfList []interface{}) *backup.ProtectedResourceConditions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &backup.ProtectedResourceConditions{}
+
+	if v, ok := tfMap["string_equals"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StringEquals = expandKeyValues(v)
+	}
+
+	if v, ok := tfMap["string_not_equals"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StringNotEquals = expandKeyValues(v)
+	}
+
+	return apiObject
+}
+
+func expandKeyValues(tfList []interface{}) []*backup.KeyValue {
+	var apiObjects []*backup.KeyValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &backup.KeyValue{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenProtectedResourceConditions(apiObject *backup.ProtectedResourceConditions) []interface{} {
+	if apiObject == nil || (len(apiObject.StringEquals) == 0 && len(apiObject.StringNotEquals) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"string_equals":     flattenKeyValues(apiObject.StringEquals),
+		"string_not_equals": flattenKeyValues(apiObject.StringNotEquals),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenKeyValues(apiObjects []*backup.KeyValue) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   aws.StringValue(apiObject.Key),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
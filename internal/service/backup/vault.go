@@ -36,7 +36,7 @@ func ResourceVault() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", ""),
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -0,0 +1,602 @@
+package bedrock
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomModelCreate,
+		Read:   resourceCustomModelRead,
+		Update: resourceCustomModelUpdate,
+		Delete: resourceCustomModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"base_model_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"custom_model_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_model_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"custom_model_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"customization_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(bedrock.CustomizationType_Values(), false),
+			},
+			"hyperparameters": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"training_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"training_metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"training_loss": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"validation_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validator": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"validation_metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validation_loss": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	jobName := d.Get("job_name").(string)
+	input := &bedrock.CreateModelCustomizationJobInput{
+		BaseModelIdentifier: aws.String(d.Get("base_model_identifier").(string)),
+		ClientRequestToken:  aws.String(resource.UniqueId()),
+		CustomModelName:     aws.String(d.Get("custom_model_name").(string)),
+		HyperParameters:     flex.ExpandStringMap(d.Get("hyperparameters").(map[string]interface{})),
+		JobName:             aws.String(jobName),
+		OutputDataConfig:    expandOutputDataConfig(d.Get("output_data_config").([]interface{})),
+		RoleArn:             aws.String(d.Get("role_arn").(string)),
+		TrainingDataConfig:  expandTrainingDataConfig(d.Get("training_data_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("custom_model_kms_key_id"); ok {
+		input.CustomModelKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customization_type"); ok {
+		input.CustomizationType = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.CustomModelTags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("validation_data_config"); ok {
+		input.ValidationDataConfig = expandValidationDataConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandVPCConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Bedrock Model Customization Job: %s", input)
+	outputRaw, err := tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateModelCustomizationJob(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, bedrock.ErrCodeValidationException, "Could not assume provided IAM role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Bedrock Model Customization Job (%s): %w", jobName, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*bedrock.CreateModelCustomizationJobOutput).JobArn))
+
+	if _, err := waitModelCustomizationJobCompleted(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Model Customization Job (%s) complete: %w", d.Id(), err)
+	}
+
+	return resourceCustomModelRead(d, meta)
+}
+
+func resourceCustomModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	job, err := FindModelCustomizationJobByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Model Customization Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Bedrock Model Customization Job (%s): %w", d.Id(), err)
+	}
+
+	customModelARN := aws.StringValue(job.OutputModelArn)
+
+	// The custom model can be deleted independently of the customization job that produced it.
+	if customModelARN != "" {
+		_, err := FindCustomModelByID(conn, customModelARN)
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] Bedrock Custom Model (%s) not found, removing from state", customModelARN)
+			d.SetId("")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Bedrock Custom Model (%s): %w", customModelARN, err)
+		}
+	}
+
+	// The API returns the base model's ARN; keep any configured model ID to avoid a spurious diff.
+	if _, ok := d.GetOk("base_model_identifier"); !ok {
+		d.Set("base_model_identifier", job.BaseModelArn)
+	}
+	d.Set("custom_model_arn", customModelARN)
+	d.Set("custom_model_kms_key_id", job.OutputModelKmsKeyArn)
+	d.Set("custom_model_name", job.OutputModelName)
+	d.Set("customization_type", job.CustomizationType)
+	d.Set("hyperparameters", aws.StringValueMap(job.HyperParameters))
+	d.Set("job_arn", job.JobArn)
+	d.Set("job_name", job.JobName)
+	d.Set("job_status", job.Status)
+
+	if err := d.Set("output_data_config", flattenOutputDataConfig(job.OutputDataConfig)); err != nil {
+		return fmt.Errorf("error setting output_data_config: %w", err)
+	}
+
+	d.Set("role_arn", job.RoleArn)
+
+	if err := d.Set("training_data_config", flattenTrainingDataConfig(job.TrainingDataConfig)); err != nil {
+		return fmt.Errorf("error setting training_data_config: %w", err)
+	}
+
+	if err := d.Set("training_metrics", flattenTrainingMetrics(job.TrainingMetrics)); err != nil {
+		return fmt.Errorf("error setting training_metrics: %w", err)
+	}
+
+	if err := d.Set("validation_data_config", flattenValidationDataConfig(job.ValidationDataConfig)); err != nil {
+		return fmt.Errorf("error setting validation_data_config: %w", err)
+	}
+
+	if err := d.Set("validation_metrics", flattenValidatorMetrics(job.ValidationMetrics)); err != nil {
+		return fmt.Errorf("error setting validation_metrics: %w", err)
+	}
+
+	if err := d.Set("vpc_config", flattenVPCConfig(job.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	if customModelARN == "" {
+		return nil
+	}
+
+	tags, err := ListTags(conn, customModelARN)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Bedrock Custom Model (%s): %w", customModelARN, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCustomModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("custom_model_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Bedrock Custom Model (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCustomModelRead(d, meta)
+}
+
+func resourceCustomModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+
+	if d.Get("job_status").(string) == bedrock.ModelCustomizationJobStatusInProgress {
+		log.Printf("[DEBUG] Stopping Bedrock Model Customization Job: %s", d.Id())
+		_, err := conn.StopModelCustomizationJob(&bedrock.StopModelCustomizationJobInput{
+			JobIdentifier: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error stopping Bedrock Model Customization Job (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitModelCustomizationJobStopped(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Bedrock Model Customization Job (%s) stop: %w", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	customModelARN := d.Get("custom_model_arn").(string)
+
+	if customModelARN == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting Bedrock Custom Model: %s", customModelARN)
+	_, err := conn.DeleteCustomModel(&bedrock.DeleteCustomModelInput{
+		ModelIdentifier: aws.String(customModelARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Bedrock Custom Model (%s): %w", customModelARN, err)
+	}
+
+	return nil
+}
+
+func expandOutputDataConfig(tfList []interface{}) *bedrock.OutputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &bedrock.OutputDataConfig{}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTrainingDataConfig(tfList []interface{}) *bedrock.TrainingDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &bedrock.TrainingDataConfig{}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandValidationDataConfig(tfList []interface{}) *bedrock.ValidationDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &bedrock.ValidationDataConfig{}
+
+	if v, ok := tfMap["validator"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Validators = expandValidators(v)
+	}
+
+	return apiObject
+}
+
+func expandValidators(tfList []interface{}) []*bedrock.Validator {
+	var apiObjects []*bedrock.Validator
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &bedrock.Validator{}
+
+		if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+			apiObject.S3Uri = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVPCConfig(tfList []interface{}) *bedrock.VpcConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &bedrock.VpcConfig{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutputDataConfig(apiObject *bedrock.OutputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_uri": aws.StringValue(apiObject.S3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrainingDataConfig(apiObject *bedrock.TrainingDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_uri": aws.StringValue(apiObject.S3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrainingMetrics(apiObject *bedrock.TrainingMetrics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"training_loss": aws.Float64Value(apiObject.TrainingLoss),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenValidationDataConfig(apiObject *bedrock.ValidationDataConfig) []interface{} {
+	// The API returns an empty validation data configuration when none was specified.
+	if apiObject == nil || len(apiObject.Validators) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.Validators {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"s3_uri": aws.StringValue(apiObject.S3Uri),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"validator": tfList,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenValidatorMetrics(apiObjects []*bedrock.ValidatorMetric) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"validation_loss": aws.Float64Value(apiObject.ValidationLoss),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVPCConfig(apiObject *bedrock.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnet_ids":         aws.StringValueSlice(apiObject.SubnetIds),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,222 @@
+package bedrock
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProvisionedModelThroughput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProvisionedModelThroughputCreate,
+		Read:   resourceProvisionedModelThroughputRead,
+		Update: resourceProvisionedModelThroughputUpdate,
+		Delete: resourceProvisionedModelThroughputDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commitment_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(bedrock.CommitmentDuration_Values(), false),
+			},
+			"foundation_model_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"model_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"provisioned_model_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProvisionedModelThroughputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("provisioned_model_name").(string)
+	input := &bedrock.CreateProvisionedModelThroughputInput{
+		ClientRequestToken:   aws.String(resource.UniqueId()),
+		ModelId:              aws.String(d.Get("model_arn").(string)),
+		ModelUnits:           aws.Int64(int64(d.Get("model_units").(int))),
+		ProvisionedModelName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("commitment_duration"); ok {
+		input.CommitmentDuration = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Bedrock Provisioned Model Throughput: %s", input)
+	output, err := conn.CreateProvisionedModelThroughput(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Bedrock Provisioned Model Throughput (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProvisionedModelArn))
+
+	if _, err := waitProvisionedModelThroughputInService(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Provisioned Model Throughput (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceProvisionedModelThroughputRead(d, meta)
+}
+
+func resourceProvisionedModelThroughputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindProvisionedModelThroughputByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Provisioned Model Throughput (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Bedrock Provisioned Model Throughput (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.ProvisionedModelArn)
+	d.Set("arn", arn)
+	d.Set("commitment_duration", output.CommitmentDuration)
+	d.Set("foundation_model_arn", output.FoundationModelArn)
+	d.Set("model_arn", output.DesiredModelArn)
+	d.Set("model_units", output.DesiredModelUnits)
+	d.Set("provisioned_model_name", output.ProvisionedModelName)
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Bedrock Provisioned Model Throughput (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceProvisionedModelThroughputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+
+	if d.HasChanges("model_arn", "provisioned_model_name") {
+		input := &bedrock.UpdateProvisionedModelThroughputInput{
+			ProvisionedModelId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("model_arn") {
+			input.DesiredModelId = aws.String(d.Get("model_arn").(string))
+		}
+
+		if d.HasChange("provisioned_model_name") {
+			input.DesiredProvisionedModelName = aws.String(d.Get("provisioned_model_name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Bedrock Provisioned Model Throughput: %s", input)
+		_, err := conn.UpdateProvisionedModelThroughput(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Bedrock Provisioned Model Throughput (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitProvisionedModelThroughputInService(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Bedrock Provisioned Model Throughput (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Bedrock Provisioned Model Throughput (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceProvisionedModelThroughputRead(d, meta)
+}
+
+func resourceProvisionedModelThroughputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockConn
+
+	log.Printf("[DEBUG] Deleting Bedrock Provisioned Model Throughput: %s", d.Id())
+	_, err := conn.DeleteProvisionedModelThroughput(&bedrock.DeleteProvisionedModelThroughputInput{
+		ProvisionedModelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Bedrock Provisioned Model Throughput (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitProvisionedModelThroughputDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Provisioned Model Throughput (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package bedrock_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
+	// Provisioned Throughput without a commitment term is only available for custom models.
+	key := "AWS_BEDROCK_CUSTOM_MODEL_ARN"
+	modelARN := os.Getenv(key)
+	if modelARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_provisioned_model_throughput.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(bedrock.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, bedrock.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProvisionedModelThroughputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedModelThroughputConfig(rName, modelARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "commitment_duration", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "foundation_model_arn"),
+					resource.TestCheckResourceAttr(resourceName, "model_arn", modelARN),
+					resource.TestCheckResourceAttr(resourceName, "model_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", bedrock.ProvisionedModelStatusInService),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProvisionedModelThroughputConfig(rNameUpdated, modelARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProvisionedModelThroughputDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_bedrock_provisioned_model_throughput" {
+			continue
+		}
+
+		_, err := tfbedrock.FindProvisionedModelThroughputByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Bedrock Provisioned Model Throughput %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckProvisionedModelThroughputExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Provisioned Model Throughput ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockConn
+
+		_, err := tfbedrock.FindProvisionedModelThroughputByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccProvisionedModelThroughputConfig(rName, modelARN string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_provisioned_model_throughput" "test" {
+  provisioned_model_name = %[1]q
+  model_arn              = %[2]q
+  model_units            = 1
+}
+`, rName, modelARN)
+}
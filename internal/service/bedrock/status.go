@@ -0,0 +1,40 @@
+package bedrock
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusModelCustomizationJob(conn *bedrock.Bedrock, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindModelCustomizationJobByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusProvisionedModelThroughput(conn *bedrock.Bedrock, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProvisionedModelThroughputByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
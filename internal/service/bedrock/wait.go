@@ -0,0 +1,96 @@
+package bedrock
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	propagationTimeout                       = 2 * time.Minute
+	modelCustomizationJobStoppedTimeout      = 30 * time.Minute
+	provisionedModelThroughputDeletedTimeout = 30 * time.Minute
+)
+
+func waitModelCustomizationJobCompleted(conn *bedrock.Bedrock, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{bedrock.ModelCustomizationJobStatusInProgress},
+		Target:     []string{bedrock.ModelCustomizationJobStatusCompleted},
+		Refresh:    statusModelCustomizationJob(conn, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelCustomizationJobStopped(conn *bedrock.Bedrock, id string) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrock.ModelCustomizationJobStatusInProgress, bedrock.ModelCustomizationJobStatusStopping},
+		Target:  []string{bedrock.ModelCustomizationJobStatusStopped},
+		Refresh: statusModelCustomizationJob(conn, id),
+		Timeout: modelCustomizationJobStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProvisionedModelThroughputInService(conn *bedrock.Bedrock, id string, timeout time.Duration) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrock.ProvisionedModelStatusCreating, bedrock.ProvisionedModelStatusUpdating},
+		Target:  []string{bedrock.ProvisionedModelStatusInService},
+		Refresh: statusProvisionedModelThroughput(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrock.GetProvisionedModelThroughputOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProvisionedModelThroughputDeleted(conn *bedrock.Bedrock, id string) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrock.ProvisionedModelStatusCreating, bedrock.ProvisionedModelStatusInService, bedrock.ProvisionedModelStatusUpdating, bedrock.ProvisionedModelStatusFailed},
+		Target:  []string{},
+		Refresh: statusProvisionedModelThroughput(conn, id),
+		Timeout: provisionedModelThroughputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrock.GetProvisionedModelThroughputOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
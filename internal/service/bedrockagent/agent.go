@@ -0,0 +1,387 @@
+package bedrockagent
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAgent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAgentCreate,
+		Read:   resourceAgentRead,
+		Update: resourceAgentUpdate,
+		Delete: resourceAgentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "must be 1-100 alphanumeric characters, optionally separated by single underscores or hyphens"),
+			},
+			"agent_resource_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"agent_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"foundation_model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"guardrail_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guardrail_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"guardrail_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 3600),
+			},
+			"instruction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(40, 4000),
+			},
+			"prepare_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"skip_resource_in_use_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAgentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("agent_name").(string)
+	input := &bedrockagent.CreateAgentInput{
+		AgentName:            aws.String(name),
+		AgentResourceRoleArn: aws.String(d.Get("agent_resource_role_arn").(string)),
+		ClientToken:          aws.String(resource.UniqueId()),
+		FoundationModel:      aws.String(d.Get("foundation_model").(string)),
+	}
+
+	if v, ok := d.GetOk("customer_encryption_key_arn"); ok {
+		input.CustomerEncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("guardrail_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.GuardrailConfiguration = expandGuardrailConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("idle_session_ttl_in_seconds"); ok {
+		input.IdleSessionTTLInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("instruction"); ok {
+		input.Instruction = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Bedrock Agent: %s", input)
+	outputRaw, err := tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateAgent(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, bedrockagent.ErrCodeValidationException, "does not have permissions") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Bedrock Agent (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*bedrockagent.CreateAgentOutput).Agent.AgentId))
+
+	if _, err := waitAgentCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Agent (%s) create: %w", d.Id(), err)
+	}
+
+	if d.Get("prepare_agent").(bool) {
+		if err := prepareAgent(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAgentRead(d, meta)
+}
+
+func resourceAgentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	agent, err := FindAgentByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Bedrock Agent (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(agent.AgentArn)
+	d.Set("agent_arn", arn)
+	d.Set("agent_id", agent.AgentId)
+	d.Set("agent_name", agent.AgentName)
+	d.Set("agent_resource_role_arn", agent.AgentResourceRoleArn)
+	d.Set("agent_version", agent.AgentVersion)
+	d.Set("customer_encryption_key_arn", agent.CustomerEncryptionKeyArn)
+	d.Set("description", agent.Description)
+	d.Set("foundation_model", agent.FoundationModel)
+	if agent.GuardrailConfiguration != nil && aws.StringValue(agent.GuardrailConfiguration.GuardrailIdentifier) != "" {
+		if err := d.Set("guardrail_configuration", []interface{}{flattenGuardrailConfiguration(agent.GuardrailConfiguration)}); err != nil {
+			return fmt.Errorf("error setting guardrail_configuration: %w", err)
+		}
+	} else {
+		d.Set("guardrail_configuration", nil)
+	}
+	d.Set("idle_session_ttl_in_seconds", agent.IdleSessionTTLInSeconds)
+	d.Set("instruction", agent.Instruction)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Bedrock Agent (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAgentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	if d.HasChangesExcept("prepare_agent", "skip_resource_in_use_check", "tags", "tags_all") {
+		input := &bedrockagent.UpdateAgentInput{
+			AgentId:              aws.String(d.Id()),
+			AgentName:            aws.String(d.Get("agent_name").(string)),
+			AgentResourceRoleArn: aws.String(d.Get("agent_resource_role_arn").(string)),
+			FoundationModel:      aws.String(d.Get("foundation_model").(string)),
+		}
+
+		if v, ok := d.GetOk("customer_encryption_key_arn"); ok {
+			input.CustomerEncryptionKeyArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("guardrail_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.GuardrailConfiguration = expandGuardrailConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("idle_session_ttl_in_seconds"); ok {
+			input.IdleSessionTTLInSeconds = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("instruction"); ok {
+			input.Instruction = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Bedrock Agent: %s", input)
+		_, err := conn.UpdateAgent(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Bedrock Agent (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitAgentUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Bedrock Agent (%s) update: %w", d.Id(), err)
+		}
+
+		if d.Get("prepare_agent").(bool) {
+			if err := prepareAgent(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("agent_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Bedrock Agent (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAgentRead(d, meta)
+}
+
+func resourceAgentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	log.Printf("[DEBUG] Deleting Bedrock Agent: %s", d.Id())
+	_, err := conn.DeleteAgent(&bedrockagent.DeleteAgentInput{
+		AgentId:                aws.String(d.Id()),
+		SkipResourceInUseCheck: aws.Bool(d.Get("skip_resource_in_use_check").(bool)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Bedrock Agent (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitAgentDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Agent (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// prepareAgent creates a DRAFT version of the agent that can be used for internal testing.
+func prepareAgent(conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Preparing Bedrock Agent: %s", id)
+	_, err := conn.PrepareAgent(&bedrockagent.PrepareAgentInput{
+		AgentId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error preparing Bedrock Agent (%s): %w", id, err)
+	}
+
+	if _, err := waitAgentPrepared(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Agent (%s) prepare: %w", id, err)
+	}
+
+	return nil
+}
+
+func expandGuardrailConfiguration(tfMap map[string]interface{}) *bedrockagent.GuardrailConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.GuardrailConfiguration{}
+
+	if v, ok := tfMap["guardrail_identifier"].(string); ok && v != "" {
+		apiObject.GuardrailIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["guardrail_version"].(string); ok && v != "" {
+		apiObject.GuardrailVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenGuardrailConfiguration(apiObject *bedrockagent.GuardrailConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GuardrailIdentifier; v != nil {
+		tfMap["guardrail_identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.GuardrailVersion; v != nil {
+		tfMap["guardrail_version"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,424 @@
+package bedrockagent
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAgentActionGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAgentActionGroupCreate,
+		Read:   resourceAgentActionGroupRead,
+		Update: resourceAgentActionGroupUpdate,
+		Delete: resourceAgentActionGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action_group_executor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_control": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(bedrockagent.CustomControlMethod_Values(), false),
+							ExactlyOneOf: []string{"action_group_executor.0.custom_control", "action_group_executor.0.lambda"},
+						},
+						"lambda": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							ExactlyOneOf: []string{"action_group_executor.0.custom_control", "action_group_executor.0.lambda"},
+						},
+					},
+				},
+			},
+			"action_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "must be 1-100 alphanumeric characters, optionally separated by single underscores or hyphens"),
+			},
+			"action_group_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(bedrockagent.ActionGroupState_Values(), false),
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agent_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_schema": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"payload": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"api_schema.0.payload", "api_schema.0.s3"},
+						},
+						"s3": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_bucket_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"s3_object_key": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"api_schema.0.payload", "api_schema.0.s3"},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"parent_action_group_signature": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(bedrockagent.ActionGroupSignature_Values(), false),
+				ConflictsWith: []string{"action_group_executor", "api_schema"},
+			},
+			"prepare_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"skip_resource_in_use_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAgentActionGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	agentID := d.Get("agent_id").(string)
+	agentVersion := d.Get("agent_version").(string)
+	name := d.Get("action_group_name").(string)
+	input := &bedrockagent.CreateAgentActionGroupInput{
+		ActionGroupName: aws.String(name),
+		AgentId:         aws.String(agentID),
+		AgentVersion:    aws.String(agentVersion),
+		ClientToken:     aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("action_group_executor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ActionGroupExecutor = expandActionGroupExecutor(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("action_group_state"); ok {
+		input.ActionGroupState = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("api_schema"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ApiSchema = expandAPISchema(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_action_group_signature"); ok {
+		input.ParentActionGroupSignature = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Bedrock Agent Action Group: %s", input)
+	output, err := conn.CreateAgentActionGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Bedrock Agent Action Group (%s): %w", name, err)
+	}
+
+	d.SetId(AgentActionGroupCreateResourceID(aws.StringValue(output.AgentActionGroup.ActionGroupId), agentID, agentVersion))
+
+	if d.Get("prepare_agent").(bool) {
+		if err := prepareAgent(conn, agentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAgentActionGroupRead(d, meta)
+}
+
+func resourceAgentActionGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	actionGroupID, agentID, agentVersion, err := AgentActionGroupParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	actionGroup, err := FindAgentActionGroupByThreePartKey(conn, actionGroupID, agentID, agentVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent Action Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Bedrock Agent Action Group (%s): %w", d.Id(), err)
+	}
+
+	if actionGroup.ActionGroupExecutor != nil {
+		if err := d.Set("action_group_executor", []interface{}{flattenActionGroupExecutor(actionGroup.ActionGroupExecutor)}); err != nil {
+			return fmt.Errorf("error setting action_group_executor: %w", err)
+		}
+	} else {
+		d.Set("action_group_executor", nil)
+	}
+	d.Set("action_group_id", actionGroup.ActionGroupId)
+	d.Set("action_group_name", actionGroup.ActionGroupName)
+	d.Set("action_group_state", actionGroup.ActionGroupState)
+	d.Set("agent_id", actionGroup.AgentId)
+	d.Set("agent_version", actionGroup.AgentVersion)
+	if actionGroup.ApiSchema != nil {
+		if err := d.Set("api_schema", []interface{}{flattenAPISchema(actionGroup.ApiSchema)}); err != nil {
+			return fmt.Errorf("error setting api_schema: %w", err)
+		}
+	} else {
+		d.Set("api_schema", nil)
+	}
+	d.Set("description", actionGroup.Description)
+	d.Set("parent_action_group_signature", actionGroup.ParentActionSignature)
+
+	return nil
+}
+
+func resourceAgentActionGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	actionGroupID, agentID, agentVersion, err := AgentActionGroupParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChangesExcept("prepare_agent", "skip_resource_in_use_check") {
+		input := &bedrockagent.UpdateAgentActionGroupInput{
+			ActionGroupId:    aws.String(actionGroupID),
+			ActionGroupName:  aws.String(d.Get("action_group_name").(string)),
+			ActionGroupState: aws.String(d.Get("action_group_state").(string)),
+			AgentId:          aws.String(agentID),
+			AgentVersion:     aws.String(agentVersion),
+		}
+
+		if v, ok := d.GetOk("action_group_executor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ActionGroupExecutor = expandActionGroupExecutor(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("api_schema"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ApiSchema = expandAPISchema(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("parent_action_group_signature"); ok {
+			input.ParentActionGroupSignature = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Bedrock Agent Action Group: %s", input)
+		_, err := conn.UpdateAgentActionGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Bedrock Agent Action Group (%s): %w", d.Id(), err)
+		}
+
+		if d.Get("prepare_agent").(bool) {
+			if err := prepareAgent(conn, agentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAgentActionGroupRead(d, meta)
+}
+
+func resourceAgentActionGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	actionGroupID, agentID, agentVersion, err := AgentActionGroupParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Bedrock Agent Action Group: %s", d.Id())
+	_, err = conn.DeleteAgentActionGroup(&bedrockagent.DeleteAgentActionGroupInput{
+		ActionGroupId:          aws.String(actionGroupID),
+		AgentId:                aws.String(agentID),
+		AgentVersion:           aws.String(agentVersion),
+		SkipResourceInUseCheck: aws.Bool(d.Get("skip_resource_in_use_check").(bool)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Bedrock Agent Action Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandActionGroupExecutor(tfMap map[string]interface{}) *bedrockagent.ActionGroupExecutor {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.ActionGroupExecutor{}
+
+	if v, ok := tfMap["custom_control"].(string); ok && v != "" {
+		apiObject.CustomControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["lambda"].(string); ok && v != "" {
+		apiObject.Lambda = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAPISchema(tfMap map[string]interface{}) *bedrockagent.APISchema {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.APISchema{}
+
+	if v, ok := tfMap["payload"].(string); ok && v != "" {
+		apiObject.Payload = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3 = expandS3Identifier(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3Identifier(tfMap map[string]interface{}) *bedrockagent.S3Identifier {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.S3Identifier{}
+
+	if v, ok := tfMap["s3_bucket_name"].(string); ok && v != "" {
+		apiObject.S3BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_object_key"].(string); ok && v != "" {
+		apiObject.S3ObjectKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenActionGroupExecutor(apiObject *bedrockagent.ActionGroupExecutor) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomControl; v != nil {
+		tfMap["custom_control"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		tfMap["lambda"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAPISchema(apiObject *bedrockagent.APISchema) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{flattenS3Identifier(v)}
+	}
+
+	return tfMap
+}
+
+func flattenS3Identifier(apiObject *bedrockagent.S3Identifier) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3BucketName; v != nil {
+		tfMap["s3_bucket_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.S3ObjectKey; v != nil {
+		tfMap["s3_object_key"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
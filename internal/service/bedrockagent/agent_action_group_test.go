@@ -0,0 +1,169 @@
+package bedrockagent_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockAgentAgentActionGroup_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(bedrockagent.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAgentActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentActionGroupConfig(rName, "first action group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_group_executor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action_group_executor.0.custom_control", bedrockagent.CustomControlMethodReturnControl),
+					resource.TestCheckResourceAttrSet(resourceName, "action_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "action_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "action_group_state", bedrockagent.ActionGroupStateEnabled),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", "aws_bedrockagent_agent.test", "agent_id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "api_schema.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_schema.0.payload"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first action group"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prepare_agent", "skip_resource_in_use_check"},
+			},
+			{
+				Config: testAccAgentActionGroupConfig(rName, "updated action group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated action group"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentActionGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_bedrockagent_agent_action_group" {
+			continue
+		}
+
+		actionGroupID, agentID, agentVersion, err := tfbedrockagent.AgentActionGroupParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfbedrockagent.FindAgentActionGroupByThreePartKey(conn, actionGroupID, agentID, agentVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Bedrock Agent Action Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAgentActionGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Agent Action Group ID is set")
+		}
+
+		actionGroupID, agentID, agentVersion, err := tfbedrockagent.AgentActionGroupParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn
+
+		_, err = tfbedrockagent.FindAgentActionGroupByThreePartKey(conn, actionGroupID, agentID, agentVersion)
+
+		return err
+	}
+}
+
+func testAccAgentActionGroupConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccAgentConfig(rName, "action group test"), fmt.Sprintf(`
+resource "aws_bedrockagent_agent_action_group" "test" {
+  action_group_name          = %[1]q
+  agent_id                   = aws_bedrockagent_agent.test.agent_id
+  agent_version              = "DRAFT"
+  description                = %[2]q
+  skip_resource_in_use_check = true
+
+  action_group_executor {
+    custom_control = "RETURN_CONTROL"
+  }
+
+  api_schema {
+    payload = jsonencode({
+      openapi = "3.0.0"
+      info = {
+        title   = "Weather API"
+        version = "1.0.0"
+      }
+      paths = {
+        "/weather" = {
+          get = {
+            summary     = "Get the current weather"
+            description = "Returns the current weather for a city"
+            operationId = "getWeather"
+            parameters = [{
+              name        = "city"
+              in          = "query"
+              description = "Name of the city"
+              required    = true
+              schema = {
+                type = "string"
+              }
+            }]
+            responses = {
+              "200" = {
+                description = "The current weather"
+                content = {
+                  "application/json" = {
+                    schema = {
+                      type = "string"
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    })
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,319 @@
+package bedrockagent
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAgentAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAgentAliasCreate,
+		Read:   resourceAgentAliasRead,
+		Update: resourceAgentAliasUpdate,
+		Delete: resourceAgentAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_alias_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_alias_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_alias_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "must be 1-100 alphanumeric characters, optionally separated by single underscores or hyphens"),
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"routing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"provisioned_throughput": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAgentAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	agentID := d.Get("agent_id").(string)
+	name := d.Get("agent_alias_name").(string)
+	input := &bedrockagent.CreateAgentAliasInput{
+		AgentAliasName: aws.String(name),
+		AgentId:        aws.String(agentID),
+		ClientToken:    aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("routing_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.RoutingConfiguration = expandAgentAliasRoutingConfigurationListItems(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Bedrock Agent Alias: %s", input)
+	output, err := conn.CreateAgentAlias(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Bedrock Agent Alias (%s): %w", name, err)
+	}
+
+	agentAliasID := aws.StringValue(output.AgentAlias.AgentAliasId)
+	d.SetId(AgentAliasCreateResourceID(agentAliasID, agentID))
+
+	if _, err := waitAgentAliasPrepared(conn, agentAliasID, agentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Agent Alias (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAgentAliasRead(d, meta)
+}
+
+func resourceAgentAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	agentAliasID, agentID, err := AgentAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	alias, err := FindAgentAliasByTwoPartKey(conn, agentAliasID, agentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Bedrock Agent Alias (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(alias.AgentAliasArn)
+	d.Set("agent_alias_arn", arn)
+	d.Set("agent_alias_id", alias.AgentAliasId)
+	d.Set("agent_alias_name", alias.AgentAliasName)
+	d.Set("agent_id", alias.AgentId)
+	d.Set("description", alias.Description)
+	if err := d.Set("routing_configuration", flattenAgentAliasRoutingConfigurationListItems(alias.RoutingConfiguration)); err != nil {
+		return fmt.Errorf("error setting routing_configuration: %w", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Bedrock Agent Alias (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAgentAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	agentAliasID, agentID, err := AgentAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &bedrockagent.UpdateAgentAliasInput{
+			AgentAliasId:   aws.String(agentAliasID),
+			AgentAliasName: aws.String(d.Get("agent_alias_name").(string)),
+			AgentId:        aws.String(agentID),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if d.HasChange("routing_configuration") {
+			if v, ok := d.GetOk("routing_configuration"); ok && len(v.([]interface{})) > 0 {
+				input.RoutingConfiguration = expandAgentAliasRoutingConfigurationListItems(v.([]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Bedrock Agent Alias: %s", input)
+		_, err := conn.UpdateAgentAlias(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Bedrock Agent Alias (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitAgentAliasPrepared(conn, agentAliasID, agentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Bedrock Agent Alias (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("agent_alias_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Bedrock Agent Alias (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAgentAliasRead(d, meta)
+}
+
+func resourceAgentAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	agentAliasID, agentID, err := AgentAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Bedrock Agent Alias: %s", d.Id())
+	_, err = conn.DeleteAgentAlias(&bedrockagent.DeleteAgentAliasInput{
+		AgentAliasId: aws.String(agentAliasID),
+		AgentId:      aws.String(agentID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Bedrock Agent Alias (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitAgentAliasDeleted(conn, agentAliasID, agentID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Agent Alias (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAgentAliasRoutingConfigurationListItems(tfList []interface{}) []*bedrockagent.AgentAliasRoutingConfigurationListItem {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*bedrockagent.AgentAliasRoutingConfigurationListItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &bedrockagent.AgentAliasRoutingConfigurationListItem{}
+
+		if v, ok := tfMap["agent_version"].(string); ok && v != "" {
+			apiObject.AgentVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["provisioned_throughput"].(string); ok && v != "" {
+			apiObject.ProvisionedThroughput = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAgentAliasRoutingConfigurationListItems(apiObjects []*bedrockagent.AgentAliasRoutingConfigurationListItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AgentVersion; v != nil {
+			tfMap["agent_version"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.ProvisionedThroughput; v != nil {
+			tfMap["provisioned_throughput"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
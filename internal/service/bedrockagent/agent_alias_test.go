@@ -0,0 +1,120 @@
+package bedrockagent_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockAgentAgentAlias_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(bedrockagent.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAgentAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentAliasConfig(rName, "first alias"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentAliasExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "agent_alias_arn", "bedrock", regexp.MustCompile(`agent-alias/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "agent_alias_id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_alias_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", "aws_bedrockagent_agent.test", "agent_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first alias"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAgentAliasConfig(rName, "updated alias"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentAliasExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated alias"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentAliasDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_bedrockagent_agent_alias" {
+			continue
+		}
+
+		agentAliasID, agentID, err := tfbedrockagent.AgentAliasParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfbedrockagent.FindAgentAliasByTwoPartKey(conn, agentAliasID, agentID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Bedrock Agent Alias %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAgentAliasExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Agent Alias ID is set")
+		}
+
+		agentAliasID, agentID, err := tfbedrockagent.AgentAliasParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn
+
+		_, err = tfbedrockagent.FindAgentAliasByTwoPartKey(conn, agentAliasID, agentID)
+
+		return err
+	}
+}
+
+func testAccAgentAliasConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccAgentConfig(rName, "alias test"), fmt.Sprintf(`
+resource "aws_bedrockagent_agent_alias" "test" {
+  agent_alias_name = %[1]q
+  agent_id         = aws_bedrockagent_agent.test.agent_id
+  description      = %[2]q
+}
+`, rName, description))
+}
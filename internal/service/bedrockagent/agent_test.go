@@ -0,0 +1,267 @@
+package bedrockagent_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockAgentAgent_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(bedrockagent.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig(rName, "basic claude"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "agent_arn", "bedrock", regexp.MustCompile(`agent/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "agent_id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_resource_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "description", "basic claude"),
+					resource.TestCheckResourceAttr(resourceName, "foundation_model", "anthropic.claude-v2"),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "500"),
+					resource.TestCheckResourceAttr(resourceName, "prepare_agent", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prepare_agent", "skip_resource_in_use_check"},
+			},
+			{
+				Config: testAccAgentConfig(rName, "updated claude"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated claude"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgent_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(bedrockagent.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig(rName, "basic claude"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfbedrockagent.ResourceAgent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgent_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(bedrockagent.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prepare_agent", "skip_resource_in_use_check"},
+			},
+			{
+				Config: testAccAgentConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAgentConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_bedrockagent_agent" {
+			continue
+		}
+
+		_, err := tfbedrockagent.FindAgentByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Bedrock Agent %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAgentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Agent ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn
+
+		_, err := tfbedrockagent.FindAgentByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAgentBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "bedrock.amazonaws.com"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:agent/*"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "bedrock:InvokeModel"
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/anthropic.claude-v2"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccAgentConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccAgentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_agent" "test" {
+  agent_name                  = %[1]q
+  agent_resource_role_arn     = aws_iam_role.test.arn
+  description                 = %[2]q
+  foundation_model            = "anthropic.claude-v2"
+  idle_session_ttl_in_seconds = 500
+  instruction                 = "You are a friendly assistant who answers questions about Terraform."
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
+
+func testAccAgentConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAgentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_agent" "test" {
+  agent_name              = %[1]q
+  agent_resource_role_arn = aws_iam_role.test.arn
+  foundation_model        = "anthropic.claude-v2"
+  instruction             = "You are a friendly assistant who answers questions about Terraform."
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAgentConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAgentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_agent" "test" {
+  agent_name              = %[1]q
+  agent_resource_role_arn = aws_iam_role.test.arn
+  foundation_model        = "anthropic.claude-v2"
+  instruction             = "You are a friendly assistant who answers questions about Terraform."
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,112 @@
+package bedrockagent
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAgentByID(conn *bedrockagent.BedrockAgent, id string) (*bedrockagent.Agent, error) {
+	input := &bedrockagent.GetAgentInput{
+		AgentId: aws.String(id),
+	}
+
+	output, err := conn.GetAgent(input)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Agent == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Agent, nil
+}
+
+func FindAgentActionGroupByThreePartKey(conn *bedrockagent.BedrockAgent, actionGroupID, agentID, agentVersion string) (*bedrockagent.AgentActionGroup, error) {
+	input := &bedrockagent.GetAgentActionGroupInput{
+		ActionGroupId: aws.String(actionGroupID),
+		AgentId:       aws.String(agentID),
+		AgentVersion:  aws.String(agentVersion),
+	}
+
+	output, err := conn.GetAgentActionGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentActionGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentActionGroup, nil
+}
+
+func FindAgentAliasByTwoPartKey(conn *bedrockagent.BedrockAgent, agentAliasID, agentID string) (*bedrockagent.AgentAlias, error) {
+	input := &bedrockagent.GetAgentAliasInput{
+		AgentAliasId: aws.String(agentAliasID),
+		AgentId:      aws.String(agentID),
+	}
+
+	output, err := conn.GetAgentAlias(input)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentAlias == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentAlias, nil
+}
+
+func FindKnowledgeBaseByID(conn *bedrockagent.BedrockAgent, id string) (*bedrockagent.KnowledgeBase, error) {
+	input := &bedrockagent.GetKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(id),
+	}
+
+	output, err := conn.GetKnowledgeBase(input)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.KnowledgeBase == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.KnowledgeBase, nil
+}
@@ -0,0 +1,44 @@
+package bedrockagent
+
+import (
+	"fmt"
+	"strings"
+)
+
+const AgentActionGroupResourceIDSeparator = ","
+
+func AgentActionGroupCreateResourceID(actionGroupID, agentID, agentVersion string) string {
+	parts := []string{actionGroupID, agentID, agentVersion}
+	id := strings.Join(parts, AgentActionGroupResourceIDSeparator)
+
+	return id
+}
+
+func AgentActionGroupParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, AgentActionGroupResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected action-group-id%[2]sagent-id%[2]sagent-version", id, AgentActionGroupResourceIDSeparator)
+}
+
+const AgentAliasResourceIDSeparator = ","
+
+func AgentAliasCreateResourceID(agentAliasID, agentID string) string {
+	parts := []string{agentAliasID, agentID}
+	id := strings.Join(parts, AgentAliasResourceIDSeparator)
+
+	return id
+}
+
+func AgentAliasParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, AgentAliasResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected agent-alias-id%[2]sagent-id", id, AgentAliasResourceIDSeparator)
+}
@@ -0,0 +1,531 @@
+package bedrockagent
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceKnowledgeBase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKnowledgeBaseCreate,
+		Read:   resourceKnowledgeBaseRead,
+		Update: resourceKnowledgeBaseUpdate,
+		Delete: resourceKnowledgeBaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"knowledge_base_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(bedrockagent.KnowledgeBaseType_Values(), false),
+						},
+						"vector_knowledge_base_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"embedding_model_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "must be 1-100 alphanumeric characters, optionally separated by single underscores or hyphens"),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"storage_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"opensearch_serverless_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"field_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metadata_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"text_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"vector_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"vector_index_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{bedrockagent.KnowledgeBaseStorageTypeOpensearchServerless}, false),
+						},
+					},
+				},
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceKnowledgeBaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &bedrockagent.CreateKnowledgeBaseInput{
+		ClientToken:                aws.String(resource.UniqueId()),
+		KnowledgeBaseConfiguration: expandKnowledgeBaseConfiguration(d.Get("knowledge_base_configuration").([]interface{})[0].(map[string]interface{})),
+		Name:                       aws.String(name),
+		RoleArn:                    aws.String(d.Get("role_arn").(string)),
+		StorageConfiguration:       expandStorageConfiguration(d.Get("storage_configuration").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Bedrock Agent Knowledge Base: %s", input)
+	outputRaw, err := tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateKnowledgeBase(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, bedrockagent.ErrCodeValidationException, "Unable to assume role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Bedrock Agent Knowledge Base (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*bedrockagent.CreateKnowledgeBaseOutput).KnowledgeBase.KnowledgeBaseId))
+
+	if _, err := waitKnowledgeBaseActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Agent Knowledge Base (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceKnowledgeBaseRead(d, meta)
+}
+
+func resourceKnowledgeBaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	kb, err := FindKnowledgeBaseByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent Knowledge Base (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Bedrock Agent Knowledge Base (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(kb.KnowledgeBaseArn)
+	d.Set("arn", arn)
+	d.Set("created_at", aws.TimeValue(kb.CreatedAt).Format(time.RFC3339))
+	d.Set("description", kb.Description)
+	d.Set("failure_reasons", aws.StringValueSlice(kb.FailureReasons))
+	if kb.KnowledgeBaseConfiguration != nil {
+		if err := d.Set("knowledge_base_configuration", []interface{}{flattenKnowledgeBaseConfiguration(kb.KnowledgeBaseConfiguration)}); err != nil {
+			return fmt.Errorf("error setting knowledge_base_configuration: %w", err)
+		}
+	} else {
+		d.Set("knowledge_base_configuration", nil)
+	}
+	d.Set("name", kb.Name)
+	d.Set("role_arn", kb.RoleArn)
+	if kb.StorageConfiguration != nil {
+		if err := d.Set("storage_configuration", []interface{}{flattenStorageConfiguration(kb.StorageConfiguration)}); err != nil {
+			return fmt.Errorf("error setting storage_configuration: %w", err)
+		}
+	} else {
+		d.Set("storage_configuration", nil)
+	}
+	d.Set("updated_at", aws.TimeValue(kb.UpdatedAt).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Bedrock Agent Knowledge Base (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceKnowledgeBaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &bedrockagent.UpdateKnowledgeBaseInput{
+			KnowledgeBaseConfiguration: expandKnowledgeBaseConfiguration(d.Get("knowledge_base_configuration").([]interface{})[0].(map[string]interface{})),
+			KnowledgeBaseId:            aws.String(d.Id()),
+			Name:                       aws.String(d.Get("name").(string)),
+			RoleArn:                    aws.String(d.Get("role_arn").(string)),
+			StorageConfiguration:       expandStorageConfiguration(d.Get("storage_configuration").([]interface{})[0].(map[string]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Bedrock Agent Knowledge Base: %s", input)
+		_, err := conn.UpdateKnowledgeBase(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Bedrock Agent Knowledge Base (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitKnowledgeBaseActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Bedrock Agent Knowledge Base (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Bedrock Agent Knowledge Base (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceKnowledgeBaseRead(d, meta)
+}
+
+func resourceKnowledgeBaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BedrockAgentConn
+
+	log.Printf("[DEBUG] Deleting Bedrock Agent Knowledge Base: %s", d.Id())
+	_, err := conn.DeleteKnowledgeBase(&bedrockagent.DeleteKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Bedrock Agent Knowledge Base (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitKnowledgeBaseDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Bedrock Agent Knowledge Base (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandKnowledgeBaseConfiguration(tfMap map[string]interface{}) *bedrockagent.KnowledgeBaseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.KnowledgeBaseConfiguration{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["vector_knowledge_base_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.VectorKnowledgeBaseConfiguration = expandVectorKnowledgeBaseConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandVectorKnowledgeBaseConfiguration(tfMap map[string]interface{}) *bedrockagent.VectorKnowledgeBaseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.VectorKnowledgeBaseConfiguration{}
+
+	if v, ok := tfMap["embedding_model_arn"].(string); ok && v != "" {
+		apiObject.EmbeddingModelArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStorageConfiguration(tfMap map[string]interface{}) *bedrockagent.StorageConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.StorageConfiguration{}
+
+	if v, ok := tfMap["opensearch_serverless_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OpensearchServerlessConfiguration = expandOpenSearchServerlessConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOpenSearchServerlessConfiguration(tfMap map[string]interface{}) *bedrockagent.OpenSearchServerlessConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.OpenSearchServerlessConfiguration{}
+
+	if v, ok := tfMap["collection_arn"].(string); ok && v != "" {
+		apiObject.CollectionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["field_mapping"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FieldMapping = expandOpenSearchServerlessFieldMapping(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["vector_index_name"].(string); ok && v != "" {
+		apiObject.VectorIndexName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOpenSearchServerlessFieldMapping(tfMap map[string]interface{}) *bedrockagent.OpenSearchServerlessFieldMapping {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.OpenSearchServerlessFieldMapping{}
+
+	if v, ok := tfMap["metadata_field"].(string); ok && v != "" {
+		apiObject.MetadataField = aws.String(v)
+	}
+
+	if v, ok := tfMap["text_field"].(string); ok && v != "" {
+		apiObject.TextField = aws.String(v)
+	}
+
+	if v, ok := tfMap["vector_field"].(string); ok && v != "" {
+		apiObject.VectorField = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenKnowledgeBaseConfiguration(apiObject *bedrockagent.KnowledgeBaseConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VectorKnowledgeBaseConfiguration; v != nil {
+		tfMap["vector_knowledge_base_configuration"] = []interface{}{flattenVectorKnowledgeBaseConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenVectorKnowledgeBaseConfiguration(apiObject *bedrockagent.VectorKnowledgeBaseConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EmbeddingModelArn; v != nil {
+		tfMap["embedding_model_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenStorageConfiguration(apiObject *bedrockagent.StorageConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OpensearchServerlessConfiguration; v != nil {
+		tfMap["opensearch_serverless_configuration"] = []interface{}{flattenOpenSearchServerlessConfiguration(v)}
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOpenSearchServerlessConfiguration(apiObject *bedrockagent.OpenSearchServerlessConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CollectionArn; v != nil {
+		tfMap["collection_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FieldMapping; v != nil {
+		tfMap["field_mapping"] = []interface{}{flattenOpenSearchServerlessFieldMapping(v)}
+	}
+
+	if v := apiObject.VectorIndexName; v != nil {
+		tfMap["vector_index_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOpenSearchServerlessFieldMapping(apiObject *bedrockagent.OpenSearchServerlessFieldMapping) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MetadataField; v != nil {
+		tfMap["metadata_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TextField; v != nil {
+		tfMap["text_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VectorField; v != nil {
+		tfMap["vector_field"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,186 @@
+package bedrockagent_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockAgentKnowledgeBase_basic(t *testing.T) {
+	// The OpenSearch Serverless collection must already contain a vector index
+	// named "bedrock-knowledge-base-default-index" and grant the test role access.
+	key := "AWS_BEDROCK_KNOWLEDGE_BASE_COLLECTION_ARN"
+	collectionARN := os.Getenv(key)
+	if collectionARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(bedrockagent.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKnowledgeBaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig(rName, collectionARN, "first knowledge base"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "bedrock", regexp.MustCompile(`knowledge-base/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first knowledge base"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.type", bedrockagent.KnowledgeBaseTypeVector),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.0.type", bedrockagent.KnowledgeBaseStorageTypeOpensearchServerless),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.0.opensearch_serverless_configuration.0.collection_arn", collectionARN),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKnowledgeBaseConfig(rName, collectionARN, "updated knowledge base"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated knowledge base"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKnowledgeBaseDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_bedrockagent_knowledge_base" {
+			continue
+		}
+
+		_, err := tfbedrockagent.FindKnowledgeBaseByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Bedrock Agent Knowledge Base %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKnowledgeBaseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Agent Knowledge Base ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn
+
+		_, err := tfbedrockagent.FindKnowledgeBaseByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccKnowledgeBaseConfig(rName, collectionARN, description string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "bedrock.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = "bedrock:InvokeModel"
+        Effect   = "Allow"
+        Resource = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/amazon.titan-embed-text-v1"
+      },
+      {
+        Action   = "aoss:APIAccessAll"
+        Effect   = "Allow"
+        Resource = %[2]q
+      },
+    ]
+  })
+}
+
+resource "aws_bedrockagent_knowledge_base" "test" {
+  name        = %[1]q
+  description = %[3]q
+  role_arn    = aws_iam_role.test.arn
+
+  knowledge_base_configuration {
+    type = "VECTOR"
+
+    vector_knowledge_base_configuration {
+      embedding_model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/amazon.titan-embed-text-v1"
+    }
+  }
+
+  storage_configuration {
+    type = "OPENSEARCH_SERVERLESS"
+
+    opensearch_serverless_configuration {
+      collection_arn    = %[2]q
+      vector_index_name = "bedrock-knowledge-base-default-index"
+
+      field_mapping {
+        metadata_field = "AMAZON_BEDROCK_METADATA"
+        text_field     = "AMAZON_BEDROCK_TEXT_CHUNK"
+        vector_field   = "bedrock-knowledge-base-default-vector"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, collectionARN, description)
+}
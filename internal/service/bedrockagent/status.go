@@ -0,0 +1,56 @@
+package bedrockagent
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusAgent(conn *bedrockagent.BedrockAgent, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAgentByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AgentStatus), nil
+	}
+}
+
+func statusAgentAlias(conn *bedrockagent.BedrockAgent, agentAliasID, agentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAgentAliasByTwoPartKey(conn, agentAliasID, agentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AgentAliasStatus), nil
+	}
+}
+
+func statusKnowledgeBase(conn *bedrockagent.BedrockAgent, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindKnowledgeBaseByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,168 @@
+package bedrockagent
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
+
+func waitAgentCreated(conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrockagent.AgentStatusCreating},
+		Target:  []string{bedrockagent.AgentStatusNotPrepared},
+		Refresh: statusAgent(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrockagent.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentUpdated(conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrockagent.AgentStatusUpdating},
+		Target:  []string{bedrockagent.AgentStatusNotPrepared, bedrockagent.AgentStatusPrepared},
+		Refresh: statusAgent(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrockagent.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentPrepared(conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrockagent.AgentStatusPreparing},
+		Target:  []string{bedrockagent.AgentStatusPrepared},
+		Refresh: statusAgent(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrockagent.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentDeleted(conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrockagent.AgentStatusDeleting},
+		Target:  []string{},
+		Refresh: statusAgent(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrockagent.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentAliasPrepared(conn *bedrockagent.BedrockAgent, agentAliasID, agentID string, timeout time.Duration) (*bedrockagent.AgentAlias, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrockagent.AgentAliasStatusCreating, bedrockagent.AgentAliasStatusUpdating},
+		Target:  []string{bedrockagent.AgentAliasStatusPrepared},
+		Refresh: statusAgentAlias(conn, agentAliasID, agentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrockagent.AgentAlias); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentAliasDeleted(conn *bedrockagent.BedrockAgent, agentAliasID, agentID string, timeout time.Duration) (*bedrockagent.AgentAlias, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrockagent.AgentAliasStatusDeleting},
+		Target:  []string{},
+		Refresh: statusAgentAlias(conn, agentAliasID, agentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrockagent.AgentAlias); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseActive(conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.KnowledgeBase, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrockagent.KnowledgeBaseStatusCreating, bedrockagent.KnowledgeBaseStatusUpdating},
+		Target:  []string{bedrockagent.KnowledgeBaseStatusActive},
+		Refresh: statusKnowledgeBase(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrockagent.KnowledgeBase); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseDeleted(conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.KnowledgeBase, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrockagent.KnowledgeBaseStatusActive, bedrockagent.KnowledgeBaseStatusDeleting},
+		Target:  []string{},
+		Refresh: statusKnowledgeBase(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*bedrockagent.KnowledgeBase); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -6,7 +6,6 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -293,13 +292,8 @@ func resourceBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting subscriber: %w", err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "budgets",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("budget/%s/action/%s", budgetName, actionID),
-	}
-	d.Set("arn", arn.String())
+	arn := meta.(*conns.AWSClient).GlobalARN("budgets", fmt.Sprintf("budget/%s/action/%s", budgetName, actionID))
+	d.Set("arn", arn)
 
 	return nil
 }
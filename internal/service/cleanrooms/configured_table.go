@@ -0,0 +1,261 @@
+package cleanrooms
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfiguredTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfiguredTableCreate,
+		Read:   resourceConfiguredTableRead,
+		Update: resourceConfiguredTableUpdate,
+		Delete: resourceConfiguredTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_columns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+			"analysis_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.AnalysisMethod_Values(), false),
+			},
+			"analysis_rule_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"table_reference": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConfiguredTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateConfiguredTableInput{
+		AllowedColumns: flex.ExpandStringSet(d.Get("allowed_columns").(*schema.Set)),
+		AnalysisMethod: aws.String(d.Get("analysis_method").(string)),
+		Name:           aws.String(name),
+		TableReference: expandTableReference(d.Get("table_reference").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Configured Table: %s", input)
+	output, err := conn.CreateConfiguredTable(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Clean Rooms Configured Table (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConfiguredTable.Id))
+
+	return resourceConfiguredTableRead(d, meta)
+}
+
+func resourceConfiguredTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	configuredTable, err := FindConfiguredTableByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Clean Rooms Configured Table (%s): %w", d.Id(), err)
+	}
+
+	d.Set("allowed_columns", aws.StringValueSlice(configuredTable.AllowedColumns))
+	d.Set("analysis_method", configuredTable.AnalysisMethod)
+	d.Set("analysis_rule_types", aws.StringValueSlice(configuredTable.AnalysisRuleTypes))
+	d.Set("arn", configuredTable.Arn)
+	d.Set("create_time", aws.TimeValue(configuredTable.CreateTime).Format(time.RFC3339))
+	d.Set("description", configuredTable.Description)
+	d.Set("name", configuredTable.Name)
+	if err := d.Set("table_reference", flattenTableReference(configuredTable.TableReference)); err != nil {
+		return fmt.Errorf("error setting table_reference: %w", err)
+	}
+	d.Set("update_time", aws.TimeValue(configuredTable.UpdateTime).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, aws.StringValue(configuredTable.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Clean Rooms Configured Table (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChanges("description", "name") {
+		input := &cleanrooms.UpdateConfiguredTableInput{
+			ConfiguredTableIdentifier: aws.String(d.Id()),
+			Description:               aws.String(d.Get("description").(string)),
+			Name:                      aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Configured Table: %s", input)
+		_, err := conn.UpdateConfiguredTable(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Clean Rooms Configured Table (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Clean Rooms Configured Table (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceConfiguredTableRead(d, meta)
+}
+
+func resourceConfiguredTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table: %s", d.Id())
+	_, err := conn.DeleteConfiguredTable(&cleanrooms.DeleteConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Clean Rooms Configured Table (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTableReference(tfList []interface{}) *cleanrooms.TableReference {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &cleanrooms.TableReference{
+		Glue: &cleanrooms.GlueTableReference{
+			DatabaseName: aws.String(tfMap["database_name"].(string)),
+			TableName:    aws.String(tfMap["table_name"].(string)),
+		},
+	}
+}
+
+func flattenTableReference(apiObject *cleanrooms.TableReference) []interface{} {
+	if apiObject == nil || apiObject.Glue == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"database_name": aws.StringValue(apiObject.Glue.DatabaseName),
+		"table_name":    aws.StringValue(apiObject.Glue.TableName),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,549 @@
+package cleanrooms
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfiguredTableAnalysisRuleCreate,
+		Read:   resourceConfiguredTableAnalysisRuleRead,
+		Update: resourceConfiguredTableAnalysisRuleUpdate,
+		Delete: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_rule_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.ConfiguredTableAnalysisRuleType_Values(), false),
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregation": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"policy.0.aggregation", "policy.0.custom", "policy.0.list"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aggregate_column": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_names": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"function": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(cleanrooms.AggregateFunctionName_Values(), false),
+												},
+											},
+										},
+									},
+									"allowed_join_operators": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(cleanrooms.JoinOperator_Values(), false),
+										},
+									},
+									"dimension_columns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"join_columns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"join_required": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(cleanrooms.JoinRequiredOption_Values(), false),
+									},
+									"output_constraint": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"minimum": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(2),
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(cleanrooms.AggregationType_Values(), false),
+												},
+											},
+										},
+									},
+									"scalar_functions": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(cleanrooms.ScalarFunctions_Values(), false),
+										},
+									},
+								},
+							},
+						},
+						"custom": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"policy.0.aggregation", "policy.0.custom", "policy.0.list"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_analyses": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"allowed_analysis_providers": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"list": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"policy.0.aggregation", "policy.0.custom", "policy.0.list"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_join_operators": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(cleanrooms.JoinOperator_Values(), false),
+										},
+									},
+									"join_columns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"list_columns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceConfiguredTableAnalysisRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	configuredTableID := d.Get("configured_table_id").(string)
+	analysisRuleType := d.Get("analysis_rule_type").(string)
+	id := ConfiguredTableAnalysisRuleCreateResourceID(configuredTableID, analysisRuleType)
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRulePolicy(d.Get("policy").([]interface{})),
+		AnalysisRuleType:          aws.String(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Configured Table Analysis Rule: %s", input)
+	_, err := conn.CreateConfiguredTableAnalysisRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Clean Rooms Configured Table Analysis Rule (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceConfiguredTableAnalysisRuleRead(d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	configuredTableID, analysisRuleType, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	analysisRule, err := FindConfiguredTableAnalysisRuleByTwoPartKey(conn, configuredTableID, analysisRuleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Clean Rooms Configured Table Analysis Rule (%s): %w", d.Id(), err)
+	}
+
+	d.Set("analysis_rule_type", analysisRule.Type)
+	d.Set("configured_table_arn", analysisRule.ConfiguredTableArn)
+	d.Set("configured_table_id", analysisRule.ConfiguredTableId)
+	d.Set("create_time", aws.TimeValue(analysisRule.CreateTime).Format(time.RFC3339))
+	if err := d.Set("policy", flattenConfiguredTableAnalysisRulePolicy(analysisRule.Policy)); err != nil {
+		return fmt.Errorf("error setting policy: %w", err)
+	}
+	d.Set("update_time", aws.TimeValue(analysisRule.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	configuredTableID, analysisRuleType, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRulePolicy(d.Get("policy").([]interface{})),
+		AnalysisRuleType:          aws.String(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	log.Printf("[DEBUG] Updating Clean Rooms Configured Table Analysis Rule: %s", input)
+	_, err = conn.UpdateConfiguredTableAnalysisRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Clean Rooms Configured Table Analysis Rule (%s): %w", d.Id(), err)
+	}
+
+	return resourceConfiguredTableAnalysisRuleRead(d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	configuredTableID, analysisRuleType, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table Analysis Rule: %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAnalysisRule(&cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          aws.String(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Clean Rooms Configured Table Analysis Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(tfList []interface{}) *cleanrooms.ConfiguredTableAnalysisRulePolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &cleanrooms.ConfiguredTableAnalysisRulePolicyV1{}
+
+	if v, ok := tfMap["aggregation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Aggregation = expandAnalysisRuleAggregation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["custom"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Custom = expandAnalysisRuleCustom(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.List = expandAnalysisRuleList(v[0].(map[string]interface{}))
+	}
+
+	return &cleanrooms.ConfiguredTableAnalysisRulePolicy{
+		V1: apiObject,
+	}
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) *cleanrooms.AnalysisRuleAggregation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.AnalysisRuleAggregation{}
+
+	if v, ok := tfMap["aggregate_column"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AggregateColumns = expandAggregateColumns(v)
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["dimension_columns"].(*schema.Set); ok {
+		apiObject.DimensionColumns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["join_columns"].(*schema.Set); ok {
+		apiObject.JoinColumns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_constraint"].([]interface{}); ok && len(v) > 0 {
+		apiObject.OutputConstraints = expandAggregationConstraints(v)
+	}
+
+	if v, ok := tfMap["scalar_functions"].(*schema.Set); ok {
+		apiObject.ScalarFunctions = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandAggregateColumns(tfList []interface{}) []*cleanrooms.AggregateColumn {
+	var apiObjects []*cleanrooms.AggregateColumn
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &cleanrooms.AggregateColumn{
+			ColumnNames: flex.ExpandStringSet(tfMap["column_names"].(*schema.Set)),
+			Function:    aws.String(tfMap["function"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAggregationConstraints(tfList []interface{}) []*cleanrooms.AggregationConstraint {
+	var apiObjects []*cleanrooms.AggregationConstraint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &cleanrooms.AggregationConstraint{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+			Minimum:    aws.Int64(int64(tfMap["minimum"].(int))),
+			Type:       aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAnalysisRuleCustom(tfMap map[string]interface{}) *cleanrooms.AnalysisRuleCustom {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.AnalysisRuleCustom{}
+
+	if v, ok := tfMap["allowed_analyses"].(*schema.Set); ok {
+		apiObject.AllowedAnalyses = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allowed_analysis_providers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) *cleanrooms.AnalysisRuleList {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.AnalysisRuleList{}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["join_columns"].(*schema.Set); ok {
+		apiObject.JoinColumns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["list_columns"].(*schema.Set); ok {
+		apiObject.ListColumns = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenConfiguredTableAnalysisRulePolicy(apiObject *cleanrooms.ConfiguredTableAnalysisRulePolicy) []interface{} {
+	if apiObject == nil || apiObject.V1 == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.V1.Aggregation; v != nil {
+		tfMap["aggregation"] = []interface{}{flattenAnalysisRuleAggregation(v)}
+	}
+
+	if v := apiObject.V1.Custom; v != nil {
+		tfMap["custom"] = []interface{}{flattenAnalysisRuleCustom(v)}
+	}
+
+	if v := apiObject.V1.List; v != nil {
+		tfMap["list"] = []interface{}{flattenAnalysisRuleList(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisRuleAggregation(apiObject *cleanrooms.AnalysisRuleAggregation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aggregate_column":       flattenAggregateColumns(apiObject.AggregateColumns),
+		"allowed_join_operators": aws.StringValueSlice(apiObject.AllowedJoinOperators),
+		"dimension_columns":      aws.StringValueSlice(apiObject.DimensionColumns),
+		"join_columns":           aws.StringValueSlice(apiObject.JoinColumns),
+		"join_required":          aws.StringValue(apiObject.JoinRequired),
+		"output_constraint":      flattenAggregationConstraints(apiObject.OutputConstraints),
+		"scalar_functions":       aws.StringValueSlice(apiObject.ScalarFunctions),
+	}
+
+	return tfMap
+}
+
+func flattenAggregateColumns(apiObjects []*cleanrooms.AggregateColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"column_names": aws.StringValueSlice(apiObject.ColumnNames),
+			"function":     aws.StringValue(apiObject.Function),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAggregationConstraints(apiObjects []*cleanrooms.AggregationConstraint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"column_name": aws.StringValue(apiObject.ColumnName),
+			"minimum":     aws.Int64Value(apiObject.Minimum),
+			"type":        aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAnalysisRuleCustom(apiObject *cleanrooms.AnalysisRuleCustom) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allowed_analyses":           aws.StringValueSlice(apiObject.AllowedAnalyses),
+		"allowed_analysis_providers": aws.StringValueSlice(apiObject.AllowedAnalysisProviders),
+	}
+
+	return tfMap
+}
+
+func flattenAnalysisRuleList(apiObject *cleanrooms.AnalysisRuleList) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allowed_join_operators": aws.StringValueSlice(apiObject.AllowedJoinOperators),
+		"join_columns":           aws.StringValueSlice(apiObject.JoinColumns),
+		"list_columns":           aws.StringValueSlice(apiObject.ListColumns),
+	}
+
+	return tfMap
+}
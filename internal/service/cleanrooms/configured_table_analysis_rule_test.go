@@ -0,0 +1,217 @@
+package cleanrooms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_list(t *testing.T) {
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfiguredTableAnalysisRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", cleanrooms.ConfiguredTableAnalysisRuleTypeList),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.custom.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.list.0.join_columns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy.0.list.0.join_columns.*", "id"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.list.0.list_columns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy.0.list.0.list_columns.*", "value"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.list.0.list_columns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy.0.list.0.list_columns.*", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_aggregation(t *testing.T) {
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfiguredTableAnalysisRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", cleanrooms.ConfiguredTableAnalysisRuleTypeAggregation),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.0.aggregate_column.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.0.aggregate_column.0.function", "SUM"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.0.join_required", "QUERY_RUNNER"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.0.output_constraint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.0.output_constraint.0.column_name", "id"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.0.output_constraint.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.0.output_constraint.0.type", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.aggregation.0.scalar_functions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfiguredTableAnalysisRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+			continue
+		}
+
+		configuredTableID, analysisRuleType, err := tfcleanrooms.ConfiguredTableAnalysisRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(conn, configuredTableID, analysisRuleType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Configured Table Analysis Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Configured Table Analysis Rule ID is set")
+		}
+
+		configuredTableID, analysisRuleType, err := tfcleanrooms.ConfiguredTableAnalysisRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		_, err = tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(conn, configuredTableID, analysisRuleType)
+
+		return err
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName, listColumn string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "LIST"
+
+  policy {
+    list {
+      join_columns = ["id"]
+      list_columns = [%[1]q]
+    }
+  }
+}
+`, listColumn))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_aggregation(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName, rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "AGGREGATION"
+
+  policy {
+    aggregation {
+      aggregate_column {
+        column_names = ["value"]
+        function     = "SUM"
+      }
+
+      join_columns     = ["id"]
+      join_required    = "QUERY_RUNNER"
+      scalar_functions = ["ABS"]
+
+      output_constraint {
+        column_name = "id"
+        minimum     = 100
+        type        = "COUNT_DISTINCT"
+      }
+    }
+  }
+}
+`)
+}
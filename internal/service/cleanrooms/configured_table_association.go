@@ -0,0 +1,247 @@
+package cleanrooms
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfiguredTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfiguredTableAssociationCreate,
+		Read:   resourceConfiguredTableAssociationRead,
+		Update: resourceConfiguredTableAssociationUpdate,
+		Delete: resourceConfiguredTableAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConfiguredTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	membershipID := d.Get("membership_id").(string)
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateConfiguredTableAssociationInput{
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_id").(string)),
+		MembershipIdentifier:      aws.String(membershipID),
+		Name:                      aws.String(name),
+		RoleArn:                   aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Configured Table Association: %s", input)
+	outputRaw, err := tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateConfiguredTableAssociation(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, cleanrooms.ErrCodeValidationException, "assume") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Clean Rooms Configured Table Association (%s): %w", name, err)
+	}
+
+	output := outputRaw.(*cleanrooms.CreateConfiguredTableAssociationOutput)
+
+	d.SetId(ConfiguredTableAssociationCreateResourceID(membershipID, aws.StringValue(output.ConfiguredTableAssociation.Id)))
+
+	return resourceConfiguredTableAssociationRead(d, meta)
+}
+
+func resourceConfiguredTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	membershipID, associationID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := FindConfiguredTableAssociationByTwoPartKey(conn, membershipID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Clean Rooms Configured Table Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", association.Arn)
+	d.Set("configured_table_arn", association.ConfiguredTableArn)
+	d.Set("configured_table_id", association.ConfiguredTableId)
+	d.Set("create_time", aws.TimeValue(association.CreateTime).Format(time.RFC3339))
+	d.Set("description", association.Description)
+	d.Set("membership_arn", association.MembershipArn)
+	d.Set("membership_id", association.MembershipId)
+	d.Set("name", association.Name)
+	d.Set("role_arn", association.RoleArn)
+	d.Set("update_time", aws.TimeValue(association.UpdateTime).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, aws.StringValue(association.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Clean Rooms Configured Table Association (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChanges("description", "role_arn") {
+		membershipID, associationID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &cleanrooms.UpdateConfiguredTableAssociationInput{
+			ConfiguredTableAssociationIdentifier: aws.String(associationID),
+			Description:                          aws.String(d.Get("description").(string)),
+			MembershipIdentifier:                 aws.String(membershipID),
+			RoleArn:                              aws.String(d.Get("role_arn").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Configured Table Association: %s", input)
+		_, err = conn.UpdateConfiguredTableAssociation(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Clean Rooms Configured Table Association (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Clean Rooms Configured Table Association (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceConfiguredTableAssociationRead(d, meta)
+}
+
+func resourceConfiguredTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	membershipID, associationID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table Association: %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAssociation(&cleanrooms.DeleteConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Clean Rooms Configured Table Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
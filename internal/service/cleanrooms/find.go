@@ -0,0 +1,177 @@
+package cleanrooms
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCollaborationByID(conn *cleanrooms.CleanRooms, id string) (*cleanrooms.Collaboration, error) {
+	input := &cleanrooms.GetCollaborationInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCollaboration(input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Collaboration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Collaboration, nil
+}
+
+func FindMembersByCollaborationID(conn *cleanrooms.CleanRooms, id string) ([]*cleanrooms.MemberSummary, error) {
+	input := &cleanrooms.ListMembersInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+	var output []*cleanrooms.MemberSummary
+
+	err := conn.ListMembersPages(input, func(page *cleanrooms.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MemberSummaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindMembershipByID(conn *cleanrooms.CleanRooms, id string) (*cleanrooms.Membership, error) {
+	input := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetMembership(input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Membership.Status); status == cleanrooms.MembershipStatusRemoved {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Membership, nil
+}
+
+func FindConfiguredTableByID(conn *cleanrooms.CleanRooms, id string) (*cleanrooms.ConfiguredTable, error) {
+	input := &cleanrooms.GetConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfiguredTable(input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredTable == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredTable, nil
+}
+
+func FindConfiguredTableAssociationByTwoPartKey(conn *cleanrooms.CleanRooms, membershipID, associationID string) (*cleanrooms.ConfiguredTableAssociation, error) {
+	input := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	output, err := conn.GetConfiguredTableAssociation(input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredTableAssociation, nil
+}
+
+func FindConfiguredTableAnalysisRuleByTwoPartKey(conn *cleanrooms.CleanRooms, configuredTableID, analysisRuleType string) (*cleanrooms.ConfiguredTableAnalysisRule, error) {
+	input := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          aws.String(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	output, err := conn.GetConfiguredTableAnalysisRule(input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisRule, nil
+}
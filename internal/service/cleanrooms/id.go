@@ -0,0 +1,44 @@
+package cleanrooms
+
+import (
+	"fmt"
+	"strings"
+)
+
+const configuredTableAssociationResourceIDSeparator = ","
+
+func ConfiguredTableAssociationCreateResourceID(membershipID, associationID string) string {
+	parts := []string{membershipID, associationID}
+	id := strings.Join(parts, configuredTableAssociationResourceIDSeparator)
+
+	return id
+}
+
+func ConfiguredTableAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configuredTableAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MEMBERSHIPID%[2]sASSOCIATIONID", id, configuredTableAssociationResourceIDSeparator)
+}
+
+const configuredTableAnalysisRuleResourceIDSeparator = ","
+
+func ConfiguredTableAnalysisRuleCreateResourceID(configuredTableID, analysisRuleType string) string {
+	parts := []string{configuredTableID, analysisRuleType}
+	id := strings.Join(parts, configuredTableAnalysisRuleResourceIDSeparator)
+
+	return id
+}
+
+func ConfiguredTableAnalysisRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configuredTableAnalysisRuleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIGUREDTABLEID%[2]sANALYSISRULETYPE", id, configuredTableAnalysisRuleResourceIDSeparator)
+}
@@ -0,0 +1,10 @@
+package cleanrooms
+
+import (
+	"time"
+)
+
+const (
+	// Maximum amount of time to wait for IAM changes to propagate to Clean Rooms
+	propagationTimeout = 2 * time.Minute
+)
@@ -1,5 +1,16 @@
 package cloudfront
 
+const (
+	distributionStatusDeployed   = "Deployed"
+	distributionStatusInProgress = "InProgress"
+)
+
+const (
+	keyValueStoreStatusFailed       = "FAILED"
+	keyValueStoreStatusProvisioning = "PROVISIONING"
+	keyValueStoreStatusReady        = "READY"
+)
+
 const (
 	StreamTypeKinesis = "Kinesis"
 )
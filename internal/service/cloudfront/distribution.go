@@ -226,6 +226,10 @@ func ResourceDistribution() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 128),
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"custom_error_response": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -808,6 +812,12 @@ func ResourceDistribution() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -861,7 +871,7 @@ func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error
 
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
+		if _, err := WaitDistributionDeployed(conn, d.Id()); err != nil {
 			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 	}
@@ -971,7 +981,7 @@ func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
+		if _, err := WaitDistributionDeployed(conn, d.Id()); err != nil {
 			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 	}
@@ -1090,6 +1100,8 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 			IfMatch:            getDistributionOutput.ETag,
 		}
 		updateDistributionInput.DistributionConfig.Enabled = aws.Bool(false)
+		// A primary distribution can't be deleted while a continuous deployment policy is attached.
+		updateDistributionInput.DistributionConfig.ContinuousDeploymentPolicyId = aws.String("")
 		var updateDistributionOutput *cloudfront.UpdateDistributionOutput
 
 		log.Printf("[DEBUG] Disabling CloudFront Distribution: %s", d.Id())
@@ -1100,7 +1112,7 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
+		if _, err := WaitDistributionDeployed(conn, d.Id()); err != nil {
 			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 
@@ -1151,42 +1163,3 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
-
-// resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
-// distribution is deployed. It currently takes exactly 15 minutes to deploy
-// but that might change in the future.
-func DistributionWaitUntilDeployed(id string, meta interface{}) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"InProgress"},
-		Target:     []string{"Deployed"},
-		Refresh:    resourceWebDistributionStateRefreshFunc(id, meta),
-		Timeout:    90 * time.Minute,
-		MinTimeout: 15 * time.Second,
-		Delay:      1 * time.Minute,
-	}
-
-	_, err := stateConf.WaitForState()
-	return err
-}
-
-// The refresh function for resourceAwsCloudFrontWebDistributionWaitUntilDeployed.
-func resourceWebDistributionStateRefreshFunc(id string, meta interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		conn := meta.(*conns.AWSClient).CloudFrontConn
-		params := &cloudfront.GetDistributionInput{
-			Id: aws.String(id),
-		}
-
-		resp, err := conn.GetDistribution(params)
-		if err != nil {
-			log.Printf("[WARN] Error retrieving CloudFront Distribution %q details: %s", id, err)
-			return nil, "", err
-		}
-
-		if resp == nil {
-			return nil, "", nil
-		}
-
-		return resp.Distribution, *resp.Distribution.Status, nil
-	}
-}
@@ -2,6 +2,7 @@ package cloudfront
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -58,6 +59,12 @@ func DataSourceDistribution() *schema.Resource {
 				Computed: true,
 			},
 
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tftags.TagsSchema(),
 		},
 	}
@@ -68,6 +75,13 @@ func dataSourceDistributionRead(d *schema.ResourceData, meta interface{}) error
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
+		if _, err := WaitDistributionDeployed(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %w", d.Id(), err)
+		}
+	}
+
 	input := &cloudfront.GetDistributionInput{
 		Id: aws.String(d.Id()),
 	}
@@ -36,6 +36,27 @@ func TestAccCloudFrontDistributionDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontDistributionDataSource_waitForDeployment(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_distribution.test"
+	resourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionDataWaitForDeploymentConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deployment", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "Deployed"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDistributionDataConfig(rName string) string {
 	return acctest.ConfigCompose(
 		testAccDistributionS3WithTagsConfig(rName), `
@@ -44,3 +65,55 @@ data "aws_cloudfront_distribution" "test" {
 }
 `)
 }
+
+func testAccDistributionDataWaitForDeploymentConfig() string {
+	return `
+resource "aws_cloudfront_distribution" "test" {
+  enabled             = false
+  retain_on_delete    = false
+  wait_for_deployment = false
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+
+data "aws_cloudfront_distribution" "test" {
+  id                  = aws_cloudfront_distribution.test.id
+  wait_for_deployment = true
+}
+`
+}
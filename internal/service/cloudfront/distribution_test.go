@@ -1363,7 +1363,11 @@ func testAccCheckCloudFrontDistributionDisappears(distribution *cloudfront.Distr
 
 func testAccCheckCloudFrontDistributionWaitForDeployment(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		return tfcloudfront.DistributionWaitUntilDeployed(aws.StringValue(distribution.Id), acctest.Provider.Meta())
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+		_, err := tfcloudfront.WaitDistributionDeployed(conn, aws.StringValue(distribution.Id))
+
+		return err
 	}
 }
 
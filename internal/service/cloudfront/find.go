@@ -33,6 +33,31 @@ func FindCachePolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.Ge
 	return output, nil
 }
 
+func FindContinuousDeploymentPolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetContinuousDeploymentPolicyOutput, error) {
+	input := &cloudfront.GetContinuousDeploymentPolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetContinuousDeploymentPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchContinuousDeploymentPolicy) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContinuousDeploymentPolicy == nil || output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindDistributionByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetDistributionOutput, error) {
 	input := &cloudfront.GetDistributionInput{
 		Id: aws.String(id),
@@ -134,6 +159,31 @@ func FindFunctionByNameAndStage(conn *cloudfront.CloudFront, name, stage string)
 	return output, nil
 }
 
+func FindKeyValueStoreByName(conn *cloudfront.CloudFront, name string) (*cloudfront.DescribeKeyValueStoreOutput, error) {
+	input := &cloudfront.DescribeKeyValueStoreInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeKeyValueStore(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeEntityNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.KeyValueStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindMonitoringSubscriptionByDistributionID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetMonitoringSubscriptionOutput, error) {
 	input := &cloudfront.GetMonitoringSubscriptionInput{
 		DistributionId: aws.String(id),
@@ -0,0 +1,149 @@
+package cloudfront
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceKeyValueStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyValueStoreCreate,
+		Read:   resourceKeyValueStoreRead,
+		Update: resourceKeyValueStoreUpdate,
+		Delete: resourceKeyValueStoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+		},
+	}
+}
+
+func resourceKeyValueStoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateKeyValueStoreInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Key Value Store: (%s)", input)
+	_, err := conn.CreateKeyValueStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Key Value Store (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitKeyValueStoreCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for CloudFront Key Value Store (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceKeyValueStoreRead(d, meta)
+}
+
+func resourceKeyValueStoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	output, err := FindKeyValueStoreByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Key Value Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Key Value Store (%s): %w", d.Id(), err)
+	}
+
+	apiObject := output.KeyValueStore
+	d.Set("arn", apiObject.ARN)
+	d.Set("comment", apiObject.Comment)
+	d.Set("etag", output.ETag)
+	d.Set("last_modified_time", aws.TimeValue(apiObject.LastModifiedTime).Format(time.RFC3339))
+	d.Set("name", apiObject.Name)
+
+	return nil
+}
+
+func resourceKeyValueStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	input := &cloudfront.UpdateKeyValueStoreInput{
+		Comment: aws.String(d.Get("comment").(string)),
+		IfMatch: aws.String(d.Get("etag").(string)),
+		Name:    aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Key Value Store: (%s)", input)
+	_, err := conn.UpdateKeyValueStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Key Value Store (%s): %w", d.Id(), err)
+	}
+
+	return resourceKeyValueStoreRead(d, meta)
+}
+
+func resourceKeyValueStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	log.Printf("[DEBUG] Deleting CloudFront Key Value Store: (%s)", d.Id())
+	_, err := conn.DeleteKeyValueStore(&cloudfront.DeleteKeyValueStoreInput{
+		IfMatch: aws.String(d.Get("etag").(string)),
+		Name:    aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeEntityNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Key Value Store (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
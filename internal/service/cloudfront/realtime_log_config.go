@@ -47,7 +47,7 @@ func ResourceRealtimeLogConfig() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"stream_arn": {
 										Type:         schema.TypeString,
@@ -0,0 +1,40 @@
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDistribution(conn *cloudfront.CloudFront, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDistributionByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output.Distribution, aws.StringValue(output.Distribution.Status), nil
+	}
+}
+
+func statusKeyValueStore(conn *cloudfront.CloudFront, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindKeyValueStoreByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.KeyValueStore.Status), nil
+	}
+}
@@ -0,0 +1,51 @@
+package cloudfront
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	distributionDeployedTimeout = 90 * time.Minute
+	keyValueStoreCreatedTimeout = 5 * time.Minute
+)
+
+// WaitDistributionDeployed blocks until the distribution is deployed.
+// It currently takes about 15 minutes to deploy but that might change in the future.
+func WaitDistributionDeployed(conn *cloudfront.CloudFront, id string) (*cloudfront.Distribution, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{distributionStatusInProgress},
+		Target:     []string{distributionStatusDeployed},
+		Refresh:    statusDistribution(conn, id),
+		Timeout:    distributionDeployedTimeout,
+		MinTimeout: 15 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*cloudfront.Distribution); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKeyValueStoreCreated(conn *cloudfront.CloudFront, name string) (*cloudfront.DescribeKeyValueStoreOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{keyValueStoreStatusProvisioning},
+		Target:  []string{keyValueStoreStatusReady},
+		Refresh: statusKeyValueStore(conn, name),
+		Timeout: keyValueStoreCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*cloudfront.DescribeKeyValueStoreOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
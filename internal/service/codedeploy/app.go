@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -154,13 +153,7 @@ func resourceAppRead(d *schema.ResourceData, meta interface{}) error {
 		d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(app.ApplicationId), appName))
 	}
 
-	appArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "codedeploy",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("application:%s", appName),
-	}.String()
+	appArn := meta.(*conns.AWSClient).RegionalARN("codedeploy", fmt.Sprintf("application:%s", appName))
 
 	d.Set("arn", appArn)
 	d.Set("application_id", app.ApplicationId)
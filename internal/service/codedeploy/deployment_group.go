@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -611,13 +610,7 @@ func resourceDeploymentGroupRead(d *schema.ResourceData, meta interface{}) error
 	group := resp.DeploymentGroupInfo
 	appName := aws.StringValue(group.ApplicationName)
 	groupName := aws.StringValue(group.DeploymentGroupName)
-	groupArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "codedeploy",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("deploymentgroup:%s/%s", appName, groupName),
-	}.String()
+	groupArn := meta.(*conns.AWSClient).RegionalARN("codedeploy", fmt.Sprintf("deploymentgroup:%s/%s", appName, groupName))
 
 	d.Set("arn", groupArn)
 	d.Set("app_name", appName)
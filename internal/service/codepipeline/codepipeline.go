@@ -60,7 +60,7 @@ func ResourceCodePipeline() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"artifact_store": {
 				Type:     schema.TypeSet,
@@ -175,7 +175,7 @@ func ResourceCodePipeline() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"run_order": {
 										Type:         schema.TypeInt,
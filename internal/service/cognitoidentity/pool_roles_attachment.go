@@ -71,7 +71,7 @@ func ResourcePoolRolesAttachment() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"value": {
 										Type:         schema.TypeString,
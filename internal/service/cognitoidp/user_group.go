@@ -46,7 +46,7 @@ func ResourceUserGroup() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"user_pool_id": {
 				Type:         schema.TypeString,
@@ -88,7 +88,7 @@ func ResourceUserPoolClient() *schema.Resource {
 							Optional:      true,
 							Computed:      true,
 							ConflictsWith: []string{"analytics_configuration.0.application_arn"},
-							ValidateFunc:  verify.ValidARN,
+							ValidateFunc:  verify.ValidARNForService("iam", "role"),
 						},
 						"user_data_shared": {
 							Type:     schema.TypeBool,
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -51,13 +50,7 @@ func dataSourceUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		userPoolID := aws.StringValue(v.Id)
-		arn := arn.ARN{
-			Partition: meta.(*conns.AWSClient).Partition,
-			Service:   cognitoidentityprovider.ServiceName,
-			Region:    meta.(*conns.AWSClient).Region,
-			AccountID: meta.(*conns.AWSClient).AccountID,
-			Resource:  fmt.Sprintf("userpool/%s", userPoolID),
-		}.String()
+		arn := meta.(*conns.AWSClient).RegionalARN(cognitoidentityprovider.ServiceName, fmt.Sprintf("userpool/%s", userPoolID))
 
 		userPoolIDs = append(userPoolIDs, userPoolID)
 		arns = append(arns, arn)
@@ -6,7 +6,6 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	cur "github.com/aws/aws-sdk-go/service/costandusagereportservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -177,13 +176,7 @@ func resourceReportDefinitionRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	reportName := aws.StringValue(reportDefinition.ReportName)
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "cur",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("definition/%s", reportName),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("cur", fmt.Sprintf("definition/%s", reportName))
 
 	d.Set("arn", arn)
 
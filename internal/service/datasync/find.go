@@ -58,6 +58,31 @@ func FindTaskByARN(conn *datasync.DataSync, arn string) (*datasync.DescribeTaskO
 	return output, nil
 }
 
+func FindLocationAzureBlobByARN(conn *datasync.DataSync, arn string) (*datasync.DescribeLocationAzureBlobOutput, error) {
+	input := &datasync.DescribeLocationAzureBlobInput{
+		LocationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeLocationAzureBlob(input)
+
+	if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindLocationHdfsByARN(conn *datasync.DataSync, arn string) (*datasync.DescribeLocationHdfsOutput, error) {
 	input := &datasync.DescribeLocationHdfsInput{
 		LocationArn: aws.String(arn),
@@ -0,0 +1,273 @@
+package datasync
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLocationAzureBlob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLocationAzureBlobCreate,
+		Read:   resourceLocationAzureBlobRead,
+		Update: resourceLocationAzureBlobUpdate,
+		Delete: resourceLocationAzureBlobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_tier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(datasync.AzureAccessTier_Values(), false),
+			},
+			"agent_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(datasync.AzureBlobAuthenticationType_Values(), false),
+			},
+			"blob_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(datasync.AzureBlobType_Values(), false),
+			},
+			"container_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"sas_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"subdirectory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLocationAzureBlobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataSyncConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &datasync.CreateLocationAzureBlobInput{
+		AgentArns:          flex.ExpandStringSet(d.Get("agent_arns").(*schema.Set)),
+		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
+		ContainerUrl:       aws.String(d.Get("container_url").(string)),
+		Tags:               Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("access_tier"); ok {
+		input.AccessTier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("blob_type"); ok {
+		input.BlobType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sas_configuration"); ok {
+		input.SasConfiguration = expandDataSyncAzureBlobSasConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("subdirectory"); ok {
+		input.Subdirectory = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DataSync Location Azure Blob: %s", input)
+	output, err := conn.CreateLocationAzureBlob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DataSync Location Azure Blob: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocationArn))
+
+	return resourceLocationAzureBlobRead(d, meta)
+}
+
+func resourceLocationAzureBlobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataSyncConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindLocationAzureBlobByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataSync Location Azure Blob (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DataSync Location Azure Blob (%s): %w", d.Id(), err)
+	}
+
+	containerURL, subdirectory, err := ContainerURLAndSubdirectoryFromAzureBlobLocationURI(aws.StringValue(output.LocationUri))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("access_tier", output.AccessTier)
+	d.Set("agent_arns", flex.FlattenStringSet(output.AgentArns))
+	d.Set("arn", output.LocationArn)
+	d.Set("authentication_type", output.AuthenticationType)
+	d.Set("blob_type", output.BlobType)
+	d.Set("container_url", containerURL)
+	d.Set("subdirectory", subdirectory)
+	d.Set("uri", output.LocationUri)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataSync Location Azure Blob (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLocationAzureBlobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataSyncConn
+
+	if d.HasChangesExcept("tags_all", "tags") {
+		input := &datasync.UpdateLocationAzureBlobInput{
+			LocationArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_tier") {
+			input.AccessTier = aws.String(d.Get("access_tier").(string))
+		}
+
+		if d.HasChange("agent_arns") {
+			input.AgentArns = flex.ExpandStringSet(d.Get("agent_arns").(*schema.Set))
+		}
+
+		if d.HasChange("authentication_type") {
+			input.AuthenticationType = aws.String(d.Get("authentication_type").(string))
+		}
+
+		if d.HasChange("blob_type") {
+			input.BlobType = aws.String(d.Get("blob_type").(string))
+		}
+
+		if d.HasChange("sas_configuration") {
+			input.SasConfiguration = expandDataSyncAzureBlobSasConfiguration(d.Get("sas_configuration").([]interface{}))
+		}
+
+		if d.HasChange("subdirectory") {
+			input.Subdirectory = aws.String(d.Get("subdirectory").(string))
+		}
+
+		log.Printf("[DEBUG] Updating DataSync Location Azure Blob: %s", input)
+		_, err := conn.UpdateLocationAzureBlob(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating DataSync Location Azure Blob (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating DataSync Location Azure Blob (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceLocationAzureBlobRead(d, meta)
+}
+
+func resourceLocationAzureBlobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataSyncConn
+
+	input := &datasync.DeleteLocationInput{
+		LocationArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting DataSync Location Azure Blob: %s", input)
+	_, err := conn.DeleteLocation(input)
+
+	if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DataSync Location Azure Blob (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataSyncAzureBlobSasConfiguration(l []interface{}) *datasync.AzureBlobSasConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	sasConfiguration := &datasync.AzureBlobSasConfiguration{
+		Token: aws.String(m["token"].(string)),
+	}
+
+	return sasConfiguration
+}
@@ -0,0 +1,373 @@
+package datasync_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatasync "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDataSyncLocationAzureBlob_basic(t *testing.T) {
+	var locationAzureBlob1 datasync.DescribeLocationAzureBlobOutput
+	resourceName := "aws_datasync_location_azure_blob.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datasync.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLocationAzureBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationAzureBlobConfig(rName, "/myvmsubdir1/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationAzureBlobExists(resourceName, &locationAzureBlob1),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "HOT"),
+					resource.TestCheckResourceAttr(resourceName, "agent_arns.#", "1"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "datasync", regexp.MustCompile(`location/loc-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "blob_type", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "container_url", "https://myaccount.blob.core.windows.net/mycontainer"),
+					resource.TestCheckResourceAttr(resourceName, "sas_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/myvmsubdir1/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "uri", regexp.MustCompile(`^azure-blob://.+/`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sas_configuration"},
+			},
+		},
+	})
+}
+
+func TestAccDataSyncLocationAzureBlob_disappears(t *testing.T) {
+	var locationAzureBlob1 datasync.DescribeLocationAzureBlobOutput
+	resourceName := "aws_datasync_location_azure_blob.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datasync.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLocationAzureBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationAzureBlobConfig(rName, "/path/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationAzureBlobExists(resourceName, &locationAzureBlob1),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdatasync.ResourceLocationAzureBlob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataSyncLocationAzureBlob_update(t *testing.T) {
+	var locationAzureBlob1, locationAzureBlob2 datasync.DescribeLocationAzureBlobOutput
+	resourceName := "aws_datasync_location_azure_blob.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datasync.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLocationAzureBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationAzureBlobConfig(rName, "/path/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationAzureBlobExists(resourceName, &locationAzureBlob1),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "HOT"),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/path/"),
+				),
+			},
+			{
+				Config: testAccLocationAzureBlobUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationAzureBlobExists(resourceName, &locationAzureBlob2),
+					testAccCheckLocationAzureBlobNotRecreated(&locationAzureBlob1, &locationAzureBlob2),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "COOL"),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/path/updated/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSyncLocationAzureBlob_tags(t *testing.T) {
+	var locationAzureBlob1, locationAzureBlob2, locationAzureBlob3 datasync.DescribeLocationAzureBlobOutput
+	resourceName := "aws_datasync_location_azure_blob.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datasync.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLocationAzureBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationAzureBlobTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationAzureBlobExists(resourceName, &locationAzureBlob1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sas_configuration"},
+			},
+			{
+				Config: testAccLocationAzureBlobTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationAzureBlobExists(resourceName, &locationAzureBlob2),
+					testAccCheckLocationAzureBlobNotRecreated(&locationAzureBlob1, &locationAzureBlob2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLocationAzureBlobTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationAzureBlobExists(resourceName, &locationAzureBlob3),
+					testAccCheckLocationAzureBlobNotRecreated(&locationAzureBlob2, &locationAzureBlob3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLocationAzureBlobDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datasync_location_azure_blob" {
+			continue
+		}
+
+		_, err := tfdatasync.FindLocationAzureBlobByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataSync Location Azure Blob %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLocationAzureBlobExists(resourceName string, locationAzureBlob *datasync.DescribeLocationAzureBlobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+		output, err := tfdatasync.FindLocationAzureBlobByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*locationAzureBlob = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLocationAzureBlobNotRecreated(i, j *datasync.DescribeLocationAzureBlobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreationTime).Equal(aws.TimeValue(j.CreationTime)) {
+			return errors.New("DataSync Location Azure Blob was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccLocationAzureBlobBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		// Reference: https://docs.aws.amazon.com/datasync/latest/userguide/agent-requirements.html
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("aws_subnet.test.availability_zone", "m5.2xlarge", "m5.4xlarge"),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+# Reference: https://docs.aws.amazon.com/datasync/latest/userguide/deploy-agents.html
+data "aws_ssm_parameter" "aws_service_datasync_ami" {
+  name = "/aws/service/datasync/ami"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "tf-acc-test-datasync-location-azure-blob"
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.0.0.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-test-datasync-location-azure-blob"
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-test-datasync-location-azure-blob"
+  }
+}
+
+resource "aws_default_route_table" "test" {
+  default_route_table_id = aws_vpc.test.default_route_table_id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = "tf-acc-test-datasync-location-azure-blob"
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  ingress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = "tf-acc-test-datasync-location-azure-blob"
+  }
+}
+
+resource "aws_instance" "test" {
+  depends_on = [aws_default_route_table.test]
+
+  ami                         = data.aws_ssm_parameter.aws_service_datasync_ami.value
+  associate_public_ip_address = true
+  instance_type               = data.aws_ec2_instance_type_offering.available.instance_type
+  vpc_security_group_ids      = [aws_security_group.test.id]
+  subnet_id                   = aws_subnet.test.id
+
+  tags = {
+    Name = "tf-acc-test-datasync-location-azure-blob"
+  }
+}
+
+resource "aws_datasync_agent" "test" {
+  ip_address = aws_instance.test.public_ip
+  name       = %[1]q
+}
+`, rName))
+}
+
+func testAccLocationAzureBlobConfig(rName, subdirectory string) string {
+	return testAccLocationAzureBlobBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_datasync_location_azure_blob" "test" {
+  agent_arns          = [aws_datasync_agent.test.arn]
+  authentication_type = "SAS"
+  container_url       = "https://myaccount.blob.core.windows.net/mycontainer"
+  subdirectory        = %[1]q
+
+  sas_configuration {
+    token = "sp=r&st=2023-12-20T14:54:52Z&se=2023-12-20T22:54:52Z&spr=https&sv=2021-06-08&sr=c&sig=aBBKDWQvyuVcTPH9EBp%%2FXTI9E%%2F%%2Fmq171%%2BZU178wcwqU%%3D"
+  }
+}
+`, subdirectory)
+}
+
+func testAccLocationAzureBlobUpdatedConfig(rName string) string {
+	return testAccLocationAzureBlobBaseConfig(rName) + `
+resource "aws_datasync_location_azure_blob" "test" {
+  access_tier         = "COOL"
+  agent_arns          = [aws_datasync_agent.test.arn]
+  authentication_type = "SAS"
+  container_url       = "https://myaccount.blob.core.windows.net/mycontainer"
+  subdirectory        = "/path/updated/"
+
+  sas_configuration {
+    token = "sp=r&st=2023-12-20T14:54:52Z&se=2023-12-20T22:54:52Z&spr=https&sv=2021-06-08&sr=c&sig=aBBKDWQvyuVcTPH9EBp%2FXTI9E%2F%2Fmq171%2BZU178wcwqU%3D"
+  }
+}
+`
+}
+
+func testAccLocationAzureBlobTags1Config(rName, key1, value1 string) string {
+	return testAccLocationAzureBlobBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_datasync_location_azure_blob" "test" {
+  agent_arns          = [aws_datasync_agent.test.arn]
+  authentication_type = "SAS"
+  container_url       = "https://myaccount.blob.core.windows.net/mycontainer"
+
+  sas_configuration {
+    token = "sp=r&st=2023-12-20T14:54:52Z&se=2023-12-20T22:54:52Z&spr=https&sv=2021-06-08&sr=c&sig=aBBKDWQvyuVcTPH9EBp%%2FXTI9E%%2F%%2Fmq171%%2BZU178wcwqU%%3D"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, key1, value1)
+}
+
+func testAccLocationAzureBlobTags2Config(rName, key1, value1, key2, value2 string) string {
+	return testAccLocationAzureBlobBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_datasync_location_azure_blob" "test" {
+  agent_arns          = [aws_datasync_agent.test.arn]
+  authentication_type = "SAS"
+  container_url       = "https://myaccount.blob.core.windows.net/mycontainer"
+
+  sas_configuration {
+    token = "sp=r&st=2023-12-20T14:54:52Z&se=2023-12-20T22:54:52Z&spr=https&sv=2021-06-08&sr=c&sig=aBBKDWQvyuVcTPH9EBp%%2FXTI9E%%2F%%2Fmq171%%2BZU178wcwqU%%3D"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, key1, value1, key2, value2)
+}
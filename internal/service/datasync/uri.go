@@ -8,9 +8,10 @@ import (
 )
 
 var (
-	locationURIPattern                      = regexp.MustCompile(`^(efs|hdfs|nfs|s3|smb|fsxw|fsxl)://(.+)$`)
+	locationURIPattern                      = regexp.MustCompile(`^(azure-blob|efs|hdfs|nfs|s3|smb|fsxw|fsxl)://(.+)$`)
 	locationURIGlobalIDAndSubdirPattern     = regexp.MustCompile(`^([a-zA-Z0-9.\-]+)(?::\d{0,5})?(/.*)$`)
 	s3OutpostsAccessPointARNResourcePattern = regexp.MustCompile(`^outpost/.*/accesspoint/.*?(/.*)$`)
+	azureBlobLocationURIPattern             = regexp.MustCompile(`^azure-blob://([a-zA-Z0-9.\-]+)(/[^/]+)(/.*)?$`)
 )
 
 // SubdirectoryFromLocationURI extracts the subdirectory from a location URI.
@@ -43,3 +44,22 @@ func SubdirectoryFromLocationURI(uri string) (string, error) {
 
 	return submatches[2], nil
 }
+
+// ContainerURLAndSubdirectoryFromAzureBlobLocationURI extracts the container URL and subdirectory from an Azure Blob Storage location URI.
+// The location URI has the form azure-blob://<account>.blob.core.windows.net/<container>/<subdirectory>.
+func ContainerURLAndSubdirectoryFromAzureBlobLocationURI(uri string) (string, string, error) {
+	submatches := azureBlobLocationURIPattern.FindStringSubmatch(uri)
+
+	if len(submatches) != 4 {
+		return "", "", fmt.Errorf("Azure Blob location URI (%s) does not match pattern %q", uri, azureBlobLocationURIPattern)
+	}
+
+	containerURL := fmt.Sprintf("https://%s%s", submatches[1], submatches[2])
+	subdirectory := submatches[3]
+
+	if subdirectory == "" {
+		subdirectory = "/"
+	}
+
+	return containerURL, subdirectory, nil
+}
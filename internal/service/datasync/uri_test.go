@@ -138,6 +138,21 @@ func TestSubdirectoryFromLocationURI(t *testing.T) {
 			InputURI:             "fsxw://us-west-2.fs-abcdef012345678901/my-folder-1/my-folder-2", //lintignore:AWSAT003
 			ExpectedSubdirectory: "/my-folder-1/my-folder-2",
 		},
+		{
+			TestName:             "Azure Blob URI top level",
+			InputURI:             "azure-blob://example.blob.core.windows.net/",
+			ExpectedSubdirectory: "/",
+		},
+		{
+			TestName:             "Azure Blob URI one level",
+			InputURI:             "azure-blob://example.blob.core.windows.net/container/",
+			ExpectedSubdirectory: "/container/",
+		},
+		{
+			TestName:             "Azure Blob URI two levels",
+			InputURI:             "azure-blob://example.blob.core.windows.net/container/my-folder-1",
+			ExpectedSubdirectory: "/container/my-folder-1",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -158,3 +173,69 @@ func TestSubdirectoryFromLocationURI(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerURLAndSubdirectoryFromAzureBlobLocationURI(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputURI             string
+		ExpectedError        bool
+		ExpectedContainerURL string
+		ExpectedSubdirectory string
+	}{
+		{
+			TestName:      "empty URI",
+			InputURI:      "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid URI scheme",
+			InputURI:      "s3://bucket/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "no container",
+			InputURI:      "azure-blob://example.blob.core.windows.net/",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "container only",
+			InputURI:             "azure-blob://example.blob.core.windows.net/container",
+			ExpectedContainerURL: "https://example.blob.core.windows.net/container",
+			ExpectedSubdirectory: "/",
+		},
+		{
+			TestName:             "container top level",
+			InputURI:             "azure-blob://example.blob.core.windows.net/container/",
+			ExpectedContainerURL: "https://example.blob.core.windows.net/container",
+			ExpectedSubdirectory: "/",
+		},
+		{
+			TestName:             "container two levels",
+			InputURI:             "azure-blob://example.blob.core.windows.net/container/my-folder-1/my-folder-2/",
+			ExpectedContainerURL: "https://example.blob.core.windows.net/container",
+			ExpectedSubdirectory: "/my-folder-1/my-folder-2/",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotContainerURL, gotSubdirectory, err := tfdatasync.ContainerURLAndSubdirectoryFromAzureBlobLocationURI(testCase.InputURI)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotContainerURL != testCase.ExpectedContainerURL {
+				t.Errorf("got container URL %s, expected %s", gotContainerURL, testCase.ExpectedContainerURL)
+			}
+
+			if gotSubdirectory != testCase.ExpectedSubdirectory {
+				t.Errorf("got subdirectory %s, expected %s", gotSubdirectory, testCase.ExpectedSubdirectory)
+			}
+		})
+	}
+}
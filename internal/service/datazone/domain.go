@@ -0,0 +1,292 @@
+package datazone
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainCreate,
+		Read:   resourceDomainRead,
+		Update: resourceDomainUpdate,
+		Delete: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"portal_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"single_sign_on": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datazone.AuthType_Values(), false),
+						},
+						"user_assignment": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datazone.UserAssignment_Values(), false),
+						},
+					},
+				},
+			},
+			"skip_deletion_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &datazone.CreateDomainInput{
+		DomainExecutionRole: aws.String(d.Get("domain_execution_role").(string)),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_identifier"); ok {
+		input.KmsKeyIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("single_sign_on"); ok {
+		input.SingleSignOn = expandSingleSignOn(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating DataZone Domain: %s", input)
+	outputRaw, err := tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateDomain(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, datazone.ErrCodeAccessDeniedException, "role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating DataZone Domain (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*datazone.CreateDomainOutput).Id))
+
+	if _, err := waitDomainCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DataZone Domain (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceDomainRead(d, meta)
+}
+
+func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	domain, err := FindDomainByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DataZone Domain (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", domain.Arn)
+	d.Set("description", domain.Description)
+	d.Set("domain_execution_role", domain.DomainExecutionRole)
+	d.Set("kms_key_identifier", domain.KmsKeyIdentifier)
+	d.Set("name", domain.Name)
+	d.Set("portal_url", domain.PortalUrl)
+	if err := d.Set("single_sign_on", flattenSingleSignOn(domain.SingleSignOn)); err != nil {
+		return fmt.Errorf("error setting single_sign_on: %w", err)
+	}
+
+	tags, err := ListTags(conn, aws.StringValue(domain.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataZone Domain (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	if d.HasChanges("description", "domain_execution_role", "name", "single_sign_on") {
+		input := &datazone.UpdateDomainInput{
+			Description:         aws.String(d.Get("description").(string)),
+			DomainExecutionRole: aws.String(d.Get("domain_execution_role").(string)),
+			Identifier:          aws.String(d.Id()),
+			Name:                aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("single_sign_on") {
+			input.SingleSignOn = expandSingleSignOn(d.Get("single_sign_on").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating DataZone Domain: %s", input)
+		_, err := conn.UpdateDomain(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating DataZone Domain (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DataZone Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDomainRead(d, meta)
+}
+
+func resourceDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	input := &datazone.DeleteDomainInput{
+		Identifier: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("skip_deletion_check"); ok {
+		input.SkipDeletionCheck = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Domain: %s", d.Id())
+	_, err := conn.DeleteDomain(input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DataZone Domain (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitDomainDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for DataZone Domain (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSingleSignOn(tfList []interface{}) *datazone.SingleSignOn {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &datazone.SingleSignOn{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_assignment"].(string); ok && v != "" {
+		apiObject.UserAssignment = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSingleSignOn(apiObject *datazone.SingleSignOn) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type":            aws.StringValue(apiObject.Type),
+		"user_assignment": aws.StringValue(apiObject.UserAssignment),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,264 @@
+package datazone
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentCreate,
+		Read:   resourceEnvironmentRead,
+		Update: resourceEnvironmentUpdate,
+		Delete: resourceEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"aws_account_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"blueprint_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_blueprint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"glossary_terms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_parameter": userParameterSchema(true),
+		},
+	}
+}
+
+func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get("name").(string)
+	input := &datazone.CreateEnvironmentInput{
+		DomainIdentifier:             aws.String(domainID),
+		EnvironmentProfileIdentifier: aws.String(d.Get("environment_profile_id").(string)),
+		Name:                         aws.String(name),
+		ProjectIdentifier:            aws.String(d.Get("project_id").(string)),
+	}
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		input.EnvironmentAccountIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("aws_account_region"); ok {
+		input.EnvironmentAccountRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("glossary_terms"); ok && len(v.([]interface{})) > 0 {
+		input.GlossaryTerms = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_parameter"); ok && len(v.([]interface{})) > 0 {
+		input.UserParameters = expandEnvironmentParameters(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating DataZone Environment: %s", input)
+	output, err := conn.CreateEnvironment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DataZone Environment (%s): %w", name, err)
+	}
+
+	environmentID := aws.StringValue(output.Id)
+	d.SetId(CreateResourceID(domainID, environmentID))
+
+	if _, err := waitEnvironmentCreated(conn, domainID, environmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DataZone Environment (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceEnvironmentRead(d, meta)
+}
+
+func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	environment, err := FindEnvironmentByTwoPartKey(conn, domainID, environmentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DataZone Environment (%s): %w", d.Id(), err)
+	}
+
+	d.Set("aws_account_id", environment.AwsAccountId)
+	d.Set("aws_account_region", environment.AwsAccountRegion)
+	d.Set("blueprint_provider", environment.Provider)
+	d.Set("created_at", aws.TimeValue(environment.CreatedAt).Format(time.RFC3339))
+	d.Set("created_by", environment.CreatedBy)
+	d.Set("description", environment.Description)
+	d.Set("domain_id", environment.DomainId)
+	d.Set("environment_blueprint_id", environment.EnvironmentBlueprintId)
+	d.Set("environment_id", environment.Id)
+	d.Set("environment_profile_id", environment.EnvironmentProfileId)
+	d.Set("glossary_terms", aws.StringValueSlice(environment.GlossaryTerms))
+	d.Set("name", environment.Name)
+	d.Set("project_id", environment.ProjectId)
+	d.Set("status", environment.Status)
+	if err := d.Set("user_parameter", flattenCustomParameters(environment.UserParameters, d.Get("user_parameter").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting user_parameter: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &datazone.UpdateEnvironmentInput{
+		Description:      aws.String(d.Get("description").(string)),
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(environmentID),
+		Name:             aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("glossary_terms"); ok && len(v.([]interface{})) > 0 {
+		input.GlossaryTerms = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating DataZone Environment: %s", input)
+	_, err = conn.UpdateEnvironment(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating DataZone Environment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEnvironmentUpdated(conn, domainID, environmentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for DataZone Environment (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceEnvironmentRead(d, meta)
+}
+
+func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Environment: %s", d.Id())
+	_, err = conn.DeleteEnvironment(&datazone.DeleteEnvironmentInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(environmentID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DataZone Environment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEnvironmentDeleted(conn, domainID, environmentID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for DataZone Environment (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
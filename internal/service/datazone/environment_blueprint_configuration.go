@@ -0,0 +1,207 @@
+package datazone
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironmentBlueprintConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentBlueprintConfigurationPut,
+		Read:   resourceEnvironmentBlueprintConfigurationRead,
+		Update: resourceEnvironmentBlueprintConfigurationPut,
+		Delete: resourceEnvironmentBlueprintConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled_regions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidRegionName,
+				},
+			},
+			"environment_blueprint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"manage_access_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"provisioning_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"regional_parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameters": {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEnvironmentBlueprintConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_id").(string)
+	environmentBlueprintID := d.Get("environment_blueprint_id").(string)
+	id := CreateResourceID(domainID, environmentBlueprintID)
+	input := &datazone.PutEnvironmentBlueprintConfigurationInput{
+		DomainIdentifier:               aws.String(domainID),
+		EnabledRegions:                 flex.ExpandStringSet(d.Get("enabled_regions").(*schema.Set)),
+		EnvironmentBlueprintIdentifier: aws.String(environmentBlueprintID),
+	}
+
+	if v, ok := d.GetOk("manage_access_role_arn"); ok {
+		input.ManageAccessRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_role_arn"); ok {
+		input.ProvisioningRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("regional_parameter"); ok && v.(*schema.Set).Len() > 0 {
+		input.RegionalParameters = expandRegionalParameters(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Putting DataZone Environment Blueprint Configuration: %s", input)
+	_, err := conn.PutEnvironmentBlueprintConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting DataZone Environment Blueprint Configuration (%s): %w", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceEnvironmentBlueprintConfigurationRead(d, meta)
+}
+
+func resourceEnvironmentBlueprintConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentBlueprintID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindEnvironmentBlueprintConfigurationByTwoPartKey(conn, domainID, environmentBlueprintID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Environment Blueprint Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DataZone Environment Blueprint Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("domain_id", output.DomainId)
+	d.Set("enabled_regions", aws.StringValueSlice(output.EnabledRegions))
+	d.Set("environment_blueprint_id", output.EnvironmentBlueprintId)
+	d.Set("manage_access_role_arn", output.ManageAccessRoleArn)
+	d.Set("provisioning_role_arn", output.ProvisioningRoleArn)
+	if err := d.Set("regional_parameter", flattenRegionalParameters(output.RegionalParameters)); err != nil {
+		return fmt.Errorf("error setting regional_parameter: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentBlueprintConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentBlueprintID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Environment Blueprint Configuration: %s", d.Id())
+	_, err = conn.DeleteEnvironmentBlueprintConfiguration(&datazone.DeleteEnvironmentBlueprintConfigurationInput{
+		DomainIdentifier:               aws.String(domainID),
+		EnvironmentBlueprintIdentifier: aws.String(environmentBlueprintID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DataZone Environment Blueprint Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRegionalParameters(tfList []interface{}) map[string]map[string]*string {
+	apiObject := make(map[string]map[string]*string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject[tfMap["region"].(string)] = flex.ExpandStringMap(tfMap["parameters"].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenRegionalParameters(apiObject map[string]map[string]*string) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for region, parameters := range apiObject {
+		tfList = append(tfList, map[string]interface{}{
+			"parameters": aws.StringValueMap(parameters),
+			"region":     region,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,136 @@
+package datazone_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDataZoneEnvironmentBlueprintConfiguration_basic(t *testing.T) {
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+	domainResourceName := "aws_datazone_domain.test"
+	dataSourceName := "data.aws_datazone_environment_blueprint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datazone.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentBlueprintConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", domainResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "enabled_regions.*", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_blueprint_id", dataSourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "regional_parameter.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentBlueprintConfiguration_disappears(t *testing.T) {
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datazone.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentBlueprintConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdatazone.ResourceEnvironmentBlueprintConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentBlueprintConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datazone_environment_blueprint_configuration" {
+			continue
+		}
+
+		domainID, environmentBlueprintID, err := tfdatazone.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfdatazone.FindEnvironmentBlueprintConfigurationByTwoPartKey(conn, domainID, environmentBlueprintID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataZone Environment Blueprint Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEnvironmentBlueprintConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataZone Environment Blueprint Configuration ID is set")
+		}
+
+		domainID, environmentBlueprintID, err := tfdatazone.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+		_, err = tfdatazone.FindEnvironmentBlueprintConfigurationByTwoPartKey(conn, domainID, environmentBlueprintID)
+
+		return err
+	}
+}
+
+func testAccEnvironmentBlueprintConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBlueprintDataSourceConfig_basic(rName), `
+data "aws_region" "current" {}
+
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = data.aws_datazone_environment_blueprint.test.id
+  enabled_regions          = [data.aws_region.current.name]
+}
+`)
+}
@@ -0,0 +1,56 @@
+package datazone
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceEnvironmentBlueprint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnvironmentBlueprintRead,
+
+		Schema: map[string]*schema.Schema{
+			"blueprint_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"managed": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentBlueprintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get("name").(string)
+	blueprint, err := FindEnvironmentBlueprintByName(conn, domainID, name, d.Get("managed").(bool))
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("DataZone Environment Blueprint", err)
+	}
+
+	d.SetId(aws.StringValue(blueprint.Id))
+	d.Set("blueprint_provider", blueprint.Provider)
+	d.Set("description", blueprint.Description)
+	d.Set("name", blueprint.Name)
+
+	return nil
+}
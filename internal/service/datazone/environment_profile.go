@@ -0,0 +1,309 @@
+package datazone
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironmentProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentProfileCreate,
+		Read:   resourceEnvironmentProfileRead,
+		Update: resourceEnvironmentProfileUpdate,
+		Delete: resourceEnvironmentProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"aws_account_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_blueprint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_parameter": userParameterSchema(false),
+		},
+	}
+}
+
+func userParameterSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: forceNew,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: forceNew,
+				},
+			},
+		},
+	}
+}
+
+func resourceEnvironmentProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get("name").(string)
+	input := &datazone.CreateEnvironmentProfileInput{
+		DomainIdentifier:               aws.String(domainID),
+		EnvironmentBlueprintIdentifier: aws.String(d.Get("environment_blueprint_id").(string)),
+		Name:                           aws.String(name),
+		ProjectIdentifier:              aws.String(d.Get("project_id").(string)),
+	}
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		input.AwsAccountId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("aws_account_region"); ok {
+		input.AwsAccountRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_parameter"); ok && len(v.([]interface{})) > 0 {
+		input.UserParameters = expandEnvironmentParameters(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating DataZone Environment Profile: %s", input)
+	output, err := conn.CreateEnvironmentProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DataZone Environment Profile (%s): %w", name, err)
+	}
+
+	d.SetId(CreateResourceID(domainID, aws.StringValue(output.Id)))
+
+	return resourceEnvironmentProfileRead(d, meta)
+}
+
+func resourceEnvironmentProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentProfileID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	environmentProfile, err := FindEnvironmentProfileByTwoPartKey(conn, domainID, environmentProfileID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Environment Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DataZone Environment Profile (%s): %w", d.Id(), err)
+	}
+
+	d.Set("aws_account_id", environmentProfile.AwsAccountId)
+	d.Set("aws_account_region", environmentProfile.AwsAccountRegion)
+	d.Set("created_at", aws.TimeValue(environmentProfile.CreatedAt).Format(time.RFC3339))
+	d.Set("created_by", environmentProfile.CreatedBy)
+	d.Set("description", environmentProfile.Description)
+	d.Set("domain_id", environmentProfile.DomainId)
+	d.Set("environment_blueprint_id", environmentProfile.EnvironmentBlueprintId)
+	d.Set("environment_profile_id", environmentProfile.Id)
+	d.Set("name", environmentProfile.Name)
+	d.Set("project_id", environmentProfile.ProjectId)
+	d.Set("updated_at", aws.TimeValue(environmentProfile.UpdatedAt).Format(time.RFC3339))
+	if err := d.Set("user_parameter", flattenCustomParameters(environmentProfile.UserParameters, d.Get("user_parameter").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting user_parameter: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentProfileID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &datazone.UpdateEnvironmentProfileInput{
+		Description:      aws.String(d.Get("description").(string)),
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(environmentProfileID),
+		Name:             aws.String(d.Get("name").(string)),
+	}
+
+	if d.HasChange("aws_account_id") {
+		input.AwsAccountId = aws.String(d.Get("aws_account_id").(string))
+	}
+
+	if d.HasChange("aws_account_region") {
+		input.AwsAccountRegion = aws.String(d.Get("aws_account_region").(string))
+	}
+
+	if d.HasChange("user_parameter") {
+		input.UserParameters = expandEnvironmentParameters(d.Get("user_parameter").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating DataZone Environment Profile: %s", input)
+	_, err = conn.UpdateEnvironmentProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating DataZone Environment Profile (%s): %w", d.Id(), err)
+	}
+
+	return resourceEnvironmentProfileRead(d, meta)
+}
+
+func resourceEnvironmentProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentProfileID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Environment Profile: %s", d.Id())
+	_, err = conn.DeleteEnvironmentProfile(&datazone.DeleteEnvironmentProfileInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(environmentProfileID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DataZone Environment Profile (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEnvironmentParameters(tfList []interface{}) []*datazone.EnvironmentParameter {
+	var apiObjects []*datazone.EnvironmentParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &datazone.EnvironmentParameter{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenCustomParameters returns the configured user parameters with their current values.
+// DataZone reports every parameter defined by the environment blueprint, so only those already
+// present in configuration (or state) are returned.
+func flattenCustomParameters(apiObjects []*datazone.CustomParameter, tfList []interface{}) []interface{} {
+	values := make(map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		values[aws.StringValue(apiObject.KeyName)] = aws.StringValue(apiObject.DefaultValue)
+	}
+
+	var tfListOut []interface{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		value, ok := values[name]
+
+		if !ok {
+			continue
+		}
+
+		tfListOut = append(tfListOut, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+
+	return tfListOut
+}
@@ -0,0 +1,164 @@
+package datazone_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDataZoneEnvironmentProfile_basic(t *testing.T) {
+	resourceName := "aws_datazone_environment_profile.test"
+	domainResourceName := "aws_datazone_domain.test"
+	projectResourceName := "aws_datazone_project.test"
+	dataSourceName := "data.aws_datazone_environment_blueprint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datazone.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentProfileConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "aws_account_region", acctest.Region()),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", domainResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_blueprint_id", dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "environment_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", projectResourceName, "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "user_parameter.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentProfileConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentProfile_disappears(t *testing.T) {
+	resourceName := "aws_datazone_environment_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datazone.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentProfileConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdatazone.ResourceEnvironmentProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentProfileDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datazone_environment_profile" {
+			continue
+		}
+
+		domainID, environmentProfileID, err := tfdatazone.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfdatazone.FindEnvironmentProfileByTwoPartKey(conn, domainID, environmentProfileID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataZone Environment Profile %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEnvironmentProfileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataZone Environment Profile ID is set")
+		}
+
+		domainID, environmentProfileID, err := tfdatazone.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+		_, err = tfdatazone.FindEnvironmentProfileByTwoPartKey(conn, domainID, environmentProfileID)
+
+		return err
+	}
+}
+
+func testAccEnvironmentProfileConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBlueprintConfigurationConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_datazone_project" "test" {
+  domain_id           = aws_datazone_domain.test.id
+  name                = %[1]q
+  skip_deletion_check = true
+}
+`, rName))
+}
+
+func testAccEnvironmentProfileConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccEnvironmentProfileConfigBase(rName), fmt.Sprintf(`
+resource "aws_datazone_environment_profile" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  project_id               = aws_datazone_project.test.project_id
+  environment_blueprint_id = aws_datazone_environment_blueprint_configuration.test.environment_blueprint_id
+  name                     = %[1]q
+  description              = %[2]q
+  aws_account_id           = data.aws_caller_identity.current.account_id
+  aws_account_region       = data.aws_region.current.name
+}
+`, rName, description))
+}
@@ -0,0 +1,27 @@
+package datazone
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = ","
+
+// CreateResourceID is a generic method for creating an ID string for a domain-scoped resource e.g. aws_datazone_project.
+func CreateResourceID(domainID, id string) string {
+	parts := []string{domainID, id}
+	id = strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+// ParseResourceID is a generic method for parsing an ID string for a domain ID and a domain-scoped resource ID.
+func ParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DOMAINID%[2]sID", id, resourceIDSeparator)
+}
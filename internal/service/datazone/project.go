@@ -0,0 +1,204 @@
+package datazone
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectCreate,
+		Read:   resourceProjectRead,
+		Update: resourceProjectUpdate,
+		Delete: resourceProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"glossary_terms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"skip_deletion_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get("name").(string)
+	input := &datazone.CreateProjectInput{
+		DomainIdentifier: aws.String(domainID),
+		Name:             aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("glossary_terms"); ok && len(v.([]interface{})) > 0 {
+		input.GlossaryTerms = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating DataZone Project: %s", input)
+	output, err := conn.CreateProject(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DataZone Project (%s): %w", name, err)
+	}
+
+	d.SetId(CreateResourceID(domainID, aws.StringValue(output.Id)))
+
+	return resourceProjectRead(d, meta)
+}
+
+func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, projectID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	project, err := FindProjectByTwoPartKey(conn, domainID, projectID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Project (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DataZone Project (%s): %w", d.Id(), err)
+	}
+
+	d.Set("created_at", aws.TimeValue(project.CreatedAt).Format(time.RFC3339))
+	d.Set("created_by", project.CreatedBy)
+	d.Set("description", project.Description)
+	d.Set("domain_id", project.DomainId)
+	d.Set("glossary_terms", aws.StringValueSlice(project.GlossaryTerms))
+	d.Set("name", project.Name)
+	d.Set("project_id", project.Id)
+
+	return nil
+}
+
+func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, projectID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("description", "glossary_terms", "name") {
+		input := &datazone.UpdateProjectInput{
+			Description:      aws.String(d.Get("description").(string)),
+			DomainIdentifier: aws.String(domainID),
+			Identifier:       aws.String(projectID),
+			Name:             aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("glossary_terms"); ok && len(v.([]interface{})) > 0 {
+			input.GlossaryTerms = flex.ExpandStringList(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating DataZone Project: %s", input)
+		_, err := conn.UpdateProject(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating DataZone Project (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceProjectRead(d, meta)
+}
+
+func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, projectID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &datazone.DeleteProjectInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(projectID),
+	}
+
+	if v, ok := d.GetOk("skip_deletion_check"); ok {
+		input.SkipDeletionCheck = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Project: %s", d.Id())
+	_, err = conn.DeleteProject(input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DataZone Project (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitProjectDeleted(conn, domainID, projectID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for DataZone Project (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
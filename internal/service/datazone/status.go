@@ -0,0 +1,56 @@
+package datazone
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDomain(conn *datazone.DataZone, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDomainByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusProject(conn *datazone.DataZone, domainID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProjectByTwoPartKey(conn, domainID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ProjectStatus), nil
+	}
+}
+
+func statusEnvironment(conn *datazone.DataZone, domainID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentByTwoPartKey(conn, domainID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
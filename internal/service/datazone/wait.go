@@ -0,0 +1,146 @@
+package datazone
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
+
+func waitDomainCreated(conn *datazone.DataZone, id string, timeout time.Duration) (*datazone.GetDomainOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.DomainStatusCreating},
+		Target:  []string{datazone.DomainStatusAvailable},
+		Refresh: statusDomain(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*datazone.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDomainDeleted(conn *datazone.DataZone, id string, timeout time.Duration) (*datazone.GetDomainOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.DomainStatusAvailable, datazone.DomainStatusDeleting},
+		Target:  []string{},
+		Refresh: statusDomain(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*datazone.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectDeleted(conn *datazone.DataZone, domainID, id string, timeout time.Duration) (*datazone.GetProjectOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.ProjectStatusActive, datazone.ProjectStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProject(conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*datazone.GetProjectOutput); ok {
+		if reasons := output.FailureReasons; len(reasons) > 0 {
+			var errs []string
+
+			for _, v := range reasons {
+				errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errors.New(strings.Join(errs, "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentCreated(conn *datazone.DataZone, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.EnvironmentStatusCreating},
+		Target:  []string{datazone.EnvironmentStatusActive},
+		Refresh: statusEnvironment(conn, domainID, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		setEnvironmentLastDeploymentError(output, err)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentUpdated(conn *datazone.DataZone, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.EnvironmentStatusUpdating},
+		Target:  []string{datazone.EnvironmentStatusActive},
+		Refresh: statusEnvironment(conn, domainID, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		setEnvironmentLastDeploymentError(output, err)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(conn *datazone.DataZone, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.EnvironmentStatusActive, datazone.EnvironmentStatusDeleting},
+		Target:  []string{},
+		Refresh: statusEnvironment(conn, domainID, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		setEnvironmentLastDeploymentError(output, err)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func setEnvironmentLastDeploymentError(output *datazone.GetEnvironmentOutput, err error) {
+	if deployment := output.LastDeployment; deployment != nil && deployment.FailureReason != nil {
+		tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(deployment.FailureReason.Code), aws.StringValue(deployment.FailureReason.Message)))
+	}
+}
@@ -307,13 +307,7 @@ func decodeDevicefarmProjectArn(id, typ string, meta interface{}) (string, error
 	}
 
 	projectId := parts[0]
-	projectArn := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("project:%s", projectId),
-		Service:   devicefarm.ServiceName,
-	}.String()
+	projectArn := meta.(*conns.AWSClient).RegionalARN(devicefarm.ServiceName, fmt.Sprintf("project:%s", projectId))
 
 	return projectArn, nil
 }
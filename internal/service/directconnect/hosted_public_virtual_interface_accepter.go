@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -62,13 +61,7 @@ func resourceHostedPublicVirtualInterfaceAccepterCreate(d *schema.ResourceData,
 	}
 
 	d.SetId(vifId)
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "directconnect",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("dxvif/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("directconnect", fmt.Sprintf("dxvif/%s", d.Id()))
 	d.Set("arn", arn)
 
 	if err := dxHostedPublicVirtualInterfaceAccepterWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
@@ -152,13 +145,7 @@ func resourceHostedPublicVirtualInterfaceAccepterImport(d *schema.ResourceData,
 		return nil, fmt.Errorf("virtual interface (%s) has incorrect type: %s", d.Id(), vifType)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "directconnect",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("dxvif/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("directconnect", fmt.Sprintf("dxvif/%s", d.Id()))
 	d.Set("arn", arn)
 
 	return []*schema.ResourceData{d}, nil
@@ -273,7 +273,7 @@ func ResourceEndpoint() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", ""),
 			},
 			"mongodb_settings": {
 				Type:             schema.TypeList,
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -208,13 +207,7 @@ func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 
 	subscription := response.EventSubscriptionsList[0]
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "dms",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("es:%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("dms", fmt.Sprintf("es:%s", d.Id()))
 	d.Set("arn", arn)
 
 	d.Set("enabled", subscription.Enabled)
@@ -70,7 +70,7 @@ func ResourceReplicationInstance() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", ""),
 			},
 			"multi_az": {
 				Type:     schema.TypeBool,
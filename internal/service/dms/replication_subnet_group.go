@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -104,13 +103,7 @@ func resourceReplicationSubnetGroupRead(d *schema.ResourceData, meta interface{}
 
 	// The AWS API for DMS subnet groups does not return the ARN which is required to
 	// retrieve tags. This ARN can be built.
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "dms",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("subgrp:%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("dms", fmt.Sprintf("subgrp:%s", d.Id()))
 	d.Set("replication_subnet_group_arn", arn)
 
 	err = resourceReplicationSubnetGroupSetState(d, response.ReplicationSubnetGroups[0])
@@ -0,0 +1,182 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// resourcePolicyRevisionIDNoPolicy is the expected revision ID used to attach a policy only if none exists.
+const resourcePolicyRevisionIDNoPolicy = "NO_POLICY"
+
+func ResourceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourcePolicyCreate,
+		Read:   resourceResourcePolicyRead,
+		Update: resourceResourcePolicyUpdate,
+		Delete: resourceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"confirm_remove_self_resource_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARNForService(dynamodb.ServiceName, "table"),
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	resourceARN := d.Get("resource_arn").(string)
+	input := &dynamodb.PutResourcePolicyInput{
+		ExpectedRevisionId: aws.String(resourcePolicyRevisionIDNoPolicy),
+		Policy:             aws.String(policy),
+		ResourceArn:        aws.String(resourceARN),
+	}
+
+	if v, ok := d.GetOk("confirm_remove_self_resource_access"); ok {
+		input.ConfirmRemoveSelfResourceAccess = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Resource Policy: %s", input)
+	_, err = conn.PutResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DynamoDB Resource Policy (%s): %w", resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+
+	return resourceResourcePolicyRead(d, meta)
+}
+
+func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(resourcePolicyPropagationTimeout, func() (interface{}, error) {
+		return FindResourcePolicyByARN(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	output := outputRaw.(*dynamodb.GetResourcePolicyOutput)
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("resource_arn", d.Id())
+	d.Set("revision_id", output.RevisionId)
+
+	return nil
+}
+
+func resourceResourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	// Only update the policy if it hasn't been changed since it was last read.
+	input := &dynamodb.PutResourcePolicyInput{
+		ExpectedRevisionId: aws.String(d.Get("revision_id").(string)),
+		Policy:             aws.String(policy),
+		ResourceArn:        aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("confirm_remove_self_resource_access"); ok {
+		input.ConfirmRemoveSelfResourceAccess = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Updating DynamoDB Resource Policy: %s", input)
+	output, err := conn.PutResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating DynamoDB Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitResourcePolicyRevisionPropagated(conn, d.Id(), aws.StringValue(output.RevisionId)); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Resource Policy (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceResourcePolicyRead(d, meta)
+}
+
+func resourceResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	input := &dynamodb.DeleteResourcePolicyInput{
+		ResourceArn: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("revision_id"); ok {
+		input.ExpectedRevisionId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Resource Policy: %s", d.Id())
+	_, err := conn.DeleteResourcePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodePolicyNotFoundException, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DynamoDB Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func statusDynamoDBKinesisStreamingDestination(ctx context.Context, conn *dynamodb.DynamoDB, streamArn, tableName string) resource.StateRefreshFunc {
@@ -184,3 +185,46 @@ func statusDynamoDBTableSES(conn *dynamodb.DynamoDB, tableName string) resource.
 		return table, aws.StringValue(table.SSEDescription.Status), nil
 	}
 }
+
+func statusImport(conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindImportByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ImportStatus), nil
+	}
+}
+
+const (
+	resourcePolicyRevisionStatusCurrent = "CURRENT"
+	resourcePolicyRevisionStatusStale   = "STALE"
+)
+
+// statusResourcePolicyRevision returns whether the resource policy has the specified revision.
+// GetResourcePolicy is eventually consistent and may return a previous revision after an update.
+func statusResourcePolicyRevision(conn *dynamodb.DynamoDB, arn, revisionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindResourcePolicyByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.StringValue(output.RevisionId) != revisionID {
+			return output, resourcePolicyRevisionStatusStale, nil
+		}
+
+		return output, resourcePolicyRevisionStatusCurrent, nil
+	}
+}
@@ -129,6 +129,10 @@ func ResourceTable() *schema.Resource {
 				Default:      dynamodb.BillingModeProvisioned,
 				ValidateFunc: validation.StringInSlice(dynamodb.BillingMode_Values(), false),
 			},
+			"deletion_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"global_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -215,6 +219,26 @@ func ResourceTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"on_demand_throughput": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_read_request_units": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_write_request_units": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -248,7 +272,7 @@ func ResourceTable() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", ""),
 						},
 						"region_name": {
 							Type:     schema.TypeString,
@@ -272,7 +296,7 @@ func ResourceTable() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", ""),
 						},
 					},
 				},
@@ -429,6 +453,10 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 			req.SSESpecificationOverride = expandDynamoDbEncryptAtRestOptions(v.([]interface{}))
 		}
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			req.OnDemandThroughputOverride = expandDynamoDbOnDemandThroughput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		var output *dynamodb.RestoreTableToPointInTimeOutput
 		err := resource.Retry(createTableTimeout, func() *resource.RetryError {
 			var err error
@@ -519,6 +547,14 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 			req.TableClass = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("deletion_protection_enabled"); ok {
+			req.DeletionProtectionEnabled = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("on_demand_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			req.OnDemandThroughput = expandDynamoDbOnDemandThroughput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		var output *dynamodb.CreateTableOutput
 		err := resource.Retry(createTableTimeout, func() *resource.RetryError {
 			var err error
@@ -558,6 +594,22 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for creation of DynamoDB table (%s): %w", d.Id(), err)
 	}
 
+	// RestoreTableToPointInTime doesn't support deletion protection.
+	if _, ok := d.GetOk("restore_source_name"); ok && d.Get("deletion_protection_enabled").(bool) {
+		_, err := conn.UpdateTable(&dynamodb.UpdateTableInput{
+			DeletionProtectionEnabled: aws.Bool(true),
+			TableName:                 aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error enabling DynamoDB Table (%s) deletion protection: %w", d.Id(), err)
+		}
+
+		if _, err := waitDynamoDBTableActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for DynamoDB Table (%s) update: %w", d.Id(), err)
+		}
+	}
+
 	if d.Get("ttl.0.enabled").(bool) {
 		if err := updateDynamoDbTimeToLive(d.Id(), d.Get("ttl").([]interface{}), conn); err != nil {
 			return fmt.Errorf("error enabling DynamoDB Table (%s) Time to Live: %w", d.Id(), err)
@@ -623,6 +675,12 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
 	}
 
+	d.Set("deletion_protection_enabled", table.DeletionProtectionEnabled)
+
+	if err := d.Set("on_demand_throughput", flattenDynamoDbOnDemandThroughput(table.OnDemandThroughput)); err != nil {
+		return fmt.Errorf("error setting on_demand_throughput: %w", err)
+	}
+
 	if err := d.Set("attribute", flattenDynamoDbTableAttributeDefinitions(table.AttributeDefinitions)); err != nil {
 		return fmt.Errorf("error setting attribute: %w", err)
 	}
@@ -811,6 +869,18 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.TableClass = aws.String(d.Get("table_class").(string))
 	}
 
+	if d.HasChange("deletion_protection_enabled") {
+		hasTableUpdate = true
+		input.DeletionProtectionEnabled = aws.Bool(d.Get("deletion_protection_enabled").(bool))
+	}
+
+	if d.HasChange("on_demand_throughput") {
+		if v, ok := d.GetOk("on_demand_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			hasTableUpdate = true
+			input.OnDemandThroughput = expandDynamoDbOnDemandThroughput(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if hasTableUpdate {
 		log.Printf("[DEBUG] Updating DynamoDB Table: %s", input)
 		_, err := conn.UpdateTable(input)
@@ -1405,6 +1475,19 @@ func flattenDynamoDbTableGlobalSecondaryIndex(gsi []*dynamodb.GlobalSecondaryInd
 	return output
 }
 
+func flattenDynamoDbOnDemandThroughput(apiObject *dynamodb.OnDemandThroughput) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_read_request_units":  aws.Int64Value(apiObject.MaxReadRequestUnits),
+		"max_write_request_units": aws.Int64Value(apiObject.MaxWriteRequestUnits),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenDynamodDbTableServerSideEncryption(description *dynamodb.SSEDescription) []interface{} {
 	if description == nil {
 		return []interface{}{}
@@ -1596,6 +1679,24 @@ func expandDynamoDbKeySchema(data map[string]interface{}) []*dynamodb.KeySchemaE
 	return keySchema
 }
 
+func expandDynamoDbOnDemandThroughput(tfMap map[string]interface{}) *dynamodb.OnDemandThroughput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.OnDemandThroughput{}
+
+	if v, ok := tfMap["max_read_request_units"].(int); ok && v != 0 {
+		apiObject.MaxReadRequestUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_write_request_units"].(int); ok && v != 0 {
+		apiObject.MaxWriteRequestUnits = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
 func expandDynamoDbEncryptAtRestOptions(vOptions []interface{}) *dynamodb.SSESpecification {
 	options := &dynamodb.SSESpecification{}
 
@@ -0,0 +1,583 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTableImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableImportCreate,
+		Read:   resourceTableImportRead,
+		Delete: resourceTableImportDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_log_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failure_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"import_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"imported_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_compression_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dynamodb.InputCompressionTypeNone,
+				ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+			},
+			"input_format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+			},
+			"input_format_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"csv": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delimiter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{",", "\t", ":", ";", "|", " "}, false),
+									},
+									"header_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"processed_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"processed_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_bucket_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket_owner": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_creation_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(dynamodb.ScalarAttributeType_Values(), false),
+									},
+								},
+							},
+						},
+						"billing_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      dynamodb.BillingModeProvisioned,
+							ValidateFunc: validation.StringInSlice(dynamodb.BillingMode_Values(), false),
+						},
+						"global_secondary_index": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hash_key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"non_key_attributes": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"projection_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(dynamodb.ProjectionType_Values(), false),
+									},
+									"range_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"read_capacity": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"write_capacity": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"hash_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"range_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"read_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"server_side_encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+									"kms_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						"write_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTableImportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableCreationParameters := expandTableCreationParameters(d.Get("table_creation_parameters").([]interface{})[0].(map[string]interface{}))
+	tableName := aws.StringValue(tableCreationParameters.TableName)
+	input := &dynamodb.ImportTableInput{
+		ClientToken:             aws.String(resource.UniqueId()),
+		InputCompressionType:    aws.String(d.Get("input_compression_type").(string)),
+		InputFormat:             aws.String(d.Get("input_format").(string)),
+		S3BucketSource:          expandS3BucketSource(d.Get("s3_bucket_source").([]interface{})[0].(map[string]interface{})),
+		TableCreationParameters: tableCreationParameters,
+	}
+
+	if v, ok := d.GetOk("input_format_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InputFormatOptions = expandInputFormatOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Importing DynamoDB Table: %s", input)
+	output, err := conn.ImportTable(input)
+
+	if err != nil {
+		return fmt.Errorf("error importing DynamoDB Table (%s): %w", tableName, err)
+	}
+
+	d.SetId(aws.StringValue(output.ImportTableDescription.ImportArn))
+
+	if _, err := waitImportCompleted(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Table (%s) import (%s): %w", tableName, d.Id(), err)
+	}
+
+	return resourceTableImportRead(d, meta)
+}
+
+func resourceTableImportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	output, err := FindImportByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Import (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table Import (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ImportArn)
+	d.Set("cloudwatch_log_group_arn", output.CloudWatchLogGroupArn)
+	if output.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	d.Set("error_count", output.ErrorCount)
+	d.Set("failure_code", output.FailureCode)
+	d.Set("failure_message", output.FailureMessage)
+	d.Set("import_status", output.ImportStatus)
+	d.Set("imported_item_count", output.ImportedItemCount)
+	d.Set("input_compression_type", output.InputCompressionType)
+	d.Set("input_format", output.InputFormat)
+	if err := d.Set("input_format_options", flattenInputFormatOptions(output.InputFormatOptions)); err != nil {
+		return fmt.Errorf("error setting input_format_options: %w", err)
+	}
+	d.Set("processed_item_count", output.ProcessedItemCount)
+	d.Set("processed_size_bytes", output.ProcessedSizeBytes)
+	if err := d.Set("s3_bucket_source", flattenS3BucketSource(output.S3BucketSource)); err != nil {
+		return fmt.Errorf("error setting s3_bucket_source: %w", err)
+	}
+	if output.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("table_arn", output.TableArn)
+	if err := d.Set("table_creation_parameters", flattenTableCreationParameters(output.TableCreationParameters)); err != nil {
+		return fmt.Errorf("error setting table_creation_parameters: %w", err)
+	}
+	d.Set("table_id", output.TableId)
+
+	return nil
+}
+
+// resourceTableImportDelete deletes the table created by the import.
+// The import itself can't be deleted; DynamoDB keeps its description for 90 days.
+func resourceTableImportDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_creation_parameters.0.table_name").(string)
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) created by import (%s)", tableName, d.Id())
+	err := deleteDynamoDbTable(tableName, conn)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DynamoDB Table (%s): %w", tableName, err)
+	}
+
+	if _, err := waitDynamoDBTableDeleted(conn, tableName); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Table (%s) deletion: %w", tableName, err)
+	}
+
+	return nil
+}
+
+func expandInputFormatOptions(tfMap map[string]interface{}) *dynamodb.InputFormatOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.InputFormatOptions{}
+
+	if v, ok := tfMap["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		csv := &dynamodb.CsvOptions{}
+
+		if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+			csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := tfMap["header_list"].([]interface{}); ok && len(v) > 0 {
+			csv.HeaderList = flex.ExpandStringList(v)
+		}
+
+		apiObject.Csv = csv
+	}
+
+	return apiObject
+}
+
+func expandS3BucketSource(tfMap map[string]interface{}) *dynamodb.S3BucketSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.S3BucketSource{
+		S3Bucket: aws.String(tfMap["bucket"].(string)),
+	}
+
+	if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+		apiObject.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTableCreationParameters(tfMap map[string]interface{}) *dynamodb.TableCreationParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	billingMode := tfMap["billing_mode"].(string)
+	apiObject := &dynamodb.TableCreationParameters{
+		AttributeDefinitions:  expandDynamoDbAttributes(tfMap["attribute"].(*schema.Set).List()),
+		BillingMode:           aws.String(billingMode),
+		KeySchema:             expandDynamoDbKeySchema(tfMap),
+		ProvisionedThroughput: expandDynamoDbProvisionedThroughput(tfMap, billingMode),
+		TableName:             aws.String(tfMap["table_name"].(string)),
+	}
+
+	if v, ok := tfMap["global_secondary_index"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			apiObject.GlobalSecondaryIndexes = append(apiObject.GlobalSecondaryIndexes, expandDynamoDbGlobalSecondaryIndex(tfMapRaw.(map[string]interface{}), billingMode))
+		}
+	}
+
+	if v, ok := tfMap["server_side_encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SSESpecification = expandDynamoDbEncryptAtRestOptions(v)
+	}
+
+	return apiObject
+}
+
+func flattenInputFormatOptions(apiObject *dynamodb.InputFormatOptions) []interface{} {
+	if apiObject == nil || apiObject.Csv == nil {
+		return []interface{}{}
+	}
+
+	csv := map[string]interface{}{
+		"delimiter":   aws.StringValue(apiObject.Csv.Delimiter),
+		"header_list": aws.StringValueSlice(apiObject.Csv.HeaderList),
+	}
+
+	return []interface{}{map[string]interface{}{
+		"csv": []interface{}{csv},
+	}}
+}
+
+func flattenS3BucketSource(apiObject *dynamodb.S3BucketSource) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":       aws.StringValue(apiObject.S3Bucket),
+		"bucket_owner": aws.StringValue(apiObject.S3BucketOwner),
+		"key_prefix":   aws.StringValue(apiObject.S3KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTableCreationParameters(apiObject *dynamodb.TableCreationParameters) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"attribute":  flattenDynamoDbTableAttributeDefinitions(apiObject.AttributeDefinitions),
+		"table_name": aws.StringValue(apiObject.TableName),
+	}
+
+	if v := apiObject.BillingMode; v != nil {
+		tfMap["billing_mode"] = aws.StringValue(v)
+	} else {
+		tfMap["billing_mode"] = dynamodb.BillingModeProvisioned
+	}
+
+	for _, attribute := range apiObject.KeySchema {
+		if attribute == nil {
+			continue
+		}
+
+		switch aws.StringValue(attribute.KeyType) {
+		case dynamodb.KeyTypeHash:
+			tfMap["hash_key"] = aws.StringValue(attribute.AttributeName)
+		case dynamodb.KeyTypeRange:
+			tfMap["range_key"] = aws.StringValue(attribute.AttributeName)
+		}
+	}
+
+	if v := apiObject.ProvisionedThroughput; v != nil {
+		tfMap["read_capacity"] = aws.Int64Value(v.ReadCapacityUnits)
+		tfMap["write_capacity"] = aws.Int64Value(v.WriteCapacityUnits)
+	}
+
+	var gsis []interface{}
+
+	for _, g := range apiObject.GlobalSecondaryIndexes {
+		if g == nil {
+			continue
+		}
+
+		gsi := map[string]interface{}{
+			"name": aws.StringValue(g.IndexName),
+		}
+
+		for _, attribute := range g.KeySchema {
+			if attribute == nil {
+				continue
+			}
+
+			switch aws.StringValue(attribute.KeyType) {
+			case dynamodb.KeyTypeHash:
+				gsi["hash_key"] = aws.StringValue(attribute.AttributeName)
+			case dynamodb.KeyTypeRange:
+				gsi["range_key"] = aws.StringValue(attribute.AttributeName)
+			}
+		}
+
+		if g.Projection != nil {
+			gsi["projection_type"] = aws.StringValue(g.Projection.ProjectionType)
+			gsi["non_key_attributes"] = aws.StringValueSlice(g.Projection.NonKeyAttributes)
+		}
+
+		if g.ProvisionedThroughput != nil {
+			gsi["read_capacity"] = aws.Int64Value(g.ProvisionedThroughput.ReadCapacityUnits)
+			gsi["write_capacity"] = aws.Int64Value(g.ProvisionedThroughput.WriteCapacityUnits)
+		}
+
+		gsis = append(gsis, gsi)
+	}
+
+	tfMap["global_secondary_index"] = gsis
+
+	if v := apiObject.SSESpecification; v != nil && aws.BoolValue(v.Enabled) {
+		tfMap["server_side_encryption"] = []interface{}{map[string]interface{}{
+			"enabled":     true,
+			"kms_key_arn": aws.StringValue(v.KMSMasterKeyId),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,155 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestAccDynamoDBTableImport_basic(t *testing.T) {
+	var v dynamodb.ImportTableDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableImportConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableImportExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dynamodb", regexp.MustCompile(`table/.+/import/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "error_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "import_status", dynamodb.ImportStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "imported_item_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_compression_type", dynamodb.InputCompressionTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "input_format", dynamodb.InputFormatCsv),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.0.csv.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.0.csv.0.delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_source.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_source.0.bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_source.0.key_prefix", "import/"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "table_arn", "dynamodb", regexp.MustCompile(`table/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.billing_mode", dynamodb.BillingModePayPerRequest),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.table_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "table_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableImportExists(n string, v *dynamodb.ImportTableDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Import ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := tfdynamodb.FindImportByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// The import itself can't be deleted, so check that the imported table has been deleted.
+func testAccCheckTableImportDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_import" {
+			continue
+		}
+
+		tableName := rs.Primary.Attributes["table_creation_parameters.0.table_name"]
+
+		_, err := tfdynamodb.FindDynamoDBTableByName(conn, tableName)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DynamoDB Table %s still exists", tableName)
+	}
+
+	return nil
+}
+
+func testAccTableImportConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "import/data.csv"
+  content = "id,name\n1,one\n2,two\n"
+}
+
+resource "aws_dynamodb_table_import" "test" {
+  input_format = "CSV"
+
+  input_format_options {
+    csv {
+      delimiter = ","
+    }
+  }
+
+  s3_bucket_source {
+    bucket     = aws_s3_bucket.test.id
+    key_prefix = "import/"
+  }
+
+  table_creation_parameters {
+    table_name   = %[1]q
+    billing_mode = "PAY_PER_REQUEST"
+    hash_key     = "id"
+
+    attribute {
+      name = "id"
+      type = "S"
+    }
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName)
+}
@@ -2,10 +2,13 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -18,6 +21,7 @@ const (
 	updateTableContinuousBackupsTimeout        = 20 * time.Minute
 	deleteTableTimeout                         = 10 * time.Minute
 	pitrUpdateTimeout                          = 30 * time.Second
+	resourcePolicyPropagationTimeout           = 2 * time.Minute
 	ttlUpdateTimeout                           = 30 * time.Second
 )
 
@@ -258,3 +262,44 @@ func waitDynamoDBSSEUpdated(conn *dynamodb.DynamoDB, tableName string) (*dynamod
 
 	return nil, err
 }
+
+func waitImportCompleted(conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.ImportStatusInProgress},
+		Target:     []string{dynamodb.ImportStatusCompleted},
+		Refresh:    statusImport(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		if output.FailureCode != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitResourcePolicyRevisionPropagated(conn *dynamodb.DynamoDB, arn, revisionID string) (*dynamodb.GetResourcePolicyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{resourcePolicyRevisionStatusStale},
+		Target:                    []string{resourcePolicyRevisionStatusCurrent},
+		Refresh:                   statusResourcePolicyRevision(conn, arn, revisionID),
+		Timeout:                   resourcePolicyPropagationTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.GetResourcePolicyOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,127 @@
+package ec2
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceCapacityBlockOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCapacityBlockOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_block_offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_duration_hours": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"tenancy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"upfront_fee": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCapacityBlockOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeCapacityBlockOfferingsInput{
+		CapacityDurationHours: aws.Int64(int64(d.Get("capacity_duration_hours").(int))),
+		InstanceCount:         aws.Int64(int64(d.Get("instance_count").(int))),
+		InstanceType:          aws.String(d.Get("instance_type").(string)),
+	}
+
+	if v, ok := d.GetOk("end_date_range"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDateRange = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("start_date_range"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.StartDateRange = aws.Time(v)
+	}
+
+	output, err := FindCapacityBlockOfferings(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Capacity Block Offerings: %w", err)
+	}
+
+	if len(output) == 0 {
+		return fmt.Errorf("no EC2 Capacity Block Offerings found matching criteria; try different search")
+	}
+
+	// Use the offering that starts soonest.
+	offering := output[0]
+	for _, v := range output[1:] {
+		if aws.TimeValue(v.StartDate).Before(aws.TimeValue(offering.StartDate)) {
+			offering = v
+		}
+	}
+
+	d.SetId(aws.StringValue(offering.CapacityBlockOfferingId))
+	d.Set("availability_zone", offering.AvailabilityZone)
+	d.Set("capacity_block_offering_id", offering.CapacityBlockOfferingId)
+	d.Set("capacity_duration_hours", offering.CapacityBlockDurationHours)
+	d.Set("currency_code", offering.CurrencyCode)
+	d.Set("end_date", aws.TimeValue(offering.EndDate).Format(time.RFC3339))
+	d.Set("instance_count", offering.InstanceCount)
+	d.Set("instance_type", offering.InstanceType)
+	d.Set("start_date", aws.TimeValue(offering.StartDate).Format(time.RFC3339))
+	d.Set("tenancy", offering.Tenancy)
+	d.Set("upfront_fee", offering.UpfrontFee)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package ec2_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2CapacityBlockOfferingDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_capacity_block_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockOfferingDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "availability_zone"),
+					resource.TestMatchResourceAttr(dataSourceName, "capacity_block_offering_id", regexp.MustCompile(`^cb-.+`)),
+					resource.TestCheckResourceAttr(dataSourceName, "capacity_duration_hours", "24"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "currency_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "end_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_type", "p4d.24xlarge"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "start_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tenancy"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "upfront_fee"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityBlockOfferingDataSourceConfig() string {
+	return `
+data "aws_ec2_capacity_block_offering" "test" {
+  capacity_duration_hours = 24
+  instance_count          = 1
+  instance_type           = "p4d.24xlarge"
+}
+`
+}
@@ -0,0 +1,206 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCapacityBlockReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCapacityBlockReservationCreate,
+		Read:   resourceCapacityBlockReservationRead,
+		Update: resourceCapacityBlockReservationUpdate,
+		Delete: resourceCapacityBlockReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_block_offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instance_platform": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.CapacityReservationInstancePlatform_Values(), false),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outpost_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"placement_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reservation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tenancy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCapacityBlockReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.PurchaseCapacityBlockInput{
+		CapacityBlockOfferingId: aws.String(d.Get("capacity_block_offering_id").(string)),
+		InstancePlatform:        aws.String(d.Get("instance_platform").(string)),
+		TagSpecifications:       ec2TagSpecificationsFromKeyValueTags(tags, ec2ResourceTypeCapacityReservation),
+	}
+
+	log.Printf("[DEBUG] Purchasing EC2 Capacity Block: %s", input)
+	output, err := conn.PurchaseCapacityBlock(input)
+
+	if err != nil {
+		return fmt.Errorf("error purchasing EC2 Capacity Block (%s): %w", d.Get("capacity_block_offering_id").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.CapacityReservation.CapacityReservationId))
+
+	if _, err := WaitCapacityBlockReservationCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Capacity Block Reservation (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceCapacityBlockReservationRead(d, meta)
+}
+
+func resourceCapacityBlockReservationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	reservation, err := FindCapacityReservationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Capacity Block Reservation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Capacity Block Reservation (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", reservation.CapacityReservationArn)
+	d.Set("availability_zone", reservation.AvailabilityZone)
+	d.Set("created_date", "")
+	if reservation.CreateDate != nil {
+		d.Set("created_date", aws.TimeValue(reservation.CreateDate).Format(time.RFC3339))
+	}
+	d.Set("ebs_optimized", reservation.EbsOptimized)
+	d.Set("end_date", "")
+	if reservation.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(reservation.EndDate).Format(time.RFC3339))
+	}
+	d.Set("end_date_type", reservation.EndDateType)
+	d.Set("instance_count", reservation.TotalInstanceCount)
+	d.Set("instance_platform", reservation.InstancePlatform)
+	d.Set("instance_type", reservation.InstanceType)
+	d.Set("outpost_arn", reservation.OutpostArn)
+	d.Set("placement_group_arn", reservation.PlacementGroupArn)
+	d.Set("reservation_type", reservation.ReservationType)
+	d.Set("start_date", "")
+	if reservation.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(reservation.StartDate).Format(time.RFC3339))
+	}
+	d.Set("tenancy", reservation.Tenancy)
+
+	tags := KeyValueTags(reservation.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCapacityBlockReservationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Capacity Block Reservation (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCapacityBlockReservationRead(d, meta)
+}
+
+func resourceCapacityBlockReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Capacity Blocks cannot be cancelled once purchased; the reservation expires at its end date.
+	log.Printf("[WARN] EC2 Capacity Block Reservation (%s) cannot be cancelled, removing from state only", d.Id())
+
+	return nil
+}
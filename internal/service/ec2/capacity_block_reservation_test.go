@@ -0,0 +1,98 @@
+package ec2_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
+	// Capacity Blocks cannot be cancelled once purchased.
+	if os.Getenv("AWS_EC2_CAPACITY_BLOCK_PURCHASE") == "" {
+		t.Skip("Environment variable AWS_EC2_CAPACITY_BLOCK_PURCHASE is not set")
+	}
+
+	var v ec2.CapacityReservation
+	resourceName := "aws_ec2_capacity_block_reservation.test"
+	dataSourceName := "data.aws_ec2_capacity_block_offering.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockReservationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityBlockReservationExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`capacity-reservation/cr-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", dataSourceName, "availability_zone"),
+					resource.TestCheckResourceAttrPair(resourceName, "capacity_block_offering_id", dataSourceName, "capacity_block_offering_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "end_date", dataSourceName, "end_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_count", dataSourceName, "instance_count"),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", "Linux/UNIX"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type", dataSourceName, "instance_type"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_type", "capacity-block"),
+					resource.TestCheckResourceAttrPair(resourceName, "start_date", dataSourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityBlockReservationExists(n string, v *ec2.CapacityReservation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Capacity Block Reservation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindCapacityReservationByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCapacityBlockReservationConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_capacity_block_offering" "test" {
+  capacity_duration_hours = 24
+  instance_count          = 1
+  instance_type           = "p4d.24xlarge"
+}
+
+resource "aws_ec2_capacity_block_reservation" "test" {
+  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.capacity_block_offering_id
+  instance_platform          = "Linux/UNIX"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
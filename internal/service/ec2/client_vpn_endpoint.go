@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -280,13 +279,7 @@ func resourceClientVPNEndpointRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error reading EC2 Client VPN Endpoint (%s): %w", d.Id(), err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("client-vpn-endpoint/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN(ec2.ServiceName, fmt.Sprintf("client-vpn-endpoint/%s", d.Id()))
 	d.Set("arn", arn)
 	if err := d.Set("authentication_options", flattenClientVpnAuthentications(ep.AuthenticationOptions)); err != nil {
 		return fmt.Errorf("error setting authentication_options: %w", err)
@@ -6,7 +6,6 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -133,13 +132,7 @@ func resourceCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error reading EC2 Customer Gateway (%s): %w", d.Id(), err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("customer-gateway/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN(ec2.ServiceName, fmt.Sprintf("customer-gateway/%s", d.Id()))
 	d.Set("arn", arn)
 	d.Set("bgp_asn", customerGateway.BgpAsn)
 	d.Set("certificate_arn", customerGateway.CertificateArn)
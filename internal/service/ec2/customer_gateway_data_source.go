@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -75,13 +74,7 @@ func dataSourceCustomerGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(aws.StringValue(cgw.CustomerGatewayId))
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("customer-gateway/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN(ec2.ServiceName, fmt.Sprintf("customer-gateway/%s", d.Id()))
 	d.Set("arn", arn)
 	if v := aws.StringValue(cgw.BgpAsn); v != "" {
 		v, err := strconv.ParseInt(v, 0, 0)
@@ -0,0 +1,113 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceDefaultCreditSpecification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDefaultCreditSpecificationCreate,
+		Read:   resourceDefaultCreditSpecificationRead,
+		Update: resourceDefaultCreditSpecificationUpdate,
+		Delete: resourceDefaultCreditSpecificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cpu_credits": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(CPUCredits_Values(), false),
+			},
+			"instance_family": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.UnlimitedSupportedInstanceFamily_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDefaultCreditSpecificationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceFamily := d.Get("instance_family").(string)
+
+	if err := modifyDefaultCreditSpecification(conn, instanceFamily, d.Get("cpu_credits").(string)); err != nil {
+		return fmt.Errorf("error creating EC2 Default Credit Specification (%s): %w", instanceFamily, err)
+	}
+
+	d.SetId(instanceFamily)
+
+	return resourceDefaultCreditSpecificationRead(d, meta)
+}
+
+func resourceDefaultCreditSpecificationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	creditSpecification, err := FindDefaultCreditSpecificationByInstanceFamily(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Default Credit Specification (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cpu_credits", creditSpecification.CpuCredits)
+	d.Set("instance_family", creditSpecification.InstanceFamily)
+
+	return nil
+}
+
+func resourceDefaultCreditSpecificationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if err := modifyDefaultCreditSpecification(conn, d.Id(), d.Get("cpu_credits").(string)); err != nil {
+		return fmt.Errorf("error updating EC2 Default Credit Specification (%s): %w", d.Id(), err)
+	}
+
+	return resourceDefaultCreditSpecificationRead(d, meta)
+}
+
+func resourceDefaultCreditSpecificationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	// Removing the resource restores the instance family's AWS default.
+	cpuCredits := defaultCreditSpecificationCPUCredits(d.Id())
+
+	log.Printf("[DEBUG] Resetting EC2 Default Credit Specification (%s) to %s", d.Id(), cpuCredits)
+	if err := modifyDefaultCreditSpecification(conn, d.Id(), cpuCredits); err != nil {
+		return fmt.Errorf("error resetting EC2 Default Credit Specification (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func modifyDefaultCreditSpecification(conn *ec2.EC2, instanceFamily, cpuCredits string) error {
+	input := &ec2.ModifyDefaultCreditSpecificationInput{
+		CpuCredits:     aws.String(cpuCredits),
+		InstanceFamily: aws.String(instanceFamily),
+	}
+
+	log.Printf("[DEBUG] Modifying EC2 Default Credit Specification: %s", input)
+	_, err := conn.ModifyDefaultCreditSpecification(input)
+
+	return err
+}
+
+// defaultCreditSpecificationCPUCredits returns the AWS default credit option for the specified instance family.
+// T2 instances launch as standard and all later burstable families launch as unlimited.
+func defaultCreditSpecificationCPUCredits(instanceFamily string) string {
+	if instanceFamily == ec2.UnlimitedSupportedInstanceFamilyT2 {
+		return CPUCreditsStandard
+	}
+
+	return CPUCreditsUnlimited
+}
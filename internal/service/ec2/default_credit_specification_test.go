@@ -0,0 +1,106 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2DefaultCreditSpecification_basic(t *testing.T) {
+	resourceName := "aws_ec2_default_credit_specification.test"
+
+	// Default credit specifications are account-wide, so the test must not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDefaultCreditSpecificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultCreditSpecificationConfig("t3", "standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultCreditSpecification(resourceName, "standard"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_credits", "standard"),
+					resource.TestCheckResourceAttr(resourceName, "instance_family", "t3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDefaultCreditSpecificationConfig("t3", "unlimited"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultCreditSpecification(resourceName, "unlimited"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_credits", "unlimited"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDefaultCreditSpecificationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_default_credit_specification" {
+			continue
+		}
+
+		output, err := tfec2.FindDefaultCreditSpecificationByInstanceFamily(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.StringValue(output.CpuCredits), "unlimited"; got != want {
+			return fmt.Errorf("EC2 Default Credit Specification (%s) not reset on resource removal, got %s", rs.Primary.ID, got)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDefaultCreditSpecification(n, cpuCredits string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Default Credit Specification ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindDefaultCreditSpecificationByInstanceFamily(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.CpuCredits); got != cpuCredits {
+			return fmt.Errorf("EC2 Default Credit Specification (%s) is %s, expected %s", rs.Primary.ID, got, cpuCredits)
+		}
+
+		return nil
+	}
+}
+
+func testAccDefaultCreditSpecificationConfig(instanceFamily, cpuCredits string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_default_credit_specification" "test" {
+  instance_family = %[1]q
+  cpu_credits     = %[2]q
+}
+`, instanceFamily, cpuCredits)
+}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -280,14 +279,8 @@ func resourceEBSVolumeRead(d *schema.ResourceData, meta interface{}) error {
 
 	volume := response.Volumes[0]
 
-	arn := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("volume/%s", d.Id()),
-		Service:   ec2.ServiceName,
-	}
-	d.Set("arn", arn.String())
+	arn := meta.(*conns.AWSClient).RegionalARN(ec2.ServiceName, fmt.Sprintf("volume/%s", d.Id()))
+	d.Set("arn", arn)
 	d.Set("availability_zone", volume.AvailabilityZone)
 	d.Set("encrypted", volume.Encrypted)
 	d.Set("iops", volume.Iops)
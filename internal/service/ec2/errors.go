@@ -20,6 +20,7 @@ const (
 	ErrCodeInvalidAllocationIDNotFound                  = "InvalidAllocationID.NotFound"
 	ErrCodeInvalidAssociationIDNotFound                 = "InvalidAssociationID.NotFound"
 	ErrCodeInvalidAttachmentIDNotFound                  = "InvalidAttachmentID.NotFound"
+	ErrCodeInvalidCapacityReservationIdNotFound         = "InvalidCapacityReservationId.NotFound"
 	ErrCodeInvalidCarrierGatewayIDNotFound              = "InvalidCarrierGatewayID.NotFound"
 	ErrCodeInvalidClientVpnAssociationIdNotFound        = "InvalidClientVpnAssociationId.NotFound"
 	ErrCodeInvalidClientVpnAuthorizationRuleNotFound    = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
@@ -31,6 +32,7 @@ const (
 	ErrCodeInvalidGatewayIDNotFound                     = "InvalidGatewayID.NotFound"
 	ErrCodeInvalidGroupNotFound                         = "InvalidGroup.NotFound"
 	ErrCodeInvalidHostIDNotFound                        = "InvalidHostID.NotFound"
+	ErrCodeInvalidInstanceConnectEndpointIdNotFound     = "InvalidInstanceConnectEndpointId.NotFound"
 	ErrCodeInvalidInstanceIDNotFound                    = "InvalidInstanceID.NotFound"
 	ErrCodeInvalidInternetGatewayIDNotFound             = "InvalidInternetGatewayID.NotFound"
 	ErrCodeInvalidKeyPairNotFound                       = "InvalidKeyPair.NotFound"
@@ -49,6 +51,7 @@ const (
 	ErrCodeInvalidRouteTableIDNotFound                  = "InvalidRouteTableID.NotFound"
 	ErrCodeInvalidRouteTableIdNotFound                  = "InvalidRouteTableId.NotFound"
 	ErrCodeInvalidSecurityGroupIDNotFound               = "InvalidSecurityGroupID.NotFound"
+	ErrCodeInvalidSecurityGroupRuleIdNotFound           = "InvalidSecurityGroupRuleId.NotFound"
 	ErrCodeInvalidSnapshotInUse                         = "InvalidSnapshot.InUse"
 	ErrCodeInvalidSnapshotNotFound                      = "InvalidSnapshot.NotFound"
 	ErrCodeInvalidSpotInstanceRequestIDNotFound         = "InvalidSpotInstanceRequestID.NotFound"
@@ -57,6 +60,8 @@ const (
 	ErrCodeInvalidSubnetIdNotFound                      = "InvalidSubnetId.NotFound"
 	ErrCodeInvalidTransitGatewayAttachmentIDNotFound    = "InvalidTransitGatewayAttachmentID.NotFound"
 	ErrCodeInvalidTransitGatewayIDNotFound              = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayPolicyTableIdNotFound   = "InvalidTransitGatewayPolicyTableId.NotFound"
+	ErrCodeInvalidTransitGatewayPolicyTableAssociation  = "InvalidTransitGatewayPolicyTableAssociation.NotFound"
 	ErrCodeInvalidVolumeNotFound                        = "InvalidVolume.NotFound"
 	ErrCodeInvalidVpcCidrBlockAssociationIDNotFound     = "InvalidVpcCidrBlockAssociationID.NotFound"
 	ErrCodeInvalidVpcEndpointIdNotFound                 = "InvalidVpcEndpointId.NotFound"
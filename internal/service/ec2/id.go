@@ -68,6 +68,25 @@ func TransitGatewayPrefixListReferenceParseID(id string) (string, string, error)
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected transit-gateway-route-table-id%[2]sprefix-list-id", id, transitGatewayPrefixListReferenceSeparator)
 }
 
+const transitGatewayPolicyTableAssociationIDSeparator = "_"
+
+func TransitGatewayPolicyTableAssociationCreateID(transitGatewayPolicyTableID, transitGatewayAttachmentID string) string {
+	parts := []string{transitGatewayPolicyTableID, transitGatewayAttachmentID}
+	id := strings.Join(parts, transitGatewayPolicyTableAssociationIDSeparator)
+
+	return id
+}
+
+func TransitGatewayPolicyTableAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayPolicyTableAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected transit-gateway-policy-table-id%[2]stransit-gateway-attachment-id", id, transitGatewayPolicyTableAssociationIDSeparator)
+}
+
 func VPCEndpointRouteTableAssociationCreateID(vpcEndpointID, routeTableID string) string {
 	return fmt.Sprintf("a-%s%d", vpcEndpointID, create.StringHashcode(routeTableID))
 }
@@ -0,0 +1,176 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReplaceRootVolumeTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceReplaceRootVolumeTaskCreate,
+		Read:   resourceReplaceRootVolumeTaskRead,
+		Update: resourceReplaceRootVolumeTaskUpdate,
+		Delete: resourceReplaceRootVolumeTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"complete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_replaced_root_volume": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"image_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id"},
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"image_id"},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"task_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReplaceRootVolumeTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	input := &ec2.CreateReplaceRootVolumeTaskInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		InstanceId:        aws.String(instanceID),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeReplaceRootVolumeTask),
+	}
+
+	if v, ok := d.GetOk("delete_replaced_root_volume"); ok {
+		input.DeleteReplacedRootVolume = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		input.ImageId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_id"); ok {
+		input.SnapshotId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Replace Root Volume Task: %s", input)
+	output, err := conn.CreateReplaceRootVolumeTask(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Replace Root Volume Task (%s): %w", instanceID, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplaceRootVolumeTask.ReplaceRootVolumeTaskId))
+
+	if _, err := WaitReplaceRootVolumeTaskSucceeded(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Replace Root Volume Task (%s) to succeed: %w", d.Id(), err)
+	}
+
+	return resourceReplaceRootVolumeTaskRead(d, meta)
+}
+
+func resourceReplaceRootVolumeTaskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	task, err := FindReplaceRootVolumeTaskByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// The root volume has already been replaced. Removing the task from state would
+		// cause Terraform to replace the instance's root volume again on the next apply.
+		log.Printf("[WARN] EC2 Replace Root Volume Task (%s) not found, keeping last known state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Replace Root Volume Task (%s): %w", d.Id(), err)
+	}
+
+	d.Set("complete_time", task.CompleteTime)
+	d.Set("delete_replaced_root_volume", task.DeleteReplacedRootVolume)
+	d.Set("image_id", task.ImageId)
+	d.Set("instance_id", task.InstanceId)
+	d.Set("snapshot_id", task.SnapshotId)
+	d.Set("start_time", task.StartTime)
+	d.Set("task_state", task.TaskState)
+
+	tags := KeyValueTags(task.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceReplaceRootVolumeTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Replace Root Volume Task (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceReplaceRootVolumeTaskRead(d, meta)
+}
+
+func resourceReplaceRootVolumeTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	// Replace root volume tasks cannot be deleted or reverted, the task is just removed from state.
+	log.Printf("[WARN] EC2 Replace Root Volume Task (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2ReplaceRootVolumeTask_snapshot(t *testing.T) {
+	var v ec2.ReplaceRootVolumeTask
+	resourceName := "aws_ec2_replace_root_volume_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplaceRootVolumeTaskConfigSnapshot(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaceRootVolumeTaskExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "complete_time"),
+					resource.TestCheckResourceAttr(resourceName, "delete_replaced_root_volume", "true"),
+					resource.TestCheckResourceAttr(resourceName, "image_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", "aws_ebs_snapshot.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task_state", ec2.ReplaceRootVolumeTaskStateSucceeded),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2ReplaceRootVolumeTask_launchState(t *testing.T) {
+	var v ec2.ReplaceRootVolumeTask
+	resourceName := "aws_ec2_replace_root_volume_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplaceRootVolumeTaskConfigLaunchState(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaceRootVolumeTaskExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delete_replaced_root_volume", "false"),
+					resource.TestCheckResourceAttr(resourceName, "image_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "task_state", ec2.ReplaceRootVolumeTaskStateSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ReplaceRootVolumeTask_tags(t *testing.T) {
+	var v ec2.ReplaceRootVolumeTask
+	resourceName := "aws_ec2_replace_root_volume_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplaceRootVolumeTaskConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaceRootVolumeTaskExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReplaceRootVolumeTaskConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaceRootVolumeTaskExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplaceRootVolumeTaskExists(n string, v *ec2.ReplaceRootVolumeTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Replace Root Volume Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindReplaceRootVolumeTaskByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReplaceRootVolumeTaskConfigBase(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHvmEbsAmi(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [root_block_device]
+  }
+}
+`, rName))
+}
+
+func testAccReplaceRootVolumeTaskConfigSnapshot(rName string) string {
+	return acctest.ConfigCompose(testAccReplaceRootVolumeTaskConfigBase(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_instance.test.root_block_device[0].volume_id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_replace_root_volume_task" "test" {
+  delete_replaced_root_volume = true
+  instance_id                 = aws_instance.test.id
+  snapshot_id                 = aws_ebs_snapshot.test.id
+}
+`, rName))
+}
+
+func testAccReplaceRootVolumeTaskConfigLaunchState(rName string) string {
+	return acctest.ConfigCompose(testAccReplaceRootVolumeTaskConfigBase(rName), `
+resource "aws_ec2_replace_root_volume_task" "test" {
+  instance_id = aws_instance.test.id
+}
+`)
+}
+
+func testAccReplaceRootVolumeTaskConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccReplaceRootVolumeTaskConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_replace_root_volume_task" "test" {
+  instance_id = aws_instance.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccReplaceRootVolumeTaskConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccReplaceRootVolumeTaskConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_replace_root_volume_task" "test" {
+  instance_id = aws_instance.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
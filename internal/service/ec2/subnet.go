@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -27,7 +29,10 @@ func ResourceSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceSubnetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -64,10 +69,12 @@ func ResourceSubnet() *schema.Resource {
 				ConflictsWith: []string{"availability_zone"},
 			},
 			"cidr_block": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  verify.ValidIPv4CIDRNetworkAddress,
+				ConflictsWith: []string{"ipv4_netmask_length"},
 			},
 			"customer_owned_ipv4_pool": {
 				Type:         schema.TypeString,
@@ -89,6 +96,18 @@ func ResourceSubnet() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"ipv4_ipam_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ipv4_netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
+				ConflictsWith: []string{"cidr_block"},
+				RequiredWith:  []string{"ipv4_ipam_pool_id"},
+			},
 			"ipv6_cidr_block": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -163,6 +182,14 @@ func resourceSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		input.CidrBlock = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("ipv4_ipam_pool_id"); ok {
+		input.Ipv4IpamPoolId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ipv4_netmask_length"); ok {
+		input.Ipv4NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("ipv6_cidr_block"); ok {
 		input.Ipv6CidrBlock = aws.String(v.(string))
 	}
@@ -230,6 +257,26 @@ func resourceSubnetRead(d *schema.ResourceData, meta interface{}) error {
 
 	subnet := outputRaw.(*ec2.Subnet)
 
+	if d.IsNewResource() {
+		tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+		err := tftags.WaitTagsPropagated(tags, KeyValueTags(subnet.Tags), meta.(*conns.AWSClient).TagsPropagationTimeout(conns.EC2, TagsPropagationTimeout), func() (tftags.KeyValueTags, error) {
+			output, err := FindSubnetByID(conn, d.Id())
+
+			if err != nil {
+				return nil, err
+			}
+
+			subnet = output
+
+			return KeyValueTags(output.Tags), nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("error waiting for EC2 Subnet (%s) tags to propagate: %w", d.Id(), err)
+		}
+	}
+
 	d.Set("arn", subnet.SubnetArn)
 	d.Set("assign_ipv6_address_on_creation", subnet.AssignIpv6AddressOnCreation)
 	d.Set("availability_zone", subnet.AvailabilityZone)
@@ -380,6 +427,19 @@ func resourceSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceSubnetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	// cidr_block can be set by a value returned from IPAM or explicitly in config.
+	if diff.Id() != "" && diff.HasChange("cidr_block") {
+		// If netmask is set then cidr_block is derived from IPAM, ignore changes.
+		if diff.Get("ipv4_netmask_length") != 0 {
+			return diff.Clear("cidr_block")
+		}
+		return diff.ForceNew("cidr_block")
+	}
+
+	return ipv4NetmaskLengthCustomizeDiff(diff)
+}
+
 // modifySubnetAttributesOnCreate sets subnet attributes on resource Create.
 // Called after new subnet creation or existing default subnet adoption.
 func modifySubnetAttributesOnCreate(conn *ec2.EC2, d *schema.ResourceData, subnet *ec2.Subnet, computedIPv6CidrBlock bool) error {
@@ -883,6 +883,39 @@ func TestAccEC2Subnet_ipv6Native(t *testing.T) {
 	})
 }
 
+func TestAccEC2Subnet_ipamIPv4Netmask(t *testing.T) {
+	var subnet1, subnet2 ec2.Subnet
+	resourceName := "aws_subnet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccIPAMPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetConfigIPAMIPv4Netmask(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(resourceName, &subnet1),
+					resource.TestMatchResourceAttr(resourceName, "cidr_block", regexp.MustCompile(`/24$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "ipv4_ipam_pool_id", "aws_vpc_ipam_pool.vpc", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_netmask_length", "24"),
+				),
+			},
+			{
+				// Omitting the netmask length keeps the CIDR block already allocated from the pool.
+				Config: testAccSubnetConfigIPAMIPv4PoolOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(resourceName, &subnet2),
+					testAccCheckSubnetNotRecreated(t, &subnet1, &subnet2),
+					resource.TestMatchResourceAttr(resourceName, "cidr_block", regexp.MustCompile(`/24$`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSubnetIPv6BeforeUpdate(subnet *ec2.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if subnet.Ipv6CidrBlockAssociationSet == nil {
@@ -1427,3 +1460,99 @@ resource "aws_subnet" "test" {
 }
 `, rName)
 }
+
+func testAccSubnetIPAMIPv4ConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "10.1.0.0/16"
+}
+
+resource "aws_vpc" "test" {
+  ipv4_ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  ipv4_netmask_length = 20
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+
+resource "aws_vpc_ipam_pool" "vpc" {
+  address_family      = "ipv4"
+  ipam_scope_id       = aws_vpc_ipam.test.private_default_scope_id
+  locale              = data.aws_region.current.name
+  source_ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  source_resource {
+    resource_id     = aws_vpc.test.id
+    resource_owner  = aws_vpc.test.owner_id
+    resource_region = data.aws_region.current.name
+    resource_type   = "vpc"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_ipam_pool_cidr" "vpc" {
+  ipam_pool_id = aws_vpc_ipam_pool.vpc.id
+  cidr         = aws_vpc.test.cidr_block
+}
+`, rName)
+}
+
+func testAccSubnetConfigIPAMIPv4Netmask(rName string, netmaskLength int) string {
+	return acctest.ConfigCompose(testAccSubnetIPAMIPv4ConfigBase(rName), fmt.Sprintf(`
+resource "aws_subnet" "test" {
+  vpc_id              = aws_vpc.test.id
+  ipv4_ipam_pool_id   = aws_vpc_ipam_pool.vpc.id
+  ipv4_netmask_length = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_vpc_ipam_pool_cidr.vpc]
+}
+`, rName, netmaskLength))
+}
+
+func testAccSubnetConfigIPAMIPv4PoolOnly(rName string) string {
+	return acctest.ConfigCompose(testAccSubnetIPAMIPv4ConfigBase(rName), fmt.Sprintf(`
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  ipv4_ipam_pool_id = aws_vpc_ipam_pool.vpc.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_vpc_ipam_pool_cidr.vpc]
+}
+`, rName))
+}
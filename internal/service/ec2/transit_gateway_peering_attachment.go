@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -26,6 +27,24 @@ func ResourceTransitGatewayPeeringAttachment() *schema.Resource {
 		CustomizeDiff: verify.SetTagsDiff,
 
 		Schema: map[string]*schema.Schema{
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dynamic_routing": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ec2.DynamicRoutingValue_Values(), false),
+						},
+					},
+				},
+			},
 			"peer_account_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -71,6 +90,10 @@ func resourceTransitGatewayPeeringAttachmentCreate(d *schema.ResourceData, meta
 		TransitGatewayId:     aws.String(d.Get("transit_gateway_id").(string)),
 	}
 
+	if v, ok := d.GetOk("options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Options = expandCreateTransitGatewayPeeringAttachmentRequestOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Transit Gateway Peering Attachment: %s", input)
 	output, err := conn.CreateTransitGatewayPeeringAttachment(input)
 	if err != nil {
@@ -115,6 +138,10 @@ func resourceTransitGatewayPeeringAttachmentRead(d *schema.ResourceData, meta in
 		return nil
 	}
 
+	if err := d.Set("options", flattenTransitGatewayPeeringAttachmentOptions(transitGatewayPeeringAttachment.Options)); err != nil {
+		return fmt.Errorf("error setting options: %w", err)
+	}
+
 	d.Set("peer_account_id", transitGatewayPeeringAttachment.AccepterTgwInfo.OwnerId)
 	d.Set("peer_region", transitGatewayPeeringAttachment.AccepterTgwInfo.Region)
 	d.Set("peer_transit_gateway_id", transitGatewayPeeringAttachment.AccepterTgwInfo.TransitGatewayId)
@@ -172,3 +199,31 @@ func resourceTransitGatewayPeeringAttachmentDelete(d *schema.ResourceData, meta
 
 	return nil
 }
+
+func expandCreateTransitGatewayPeeringAttachmentRequestOptions(tfMap map[string]interface{}) *ec2.CreateTransitGatewayPeeringAttachmentRequestOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CreateTransitGatewayPeeringAttachmentRequestOptions{}
+
+	if v, ok := tfMap["dynamic_routing"].(string); ok && v != "" {
+		apiObject.DynamicRouting = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTransitGatewayPeeringAttachmentOptions(apiObject *ec2.TransitGatewayPeeringAttachmentOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DynamicRouting; v != nil {
+		tfMap["dynamic_routing"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -83,6 +83,40 @@ func testAccTransitGatewayPeeringAttachment_disappears(t *testing.T) {
 	})
 }
 
+func testAccTransitGatewayPeeringAttachment_options(t *testing.T) {
+	var transitGatewayPeeringAttachment ec2.TransitGatewayPeeringAttachment
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_transit_gateway_peering_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTransitGateway(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTransitGatewayPeeringAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPeeringAttachmentOptionsConfig_sameAccount(rName, ec2.DynamicRoutingValueEnable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayPeeringAttachmentExists(resourceName, &transitGatewayPeeringAttachment),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.dynamic_routing", ec2.DynamicRoutingValueEnable),
+				),
+			},
+			{
+				Config:            testAccTransitGatewayPeeringAttachmentOptionsConfig_sameAccount(rName, ec2.DynamicRoutingValueEnable),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayPeeringAttachment_Tags_sameAccount(t *testing.T) {
 	var transitGatewayPeeringAttachment ec2.TransitGatewayPeeringAttachment
 	var providers []*schema.Provider
@@ -307,6 +341,20 @@ resource "aws_ec2_transit_gateway_peering_attachment" "test" {
 `, acctest.AlternateRegion())
 }
 
+func testAccTransitGatewayPeeringAttachmentOptionsConfig_sameAccount(rName, dynamicRouting string) string {
+	return testAccTransitGatewayPeeringAttachmentConfig_sameAccount_base(rName) + fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_peering_attachment" "test" {
+  peer_region             = %[1]q
+  peer_transit_gateway_id = aws_ec2_transit_gateway.peer.id
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+
+  options {
+    dynamic_routing = %[2]q
+  }
+}
+`, acctest.AlternateRegion(), dynamicRouting)
+}
+
 func testAccTransitGatewayPeeringAttachmentTags1Config_sameAccount(rName, tagKey1, tagValue1 string) string {
 	return testAccTransitGatewayPeeringAttachmentConfig_sameAccount_base(rName) + fmt.Sprintf(`
 resource "aws_ec2_transit_gateway_peering_attachment" "test" {
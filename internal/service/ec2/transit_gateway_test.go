@@ -35,6 +35,7 @@ func TestAccEC2TransitGateway_serial(t *testing.T) {
 			"basic":            testAccTransitGatewayPeeringAttachment_basic,
 			"disappears":       testAccTransitGatewayPeeringAttachment_disappears,
 			"DifferentAccount": testAccTransitGatewayPeeringAttachment_differentAccount,
+			"Options":          testAccTransitGatewayPeeringAttachment_options,
 			"TagsSameAccount":  testAccTransitGatewayPeeringAttachment_Tags_sameAccount,
 		},
 		"PeeringAttachmentAccepter": {
@@ -42,6 +43,15 @@ func TestAccEC2TransitGateway_serial(t *testing.T) {
 			"TagsSameAccount":       testAccTransitGatewayPeeringAttachmentAccepter_Tags_sameAccount,
 			"basicDifferentAccount": testAccTransitGatewayPeeringAttachmentAccepter_basic_differentAccount,
 		},
+		"PolicyTable": {
+			"basic":      testAccTransitGatewayPolicyTable_basic,
+			"disappears": testAccTransitGatewayPolicyTable_disappears,
+			"Tags":       testAccTransitGatewayPolicyTable_Tags,
+		},
+		"PolicyTableAssociation": {
+			"basic":      testAccTransitGatewayPolicyTableAssociation_basic,
+			"disappears": testAccTransitGatewayPolicyTableAssociation_disappears,
+		},
 		"PrefixListReference": {
 			"basic":                      testAccTransitGatewayPrefixListReference_basic,
 			"disappears":                 testAccTransitGatewayPrefixListReference_disappears,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"strconv"
 	"strings"
 
@@ -115,7 +116,6 @@ func ResourceVPC() *schema.Resource {
 			"ipv4_netmask_length": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ForceNew:      true,
 				ValidateFunc:  validation.IntBetween(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
 				ConflictsWith: []string{"cidr_block"},
 				RequiredWith:  []string{"ipv4_ipam_pool_id"},
@@ -151,6 +151,7 @@ func ResourceVPC() *schema.Resource {
 			"ipv6_netmask_length": {
 				Type:          schema.TypeInt,
 				Optional:      true,
+				Computed:      true,
 				ValidateFunc:  validation.IntInSlice([]int{VPCCIDRMaxIPv6}),
 				ConflictsWith: []string{"ipv6_cidr_block"},
 				RequiredWith:  []string{"ipv6_ipam_pool_id"},
@@ -269,6 +270,26 @@ func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
 
 	vpc := outputRaw.(*ec2.Vpc)
 
+	if d.IsNewResource() {
+		tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+		err := tftags.WaitTagsPropagated(tags, KeyValueTags(vpc.Tags), meta.(*conns.AWSClient).TagsPropagationTimeout(conns.EC2, TagsPropagationTimeout), func() (tftags.KeyValueTags, error) {
+			output, err := FindVPCByID(conn, d.Id())
+
+			if err != nil {
+				return nil, err
+			}
+
+			vpc = output
+
+			return KeyValueTags(output.Tags), nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("error waiting for EC2 VPC (%s) tags to propagate: %w", d.Id(), err)
+		}
+	}
+
 	ownerID := aws.StringValue(vpc.OwnerId)
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -361,7 +382,7 @@ func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
 		if ipv6PoolID != "" && !isAmazonIPv6Pool {
 			parts := strings.Split(cidrBlock, "/")
 			if len(parts) == 2 {
-				if v, err := strconv.Atoi(parts[1]); err != nil {
+				if v, err := strconv.Atoi(parts[1]); err == nil {
 					d.Set("ipv6_netmask_length", v)
 				} else {
 					log.Printf("[WARN] Unable to parse CIDR (%s) netmask length: %s", cidrBlock, err)
@@ -507,7 +528,40 @@ func resourceVPCCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v in
 		return diff.ForceNew("cidr_block")
 	}
 
-	return nil
+	return ipv4NetmaskLengthCustomizeDiff(diff)
+}
+
+// ipv4NetmaskLengthCustomizeDiff forces replacement on a change to ipv4_netmask_length
+// unless the CIDR block already allocated from IPAM has the requested netmask length.
+func ipv4NetmaskLengthCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("ipv4_netmask_length") {
+		return nil
+	}
+
+	// Switching to an explicit cidr_block is handled by cidr_block's own diff.
+	v := diff.Get("ipv4_netmask_length").(int)
+	if v == 0 {
+		return nil
+	}
+
+	if o, _ := diff.GetChange("cidr_block"); cidrBlockNetmaskLength(o.(string)) == v {
+		return nil
+	}
+
+	return diff.ForceNew("ipv4_netmask_length")
+}
+
+// cidrBlockNetmaskLength returns the netmask length of the specified CIDR block, or 0 if it is invalid.
+func cidrBlockNetmaskLength(cidrBlock string) int {
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+
+	if err != nil {
+		return 0
+	}
+
+	ones, _ := ipNet.Mask.Size()
+
+	return ones
 }
 
 // defaultIPv6CIDRBlockAssociation returns the "default" IPv6 CIDR block.
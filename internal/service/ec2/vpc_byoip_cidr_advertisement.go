@@ -0,0 +1,148 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCByoipCidrAdvertisement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCByoipCidrAdvertisementCreate,
+		Read:   resourceVPCByoipCidrAdvertisementRead,
+		Delete: resourceVPCByoipCidrAdvertisementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					verify.ValidIPv4CIDRNetworkAddress,
+					verify.ValidIPv6CIDRNetworkAddress,
+				),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_border_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVPCByoipCidrAdvertisementCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	cidr := d.Get("cidr").(string)
+
+	// A CIDR that was just provisioned to an IPAM pool may still be pending provisioning.
+	if _, err := WaitByoipCidrProvisioned(conn, cidr, ByoipCidrProvisionedTimeout); err != nil {
+		return fmt.Errorf("error waiting for BYOIP CIDR (%s) provision: %w", cidr, err)
+	}
+
+	input := &ec2.AdvertiseByoipCidrInput{
+		Cidr: aws.String(cidr),
+	}
+
+	if v, ok := d.GetOk("asn"); ok {
+		input.Asn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_border_group"); ok {
+		input.NetworkBorderGroup = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Advertising BYOIP CIDR: %s", input)
+	_, err := conn.AdvertiseByoipCidr(input)
+
+	if err != nil {
+		return fmt.Errorf("error advertising BYOIP CIDR (%s): %w", cidr, err)
+	}
+
+	d.SetId(cidr)
+
+	if _, err := WaitByoipCidrAdvertised(conn, d.Id(), ByoipCidrAdvertisedTimeout); err != nil {
+		return fmt.Errorf("error waiting for BYOIP CIDR (%s) advertise: %w", d.Id(), err)
+	}
+
+	return resourceVPCByoipCidrAdvertisementRead(d, meta)
+}
+
+func resourceVPCByoipCidrAdvertisementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	byoipCidr, err := FindByoipCidrByCidr(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] BYOIP CIDR %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading BYOIP CIDR (%s): %w", d.Id(), err)
+	}
+
+	// The CIDR is still provisioned but is no longer advertised.
+	if state := aws.StringValue(byoipCidr.State); !d.IsNewResource() && state != ec2.ByoipCidrStateAdvertised {
+		log.Printf("[WARN] BYOIP CIDR %s is %s, removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cidr", byoipCidr.Cidr)
+	d.Set("description", byoipCidr.Description)
+	d.Set("network_border_group", byoipCidr.NetworkBorderGroup)
+	d.Set("state", byoipCidr.State)
+
+	return nil
+}
+
+func resourceVPCByoipCidrAdvertisementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Withdrawing BYOIP CIDR: %s", d.Id())
+	_, err := conn.WithdrawByoipCidr(&ec2.WithdrawByoipCidrInput{
+		Cidr: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		// Nothing to do if the CIDR has been deprovisioned or is no longer advertised.
+		if byoipCidr, findErr := FindByoipCidrByCidr(conn, d.Id()); tfresource.NotFound(findErr) || (findErr == nil && aws.StringValue(byoipCidr.State) != ec2.ByoipCidrStateAdvertised) {
+			return nil
+		}
+
+		return fmt.Errorf("error withdrawing BYOIP CIDR (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitByoipCidrWithdrawn(conn, d.Id(), ByoipCidrWithdrawnTimeout); err != nil {
+		return fmt.Errorf("error waiting for BYOIP CIDR (%s) withdraw: %w", d.Id(), err)
+	}
+
+	return nil
+}
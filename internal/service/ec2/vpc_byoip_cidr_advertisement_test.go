@@ -0,0 +1,109 @@
+package ec2_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The CIDR must already have been provisioned to the account with ProvisionByoipCidr.
+func TestAccVPCByoipCidrAdvertisement_basic(t *testing.T) {
+	cidr := os.Getenv("IPAM_BYOIP_IPV4_PROVISIONED_CIDR")
+	if cidr == "" {
+		t.Skip("Environment variable IPAM_BYOIP_IPV4_PROVISIONED_CIDR is not set")
+	}
+
+	resourceName := "aws_vpc_byoip_cidr_advertisement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCByoipCidrAdvertisementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCByoipCidrAdvertisementConfig(cidr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCByoipCidrAdvertisementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr", cidr),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ByoipCidrStateAdvertised),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCByoipCidrAdvertisementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No BYOIP CIDR ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindByoipCidrByCidr(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if state := aws.StringValue(output.State); state != ec2.ByoipCidrStateAdvertised {
+			return fmt.Errorf("BYOIP CIDR %s is %s", rs.Primary.ID, state)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCByoipCidrAdvertisementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_byoip_cidr_advertisement" {
+			continue
+		}
+
+		output, err := tfec2.FindByoipCidrByCidr(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.State) == ec2.ByoipCidrStateAdvertised {
+			return fmt.Errorf("BYOIP CIDR %s still advertised", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccVPCByoipCidrAdvertisementConfig(cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_byoip_cidr_advertisement" "test" {
+  cidr = %[1]q
+}
+`, cidr)
+}
@@ -58,11 +58,16 @@ func TestAccVPCIpam_ByoipIPv6(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					acctest.CheckVPCExists(resourceName, &vpc),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`vpc/vpc-.+`)),
-					resource.TestCheckNoResourceAttr(resourceName, "ipv6_netmask_length"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_netmask_length", "56"),
 					resource.TestMatchResourceAttr(resourceName, "ipv6_association_id", regexp.MustCompile(`^vpc-cidr-assoc-.+`)),
 					resource.TestMatchResourceAttr(resourceName, "ipv6_cidr_block", regexp.MustCompile(`/56$`)),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// disassociate ipv6
 			{
 				Config: testAccVPCIpamIPv6ByoipCIDRBase(p, m, s),
@@ -40,6 +40,13 @@ func ResourceVPCIpamPool() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ec2.AddressFamily_Values(), false),
 			},
+			"public_ip_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.IpamPoolPublicIpSource_Values(), false),
+			},
 			"publicly_advertisable": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -103,6 +110,39 @@ func ResourceVPCIpamPool() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"source_resource": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"resource_owner": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"resource_region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ec2.IpamPoolSourceResourceType_Values(), false),
+						},
+					},
+				},
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -170,10 +210,18 @@ func ResourceVPCIpamPoolCreate(d *schema.ResourceData, meta interface{}) error {
 		input.AwsService = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("public_ip_source"); ok {
+		input.PublicIpSource = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("source_ipam_pool_id"); ok {
 		input.SourceIpamPoolId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("source_resource"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceResource = expandIpamPoolSourceResourceRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating IPAM Pool: %s", input)
 	output, err := conn.CreateIpamPool(input)
 	if err != nil {
@@ -217,8 +265,16 @@ func ResourceVPCIpamPoolRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ipam_scope_type", pool.IpamScopeType)
 	d.Set("locale", pool.Locale)
 	d.Set("pool_depth", pool.PoolDepth)
+	d.Set("public_ip_source", pool.PublicIpSource)
 	d.Set("publicly_advertisable", pool.PubliclyAdvertisable)
 	d.Set("source_ipam_pool_id", pool.SourceIpamPoolId)
+	if pool.SourceResource != nil {
+		if err := d.Set("source_resource", []interface{}{flattenIpamPoolSourceResource(pool.SourceResource)}); err != nil {
+			return fmt.Errorf("error setting source_resource: %w", err)
+		}
+	} else {
+		d.Set("source_resource", nil)
+	}
 	d.Set("state", pool.State)
 
 	tags := KeyValueTags(pool.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
@@ -439,3 +495,55 @@ func ec2TagsFromIpamAllocationTags(rts []*ec2.IpamResourceTag) []*ec2.Tag {
 
 	return tags
 }
+
+func expandIpamPoolSourceResourceRequest(tfMap map[string]interface{}) *ec2.IpamPoolSourceResourceRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.IpamPoolSourceResourceRequest{}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_owner"].(string); ok && v != "" {
+		apiObject.ResourceOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_region"].(string); ok && v != "" {
+		apiObject.ResourceRegion = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_type"].(string); ok && v != "" {
+		apiObject.ResourceType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIpamPoolSourceResource(apiObject *ec2.IpamPoolSourceResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ResourceId; v != nil {
+		tfMap["resource_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceOwner; v != nil {
+		tfMap["resource_owner"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceRegion; v != nil {
+		tfMap["resource_region"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceType; v != nil {
+		tfMap["resource_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
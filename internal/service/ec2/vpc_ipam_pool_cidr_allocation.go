@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -87,7 +89,11 @@ func ResourceVPCIpamPoolCidrAllocation() *schema.Resource {
 }
 
 const (
-	IpamPoolAllocationNotFound = "InvalidIpamPoolCidrAllocationId.NotFound"
+	IpamPoolAllocationNotFound          = "InvalidIpamPoolCidrAllocationId.NotFound"
+	IpamPoolCidrAllocationCreateTimeout = 5 * time.Minute
+	IpamPoolCidrAllocationCreatedDelay  = 5 * time.Second
+	// ipamPoolCidrAllocationStateCreated is a synthetic state; allocations have no state of their own.
+	ipamPoolCidrAllocationStateCreated = "created"
 )
 
 func resourceVPCIpamPoolCidrAllocationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -116,12 +122,20 @@ func resourceVPCIpamPoolCidrAllocationCreate(d *schema.ResourceData, meta interf
 	}
 
 	log.Printf("[DEBUG] Creating IPAM Pool Allocation: %s", input)
-	output, err := conn.AllocateIpamPoolCidr(input)
+	// The pool's CIDRs may still be provisioning, e.g. a BYOIP CIDR that has not finished provisioning.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(IpamPoolCidrCreateTimeout, func() (interface{}, error) {
+		return conn.AllocateIpamPoolCidr(input)
+	}, ErrCodeIncorrectState)
 	if err != nil {
 		return fmt.Errorf("Error allocating cidr from IPAM pool (%s): %w", d.Get("ipam_pool_id").(string), err)
 	}
+	output := outputRaw.(*ec2.AllocateIpamPoolCidrOutput)
 	d.SetId(encodeIpamPoolCidrAllocationID(aws.StringValue(output.IpamPoolAllocation.IpamPoolAllocationId), pool_id))
 
+	if _, err := WaitIpamPoolCidrAllocationCreated(conn, d.Id(), IpamPoolCidrAllocationCreateTimeout); err != nil {
+		return fmt.Errorf("error waiting for IPAM Pool Cidr Allocation (%s) create: %w", d.Id(), err)
+	}
+
 	return resourceVPCIpamPoolCidrAllocationRead(d, meta)
 }
 
@@ -209,6 +223,44 @@ func FindIpamPoolCidrAllocation(conn *ec2.EC2, id string) (*ec2.IpamPoolAllocati
 	return output.IpamPoolAllocations[0], pool_id, nil
 }
 
+// WaitIpamPoolCidrAllocationCreated waits for a new allocation to become visible in the pool.
+// Allocations are eventually consistent and may not be returned by GetIpamPoolAllocations immediately.
+func WaitIpamPoolCidrAllocationCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.IpamPoolAllocation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{ipamPoolCidrAllocationStateCreated},
+		Refresh:                   statusIpamPoolCidrAllocation(conn, id),
+		Timeout:                   timeout,
+		Delay:                     IpamPoolCidrAllocationCreatedDelay,
+		NotFoundChecks:            int(timeout / IpamPoolCidrAllocationCreatedDelay),
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.IpamPoolAllocation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusIpamPoolCidrAllocation(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, _, err := FindIpamPoolCidrAllocation(conn, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, ipamPoolCidrAllocationStateCreated, nil
+	}
+}
+
 func encodeIpamPoolCidrAllocationID(allocation_id, pool_id string) string {
 	return fmt.Sprintf("%s_%s", allocation_id, pool_id)
 }
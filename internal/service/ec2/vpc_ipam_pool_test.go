@@ -125,6 +125,38 @@ func TestAccVPCIpamPool_ipv6Basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCIpamPool_sourceResource(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccIPAMPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIpamPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIpamPool_sourceResource,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIpamPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttrPair(resourceName, "source_ipam_pool_id", "aws_vpc_ipam_pool.parent", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_resource.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_resource.0.resource_id", vpcResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_resource.0.resource_owner", vpcResourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_resource.0.resource_region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "source_resource.0.resource_type", "vpc"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckVPCIpamPoolExists(n string, pool *ec2.IpamPool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -209,6 +241,40 @@ resource "aws_vpc_ipam_pool" "test" {
 }
 `
 
+const testAccVPCIpamPool_sourceResource = testAccVPCIpamPoolBase + `
+resource "aws_vpc_ipam_pool" "parent" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+}
+
+resource "aws_vpc_ipam_pool_cidr" "parent" {
+  ipam_pool_id = aws_vpc_ipam_pool.parent.id
+  cidr         = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "test" {
+  ipv4_ipam_pool_id   = aws_vpc_ipam_pool.parent.id
+  ipv4_netmask_length = 24
+
+  depends_on = [aws_vpc_ipam_pool_cidr.parent]
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family      = "ipv4"
+  ipam_scope_id       = aws_vpc_ipam.test.private_default_scope_id
+  locale              = data.aws_region.current.name
+  source_ipam_pool_id = aws_vpc_ipam_pool.parent.id
+
+  source_resource {
+    resource_id     = aws_vpc.test.id
+    resource_owner  = aws_vpc.test.owner_id
+    resource_region = data.aws_region.current.name
+    resource_type   = "vpc"
+  }
+}
+`
+
 func testAccVPCIpamPoolTagsConfig(tagKey1, tagValue1 string) string {
 	return testAccVPCIpamPoolBase + fmt.Sprintf(`
 resource "aws_vpc_ipam_pool" "test" {
@@ -0,0 +1,90 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSecurityGroupEgressRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityGroupEgressRuleCreate,
+		Read:   resourceSecurityGroupEgressRuleRead,
+		Update: resourceSecurityGroupEgressRuleUpdate,
+		Delete: resourceSecurityGroupEgressRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: vpcSecurityGroupRuleSchema(),
+	}
+}
+
+func resourceSecurityGroupEgressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	input := &ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId:           aws.String(securityGroupID),
+		IpPermissions:     []*ec2.IpPermission{expandVPCSecurityGroupRuleIPPermission(d)},
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule),
+	}
+
+	log.Printf("[DEBUG] Creating VPC Security Group Egress Rule: %s", input)
+	output, err := conn.AuthorizeSecurityGroupEgress(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Security Group (%s) Egress Rule: %w", securityGroupID, err)
+	}
+
+	if output == nil || len(output.SecurityGroupRules) == 0 {
+		return fmt.Errorf("error creating VPC Security Group (%s) Egress Rule: empty result", securityGroupID)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroupRules[0].SecurityGroupRuleId))
+
+	return resourceSecurityGroupEgressRuleRead(d, meta)
+}
+
+func resourceSecurityGroupEgressRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return vpcSecurityGroupRuleRead(d, meta, true)
+}
+
+func resourceSecurityGroupEgressRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := vpcSecurityGroupRuleUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return resourceSecurityGroupEgressRuleRead(d, meta)
+}
+
+func resourceSecurityGroupEgressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting VPC Security Group Egress Rule: %s", d.Id())
+	_, err := conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+		GroupId:              aws.String(d.Get("security_group_id").(string)),
+		SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidGroupNotFound, ErrCodeInvalidSecurityGroupIDNotFound, ErrCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Security Group Egress Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
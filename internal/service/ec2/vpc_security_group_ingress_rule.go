@@ -0,0 +1,341 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSecurityGroupIngressRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityGroupIngressRuleCreate,
+		Read:   resourceSecurityGroupIngressRuleRead,
+		Update: resourceSecurityGroupIngressRuleUpdate,
+		Delete: resourceSecurityGroupIngressRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: vpcSecurityGroupRuleSchema(),
+	}
+}
+
+func resourceSecurityGroupIngressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	input := &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:           aws.String(securityGroupID),
+		IpPermissions:     []*ec2.IpPermission{expandVPCSecurityGroupRuleIPPermission(d)},
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule),
+	}
+
+	log.Printf("[DEBUG] Creating VPC Security Group Ingress Rule: %s", input)
+	output, err := conn.AuthorizeSecurityGroupIngress(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Security Group (%s) Ingress Rule: %w", securityGroupID, err)
+	}
+
+	if output == nil || len(output.SecurityGroupRules) == 0 {
+		return fmt.Errorf("error creating VPC Security Group (%s) Ingress Rule: empty result", securityGroupID)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroupRules[0].SecurityGroupRuleId))
+
+	return resourceSecurityGroupIngressRuleRead(d, meta)
+}
+
+func resourceSecurityGroupIngressRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return vpcSecurityGroupRuleRead(d, meta, false)
+}
+
+func resourceSecurityGroupIngressRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := vpcSecurityGroupRuleUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return resourceSecurityGroupIngressRuleRead(d, meta)
+}
+
+func resourceSecurityGroupIngressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting VPC Security Group Ingress Rule: %s", d.Id())
+	_, err := conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId:              aws.String(d.Get("security_group_id").(string)),
+		SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidGroupNotFound, ErrCodeInvalidSecurityGroupIDNotFound, ErrCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Security Group Ingress Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// vpcSecurityGroupRuleSchema returns the schema shared by the
+// aws_vpc_security_group_ingress_rule and aws_vpc_security_group_egress_rule resources.
+func vpcSecurityGroupRuleSchema() map[string]*schema.Schema {
+	sources := []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"}
+
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cidr_ipv4": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			ExactlyOneOf: sources,
+		},
+		"cidr_ipv6": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+			ExactlyOneOf: sources,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"from_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(-1, 65535),
+		},
+		"ip_protocol": {
+			Type:      schema.TypeString,
+			Required:  true,
+			StateFunc: ProtocolStateFunc,
+		},
+		"prefix_list_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: sources,
+		},
+		"referenced_security_group_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: sources,
+		},
+		"security_group_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"security_group_rule_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags":     tftags.TagsSchema(),
+		"tags_all": tftags.TagsSchemaComputed(),
+		"to_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(-1, 65535),
+		},
+	}
+}
+
+func vpcSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	ruleType := "Ingress"
+	if isEgress {
+		ruleType = "Egress"
+	}
+
+	securityGroupRule, err := FindSecurityGroupRuleByID(conn, d.Id())
+
+	if err == nil && aws.BoolValue(securityGroupRule.IsEgress) != isEgress {
+		err = tfresource.NewEmptyResultError(d.Id())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group %s Rule %s not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Security Group %s Rule (%s): %w", ruleType, d.Id(), err)
+	}
+
+	arn := meta.(*conns.AWSClient).RegionalARN(ec2.ServiceName, fmt.Sprintf("security-group-rule/%s", d.Id()))
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", securityGroupRule.CidrIpv4)
+	d.Set("cidr_ipv6", securityGroupRule.CidrIpv6)
+	d.Set("description", securityGroupRule.Description)
+	d.Set("prefix_list_id", securityGroupRule.PrefixListId)
+	if securityGroupRule.ReferencedGroupInfo != nil {
+		d.Set("referenced_security_group_id", securityGroupRule.ReferencedGroupInfo.GroupId)
+	} else {
+		d.Set("referenced_security_group_id", nil)
+	}
+	d.Set("security_group_id", securityGroupRule.GroupId)
+	d.Set("security_group_rule_id", securityGroupRule.SecurityGroupRuleId)
+
+	ipProtocol := ProtocolForValue(aws.StringValue(securityGroupRule.IpProtocol))
+	d.Set("ip_protocol", ipProtocol)
+	// Ports are not applicable to all protocols and are reported as -1.
+	if ipProtocol != "-1" {
+		d.Set("from_port", securityGroupRule.FromPort)
+		d.Set("to_port", securityGroupRule.ToPort)
+	} else {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	}
+
+	tags := KeyValueTags(securityGroupRule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func vpcSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		ipPermission := expandVPCSecurityGroupRuleIPPermission(d)
+		securityGroupRuleRequest := &ec2.SecurityGroupRuleRequest{
+			Description: aws.String(d.Get("description").(string)),
+			FromPort:    ipPermission.FromPort,
+			IpProtocol:  ipPermission.IpProtocol,
+			ToPort:      ipPermission.ToPort,
+		}
+
+		if v, ok := d.GetOk("cidr_ipv4"); ok {
+			securityGroupRuleRequest.CidrIpv4 = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("cidr_ipv6"); ok {
+			securityGroupRuleRequest.CidrIpv6 = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("prefix_list_id"); ok {
+			securityGroupRuleRequest.PrefixListId = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("referenced_security_group_id"); ok {
+			securityGroupRuleRequest.ReferencedGroupId = aws.String(v.(string))
+		}
+
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   securityGroupRuleRequest,
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		log.Printf("[DEBUG] Updating VPC Security Group Rule: %s", input)
+		_, err := conn.ModifySecurityGroupRules(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating VPC Security Group Rule (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Security Group Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandVPCSecurityGroupRuleIPPermission(d *schema.ResourceData) *ec2.IpPermission {
+	ipProtocol := ProtocolForValue(d.Get("ip_protocol").(string))
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(ipProtocol),
+	}
+
+	if ipProtocol != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	description := d.Get("description").(string)
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		ipRange := &ec2.IpRange{
+			CidrIp: aws.String(v.(string)),
+		}
+
+		if description != "" {
+			ipRange.Description = aws.String(description)
+		}
+
+		apiObject.IpRanges = []*ec2.IpRange{ipRange}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		ipv6Range := &ec2.Ipv6Range{
+			CidrIpv6: aws.String(v.(string)),
+		}
+
+		if description != "" {
+			ipv6Range.Description = aws.String(description)
+		}
+
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{ipv6Range}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		prefixListID := &ec2.PrefixListId{
+			PrefixListId: aws.String(v.(string)),
+		}
+
+		if description != "" {
+			prefixListID.Description = aws.String(description)
+		}
+
+		apiObject.PrefixListIds = []*ec2.PrefixListId{prefixListID}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		userIDGroupPair := &ec2.UserIdGroupPair{
+			GroupId: aws.String(v.(string)),
+		}
+
+		if description != "" {
+			userIDGroupPair.Description = aws.String(description)
+		}
+
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{userIDGroupPair}
+	}
+
+	return apiObject
+}
@@ -898,6 +898,41 @@ func TestAccVPC_IpamIpv4BasicNetmask(t *testing.T) {
 	})
 }
 
+func TestAccVPC_IpamIpv4NetmaskOmitted(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var vpc1, vpc2 ec2.Vpc
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccIPAMPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcIpamIpv4(rName, 28),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(resourceName, &vpc1),
+					testAccCheckVpcCidrPrefix(&vpc1, "28"),
+				),
+			},
+			{
+				// Omitting the netmask length keeps the CIDR block already allocated from the pool.
+				Config: testAccVpcIpamIpv4PoolOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(resourceName, &vpc2),
+					testAccCheckVpcIdsEqual(&vpc1, &vpc2),
+					testAccCheckVpcCidrPrefix(&vpc2, "28"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPC_IpamIpv4BasicExplicitCidr(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1259,3 +1294,17 @@ resource "aws_vpc" "test" {
 }
 `, rName, cidr))
 }
+
+func testAccVpcIpamIpv4PoolOnly(rName string) string {
+	return acctest.ConfigCompose(testAccVpcIPv4IPAMConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  ipv4_ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, rName))
+}
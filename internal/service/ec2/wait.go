@@ -22,6 +22,9 @@ const (
 	// General timeout for EC2 resource creations to propagate
 	PropagationTimeout = 2 * time.Minute
 
+	// Maximum amount of time to wait for tags applied on EC2 resource creation to propagate
+	TagsPropagationTimeout = 2 * time.Minute
+
 	RouteNotFoundChecks                        = 1000 // Should exceed any reasonable custom timeout value.
 	RouteTableNotFoundChecks                   = 1000 // Should exceed any reasonable custom timeout value.
 	RouteTableAssociationCreatedNotFoundChecks = 1000 // Should exceed any reasonable custom timeout value.
@@ -29,6 +32,23 @@ const (
 	InternetGatewayNotFoundChecks              = 1000 // Should exceed any reasonable custom timeout value.
 )
 
+func WaitCapacityBlockReservationCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationStatePaymentPending},
+		Target:  []string{ec2.CapacityReservationStateActive, ec2.CapacityReservationStateScheduled},
+		Refresh: StatusCapacityReservationState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
@@ -751,6 +771,81 @@ func WaitTransitGatewayRouteTablePropagationStateDisabled(conn *ec2.EC2, transit
 	return nil, err
 }
 
+const (
+	TransitGatewayPolicyTableCreatedTimeout            = 10 * time.Minute
+	TransitGatewayPolicyTableDeletedTimeout            = 10 * time.Minute
+	TransitGatewayPolicyTableAssociationCreatedTimeout = 5 * time.Minute
+	TransitGatewayPolicyTableAssociationDeletedTimeout = 5 * time.Minute
+)
+
+func WaitTransitGatewayPolicyTableCreated(conn *ec2.EC2, id string) (*ec2.TransitGatewayPolicyTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPolicyTableStatePending},
+		Target:  []string{ec2.TransitGatewayPolicyTableStateAvailable},
+		Timeout: TransitGatewayPolicyTableCreatedTimeout,
+		Refresh: StatusTransitGatewayPolicyTableState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayPolicyTableDeleted(conn *ec2.EC2, id string) (*ec2.TransitGatewayPolicyTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPolicyTableStateAvailable, ec2.TransitGatewayPolicyTableStateDeleting},
+		Target:  []string{},
+		Timeout: TransitGatewayPolicyTableDeletedTimeout,
+		Refresh: StatusTransitGatewayPolicyTableState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayPolicyTableAssociationCreated(conn *ec2.EC2, transitGatewayPolicyTableID, transitGatewayAttachmentID string) (*ec2.TransitGatewayPolicyTableAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAssociationStateAssociating},
+		Target:  []string{ec2.TransitGatewayAssociationStateAssociated},
+		Timeout: TransitGatewayPolicyTableAssociationCreatedTimeout,
+		Refresh: StatusTransitGatewayPolicyTableAssociationState(conn, transitGatewayPolicyTableID, transitGatewayAttachmentID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTableAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayPolicyTableAssociationDeleted(conn *ec2.EC2, transitGatewayPolicyTableID, transitGatewayAttachmentID string) (*ec2.TransitGatewayPolicyTableAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAssociationStateAssociated, ec2.TransitGatewayAssociationStateDisassociating},
+		Target:  []string{},
+		Timeout: TransitGatewayPolicyTableAssociationDeletedTimeout,
+		Refresh: StatusTransitGatewayPolicyTableAssociationState(conn, transitGatewayPolicyTableID, transitGatewayAttachmentID),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTableAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	vpcAttributePropagationTimeout = 5 * time.Minute
 	vpcCreatedTimeout              = 10 * time.Minute
@@ -1179,6 +1274,72 @@ func WaitHostDeleted(conn *ec2.EC2, id string) (*ec2.Host, error) {
 	return nil, err
 }
 
+const (
+	instanceConnectEndpointCreatedTimeout = 10 * time.Minute
+	instanceConnectEndpointDeletedTimeout = 10 * time.Minute
+)
+
+func WaitInstanceConnectEndpointCreated(conn *ec2.EC2, id string) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.Ec2InstanceConnectEndpointStateCreateInProgress},
+		Target:  []string{ec2.Ec2InstanceConnectEndpointStateCreateComplete},
+		Refresh: StatusInstanceConnectEndpointState(conn, id),
+		Timeout: instanceConnectEndpointCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Ec2InstanceConnectEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInstanceConnectEndpointDeleted(conn *ec2.EC2, id string) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.Ec2InstanceConnectEndpointStateDeleteInProgress},
+		Target:  []string{},
+		Refresh: StatusInstanceConnectEndpointState(conn, id),
+		Timeout: instanceConnectEndpointDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Ec2InstanceConnectEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitReplaceRootVolumeTaskSucceeded(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ReplaceRootVolumeTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.ReplaceRootVolumeTaskStatePending,
+			ec2.ReplaceRootVolumeTaskStateInProgress,
+			ec2.ReplaceRootVolumeTaskStateFailing,
+		},
+		Target:     []string{ec2.ReplaceRootVolumeTaskStateSucceeded},
+		Refresh:    StatusReplaceRootVolumeTaskState(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ReplaceRootVolumeTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	dhcpOptionSetDeletedTimeout = 3 * time.Minute
 )
@@ -1580,3 +1741,77 @@ func WaitVolumeAttachmentAttached(conn *ec2.EC2, name, volumeID, instanceID stri
 
 	return err
 }
+
+const (
+	ByoipCidrProvisionedTimeout = 30 * time.Minute
+	ByoipCidrAdvertisedTimeout  = 10 * time.Minute
+	ByoipCidrWithdrawnTimeout   = 10 * time.Minute
+)
+
+// WaitByoipCidrProvisioned waits for a BYOIP CIDR to finish provisioning.
+// A CIDR that is provisioned but not (or not yet) advertised is considered provisioned.
+func WaitByoipCidrProvisioned(conn *ec2.EC2, cidr string, timeout time.Duration) (*ec2.ByoipCidr, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ByoipCidrStatePendingProvision},
+		Target: []string{
+			ec2.ByoipCidrStateAdvertised,
+			ec2.ByoipCidrStateProvisioned,
+			ec2.ByoipCidrStateProvisionedNotPubliclyAdvertisable,
+		},
+		Refresh: StatusByoipCidrState(conn, cidr),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ByoipCidr); ok {
+		if state := aws.StringValue(output.State); state == ec2.ByoipCidrStateFailedProvision {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitByoipCidrAdvertised(conn *ec2.EC2, cidr string, timeout time.Duration) (*ec2.ByoipCidr, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ByoipCidrStateProvisioned},
+		Target:  []string{ec2.ByoipCidrStateAdvertised},
+		Refresh: StatusByoipCidrState(conn, cidr),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ByoipCidr); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitByoipCidrWithdrawn(conn *ec2.EC2, cidr string, timeout time.Duration) (*ec2.ByoipCidr, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ByoipCidrStateAdvertised},
+		Target:  []string{ec2.ByoipCidrStateProvisioned},
+		Refresh: StatusByoipCidrState(conn, cidr),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ByoipCidr); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
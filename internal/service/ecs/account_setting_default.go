@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,13 +44,7 @@ func ResourceAccountSettingDefault() *schema.Resource {
 
 func resourceAccountSettingDefaultImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("name", d.Id())
-	d.SetId(arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Service:   ecs.ServiceName,
-		Resource:  fmt.Sprintf("cluster/%s", d.Id()),
-	}.String())
+	d.SetId(meta.(*conns.AWSClient).RegionalARN(ecs.ServiceName, fmt.Sprintf("cluster/%s", d.Id())))
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -166,13 +165,7 @@ func ResourceCluster() *schema.Resource {
 
 func resourceClusterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("name", d.Id())
-	d.SetId(arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Service:   "ecs",
-		Resource:  fmt.Sprintf("cluster/%s", d.Id()),
-	}.String())
+	d.SetId(meta.(*conns.AWSClient).RegionalARN("ecs", fmt.Sprintf("cluster/%s", d.Id())))
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -288,6 +281,26 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	if d.IsNewResource() {
+		tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+		err := tftags.WaitTagsPropagated(tags, KeyValueTags(cluster.Tags), meta.(*conns.AWSClient).TagsPropagationTimeout(conns.ECS, tagsPropagationTimeout), func() (tftags.KeyValueTags, error) {
+			output, err := FindClusterByNameOrARN(context.Background(), conn, d.Id())
+
+			if err != nil {
+				return nil, err
+			}
+
+			cluster = output
+
+			return KeyValueTags(output.Tags), nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("error waiting for ECS Cluster (%s) tags to propagate: %w", d.Id(), err)
+		}
+	}
+
 	d.Set("arn", cluster.ClusterArn)
 	d.Set("name", cluster.ClusterName)
 
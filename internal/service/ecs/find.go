@@ -72,3 +72,32 @@ func FindClusterByNameOrARN(ctx context.Context, conn *ecs.ECS, nameOrARN string
 
 	return output.Clusters[0], nil
 }
+
+func FindServiceByID(conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Services: aws.StringSlice([]string{id}),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeServiceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Services[0], nil
+}
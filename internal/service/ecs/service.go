@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -37,6 +36,8 @@ func ResourceService() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -69,6 +70,28 @@ func ResourceService() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"deployment_alarms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"rollback": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 			"deployment_circuit_breaker": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -371,6 +394,75 @@ func ResourceService() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"volume_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_ebs_volume": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"file_system_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(ecs.TaskFilesystemType_Values(), false),
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
+									},
+									"size_in_gb": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 16384),
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"throughput": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(125, 1000),
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"wait_for_steady_state": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -425,13 +517,7 @@ func resourceServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.
 	log.Printf("[DEBUG] Importing ECS service %s from cluster %s", name, cluster)
 
 	d.SetId(name)
-	clusterArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "ecs",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("cluster/%s", cluster),
-	}.String()
+	clusterArn := meta.(*conns.AWSClient).RegionalARN("ecs", fmt.Sprintf("cluster/%s", cluster))
 	d.Set("cluster", clusterArn)
 	return []*schema.ResourceData{d}, nil
 }
@@ -473,6 +559,14 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.DeploymentConfiguration.DeploymentCircuitBreaker = expandDeploymentCircuitBreaker(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("deployment_alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		input.DeploymentConfiguration.Alarms = expandDeploymentAlarms(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("cluster"); ok {
 		input.Cluster = aws.String(v.(string))
 	}
@@ -556,6 +650,10 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.ServiceRegistries = srs
 	}
 
+	if v, ok := d.GetOk("volume_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VolumeConfigurations = expandServiceVolumeConfigurations(v.([]interface{}))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS()) // tags field doesn't exist in all partitions
 	}
@@ -589,9 +687,14 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
 		}
+	} else if input.VolumeConfigurations != nil {
+		// The EBS volumes are created and attached as part of the deployment.
+		if err := waitServiceDeploymentCompleted(conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for ECS service (%s) deployment to complete: %w", d.Id(), err)
+		}
 	}
 
 	// Some partitions (i.e., ISO) may not support tag-on-create, attempt tag after create
@@ -664,6 +767,30 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	if d.IsNewResource() {
+		tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+		err := tftags.WaitTagsPropagated(tags, KeyValueTags(service.Tags), meta.(*conns.AWSClient).TagsPropagationTimeout(conns.ECS, tagsPropagationTimeout), func() (tftags.KeyValueTags, error) {
+			output, err := conn.DescribeServices(&input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if len(output.Services) == 0 || output.Services[0] == nil {
+				return nil, tfresource.NewEmptyResultError(input)
+			}
+
+			service = output.Services[0]
+
+			return KeyValueTags(service.Tags), nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("error waiting for ECS Service (%s) tags to propagate: %w", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Received ECS service %s", service)
 
 	d.SetId(aws.StringValue(service.ServiceArn))
@@ -720,6 +847,26 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			d.Set("deployment_circuit_breaker", nil)
 		}
+
+		if v := service.DeploymentConfiguration.Alarms; v != nil && (aws.BoolValue(v.Enable) || len(v.AlarmNames) > 0) {
+			if err := d.Set("deployment_alarms", []interface{}{flattenDeploymentAlarms(v)}); err != nil {
+				return fmt.Errorf("error setting deployment_alarms: %w", err)
+			}
+		} else {
+			d.Set("deployment_alarms", nil)
+		}
+	}
+
+	var volumeConfigurations []*ecs.ServiceVolumeConfiguration
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+			volumeConfigurations = deployment.VolumeConfigurations
+			break
+		}
+	}
+
+	if err := d.Set("volume_configuration", flattenServiceVolumeConfigurations(volumeConfigurations)); err != nil {
+		return fmt.Errorf("error setting volume_configuration: %w", err)
 	}
 
 	if err := d.Set("deployment_controller", flattenDeploymentController(service.DeploymentController)); err != nil {
@@ -824,6 +971,141 @@ func flattenDeploymentCircuitBreaker(apiObject *ecs.DeploymentCircuitBreaker) ma
 	return tfMap
 }
 
+func expandDeploymentAlarms(tfMap map[string]interface{}) *ecs.DeploymentAlarms {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.DeploymentAlarms{}
+
+	apiObject.AlarmNames = flex.ExpandStringSet(tfMap["alarm_names"].(*schema.Set))
+	apiObject.Enable = aws.Bool(tfMap["enable"].(bool))
+	apiObject.Rollback = aws.Bool(tfMap["rollback"].(bool))
+
+	return apiObject
+}
+
+func flattenDeploymentAlarms(apiObject *ecs.DeploymentAlarms) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	tfMap["alarm_names"] = aws.StringValueSlice(apiObject.AlarmNames)
+	tfMap["enable"] = aws.BoolValue(apiObject.Enable)
+	tfMap["rollback"] = aws.BoolValue(apiObject.Rollback)
+
+	return tfMap
+}
+
+func expandServiceVolumeConfigurations(tfList []interface{}) []*ecs.ServiceVolumeConfiguration {
+	apiObjects := make([]*ecs.ServiceVolumeConfiguration, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceVolumeConfiguration{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["managed_ebs_volume"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ManagedEBSVolume = expandServiceManagedEBSVolumeConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServiceManagedEBSVolumeConfiguration(tfMap map[string]interface{}) *ecs.ServiceManagedEBSVolumeConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.ServiceManagedEBSVolumeConfiguration{
+		Encrypted: aws.Bool(tfMap["encrypted"].(bool)),
+		RoleArn:   aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["file_system_type"].(string); ok && v != "" {
+		apiObject.FilesystemType = aws.String(v)
+	}
+
+	if v, ok := tfMap["iops"].(int); ok && v != 0 {
+		apiObject.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["size_in_gb"].(int); ok && v != 0 {
+		apiObject.SizeInGiB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["snapshot_id"].(string); ok && v != "" {
+		apiObject.SnapshotId = aws.String(v)
+	}
+
+	if v, ok := tfMap["throughput"].(int); ok && v != 0 {
+		apiObject.Throughput = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["volume_type"].(string); ok && v != "" {
+		apiObject.VolumeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenServiceVolumeConfigurations(apiObjects []*ecs.ServiceVolumeConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.ManagedEBSVolume; v != nil {
+			tfMap["managed_ebs_volume"] = []interface{}{flattenServiceManagedEBSVolumeConfiguration(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenServiceManagedEBSVolumeConfiguration(apiObject *ecs.ServiceManagedEBSVolumeConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"encrypted":        aws.BoolValue(apiObject.Encrypted),
+		"file_system_type": aws.StringValue(apiObject.FilesystemType),
+		"iops":             aws.Int64Value(apiObject.Iops),
+		"kms_key_id":       aws.StringValue(apiObject.KmsKeyId),
+		"role_arn":         aws.StringValue(apiObject.RoleArn),
+		"size_in_gb":       aws.Int64Value(apiObject.SizeInGiB),
+		"snapshot_id":      aws.StringValue(apiObject.SnapshotId),
+		"throughput":       aws.Int64Value(apiObject.Throughput),
+		"volume_type":      aws.StringValue(apiObject.VolumeType),
+	}
+
+	return tfMap
+}
+
 func flattenNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -1045,6 +1327,25 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("deployment_alarms") {
+		updateService = true
+
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		// To remove the deployment alarms, disable them with an empty list of alarm names.
+		input.DeploymentConfiguration.Alarms = &ecs.DeploymentAlarms{
+			AlarmNames: aws.StringSlice([]string{}),
+			Enable:     aws.Bool(false),
+			Rollback:   aws.Bool(false),
+		}
+
+		if v, ok := d.GetOk("deployment_alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DeploymentConfiguration.Alarms = expandDeploymentAlarms(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if d.HasChange("ordered_placement_strategy") {
 		updateService = true
 		// Reference: https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_UpdateService.html#ECS-UpdateService-request-placementStrategy
@@ -1109,6 +1410,12 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.EnableExecuteCommand = aws.Bool(d.Get("enable_execute_command").(bool))
 	}
 
+	if d.HasChange("volume_configuration") {
+		updateService = true
+		// To remove the volume configurations, specify an empty list.
+		input.VolumeConfigurations = expandServiceVolumeConfigurations(d.Get("volume_configuration").([]interface{}))
+	}
+
 	if updateService {
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
 		// Retry due to IAM eventual consistency
@@ -1144,9 +1451,13 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
 		}
+	} else if updateService && d.HasChange("volume_configuration") {
+		if err := waitServiceDeploymentCompleted(conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for ECS service (%s) deployment to complete: %w", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("tags_all") {
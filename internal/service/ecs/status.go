@@ -2,7 +2,9 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -17,9 +19,14 @@ const (
 	serviceStatusActive   = "ACTIVE"
 	serviceStatusDraining = "DRAINING"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	serviceStatusError = "ERROR"
 	serviceStatusNone  = "NONE"
 
+	serviceStabilityStatusPending     = "PENDING"
+	serviceStabilityStatusSteadyState = "STEADY_STATE"
+
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
 
@@ -86,6 +93,88 @@ func statusService(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc
 	}
 }
 
+// stabilityStatusService mirrors the ServicesStable waiter: a service is in a steady state once it has
+// a single deployment and its running task count matches the desired count.
+// A failed rollout of a deployment made during the wait, e.g. one stopped by the deployment circuit breaker, is an error.
+func stabilityStatusService(conn *ecs.ECS, id, cluster string, since time.Time) resource.StateRefreshFunc {
+	primaryDeploymentIDs := make(map[string]bool)
+
+	return func() (interface{}, string, error) {
+		service, err := FindServiceByID(conn, id, cluster)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if deployment := failedServiceDeployment(service, since, primaryDeploymentIDs); deployment != nil {
+			return service, ecs.DeploymentRolloutStateFailed, fmt.Errorf("deployment (%s) failed: %s", aws.StringValue(deployment.Id), aws.StringValue(deployment.RolloutStateReason))
+		}
+
+		if len(service.Deployments) == 1 && aws.Int64Value(service.RunningCount) == aws.Int64Value(service.DesiredCount) {
+			return service, serviceStabilityStatusSteadyState, nil
+		}
+
+		return service, serviceStabilityStatusPending, nil
+	}
+}
+
+// statusServicePrimaryDeploymentRollout returns the rollout state of the service's primary deployment.
+// Deployments that are not managed by the ECS deployment controller have no rollout state and are reported as completed.
+// A failed rollout of a deployment made during the wait is an error.
+func statusServicePrimaryDeploymentRollout(conn *ecs.ECS, id, cluster string, since time.Time) resource.StateRefreshFunc {
+	primaryDeploymentIDs := make(map[string]bool)
+
+	return func() (interface{}, string, error) {
+		service, err := FindServiceByID(conn, id, cluster)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if deployment := failedServiceDeployment(service, since, primaryDeploymentIDs); deployment != nil {
+			return service, ecs.DeploymentRolloutStateFailed, fmt.Errorf("deployment (%s) failed: %s", aws.StringValue(deployment.Id), aws.StringValue(deployment.RolloutStateReason))
+		}
+
+		for _, deployment := range service.Deployments {
+			if aws.StringValue(deployment.Status) != serviceDeploymentStatusPrimary {
+				continue
+			}
+
+			if state := aws.StringValue(deployment.RolloutState); state != "" {
+				return service, state, nil
+			}
+
+			return service, ecs.DeploymentRolloutStateCompleted, nil
+		}
+
+		return service, ecs.DeploymentRolloutStateInProgress, nil
+	}
+}
+
+// failedServiceDeployment returns the service's deployment, if any, whose rollout failed during the wait.
+// When the deployment circuit breaker rolls back a failed deployment it creates a new primary deployment,
+// so any deployment that has been the primary one (recorded in primaryDeploymentIDs) or that was created
+// since the wait started is checked, not just the current primary deployment.
+func failedServiceDeployment(service *ecs.Service, since time.Time, primaryDeploymentIDs map[string]bool) *ecs.Deployment {
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+			primaryDeploymentIDs[aws.StringValue(deployment.Id)] = true
+		}
+	}
+
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.RolloutState) != ecs.DeploymentRolloutStateFailed {
+			continue
+		}
+
+		if primaryDeploymentIDs[aws.StringValue(deployment.Id)] || !aws.TimeValue(deployment.CreatedAt).Before(since) {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
 func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := FindClusterByNameOrARN(ctx, conn, arn)
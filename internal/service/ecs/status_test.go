@@ -0,0 +1,88 @@
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestFailedServiceDeployment(t *testing.T) {
+	since := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := since.Add(-1 * time.Minute)
+	after := since.Add(1 * time.Minute)
+
+	deployment := func(id, status, rolloutState string, createdAt time.Time) *ecs.Deployment {
+		return &ecs.Deployment{
+			CreatedAt:    aws.Time(createdAt),
+			Id:           aws.String(id),
+			RolloutState: aws.String(rolloutState),
+			Status:       aws.String(status),
+		}
+	}
+
+	testCases := []struct {
+		Name                 string
+		Deployments          []*ecs.Deployment
+		PrimaryDeploymentIDs []string
+		ExpectedID           string
+	}{
+		{
+			Name: "in progress",
+			Deployments: []*ecs.Deployment{
+				deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, before),
+				deployment("ecs-svc/1", "ACTIVE", ecs.DeploymentRolloutStateCompleted, before),
+			},
+		},
+		{
+			Name: "primary failed",
+			Deployments: []*ecs.Deployment{
+				deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateFailed, before),
+			},
+			ExpectedID: "ecs-svc/2",
+		},
+		{
+			Name: "rolled back after being seen as primary",
+			Deployments: []*ecs.Deployment{
+				deployment("ecs-svc/3", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, after),
+				deployment("ecs-svc/2", "ACTIVE", ecs.DeploymentRolloutStateFailed, before),
+			},
+			PrimaryDeploymentIDs: []string{"ecs-svc/2"},
+			ExpectedID:           "ecs-svc/2",
+		},
+		{
+			Name: "rolled back after being created during the wait",
+			Deployments: []*ecs.Deployment{
+				deployment("ecs-svc/3", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, after),
+				deployment("ecs-svc/2", "ACTIVE", ecs.DeploymentRolloutStateFailed, after),
+			},
+			ExpectedID: "ecs-svc/2",
+		},
+		{
+			Name: "failed before the wait",
+			Deployments: []*ecs.Deployment{
+				deployment("ecs-svc/3", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, before),
+				deployment("ecs-svc/2", "ACTIVE", ecs.DeploymentRolloutStateFailed, before),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			primaryDeploymentIDs := make(map[string]bool)
+			for _, id := range testCase.PrimaryDeploymentIDs {
+				primaryDeploymentIDs[id] = true
+			}
+
+			var gotID string
+			if deployment := failedServiceDeployment(&ecs.Service{Deployments: testCase.Deployments}, since, primaryDeploymentIDs); deployment != nil {
+				gotID = aws.StringValue(deployment.Id)
+			}
+
+			if got, want := gotID, testCase.ExpectedID; got != want {
+				t.Errorf("got failed deployment %q, want %q", got, want)
+			}
+		})
+	}
+}
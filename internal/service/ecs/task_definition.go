@@ -227,23 +227,26 @@ func ResourceTaskDefinition() *schema.Resource {
 				Computed: true,
 			},
 			"runtime_platform": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeList,
+				MaxItems:         1,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: runtimePlatformDiffSuppress,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cpu_architecture": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.StringInSlice(ecs.CPUArchitecture_Values(), false),
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringInSlice(ecs.CPUArchitecture_Values(), false),
+							DiffSuppressFunc: runtimePlatformDiffSuppress,
 						},
 						"operating_system_family": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.StringInSlice(ecs.OSFamily_Values(), false),
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringInSlice(ecs.OSFamily_Values(), false),
+							DiffSuppressFunc: runtimePlatformDiffSuppress,
 						},
 					},
 				},
@@ -267,6 +270,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"configured_at_launch": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
 						"docker_volume_configuration": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -694,6 +702,43 @@ func flattenRuntimePlatform(rp *ecs.RuntimePlatform) []map[string]interface{} {
 	}
 }
 
+// runtimePlatformDiffSuppress suppresses differences between an omitted runtime platform (or omitted runtime platform
+// attribute) and the default values that ECS reports back for some task definitions, LINUX and X86_64.
+func runtimePlatformDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if new != "" && new != "0" {
+		return false
+	}
+
+	switch k {
+	case "runtime_platform.#":
+		if old != "1" {
+			return false
+		}
+
+		o, _ := d.GetChange("runtime_platform")
+		tfList := o.([]interface{})
+
+		if len(tfList) == 0 || tfList[0] == nil {
+			return true
+		}
+
+		tfMap := tfList[0].(map[string]interface{})
+
+		return runtimePlatformIsDefault(tfMap["cpu_architecture"].(string), tfMap["operating_system_family"].(string))
+	case "runtime_platform.0.cpu_architecture":
+		return runtimePlatformIsDefault(old, "")
+	case "runtime_platform.0.operating_system_family":
+		return runtimePlatformIsDefault("", old)
+	}
+
+	return false
+}
+
+func runtimePlatformIsDefault(cpuArchitecture, operatingSystemFamily string) bool {
+	return (cpuArchitecture == "" || cpuArchitecture == ecs.CPUArchitectureX8664) &&
+		(operatingSystemFamily == "" || operatingSystemFamily == ecs.OSFamilyLinux)
+}
+
 func flattenProxyConfiguration(pc *ecs.ProxyConfiguration) []map[string]interface{} {
 	if pc == nil {
 		return nil
@@ -764,6 +809,10 @@ func resourceTaskDefinitionVolumeHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["host_path"].(string)))
 
+	if v, ok := m["configured_at_launch"].(bool); ok && v {
+		buf.WriteString(fmt.Sprintf("%t-", v))
+	}
+
 	if v, ok := m["efs_volume_configuration"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		m := v.([]interface{})[0].(map[string]interface{})
 
@@ -918,6 +967,10 @@ func expandVolumes(configured []interface{}) []*ecs.Volume {
 			Name: aws.String(data["name"].(string)),
 		}
 
+		if v, ok := data["configured_at_launch"].(bool); ok && v {
+			l.ConfiguredAtLaunch = aws.Bool(v)
+		}
+
 		hostPath := data["host_path"].(string)
 		if hostPath != "" {
 			l.Host = &ecs.HostVolumeProperties{
@@ -1055,6 +1108,10 @@ func flattenVolumes(list []*ecs.Volume) []map[string]interface{} {
 			"name": aws.StringValue(volume.Name),
 		}
 
+		if v := volume.ConfiguredAtLaunch; v != nil {
+			l["configured_at_launch"] = aws.BoolValue(v)
+		}
+
 		if volume.Host != nil && volume.Host.SourcePath != nil {
 			l["host_path"] = aws.StringValue(volume.Host.SourcePath)
 		}
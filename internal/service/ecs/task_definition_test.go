@@ -272,6 +272,68 @@ func TestAccECSTaskDefinition_Fargate_runtimePlatformWithoutArch(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_Fargate_runtimePlatformDefaults(t *testing.T) {
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartition(endpoints.AwsPartitionID, t) }, // runtime platform not support on GovCloud
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFargateTaskDefinitionRuntimePlatformLinuxConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "runtime_platform.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_platform.0.operating_system_family", "LINUX"),
+				),
+			},
+			{
+				Config:   testAccFargateTaskDefinitionRuntimePlatformLinuxConfig(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_Fargate_volumeConfiguredAtLaunch(t *testing.T) {
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFargateTaskDefinitionVolumeConfiguredAtLaunchConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "volume.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "volume.*", map[string]string{
+						"name":                 rName,
+						"configured_at_launch": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+		},
+	})
+}
+
 func TestAccECSTaskDefinition_EFSVolume_minimal(t *testing.T) {
 	var def ecs.TaskDefinition
 
@@ -1768,6 +1830,71 @@ TASK_DEFINITION
 `, rName, arch, os)
 }
 
+func testAccFargateTaskDefinitionRuntimePlatformLinuxConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  requires_compatibilities = ["FARGATE"]
+  network_mode             = "awsvpc"
+  cpu                      = 256
+  memory                   = 512
+
+  runtime_platform {
+    operating_system_family = "LINUX"
+  }
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "name": "sleep",
+    "image": "busybox",
+    "cpu": 256,
+    "command": ["sleep","360"],
+    "memory": 512,
+    "essential": true
+  }
+]
+TASK_DEFINITION
+}
+`, rName)
+}
+
+func testAccFargateTaskDefinitionVolumeConfiguredAtLaunchConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  requires_compatibilities = ["FARGATE"]
+  network_mode             = "awsvpc"
+  cpu                      = 256
+  memory                   = 512
+
+  volume {
+    name                 = %[1]q
+    configured_at_launch = true
+  }
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "name": "sleep",
+    "image": "busybox",
+    "cpu": 256,
+    "command": ["sleep","360"],
+    "memory": 512,
+    "essential": true,
+    "mountPoints": [
+      {
+        "sourceVolume": %[1]q,
+        "containerPath": "/data"
+      }
+    ]
+  }
+]
+TASK_DEFINITION
+}
+`, rName)
+}
+
 func testAccTaskDefinitionTaskScopedDockerVolume(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
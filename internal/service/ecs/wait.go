@@ -2,6 +2,8 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +20,7 @@ const (
 	serviceInactiveTimeoutMin = 1 * time.Second
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
+	serviceStableMinTimeout   = 15 * time.Second
 
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
@@ -26,6 +29,8 @@ const (
 
 	taskSetCreateTimeout = 10 * time.Minute
 	taskSetDeleteTimeout = 10 * time.Minute
+
+	tagsPropagationTimeout = 1 * time.Minute
 )
 
 func waitCapacityProviderDeleted(conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -62,21 +67,83 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 	return nil, err
 }
 
-func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
+func waitServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
+	start := time.Now()
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{serviceStabilityStatusPending},
+		Target:     []string{serviceStabilityStatusSteadyState},
+		Refresh:    stabilityStatusService(conn, id, cluster, start),
+		Timeout:    timeout,
+		MinTimeout: serviceStableMinTimeout,
 	}
 
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
+	outputRaw, err := stateConf.WaitForState()
+
+	if err != nil {
+		return serviceWaitError(conn, id, cluster, outputRaw, start, err)
 	}
 
-	if err := conn.WaitUntilServicesStable(input); err != nil {
-		return err
+	return nil
+}
+
+func waitServiceDeploymentCompleted(conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
+	start := time.Now()
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ecs.DeploymentRolloutStateInProgress},
+		Target:     []string{ecs.DeploymentRolloutStateCompleted},
+		Refresh:    statusServicePrimaryDeploymentRollout(conn, id, cluster, start),
+		Timeout:    timeout,
+		MinTimeout: serviceStableMinTimeout,
 	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if err != nil {
+		return serviceWaitError(conn, id, cluster, outputRaw, start, err)
+	}
+
 	return nil
 }
 
+// serviceWaitError surfaces the service events recorded while waiting, e.g. the reason for a
+// circuit breaker or alarm based rollback, rather than a generic timeout.
+func serviceWaitError(conn *ecs.ECS, id, cluster string, outputRaw interface{}, start time.Time, err error) error {
+	service, ok := outputRaw.(*ecs.Service)
+
+	if !ok {
+		service, _ = FindServiceByID(conn, id, cluster)
+	}
+
+	if service != nil {
+		if events := serviceEventsSince(service, start); events != "" {
+			return fmt.Errorf("%w\n\nService events:\n%s", err, events)
+		}
+	}
+
+	return err
+}
+
+// serviceEventsSince returns the messages of the service's events created at or after the specified time,
+// oldest first and one per line.
+func serviceEventsSince(service *ecs.Service, since time.Time) string {
+	var messages []string
+
+	// Events are returned newest first.
+	for i := len(service.Events) - 1; i >= 0; i-- {
+		event := service.Events[i]
+
+		if event == nil || aws.TimeValue(event.CreatedAt).Before(since) {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.TimeValue(event.CreatedAt).Format(time.RFC3339), aws.StringValue(event.Message)))
+	}
+
+	return strings.Join(messages, "\n")
+}
+
 func waitServiceInactive(conn *ecs.ECS, id, cluster string) error {
 	input := &ecs.DescribeServicesInput{
 		Services: aws.StringSlice([]string{id}),
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -197,13 +196,7 @@ func resourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Found EFS access point: %#v", ap)
 
-	fsARN := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("file-system/%s", aws.StringValue(ap.FileSystemId)),
-		Service:   "elasticfilesystem",
-	}.String()
+	fsARN := meta.(*conns.AWSClient).RegionalARN("elasticfilesystem", fmt.Sprintf("file-system/%s", aws.StringValue(ap.FileSystemId)))
 
 	d.Set("file_system_arn", fsARN)
 	d.Set("file_system_id", ap.FileSystemId)
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -212,13 +211,7 @@ func resourceMountTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Found EFS mount target: %#v", mt)
 
-	fsARN := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("file-system/%s", aws.StringValue(mt.FileSystemId)),
-		Service:   "elasticfilesystem",
-	}.String()
+	fsARN := meta.(*conns.AWSClient).RegionalARN("elasticfilesystem", fmt.Sprintf("file-system/%s", aws.StringValue(mt.FileSystemId)))
 
 	d.Set("file_system_arn", fsARN)
 	d.Set("file_system_id", mt.FileSystemId)
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -110,13 +109,7 @@ func dataSourceMountTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(mt.MountTargetId))
 
-	fsARN := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("file-system/%s", aws.StringValue(mt.FileSystemId)),
-		Service:   "elasticfilesystem",
-	}.String()
+	fsARN := meta.(*conns.AWSClient).RegionalARN("elasticfilesystem", fmt.Sprintf("file-system/%s", aws.StringValue(mt.FileSystemId)))
 
 	d.Set("availability_zone_id", mt.AvailabilityZoneId)
 	d.Set("availability_zone_name", mt.AvailabilityZoneName)
@@ -38,6 +38,9 @@ func ResourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			customdiff.ValidateChange("access_config.0.authentication_mode", func(_ context.Context, old, new, meta interface{}) error {
+				return validAuthenticationModeChange(old.(string), new.(string))
+			}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -47,6 +50,28 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(eks.AuthenticationMode_Values(), false),
+						},
+						"bootstrap_cluster_creator_admin_permissions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -171,7 +196,7 @@ func ResourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -252,6 +277,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		RoleArn:            aws.String(d.Get("role_arn").(string)),
 	}
 
+	if v, ok := d.GetOk("access_config"); ok {
+		input.AccessConfig = expandEksCreateAccessConfigRequest(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("kubernetes_network_config"); ok {
 		input.KubernetesNetworkConfig = expandEksNetworkConfigRequest(d.Get("kubernetes_network_config").([]interface{}))
 	}
@@ -338,6 +367,10 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading EKS Cluster (%s): %w", d.Id(), err)
 	}
 
+	if err := d.Set("access_config", flattenEksAccessConfigResponse(cluster.AccessConfig, d.Get("access_config").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting access_config: %w", err)
+	}
+
 	d.Set("arn", cluster.Arn)
 
 	if err := d.Set("certificate_authority", flattenEksCertificate(cluster.CertificateAuthority)); err != nil {
@@ -440,6 +473,30 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("access_config.0.authentication_mode") {
+		input := &eks.UpdateClusterConfigInput{
+			AccessConfig: &eks.UpdateAccessConfigRequest{
+				AuthenticationMode: aws.String(d.Get("access_config.0.authentication_mode").(string)),
+			},
+			Name: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating EKS Cluster (%s) access config: %s", d.Id(), input)
+		output, err := conn.UpdateClusterConfig(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EKS Cluster (%s) access config: %w", d.Id(), err)
+		}
+
+		updateID := aws.StringValue(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return fmt.Errorf("error waiting for EKS Cluster (%s) access config update (%s): %w", d.Id(), updateID, err)
+		}
+	}
+
 	if d.HasChange("enabled_cluster_log_types") {
 		input := &eks.UpdateClusterConfigInput{
 			Logging: expandEksLoggingTypes(d.Get("enabled_cluster_log_types").(*schema.Set)),
@@ -525,6 +582,45 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// validAuthenticationModeChange returns an error if the cluster authentication mode change
+// cannot be made in place. The authentication mode can only be changed from CONFIG_MAP to
+// API_AND_CONFIG_MAP or API, and from API_AND_CONFIG_MAP to API.
+func validAuthenticationModeChange(old, new string) error {
+	if old == "" || new == "" || old == new {
+		return nil
+	}
+
+	order := map[string]int{
+		eks.AuthenticationModeConfigMap:       0,
+		eks.AuthenticationModeApiAndConfigMap: 1,
+		eks.AuthenticationModeApi:             2,
+	}
+
+	if order[new] < order[old] {
+		return fmt.Errorf("cannot change EKS Cluster authentication_mode from %s to %s: authentication mode changes are one-way (%s to %s to %s)", old, new, eks.AuthenticationModeConfigMap, eks.AuthenticationModeApiAndConfigMap, eks.AuthenticationModeApi)
+	}
+
+	return nil
+}
+
+func expandEksCreateAccessConfigRequest(tfList []interface{}) *eks.CreateAccessConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &eks.CreateAccessConfigRequest{
+		BootstrapClusterCreatorAdminPermissions: aws.Bool(tfMap["bootstrap_cluster_creator_admin_permissions"].(bool)),
+	}
+
+	if v, ok := tfMap["authentication_mode"].(string); ok && v != "" {
+		apiObject.AuthenticationMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandEksEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 	if len(tfList) == 0 {
 		return nil
@@ -650,6 +746,26 @@ func expandEksLoggingTypes(vEnabledLogTypes *schema.Set) *eks.Logging {
 	}
 }
 
+func flattenEksAccessConfigResponse(apiObject *eks.AccessConfigResponse, tfList []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"authentication_mode": aws.StringValue(apiObject.AuthenticationMode),
+		// Clusters created before the access entry API was introduced do not report this value.
+		"bootstrap_cluster_creator_admin_permissions": true,
+	}
+
+	if v := apiObject.BootstrapClusterCreatorAdminPermissions; v != nil {
+		tfMap["bootstrap_cluster_creator_admin_permissions"] = aws.BoolValue(v)
+	} else if len(tfList) > 0 && tfList[0] != nil {
+		tfMap["bootstrap_cluster_creator_admin_permissions"] = tfList[0].(map[string]interface{})["bootstrap_cluster_creator_admin_permissions"]
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenEksCertificate(certificate *eks.Certificate) []map[string]interface{} {
 	if certificate == nil {
 		return []map[string]interface{}{}
@@ -24,6 +24,8 @@ func TestAccEKSClusterDataSource_basic(t *testing.T) {
 			{
 				Config: testAccClusterDataSourceConfig_Basic(rName),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "access_config.#", dataSourceResourceName, "access_config.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_config.0.authentication_mode", dataSourceResourceName, "access_config.0.authentication_mode"),
 					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceResourceName, "arn"),
 					resource.TestCheckResourceAttr(dataSourceResourceName, "certificate_authority.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.data", dataSourceResourceName, "certificate_authority.0.data"),
@@ -1,9 +1,30 @@
 package eks
 
+const (
+	AccessEntryTypeEC2Linux     = "EC2_LINUX"
+	AccessEntryTypeEC2Windows   = "EC2_WINDOWS"
+	AccessEntryTypeFargateLinux = "FARGATE_LINUX"
+	AccessEntryTypeStandard     = "STANDARD"
+)
+
+func AccessEntryType_Values() []string {
+	return []string{
+		AccessEntryTypeEC2Linux,
+		AccessEntryTypeEC2Windows,
+		AccessEntryTypeFargateLinux,
+		AccessEntryTypeStandard,
+	}
+}
+
 const (
 	IdentityProviderConfigTypeOIDC = "oidc"
 )
 
+const (
+	launchTemplateVersionDefault = "$Default"
+	launchTemplateVersionLatest  = "$Latest"
+)
+
 const (
 	ResourcesSecrets = "secrets"
 )
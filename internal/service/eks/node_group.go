@@ -108,6 +108,10 @@ func ResourceNodeGroup() *schema.Resource {
 							ConflictsWith: []string{"launch_template.0.id"},
 							ValidateFunc:  verify.ValidLaunchTemplateName,
 						},
+						"resolved_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"version": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -407,7 +411,7 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("error setting labels: %s", err)
 	}
 
-	if err := d.Set("launch_template", flattenEksLaunchTemplateSpecification(nodeGroup.LaunchTemplate)); err != nil {
+	if err := d.Set("launch_template", flattenEksLaunchTemplateSpecification(nodeGroup.LaunchTemplate, d.Get("launch_template").([]interface{}))); err != nil {
 		return diag.Errorf("error setting launch_template: %s", err)
 	}
 
@@ -837,7 +841,10 @@ func flattenEksAutoScalingGroups(autoScalingGroups []*eks.AutoScalingGroup) []ma
 	return l
 }
 
-func flattenEksLaunchTemplateSpecification(config *eks.LaunchTemplateSpecification) []map[string]interface{} {
+// EKS resolves $Latest and $Default launch template versions to a version number.
+// Keep the configured alias in "version" and report the number in "resolved_version";
+// numbered versions are always refreshed so that changes outside Terraform show as drift.
+func flattenEksLaunchTemplateSpecification(config *eks.LaunchTemplateSpecification, tfList []interface{}) []map[string]interface{} {
 	if config == nil {
 		return nil
 	}
@@ -853,9 +860,16 @@ func flattenEksLaunchTemplateSpecification(config *eks.LaunchTemplateSpecificati
 	}
 
 	if v := config.Version; v != nil {
+		m["resolved_version"] = aws.StringValue(v)
 		m["version"] = aws.StringValue(v)
 	}
 
+	if len(tfList) > 0 && tfList[0] != nil {
+		if v, ok := tfList[0].(map[string]interface{})["version"].(string); ok && (v == launchTemplateVersionLatest || v == launchTemplateVersionDefault) {
+			m["version"] = v
+		}
+	}
+
 	return []map[string]interface{}{m}
 }
 
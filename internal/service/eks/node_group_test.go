@@ -476,6 +476,7 @@ func TestAccEKSNodeGroup_LaunchTemplate_version(t *testing.T) {
 					testAccCheckNodeGroupExists(resourceName, &nodeGroup1),
 					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.version", launchTemplateResourceName, "default_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.resolved_version", launchTemplateResourceName, "default_version"),
 				),
 			},
 			{
@@ -496,6 +497,37 @@ func TestAccEKSNodeGroup_LaunchTemplate_version(t *testing.T) {
 	})
 }
 
+func TestAccEKSNodeGroup_LaunchTemplate_versionLatest(t *testing.T) {
+	var nodeGroup eks.Nodegroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	launchTemplateResourceName := "aws_launch_template.test"
+	resourceName := "aws_eks_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eks.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeGroupLaunchTemplateVersionLatestConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(resourceName, &nodeGroup),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Latest"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.resolved_version", launchTemplateResourceName, "latest_version"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"launch_template.0.version"},
+			},
+		},
+	})
+}
+
 func TestAccEKSNodeGroup_releaseVersion(t *testing.T) {
 	var nodeGroup1, nodeGroup2 eks.Nodegroup
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1742,6 +1774,47 @@ resource "aws_eks_node_group" "test" {
 `, rName))
 }
 
+func testAccNodeGroupLaunchTemplateVersionLatestConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccNodeGroupBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_ssm_parameter" "test" {
+  name = "/aws/service/eks/optimized-ami/${aws_eks_cluster.test.version}/amazon-linux-2/recommended/image_id"
+}
+
+resource "aws_launch_template" "test" {
+  image_id      = data.aws_ssm_parameter.test.value
+  instance_type = "t3.medium"
+  name          = %[1]q
+  user_data     = base64encode(templatefile("testdata/node-group-launch-template-user-data.sh.tmpl", { cluster_name = aws_eks_cluster.test.name }))
+}
+
+resource "aws_eks_node_group" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  node_group_name = %[1]q
+  node_role_arn   = aws_iam_role.node.arn
+  subnet_ids      = aws_subnet.test[*].id
+
+  launch_template {
+    name    = aws_launch_template.test.name
+    version = "$Latest"
+  }
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 1
+    min_size     = 1
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+  ]
+}
+`, rName))
+}
+
 func testAccNodeGroupLaunchTemplateVersion2Config(rName string) string {
 	return acctest.ConfigCompose(
 		testAccNodeGroupBaseConfig(rName),
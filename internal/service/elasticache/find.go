@@ -226,3 +226,29 @@ func FindElastiCacheUserGroupByID(conn *elasticache.ElastiCache, groupID string)
 		}
 	}
 }
+
+// FindServerlessCacheByName retrieves an ElastiCache Serverless Cache by name.
+func FindServerlessCacheByName(conn *elasticache.ElastiCache, name string) (*elasticache.ServerlessCache, error) {
+	input := &elasticache.DescribeServerlessCachesInput{
+		ServerlessCacheName: aws.String(name),
+	}
+	output, err := conn.DescribeServerlessCaches(input)
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ServerlessCaches) == 0 || output.ServerlessCaches[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ServerlessCaches[0], nil
+}
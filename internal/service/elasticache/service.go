@@ -3,6 +3,7 @@ package elasticache
 const (
 	engineMemcached = "memcached"
 	engineRedis     = "redis"
+	engineValkey    = "valkey"
 )
 
 // engine_Values returns all elements of the Engine enum
@@ -12,3 +13,12 @@ func engine_Values() []string {
 		engineRedis,
 	}
 }
+
+// serverlessCacheEngine_Values returns the engines supported by ElastiCache Serverless
+func serverlessCacheEngine_Values() []string {
+	return []string{
+		engineMemcached,
+		engineRedis,
+		engineValkey,
+	}
+}
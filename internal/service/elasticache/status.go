@@ -145,3 +145,28 @@ func StatusUser(conn *elasticache.ElastiCache, userId string) resource.StateRefr
 		return user, aws.StringValue(user.Status), nil
 	}
 }
+
+const (
+	ServerlessCacheStatusAvailable    = "available"
+	ServerlessCacheStatusCreateFailed = "create-failed"
+	ServerlessCacheStatusCreating     = "creating"
+	ServerlessCacheStatusDeleting     = "deleting"
+	ServerlessCacheStatusModifying    = "modifying"
+)
+
+// StatusServerlessCache fetches the ElastiCache Serverless Cache and its Status
+func StatusServerlessCache(conn *elasticache.ElastiCache, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cache, err := FindServerlessCacheByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cache, aws.StringValue(cache.Status), nil
+	}
+}
@@ -261,3 +261,60 @@ func WaitUserDeleted(conn *elasticache.ElastiCache, userId string) error {
 
 	return err
 }
+
+const (
+	ServerlessCacheDefaultCreatedTimeout = 40 * time.Minute
+	ServerlessCacheDefaultUpdatedTimeout = 80 * time.Minute
+	ServerlessCacheDefaultDeletedTimeout = 40 * time.Minute
+
+	serverlessCacheAvailableMinTimeout = 10 * time.Second
+	serverlessCacheAvailableDelay      = 30 * time.Second
+
+	serverlessCacheDeletedMinTimeout = 10 * time.Second
+	serverlessCacheDeletedDelay      = 30 * time.Second
+)
+
+// waitServerlessCacheAvailable waits for a Serverless Cache to return Available
+func waitServerlessCacheAvailable(conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCache, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ServerlessCacheStatusCreating,
+			ServerlessCacheStatusModifying,
+		},
+		Target:     []string{ServerlessCacheStatusAvailable},
+		Refresh:    StatusServerlessCache(conn, name),
+		Timeout:    timeout,
+		MinTimeout: serverlessCacheAvailableMinTimeout,
+		Delay:      serverlessCacheAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+// waitServerlessCacheDeleted waits for a Serverless Cache to be deleted
+func waitServerlessCacheDeleted(conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCache, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ServerlessCacheStatusAvailable,
+			ServerlessCacheStatusCreateFailed,
+			ServerlessCacheStatusCreating,
+			ServerlessCacheStatusDeleting,
+			ServerlessCacheStatusModifying,
+		},
+		Target:     []string{},
+		Refresh:    StatusServerlessCache(conn, name),
+		Timeout:    timeout,
+		MinTimeout: serverlessCacheDeletedMinTimeout,
+		Delay:      serverlessCacheDeletedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return v, err
+	}
+	return nil, err
+}
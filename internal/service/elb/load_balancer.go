@@ -10,7 +10,6 @@ import ( // nosemgrep: aws-sdk-go-multiple-service-imports
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
@@ -358,14 +357,8 @@ func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
 
 	elbName := d.Id()
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "elasticloadbalancing",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("loadbalancer/%s", d.Id()),
-	}
-	d.Set("arn", arn.String())
+	arn := meta.(*conns.AWSClient).RegionalARN("elasticloadbalancing", fmt.Sprintf("loadbalancer/%s", d.Id()))
+	d.Set("arn", arn)
 
 	// Retrieve the ELB properties for updating the state
 	describeElbOpts := &elb.DescribeLoadBalancersInput{
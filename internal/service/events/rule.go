@@ -83,7 +83,7 @@ func ResourceRule() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"is_enabled": {
 				Type:     schema.TypeBool,
@@ -127,7 +127,7 @@ func s3ConfigurationSchema() *schema.Schema {
 				"bucket_arn": {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidARNForService("s3", ""),
 				},
 
 				"buffer_size": {
@@ -160,13 +160,13 @@ func s3ConfigurationSchema() *schema.Schema {
 				"kms_key_arn": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidARNForService("kms", ""),
 				},
 
 				"role_arn": {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidARNForService("iam", "role"),
 				},
 
 				"prefix": {
@@ -928,7 +928,7 @@ func ResourceDeliveryStream() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -964,7 +964,7 @@ func ResourceDeliveryStream() *schema.Resource {
 						"bucket_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("s3", ""),
 						},
 
 						"buffer_size": {
@@ -1213,7 +1213,7 @@ func ResourceDeliveryStream() *schema.Resource {
 												"role_arn": {
 													Type:         schema.TypeString,
 													Required:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidARNForService("iam", "role"),
 												},
 												"table_name": {
 													Type:     schema.TypeString,
@@ -1240,13 +1240,13 @@ func ResourceDeliveryStream() *schema.Resource {
 						"kms_key_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", ""),
 						},
 
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 
 						"prefix": {
@@ -1299,7 +1299,7 @@ func ResourceDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 
 						"s3_backup_mode": {
@@ -1387,7 +1387,7 @@ func ResourceDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 
 						"s3_backup_mode": {
@@ -1431,7 +1431,7 @@ func ResourceDeliveryStream() *schema.Resource {
 										Type:         schema.TypeString,
 										Required:     true,
 										ForceNew:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -1539,7 +1539,7 @@ func ResourceDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 
 						"s3_backup_mode": {
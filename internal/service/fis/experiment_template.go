@@ -0,0 +1,851 @@
+package fis
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceExperimentTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceExperimentTemplateCreate,
+		Read:   resourceExperimentTemplateRead,
+		Update: resourceExperimentTemplateUpdate,
+		Delete: resourceExperimentTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 128),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 512),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"parameter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"start_after": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 64),
+							},
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"experiment_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_targeting": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(fis.AccountTargeting_Values(), false),
+						},
+						"empty_target_resolution_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(fis.EmptyTargetResolutionMode_Values(), false),
+						},
+					},
+				},
+			},
+			"log_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"log_schema_version": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"stop_condition": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(0, 128),
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"resource_tag": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 128),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+								},
+							},
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"selection_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceExperimentTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &fis.CreateExperimentTemplateInput{
+		Actions:        expandExperimentTemplateActions(d.Get("action").(*schema.Set)),
+		Description:    aws.String(d.Get("description").(string)),
+		RoleArn:        aws.String(d.Get("role_arn").(string)),
+		StopConditions: expandExperimentTemplateStopConditions(d.Get("stop_condition").(*schema.Set)),
+		Targets:        expandExperimentTemplateTargets(d.Get("target").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("experiment_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		apiObject := &fis.CreateExperimentTemplateExperimentOptionsInput_{}
+
+		if v, ok := tfMap["account_targeting"].(string); ok && v != "" {
+			apiObject.AccountTargeting = aws.String(v)
+		}
+
+		if v, ok := tfMap["empty_target_resolution_mode"].(string); ok && v != "" {
+			apiObject.EmptyTargetResolutionMode = aws.String(v)
+		}
+
+		input.ExperimentOptions = apiObject
+	}
+
+	if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.LogConfiguration = &fis.CreateExperimentTemplateLogConfigurationInput_{
+			CloudWatchLogsConfiguration: expandExperimentTemplateCloudWatchLogsLogConfiguration(tfMap["cloudwatch_logs_configuration"].([]interface{})),
+			LogSchemaVersion:            aws.Int64(int64(tfMap["log_schema_version"].(int))),
+			S3Configuration:             expandExperimentTemplateS3LogConfiguration(tfMap["s3_configuration"].([]interface{})),
+		}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating FIS Experiment Template: %s", input)
+	output, err := conn.CreateExperimentTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FIS Experiment Template: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.ExperimentTemplate.Id))
+
+	return resourceExperimentTemplateRead(d, meta)
+}
+
+func resourceExperimentTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindExperimentTemplateByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FIS Experiment Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FIS Experiment Template (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenExperimentTemplateActions(template.Actions)); err != nil {
+		return fmt.Errorf("error setting action: %w", err)
+	}
+	d.Set("arn", template.Arn)
+	d.Set("description", template.Description)
+	if err := d.Set("experiment_options", flattenExperimentTemplateExperimentOptions(template.ExperimentOptions)); err != nil {
+		return fmt.Errorf("error setting experiment_options: %w", err)
+	}
+	if err := d.Set("log_configuration", flattenExperimentTemplateLogConfiguration(template.LogConfiguration)); err != nil {
+		return fmt.Errorf("error setting log_configuration: %w", err)
+	}
+	d.Set("role_arn", template.RoleArn)
+	if err := d.Set("stop_condition", flattenExperimentTemplateStopConditions(template.StopConditions)); err != nil {
+		return fmt.Errorf("error setting stop_condition: %w", err)
+	}
+	if err := d.Set("target", flattenExperimentTemplateTargets(template.Targets)); err != nil {
+		return fmt.Errorf("error setting target: %w", err)
+	}
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceExperimentTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &fis.UpdateExperimentTemplateInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			input.Actions = expandExperimentTemplateActionsForUpdate(d.Get("action").(*schema.Set))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("experiment_options") {
+			if v, ok := d.GetOk("experiment_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				tfMap := v.([]interface{})[0].(map[string]interface{})
+				apiObject := &fis.UpdateExperimentTemplateExperimentOptionsInput_{}
+
+				if v, ok := tfMap["empty_target_resolution_mode"].(string); ok && v != "" {
+					apiObject.EmptyTargetResolutionMode = aws.String(v)
+				}
+
+				input.ExperimentOptions = apiObject
+			}
+		}
+
+		if d.HasChange("log_configuration") {
+			apiObject := &fis.UpdateExperimentTemplateLogConfigurationInput_{}
+
+			// An empty configuration removes experiment logging.
+			if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				tfMap := v.([]interface{})[0].(map[string]interface{})
+
+				apiObject.CloudWatchLogsConfiguration = expandExperimentTemplateCloudWatchLogsLogConfiguration(tfMap["cloudwatch_logs_configuration"].([]interface{}))
+				apiObject.LogSchemaVersion = aws.Int64(int64(tfMap["log_schema_version"].(int)))
+				apiObject.S3Configuration = expandExperimentTemplateS3LogConfiguration(tfMap["s3_configuration"].([]interface{}))
+			}
+
+			input.LogConfiguration = apiObject
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("stop_condition") {
+			input.StopConditions = expandExperimentTemplateStopConditionsForUpdate(d.Get("stop_condition").(*schema.Set))
+		}
+
+		if d.HasChange("target") {
+			input.Targets = expandExperimentTemplateTargetsForUpdate(d.Get("target").(*schema.Set))
+		}
+
+		log.Printf("[DEBUG] Updating FIS Experiment Template: %s", input)
+		_, err := conn.UpdateExperimentTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating FIS Experiment Template (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FIS Experiment Template (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceExperimentTemplateRead(d, meta)
+}
+
+func resourceExperimentTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	log.Printf("[DEBUG] Deleting FIS Experiment Template: %s", d.Id())
+	_, err := conn.DeleteExperimentTemplate(&fis.DeleteExperimentTemplateInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, fis.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FIS Experiment Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandExperimentTemplateActions(tfSet *schema.Set) map[string]*fis.CreateExperimentTemplateActionInput {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*fis.CreateExperimentTemplateActionInput)
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.CreateExperimentTemplateActionInput{
+			ActionId:   aws.String(tfMap["action_id"].(string)),
+			Parameters: expandExperimentTemplateActionParameters(tfMap["parameter"].(*schema.Set)),
+			Targets:    expandExperimentTemplateActionTargets(tfMap["target"].([]interface{})),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["start_after"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.StartAfter = flex.ExpandStringSet(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateActionsForUpdate(tfSet *schema.Set) map[string]*fis.UpdateExperimentTemplateActionInputItem {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*fis.UpdateExperimentTemplateActionInputItem)
+
+	for name, v := range expandExperimentTemplateActions(tfSet) {
+		apiObjects[name] = &fis.UpdateExperimentTemplateActionInputItem{
+			ActionId:    v.ActionId,
+			Description: v.Description,
+			Parameters:  v.Parameters,
+			StartAfter:  v.StartAfter,
+			Targets:     v.Targets,
+		}
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateActionParameters(tfSet *schema.Set) map[string]*string {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObject := make(map[string]*string)
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject[tfMap["key"].(string)] = aws.String(tfMap["value"].(string))
+	}
+
+	return apiObject
+}
+
+func expandExperimentTemplateActionTargets(tfList []interface{}) map[string]*string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return map[string]*string{
+		tfMap["key"].(string): aws.String(tfMap["value"].(string)),
+	}
+}
+
+func expandExperimentTemplateStopConditions(tfSet *schema.Set) []*fis.CreateExperimentTemplateStopConditionInput {
+	var apiObjects []*fis.CreateExperimentTemplateStopConditionInput
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.CreateExperimentTemplateStopConditionInput{
+			Source: aws.String(tfMap["source"].(string)),
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateStopConditionsForUpdate(tfSet *schema.Set) []*fis.UpdateExperimentTemplateStopConditionInput {
+	var apiObjects []*fis.UpdateExperimentTemplateStopConditionInput
+
+	for _, v := range expandExperimentTemplateStopConditions(tfSet) {
+		apiObjects = append(apiObjects, &fis.UpdateExperimentTemplateStopConditionInput{
+			Source: v.Source,
+			Value:  v.Value,
+		})
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateTargets(tfSet *schema.Set) map[string]*fis.CreateExperimentTemplateTargetInput {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*fis.CreateExperimentTemplateTargetInput)
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.CreateExperimentTemplateTargetInput{
+			ResourceType:  aws.String(tfMap["resource_type"].(string)),
+			SelectionMode: aws.String(tfMap["selection_mode"].(string)),
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Filters = expandExperimentTemplateTargetFilters(v)
+		}
+
+		if v, ok := tfMap["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Parameters = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["resource_arns"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceArns = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["resource_tag"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceTags = expandExperimentTemplateActionParameters(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateTargetsForUpdate(tfSet *schema.Set) map[string]*fis.UpdateExperimentTemplateTargetInput {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*fis.UpdateExperimentTemplateTargetInput)
+
+	for name, v := range expandExperimentTemplateTargets(tfSet) {
+		apiObjects[name] = &fis.UpdateExperimentTemplateTargetInput{
+			Filters:       v.Filters,
+			Parameters:    v.Parameters,
+			ResourceArns:  v.ResourceArns,
+			ResourceTags:  v.ResourceTags,
+			ResourceType:  v.ResourceType,
+			SelectionMode: v.SelectionMode,
+		}
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateTargetFilters(tfList []interface{}) []*fis.ExperimentTemplateTargetInputFilter {
+	var apiObjects []*fis.ExperimentTemplateTargetInputFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &fis.ExperimentTemplateTargetInputFilter{
+			Path:   aws.String(tfMap["path"].(string)),
+			Values: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateCloudWatchLogsLogConfiguration(tfList []interface{}) *fis.ExperimentTemplateCloudWatchLogsLogConfigurationInput_ {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &fis.ExperimentTemplateCloudWatchLogsLogConfigurationInput_{
+		LogGroupArn: aws.String(tfMap["log_group_arn"].(string)),
+	}
+}
+
+func expandExperimentTemplateS3LogConfiguration(tfList []interface{}) *fis.ExperimentTemplateS3LogConfigurationInput_ {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &fis.ExperimentTemplateS3LogConfigurationInput_{
+		BucketName: aws.String(tfMap["bucket_name"].(string)),
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenExperimentTemplateActions(apiObjects map[string]*fis.ExperimentTemplateAction) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action_id":   aws.StringValue(apiObject.ActionId),
+			"description": aws.StringValue(apiObject.Description),
+			"name":        name,
+			"parameter":   flattenExperimentTemplateKeyValues(apiObject.Parameters),
+			"start_after": aws.StringValueSlice(apiObject.StartAfter),
+		}
+
+		for k, v := range apiObject.Targets {
+			tfMap["target"] = []interface{}{
+				map[string]interface{}{
+					"key":   k,
+					"value": aws.StringValue(v),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenExperimentTemplateKeyValues(apiObject map[string]*string) []interface{} {
+	var tfList []interface{}
+
+	for k, v := range apiObject {
+		tfList = append(tfList, map[string]interface{}{
+			"key":   k,
+			"value": aws.StringValue(v),
+		})
+	}
+
+	return tfList
+}
+
+func flattenExperimentTemplateExperimentOptions(apiObject *fis.ExperimentTemplateExperimentOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"account_targeting":            aws.StringValue(apiObject.AccountTargeting),
+		"empty_target_resolution_mode": aws.StringValue(apiObject.EmptyTargetResolutionMode),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExperimentTemplateLogConfiguration(apiObject *fis.ExperimentTemplateLogConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"log_schema_version": aws.Int64Value(apiObject.LogSchemaVersion),
+	}
+
+	if v := apiObject.CloudWatchLogsConfiguration; v != nil {
+		tfMap["cloudwatch_logs_configuration"] = []interface{}{
+			map[string]interface{}{
+				"log_group_arn": aws.StringValue(v.LogGroupArn),
+			},
+		}
+	}
+
+	if v := apiObject.S3Configuration; v != nil {
+		tfMap["s3_configuration"] = []interface{}{
+			map[string]interface{}{
+				"bucket_name": aws.StringValue(v.BucketName),
+				"prefix":      aws.StringValue(v.Prefix),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExperimentTemplateStopConditions(apiObjects []*fis.ExperimentTemplateStopCondition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source": aws.StringValue(apiObject.Source),
+			"value":  aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenExperimentTemplateTargets(apiObjects map[string]*fis.ExperimentTemplateTarget) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":           name,
+			"parameters":     aws.StringValueMap(apiObject.Parameters),
+			"resource_arns":  aws.StringValueSlice(apiObject.ResourceArns),
+			"resource_tag":   flattenExperimentTemplateKeyValues(apiObject.ResourceTags),
+			"resource_type":  aws.StringValue(apiObject.ResourceType),
+			"selection_mode": aws.StringValue(apiObject.SelectionMode),
+		}
+
+		var filters []interface{}
+
+		for _, v := range apiObject.Filters {
+			if v == nil {
+				continue
+			}
+
+			filters = append(filters, map[string]interface{}{
+				"path":   aws.StringValue(v.Path),
+				"values": aws.StringValueSlice(v.Values),
+			})
+		}
+
+		tfMap["filter"] = filters
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
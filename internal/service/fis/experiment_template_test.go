@@ -0,0 +1,489 @@
+package fis_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffis "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFISExperimentTemplate_basic(t *testing.T) {
+	var template fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, fis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig(rName, "An experiment template for testing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*", map[string]string{
+						"action_id": "aws:fis:wait",
+						"name":      "wait",
+					}),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "fis", regexp.MustCompile(`experiment-template/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "An experiment template for testing"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "single-account"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "fail"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stop_condition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "stop_condition.*", map[string]string{
+						"source": "none",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_disappears(t *testing.T) {
+	var template fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, fis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig(rName, "An experiment template for testing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template),
+					acctest.CheckResourceDisappears(acctest.Provider, tffis.ResourceExperimentTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_update(t *testing.T) {
+	var template fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, fis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig(rName, "An experiment template for testing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "description", "An experiment template for testing"),
+				),
+			},
+			{
+				Config: testAccExperimentTemplateFullConfig(rName, "skip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*", map[string]string{
+						"action_id":      "aws:ec2:stop-instances",
+						"name":           "stop-instances",
+						"parameter.#":    "1",
+						"target.#":       "1",
+						"target.0.key":   "Instances",
+						"target.0.value": "instances",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated experiment template"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "single-account"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "skip"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.log_schema_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.cloudwatch_logs_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_configuration.0.cloudwatch_logs_configuration.0.log_group_arn"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target.*", map[string]string{
+						"name":           "instances",
+						"resource_tag.#": "1",
+						"resource_type":  "aws:ec2:instance",
+						"selection_mode": "COUNT(1)",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_accountTargeting(t *testing.T) {
+	var template1, template2 fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, fis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateAccountTargetingConfig(rName, "single-account"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template1),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "single-account"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateAccountTargetingConfig(rName, "multi-account"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template2),
+					testAccCheckExperimentTemplateRecreated(&template1, &template2),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "multi-account"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_tags(t *testing.T) {
+	var template fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, fis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccExperimentTemplateTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExperimentTemplateExists(n string, v *fis.ExperimentTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FIS Experiment Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FISConn
+
+		output, err := tffis.FindExperimentTemplateByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckExperimentTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FISConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fis_experiment_template" {
+			continue
+		}
+
+		_, err := tffis.FindExperimentTemplateByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("FIS Experiment Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckExperimentTemplateRecreated(i, j *fis.ExperimentTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.Id) == aws.StringValue(j.Id) {
+			return fmt.Errorf("FIS Experiment Template (%s) not recreated", aws.StringValue(i.Id))
+		}
+
+		return nil
+	}
+}
+
+func testAccExperimentTemplateBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "fis.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccExperimentTemplateConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  action {
+    name      = "wait"
+    action_id = "aws:fis:wait"
+
+    parameter {
+      key   = "duration"
+      value = "PT1M"
+    }
+  }
+
+  stop_condition {
+    source = "none"
+  }
+}
+`, description))
+}
+
+func testAccExperimentTemplateFullConfig(rName, emptyTargetResolutionMode string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": [
+      "ec2:StopInstances",
+      "ec2:StartInstances",
+      "ec2:DescribeInstances",
+      "logs:CreateLogDelivery",
+      "logs:PutResourcePolicy",
+      "logs:DescribeResourcePolicies",
+      "logs:DescribeLogGroups"
+    ],
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_fis_experiment_template" "test" {
+  description = "Updated experiment template"
+  role_arn    = aws_iam_role.test.arn
+
+  action {
+    name      = "stop-instances"
+    action_id = "aws:ec2:stop-instances"
+
+    parameter {
+      key   = "startInstancesAfterDuration"
+      value = "PT1M"
+    }
+
+    target {
+      key   = "Instances"
+      value = "instances"
+    }
+  }
+
+  experiment_options {
+    empty_target_resolution_mode = %[2]q
+  }
+
+  log_configuration {
+    log_schema_version = 2
+
+    cloudwatch_logs_configuration {
+      log_group_arn = "${aws_cloudwatch_log_group.test.arn}:*"
+    }
+  }
+
+  stop_condition {
+    source = "none"
+  }
+
+  target {
+    name           = "instances"
+    resource_type  = "aws:ec2:instance"
+    selection_mode = "COUNT(1)"
+
+    resource_tag {
+      key   = "Name"
+      value = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, emptyTargetResolutionMode))
+}
+
+func testAccExperimentTemplateAccountTargetingConfig(rName, accountTargeting string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  action {
+    name      = "wait"
+    action_id = "aws:fis:wait"
+
+    parameter {
+      key   = "duration"
+      value = "PT1M"
+    }
+  }
+
+  experiment_options {
+    account_targeting = %[2]q
+  }
+
+  stop_condition {
+    source = "none"
+  }
+}
+`, rName, accountTargeting))
+}
+
+func testAccExperimentTemplateTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  action {
+    name      = "wait"
+    action_id = "aws:fis:wait"
+
+    parameter {
+      key   = "duration"
+      value = "PT1M"
+    }
+  }
+
+  stop_condition {
+    source = "none"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccExperimentTemplateTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  action {
+    name      = "wait"
+    action_id = "aws:fis:wait"
+
+    parameter {
+      key   = "duration"
+      value = "PT1M"
+    }
+  }
+
+  stop_condition {
+    source = "none"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
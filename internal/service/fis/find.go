@@ -0,0 +1,60 @@
+package fis
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindExperimentTemplateByID(conn *fis.FIS, id string) (*fis.ExperimentTemplate, error) {
+	input := &fis.GetExperimentTemplateInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetExperimentTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, fis.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExperimentTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExperimentTemplate, nil
+}
+
+func FindTargetAccountConfigurationByTwoPartKey(conn *fis.FIS, experimentTemplateID, accountID string) (*fis.TargetAccountConfiguration, error) {
+	input := &fis.GetTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ExperimentTemplateId: aws.String(experimentTemplateID),
+	}
+
+	output, err := conn.GetTargetAccountConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, fis.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TargetAccountConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TargetAccountConfiguration, nil
+}
@@ -0,0 +1,186 @@
+package fis
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTargetAccountConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTargetAccountConfigurationCreate,
+		Read:   resourceTargetAccountConfigurationRead,
+		Update: resourceTargetAccountConfigurationUpdate,
+		Delete: resourceTargetAccountConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"experiment_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTargetAccountConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	experimentTemplateID := d.Get("experiment_template_id").(string)
+	accountID := d.Get("account_id").(string)
+	id := TargetAccountConfigurationCreateResourceID(experimentTemplateID, accountID)
+	input := &fis.CreateTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ExperimentTemplateId: aws.String(experimentTemplateID),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating FIS Target Account Configuration: %s", input)
+	_, err := conn.CreateTargetAccountConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FIS Target Account Configuration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceTargetAccountConfigurationRead(d, meta)
+}
+
+func resourceTargetAccountConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	experimentTemplateID, accountID, err := TargetAccountConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	configuration, err := FindTargetAccountConfigurationByTwoPartKey(conn, experimentTemplateID, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FIS Target Account Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FIS Target Account Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", configuration.AccountId)
+	d.Set("description", configuration.Description)
+	d.Set("experiment_template_id", experimentTemplateID)
+	d.Set("role_arn", configuration.RoleArn)
+
+	return nil
+}
+
+func resourceTargetAccountConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	experimentTemplateID, accountID, err := TargetAccountConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &fis.UpdateTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ExperimentTemplateId: aws.String(experimentTemplateID),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleArn = aws.String(d.Get("role_arn").(string))
+	}
+
+	log.Printf("[DEBUG] Updating FIS Target Account Configuration: %s", input)
+	_, err = conn.UpdateTargetAccountConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating FIS Target Account Configuration (%s): %w", d.Id(), err)
+	}
+
+	return resourceTargetAccountConfigurationRead(d, meta)
+}
+
+func resourceTargetAccountConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	experimentTemplateID, accountID, err := TargetAccountConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting FIS Target Account Configuration: %s", d.Id())
+	_, err = conn.DeleteTargetAccountConfiguration(&fis.DeleteTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ExperimentTemplateId: aws.String(experimentTemplateID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, fis.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FIS Target Account Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const targetAccountConfigurationResourceIDSeparator = ":"
+
+func TargetAccountConfigurationCreateResourceID(experimentTemplateID, accountID string) string {
+	parts := []string{experimentTemplateID, accountID}
+	id := strings.Join(parts, targetAccountConfigurationResourceIDSeparator)
+
+	return id
+}
+
+func TargetAccountConfigurationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, targetAccountConfigurationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected experiment-template-id%[2]saccount-id", id, targetAccountConfigurationResourceIDSeparator)
+}
@@ -0,0 +1,149 @@
+package fis_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffis "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFISTargetAccountConfiguration_basic(t *testing.T) {
+	var configuration fis.TargetAccountConfiguration
+	resourceName := "aws_fis_target_account_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, fis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetAccountConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetAccountConfigurationConfig(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetAccountConfigurationExists(resourceName, &configuration),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttrPair(resourceName, "experiment_template_id", "aws_fis_experiment_template.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetAccountConfigurationConfig(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetAccountConfigurationExists(resourceName, &configuration),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFISTargetAccountConfiguration_disappears(t *testing.T) {
+	var configuration fis.TargetAccountConfiguration
+	resourceName := "aws_fis_target_account_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, fis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetAccountConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetAccountConfigurationConfig(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetAccountConfigurationExists(resourceName, &configuration),
+					acctest.CheckResourceDisappears(acctest.Provider, tffis.ResourceTargetAccountConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTargetAccountConfigurationExists(n string, v *fis.TargetAccountConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FIS Target Account Configuration ID is set")
+		}
+
+		experimentTemplateID, accountID, err := tffis.TargetAccountConfigurationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FISConn
+
+		output, err := tffis.FindTargetAccountConfigurationByTwoPartKey(conn, experimentTemplateID, accountID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTargetAccountConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FISConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fis_target_account_configuration" {
+			continue
+		}
+
+		experimentTemplateID, accountID, err := tffis.TargetAccountConfigurationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tffis.FindTargetAccountConfigurationByTwoPartKey(conn, experimentTemplateID, accountID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("FIS Target Account Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTargetAccountConfigurationConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateAccountTargetingConfig(rName, "multi-account"), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_fis_target_account_configuration" "test" {
+  experiment_template_id = aws_fis_experiment_template.test.id
+  account_id             = data.aws_caller_identity.current.account_id
+  role_arn               = aws_iam_role.test.arn
+  description            = %[1]q
+}
+`, description))
+}
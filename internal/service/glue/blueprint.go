@@ -0,0 +1,228 @@
+package glue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBlueprint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlueprintCreate,
+		ReadContext:   resourceBlueprintRead,
+		UpdateContext: resourceBlueprintUpdate,
+		DeleteContext: resourceBlueprintDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blueprint_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 8192),
+			},
+			"blueprint_service_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"last_modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parameter_spec": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &glue.CreateBlueprintInput{
+		BlueprintLocation: aws.String(d.Get("blueprint_location").(string)),
+		Name:              aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Glue Blueprint: %s", input)
+	_, err := conn.CreateBlueprintWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Glue Blueprint (%s): %w", name, err))
+	}
+
+	d.SetId(name)
+
+	if _, err := waitBlueprintActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Glue Blueprint (%s) to become active: %w", d.Id(), err))
+	}
+
+	return resourceBlueprintRead(ctx, d, meta)
+}
+
+func resourceBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	blueprint, err := FindBlueprintByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Blueprint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Glue Blueprint (%s): %w", d.Id(), err))
+	}
+
+	blueprintARN := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("blueprint/%s", d.Id()))
+	d.Set("arn", blueprintARN)
+	d.Set("blueprint_location", blueprint.BlueprintLocation)
+	d.Set("blueprint_service_location", blueprint.BlueprintServiceLocation)
+	if blueprint.CreatedOn != nil {
+		d.Set("created_on", aws.TimeValue(blueprint.CreatedOn).Format(time.RFC3339))
+	} else {
+		d.Set("created_on", nil)
+	}
+	d.Set("description", blueprint.Description)
+	if blueprint.LastModifiedOn != nil {
+		d.Set("last_modified_on", aws.TimeValue(blueprint.LastModifiedOn).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_on", nil)
+	}
+	d.Set("name", blueprint.Name)
+	d.Set("parameter_spec", blueprint.ParameterSpec)
+	d.Set("status", blueprint.Status)
+
+	tags, err := ListTags(conn, blueprintARN)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for Glue Blueprint (%s): %w", blueprintARN, err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	if d.HasChanges("blueprint_location", "description") {
+		input := &glue.UpdateBlueprintInput{
+			BlueprintLocation: aws.String(d.Get("blueprint_location").(string)),
+			Name:              aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Glue Blueprint: %s", input)
+		_, err := conn.UpdateBlueprintWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Glue Blueprint (%s): %w", d.Id(), err))
+		}
+
+		if _, err := waitBlueprintActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for Glue Blueprint (%s) to become active: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
+		}
+	}
+
+	return resourceBlueprintRead(ctx, d, meta)
+}
+
+func resourceBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	log.Printf("[DEBUG] Deleting Glue Blueprint: %s", d.Id())
+	_, err := conn.DeleteBlueprintWithContext(ctx, &glue.DeleteBlueprintInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Glue Blueprint (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlueBlueprint_basic(t *testing.T) {
+	var blueprint glue.Blueprint
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_blueprint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBlueprintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueprintBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueprintExists(resourceName, &blueprint),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "glue", fmt.Sprintf("blueprint/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "blueprint_location", fmt.Sprintf("s3://%s/blueprint.zip", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_on"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "parameter_spec"),
+					resource.TestCheckResourceAttr(resourceName, "status", glue.BlueprintStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueBlueprint_description(t *testing.T) {
+	var blueprint glue.Blueprint
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_blueprint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBlueprintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueprintDescriptionConfig(rName, "First Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueprintExists(resourceName, &blueprint),
+					resource.TestCheckResourceAttr(resourceName, "description", "First Description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBlueprintDescriptionConfig(rName, "Second Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueprintExists(resourceName, &blueprint),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second Description"),
+					resource.TestCheckResourceAttr(resourceName, "status", glue.BlueprintStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueBlueprint_tags(t *testing.T) {
+	var blueprint glue.Blueprint
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_blueprint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBlueprintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueprintTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueprintExists(resourceName, &blueprint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBlueprintTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueprintExists(resourceName, &blueprint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccBlueprintTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueprintExists(resourceName, &blueprint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueBlueprint_disappears(t *testing.T) {
+	var blueprint glue.Blueprint
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_blueprint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBlueprintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueprintBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueprintExists(resourceName, &blueprint),
+					acctest.CheckResourceDisappears(acctest.Provider, tfglue.ResourceBlueprint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBlueprintExists(resourceName string, blueprint *glue.Blueprint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Blueprint ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+
+		output, err := tfglue.FindBlueprintByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*blueprint = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBlueprintDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_blueprint" {
+			continue
+		}
+
+		_, err := tfglue.FindBlueprintByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Blueprint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBlueprintBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "blueprint.zip"
+  source = "test-fixtures/blueprint.zip"
+}
+`, rName)
+}
+
+func testAccBlueprintBasicConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBlueprintBaseConfig(rName), fmt.Sprintf(`
+resource "aws_glue_blueprint" "test" {
+  name               = %[1]q
+  blueprint_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+}
+`, rName))
+}
+
+func testAccBlueprintDescriptionConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccBlueprintBaseConfig(rName), fmt.Sprintf(`
+resource "aws_glue_blueprint" "test" {
+  name               = %[1]q
+  blueprint_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  description        = %[2]q
+}
+`, rName, description))
+}
+
+func testAccBlueprintTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccBlueprintBaseConfig(rName), fmt.Sprintf(`
+resource "aws_glue_blueprint" "test" {
+  name               = %[1]q
+  blueprint_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccBlueprintTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccBlueprintBaseConfig(rName), fmt.Sprintf(`
+resource "aws_glue_blueprint" "test" {
+  name               = %[1]q
+  blueprint_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -189,13 +188,7 @@ func resourceCatalogDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	database := out.Database
-	databaseArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("database/%s", aws.StringValue(database.Name)),
-	}.String()
+	databaseArn := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("database/%s", aws.StringValue(database.Name)))
 	d.Set("arn", databaseArn)
 	d.Set("name", database.Name)
 	d.Set("catalog_id", database.CatalogId)
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -57,6 +56,38 @@ func ResourceCatalogTable() *schema.Resource {
 					validation.StringDoesNotMatch(regexp.MustCompile(`[A-Z]`), "uppercase characters cannot be used"),
 				),
 			},
+			"open_table_format_input": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iceberg_input": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metadata_operation": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(glue.MetadataOperation_Values(), false),
+									},
+									"version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"owner": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -369,6 +400,10 @@ func resourceCatalogTableCreate(d *schema.ResourceData, meta interface{}) error
 		PartitionIndexes: expandGlueTablePartitionIndexes(d.Get("partition_index").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("open_table_format_input"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OpenTableFormatInput = expandGlueOpenTableFormatInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Glue catalog table input: %#v", input)
 	_, err := conn.CreateTable(input)
 	if err != nil {
@@ -401,13 +436,7 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	table := out.Table
-	tableArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("table/%s/%s", dbName, aws.StringValue(table.Name)),
-	}.String()
+	tableArn := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("table/%s/%s", dbName, aws.StringValue(table.Name)))
 	d.Set("arn", tableArn)
 
 	d.Set("name", table.Name)
@@ -417,6 +446,23 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("owner", table.Owner)
 	d.Set("retention", table.Retention)
 
+	// Iceberg tables have parameters and column parameters that are managed by the Iceberg library
+	// on every commit; these are not reported unless they are configured to avoid perpetual differences.
+	parameters := aws.StringValueMap(table.Parameters)
+	if isIcebergTable(table.Parameters) {
+		configParameters := d.Get("parameters").(map[string]interface{})
+
+		for _, k := range icebergManagedTableParameters {
+			if _, ok := configParameters[k]; !ok {
+				delete(parameters, k)
+			}
+		}
+
+		if table.StorageDescriptor != nil {
+			removeIcebergManagedColumnParameters(table.StorageDescriptor.Columns)
+		}
+	}
+
 	if err := d.Set("storage_descriptor", flattenGlueStorageDescriptor(table.StorageDescriptor)); err != nil {
 		return fmt.Errorf("error setting storage_descriptor: %w", err)
 	}
@@ -429,7 +475,7 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("view_expanded_text", table.ViewExpandedText)
 	d.Set("table_type", table.TableType)
 
-	if err := d.Set("parameters", aws.StringValueMap(table.Parameters)); err != nil {
+	if err := d.Set("parameters", parameters); err != nil {
 		return fmt.Errorf("error setting parameters: %w", err)
 	}
 
@@ -463,7 +509,7 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 func resourceCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlueConn
 
-	catalogID, dbName, _, err := ReadTableID(d.Id())
+	catalogID, dbName, name, err := ReadTableID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -474,6 +520,34 @@ func resourceCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error
 		TableInput:   expandGlueTableInput(d),
 	}
 
+	// UpdateTable replaces all table parameters, so retain the Iceberg-managed parameters (notably the current
+	// metadata location) which are not reported in state.
+	out, err := FindTableByName(conn, catalogID, dbName, name)
+	if err != nil {
+		return fmt.Errorf("Error reading Glue Catalog Table (%s): %w", d.Id(), err)
+	}
+
+	if table := out.Table; isIcebergTable(table.Parameters) {
+		if updateTableInput.TableInput.Parameters == nil {
+			updateTableInput.TableInput.Parameters = make(map[string]*string)
+		}
+
+		for _, k := range icebergManagedTableParameters {
+			if _, ok := updateTableInput.TableInput.Parameters[k]; ok {
+				continue
+			}
+
+			if v, ok := table.Parameters[k]; ok {
+				updateTableInput.TableInput.Parameters[k] = v
+			}
+		}
+
+		// Likewise retain the Iceberg field IDs on columns, without which the table can no longer be read.
+		if sd := updateTableInput.TableInput.StorageDescriptor; sd != nil && table.StorageDescriptor != nil {
+			mergeIcebergManagedColumnParameters(sd.Columns, table.StorageDescriptor.Columns)
+		}
+	}
+
 	if _, err := conn.UpdateTable(updateTableInput); err != nil {
 		return fmt.Errorf("Error updating Glue Catalog Table: %w", err)
 	}
@@ -1031,3 +1105,104 @@ func flattenGlueTableTargetTable(apiObject *glue.TableIdentifier) map[string]int
 
 	return tfMap
 }
+
+func expandGlueOpenTableFormatInput(tfMap map[string]interface{}) *glue.OpenTableFormatInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.OpenTableFormatInput_{}
+
+	if v, ok := tfMap["iceberg_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IcebergInput = expandGlueIcebergInput(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandGlueIcebergInput(tfMap map[string]interface{}) *glue.IcebergInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.IcebergInput_{}
+
+	if v, ok := tfMap["metadata_operation"].(string); ok && v != "" {
+		apiObject.MetadataOperation = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+const (
+	icebergTableParameterTableType = "table_type"
+	icebergTableType               = "ICEBERG"
+	icebergColumnParameterPrefix   = "iceberg.field."
+)
+
+// icebergManagedTableParameters are the table parameters written by the Iceberg library on each commit.
+var icebergManagedTableParameters = []string{
+	"metadata_location",
+	"previous_metadata_location",
+	icebergTableParameterTableType,
+}
+
+func isIcebergTable(parameters map[string]*string) bool {
+	return strings.EqualFold(aws.StringValue(parameters[icebergTableParameterTableType]), icebergTableType)
+}
+
+func removeIcebergManagedColumnParameters(columns []*glue.Column) {
+	for _, column := range columns {
+		if column == nil {
+			continue
+		}
+
+		for k := range column.Parameters {
+			if strings.HasPrefix(k, icebergColumnParameterPrefix) {
+				delete(column.Parameters, k)
+			}
+		}
+
+		if len(column.Parameters) == 0 {
+			column.Parameters = nil
+		}
+	}
+}
+
+// mergeIcebergManagedColumnParameters copies the Iceberg-managed parameters of existing columns
+// to the columns with the same name, unless they are explicitly configured.
+func mergeIcebergManagedColumnParameters(columns, existing []*glue.Column) {
+	existingParameters := make(map[string]map[string]*string)
+
+	for _, column := range existing {
+		if column == nil {
+			continue
+		}
+
+		existingParameters[aws.StringValue(column.Name)] = column.Parameters
+	}
+
+	for _, column := range columns {
+		if column == nil {
+			continue
+		}
+
+		for k, v := range existingParameters[aws.StringValue(column.Name)] {
+			if !strings.HasPrefix(k, icebergColumnParameterPrefix) {
+				continue
+			}
+
+			if column.Parameters == nil {
+				column.Parameters = make(map[string]*string)
+			}
+
+			if _, ok := column.Parameters[k]; !ok {
+				column.Parameters[k] = v
+			}
+		}
+	}
+}
@@ -2,6 +2,8 @@ package glue_test
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -638,6 +640,53 @@ func TestAccGlueCatalogTable_targetTable(t *testing.T) {
 	})
 }
 
+func TestAccGlueCatalogTable_openTableFormat(t *testing.T) {
+	var icebergColumnParameters1, icebergColumnParameters2 map[string]map[string]string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_catalog_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGlueTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogTableConfigOpenTableFormat(rName, "desc1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc1"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.metadata_operation", "CREATE"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.parameters.%", "0"),
+					testAccCheckGlueCatalogTableIcebergColumnParameters(resourceName, &icebergColumnParameters1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"open_table_format_input"},
+			},
+			{
+				Config: testAccGlueCatalogTableConfigOpenTableFormat(rName, "desc2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.parameters.%", "0"),
+					testAccCheckGlueCatalogTableIcebergColumnParameters(resourceName, &icebergColumnParameters2),
+					testAccCheckGlueCatalogTableIcebergColumnParametersEqual(&icebergColumnParameters1, &icebergColumnParameters2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueCatalogTable_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_catalog_table.test"
@@ -1133,6 +1182,64 @@ func testAccCheckGlueTableDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckGlueCatalogTableIcebergColumnParameters records the Iceberg-managed column parameters,
+// which are not reported in state, of each column of the table.
+func testAccCheckGlueCatalogTableIcebergColumnParameters(name string, v *map[string]map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		catalogId, dbName, resourceName, err := tfglue.ReadTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		out, err := tfglue.FindTableByName(conn, catalogId, dbName, resourceName)
+		if err != nil {
+			return err
+		}
+
+		parameters := make(map[string]map[string]string)
+
+		if sd := out.Table.StorageDescriptor; sd != nil {
+			for _, column := range sd.Columns {
+				for k, v := range column.Parameters {
+					if !strings.HasPrefix(k, "iceberg.field.") {
+						continue
+					}
+
+					columnName := aws.StringValue(column.Name)
+					if parameters[columnName] == nil {
+						parameters[columnName] = make(map[string]string)
+					}
+					parameters[columnName][k] = aws.StringValue(v)
+				}
+			}
+		}
+
+		if len(parameters) == 0 {
+			return fmt.Errorf("Glue Table (%s) has no Iceberg column parameters", rs.Primary.ID)
+		}
+
+		*v = parameters
+
+		return nil
+	}
+}
+
+func testAccCheckGlueCatalogTableIcebergColumnParametersEqual(before, after *map[string]map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !reflect.DeepEqual(*before, *after) {
+			return fmt.Errorf("Iceberg column parameters changed: before %v, after %v", *before, *after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckGlueCatalogTableExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1388,3 +1495,44 @@ resource "aws_glue_catalog_table" "test2" {
 }
 `, rName)
 }
+
+func testAccGlueCatalogTableConfigOpenTableFormat(rName, desc string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+  description   = %[2]q
+  table_type    = "EXTERNAL_TABLE"
+
+  open_table_format_input {
+    iceberg_input {
+      metadata_operation = "CREATE"
+      version            = "2"
+    }
+  }
+
+  storage_descriptor {
+    location = "s3://${aws_s3_bucket.test.bucket}/iceberg"
+
+    columns {
+      name = "my_column_1"
+      type = "int"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+`, rName, desc)
+}
@@ -0,0 +1,288 @@
+package glue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceCatalogTables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCatalogTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"expression": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partition_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     catalogTablesColumnSchema(),
+						},
+						"storage_descriptor": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_columns": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"columns": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     catalogTablesColumnSchema(),
+									},
+									"compressed": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"input_format": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"location": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"number_of_buckets": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"output_format": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"parameters": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"ser_de_info": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"parameters": {
+													Type:     schema.TypeMap,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"serialization_library": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"schema_reference": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"schema_id": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"registry_name": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+															"schema_arn": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+															"schema_name": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+												"schema_version_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"schema_version_number": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"skewed_info": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"skewed_column_names": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"skewed_column_value_location_maps": {
+													Type:     schema.TypeMap,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"skewed_column_values": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"sort_columns": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"sort_order": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"stored_as_sub_directories": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"table_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func catalogTablesColumnSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCatalogTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
+	dbName := d.Get("database_name").(string)
+
+	input := &glue.GetTablesInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+	}
+
+	if v, ok := d.GetOk("expression"); ok {
+		input.Expression = aws.String(v.(string))
+	}
+
+	tables, err := FindTables(ctx, conn, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Glue Catalog Tables (%s:%s): %w", catalogID, dbName, err))
+	}
+
+	var names []string
+	var tfList []interface{}
+
+	for _, table := range tables {
+		names = append(names, aws.StringValue(table.Name))
+		tfList = append(tfList, flattenCatalogTablesTableData(table))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", catalogID, dbName))
+	d.Set("catalog_id", catalogID)
+	d.Set("database_name", dbName)
+
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting names: %w", err))
+	}
+
+	if err := d.Set("tables", tfList); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tables: %w", err))
+	}
+
+	return nil
+}
+
+func flattenCatalogTablesTableData(apiObject *glue.TableData) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"name":               aws.StringValue(apiObject.Name),
+		"partition_keys":     flattenGlueColumns(apiObject.PartitionKeys),
+		"storage_descriptor": flattenGlueStorageDescriptor(apiObject.StorageDescriptor),
+		"table_type":         aws.StringValue(apiObject.TableType),
+	}
+
+	return tfMap
+}
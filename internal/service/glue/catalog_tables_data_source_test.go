@@ -0,0 +1,126 @@
+package glue_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGlueCatalogTablesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_glue_catalog_tables.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTablesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "catalog_id", "aws_glue_catalog_database.test", "catalog_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tables.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "tables.*", map[string]string{
+						"name":                                    fmt.Sprintf("%s_events", rName),
+						"table_type":                              "EXTERNAL_TABLE",
+						"partition_keys.#":                        "1",
+						"partition_keys.0.name":                   "dt",
+						"storage_descriptor.#":                    "1",
+						"storage_descriptor.0.columns.#":          "2",
+						"storage_descriptor.0.ser_de_info.#":      "1",
+						"storage_descriptor.0.ser_de_info.0.name": "events",
+					}),
+				),
+			},
+			{
+				Config: testAccCatalogTablesDataSourceExpressionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", fmt.Sprintf("%s_users", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "tables.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tables.0.partition_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCatalogTablesDataSourceBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "events" {
+  name          = "%[1]s_events"
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  partition_keys {
+    name = "dt"
+    type = "string"
+  }
+
+  storage_descriptor {
+    location = "s3://example-bucket/events"
+
+    columns {
+      name = "id"
+      type = "string"
+    }
+
+    columns {
+      name = "payload"
+      type = "string"
+    }
+
+    ser_de_info {
+      name                  = "events"
+      serialization_library = "org.openx.data.jsonserde.JsonSerDe"
+    }
+  }
+}
+
+resource "aws_glue_catalog_table" "users" {
+  name          = "%[1]s_users"
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location = "s3://example-bucket/users"
+
+    columns {
+      name = "id"
+      type = "string"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCatalogTablesDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccCatalogTablesDataSourceBaseConfig(rName), `
+data "aws_glue_catalog_tables" "test" {
+  database_name = aws_glue_catalog_database.test.name
+
+  depends_on = [aws_glue_catalog_table.events, aws_glue_catalog_table.users]
+}
+`)
+}
+
+func testAccCatalogTablesDataSourceExpressionConfig(rName string) string {
+	return acctest.ConfigCompose(testAccCatalogTablesDataSourceBaseConfig(rName), `
+data "aws_glue_catalog_tables" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  expression    = ".*_users"
+
+  depends_on = [aws_glue_catalog_table.events, aws_glue_catalog_table.users]
+}
+`)
+}
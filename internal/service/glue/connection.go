@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -153,13 +152,7 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading Glue Connection (%s): %w", d.Id(), err)
 	}
 
-	connectionArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("connection/%s", connectionName),
-	}.String()
+	connectionArn := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("connection/%s", connectionName))
 	d.Set("arn", connectionArn)
 
 	d.Set("catalog_id", catalogID)
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -107,13 +106,7 @@ func dataSourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("name", connection.Name)
 	d.Set("description", connection.Description)
 
-	connectionArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("connection/%s", connectionName),
-	}.String()
+	connectionArn := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("connection/%s", connectionName))
 	d.Set("arn", connectionArn)
 
 	if err := d.Set("connection_properties", aws.StringValueMap(connection.ConnectionProperties)); err != nil {
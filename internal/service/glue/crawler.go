@@ -754,13 +754,7 @@ func resourceCrawlerRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	crawlerARN := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("crawler/%s", d.Id()),
-	}.String()
+	crawlerARN := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("crawler/%s", d.Id()))
 	d.Set("arn", crawlerARN)
 	d.Set("name", crawler.Name)
 	d.Set("database_name", crawler.DatabaseName)
@@ -0,0 +1,243 @@
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlueCustomEntityType_basic(t *testing.T) {
+	var customEntityType glue.GetCustomEntityTypeOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_custom_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomEntityTypeBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomEntityTypeExists(resourceName, &customEntityType),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "glue", fmt.Sprintf("customEntityType/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "context_words.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "regex_string", "[A-Z]{3}-[0-9]{4}"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueCustomEntityType_contextWords(t *testing.T) {
+	var customEntityType glue.GetCustomEntityTypeOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_custom_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomEntityTypeContextWordsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomEntityTypeExists(resourceName, &customEntityType),
+					resource.TestCheckResourceAttr(resourceName, "context_words.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "context_words.0", "account"),
+					resource.TestCheckResourceAttr(resourceName, "context_words.1", "employee"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueCustomEntityType_tags(t *testing.T) {
+	var customEntityType glue.GetCustomEntityTypeOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_custom_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomEntityTypeTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomEntityTypeExists(resourceName, &customEntityType),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomEntityTypeTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomEntityTypeExists(resourceName, &customEntityType),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCustomEntityTypeTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomEntityTypeExists(resourceName, &customEntityType),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueCustomEntityType_disappears(t *testing.T) {
+	var customEntityType glue.GetCustomEntityTypeOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_custom_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomEntityTypeBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomEntityTypeExists(resourceName, &customEntityType),
+					acctest.CheckResourceDisappears(acctest.Provider, tfglue.ResourceCustomEntityType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomEntityTypeExists(resourceName string, customEntityType *glue.GetCustomEntityTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Custom Entity Type ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+
+		output, err := tfglue.FindCustomEntityTypeByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*customEntityType = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCustomEntityTypeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_custom_entity_type" {
+			continue
+		}
+
+		_, err := tfglue.FindCustomEntityTypeByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Custom Entity Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCustomEntityTypeBasicConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_custom_entity_type" "test" {
+  name         = %[1]q
+  regex_string = "[A-Z]{3}-[0-9]{4}"
+}
+`, rName)
+}
+
+func testAccCustomEntityTypeContextWordsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_custom_entity_type" "test" {
+  name          = %[1]q
+  regex_string  = "[A-Z]{3}-[0-9]{4}"
+  context_words = ["account", "employee"]
+}
+`, rName)
+}
+
+func testAccCustomEntityTypeTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_custom_entity_type" "test" {
+  name         = %[1]q
+  regex_string = "[A-Z]{3}-[0-9]{4}"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCustomEntityTypeTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_custom_entity_type" "test" {
+  name         = %[1]q
+  regex_string = "[A-Z]{3}-[0-9]{4}"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
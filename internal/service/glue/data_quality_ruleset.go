@@ -0,0 +1,279 @@
+package glue
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataQualityRuleset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataQualityRulesetCreate,
+		Read:   resourceDataQualityRulesetRead,
+		Update: resourceDataQualityRulesetUpdate,
+		Delete: resourceDataQualityRulesetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"last_modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"recommendation_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ruleset": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 65536),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"database_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataQualityRulesetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlueConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &glue.CreateDataQualityRulesetInput{
+		Name:    aws.String(name),
+		Ruleset: aws.String(d.Get("ruleset").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TargetTable = expandDataQualityTargetTable(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Glue Data Quality Ruleset: %s", input)
+	_, err := conn.CreateDataQualityRuleset(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue Data Quality Ruleset (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceDataQualityRulesetRead(d, meta)
+}
+
+func resourceDataQualityRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlueConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDataQualityRulesetByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Data Quality Ruleset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Data Quality Ruleset (%s): %w", d.Id(), err)
+	}
+
+	rulesetARN := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("dataQualityRuleset/%s", d.Id()))
+	d.Set("arn", rulesetARN)
+	d.Set("created_on", aws.TimeValue(output.CreatedOn).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_modified_on", aws.TimeValue(output.LastModifiedOn).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("recommendation_run_id", output.RecommendationRunId)
+	d.Set("ruleset", output.Ruleset)
+
+	if output.TargetTable != nil {
+		if err := d.Set("target_table", []interface{}{flattenDataQualityTargetTable(output.TargetTable)}); err != nil {
+			return fmt.Errorf("error setting target_table: %w", err)
+		}
+	} else {
+		d.Set("target_table", nil)
+	}
+
+	tags, err := ListTags(conn, rulesetARN)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Glue Data Quality Ruleset (%s): %w", rulesetARN, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDataQualityRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	if d.HasChanges("description", "ruleset") {
+		input := &glue.UpdateDataQualityRulesetInput{
+			Name:    aws.String(d.Id()),
+			Ruleset: aws.String(d.Get("ruleset").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Glue Data Quality Ruleset: %s", input)
+		_, err := conn.UpdateDataQualityRuleset(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Glue Data Quality Ruleset (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %w", err)
+		}
+	}
+
+	return resourceDataQualityRulesetRead(d, meta)
+}
+
+func resourceDataQualityRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	log.Printf("[DEBUG] Deleting Glue Data Quality Ruleset: %s", d.Id())
+	_, err := conn.DeleteDataQualityRuleset(&glue.DeleteDataQualityRulesetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Data Quality Ruleset (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataQualityTargetTable(tfMap map[string]interface{}) *glue.DataQualityTargetTable {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.DataQualityTargetTable{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataQualityTargetTable(apiObject *glue.DataQualityTargetTable) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,310 @@
+package glue_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlueDataQualityRuleset_basic(t *testing.T) {
+	var ruleset glue.GetDataQualityRulesetOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataQualityRulesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetBasicConfig(rName, "ColumnCount > 3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "glue", fmt.Sprintf("dataQualityRuleset/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_on"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_on"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "ruleset", "Rules = [ ColumnCount > 3 ]"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_table.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataQualityRulesetBasicConfig(rName, "ColumnCount > 5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					resource.TestCheckResourceAttr(resourceName, "ruleset", "Rules = [ ColumnCount > 5 ]"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueDataQualityRuleset_description(t *testing.T) {
+	var ruleset glue.GetDataQualityRulesetOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataQualityRulesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetDescriptionConfig(rName, "First Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					resource.TestCheckResourceAttr(resourceName, "description", "First Description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataQualityRulesetDescriptionConfig(rName, "Second Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second Description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueDataQualityRuleset_targetTable(t *testing.T) {
+	var ruleset glue.GetDataQualityRulesetOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataQualityRulesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetTargetTableConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					resource.TestCheckResourceAttr(resourceName, "target_table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_table.0.database_name", "aws_glue_catalog_table.test", "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_table.0.table_name", "aws_glue_catalog_table.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueDataQualityRuleset_tags(t *testing.T) {
+	var ruleset glue.GetDataQualityRulesetOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataQualityRulesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataQualityRulesetTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDataQualityRulesetTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueDataQualityRuleset_disappears(t *testing.T) {
+	var ruleset glue.GetDataQualityRulesetOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataQualityRulesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetBasicConfig(rName, "ColumnCount > 3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(resourceName, &ruleset),
+					acctest.CheckResourceDisappears(acctest.Provider, tfglue.ResourceDataQualityRuleset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataQualityRulesetExists(resourceName string, ruleset *glue.GetDataQualityRulesetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Data Quality Ruleset ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+
+		output, err := tfglue.FindDataQualityRulesetByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*ruleset = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDataQualityRulesetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_data_quality_ruleset" {
+			continue
+		}
+
+		_, err := tfglue.FindDataQualityRulesetByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Data Quality Ruleset %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDataQualityRulesetBasicConfig(rName, rule string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_data_quality_ruleset" "test" {
+  name    = %[1]q
+  ruleset = "Rules = [ %[2]s ]"
+}
+`, rName, rule)
+}
+
+func testAccDataQualityRulesetDescriptionConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_data_quality_ruleset" "test" {
+  name        = %[1]q
+  description = %[2]q
+  ruleset     = "Rules = [ ColumnCount > 3 ]"
+}
+`, rName, description)
+}
+
+func testAccDataQualityRulesetTargetTableConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+}
+
+resource "aws_glue_data_quality_ruleset" "test" {
+  name    = %[1]q
+  ruleset = "Rules = [ ColumnCount > 3 ]"
+
+  target_table {
+    database_name = aws_glue_catalog_table.test.database_name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, rName)
+}
+
+func testAccDataQualityRulesetTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_data_quality_ruleset" "test" {
+  name    = %[1]q
+  ruleset = "Rules = [ ColumnCount > 3 ]"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDataQualityRulesetTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_data_quality_ruleset" "test" {
+  name    = %[1]q
+  ruleset = "Rules = [ ColumnCount > 3 ]"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
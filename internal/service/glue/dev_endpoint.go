@@ -1,38 +1,55 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	devEndpointServiceCode = "glue"
+	devEndpointQuotaName   = "Max dev endpoints per account"
+)
+
 func ResourceDevEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDevEndpointCreate,
-		Read:   resourceDevEndpointRead,
-		Update: resourceDevEndpointUpdate,
-		Delete: resourceDevEndpointDelete,
+		CreateContext: resourceDevEndpointCreate,
+		ReadContext:   resourceDevEndpointRead,
+		UpdateContext: resourceDevEndpointUpdate,
+		DeleteContext: resourceDevEndpointDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDevEndpointPreflightCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arguments": {
@@ -98,7 +115,7 @@ func ResourceDevEndpoint() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"security_configuration": {
 				Type:     schema.TypeString,
@@ -164,7 +181,7 @@ func ResourceDevEndpoint() *schema.Resource {
 	}
 }
 
-func resourceDevEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceDevEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -227,8 +244,8 @@ func resourceDevEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating Glue Dev Endpoint: %#v", *input)
-	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateDevEndpoint(input)
+	err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.CreateDevEndpointWithContext(ctx, input)
 		if err != nil {
 			// Retry for IAM eventual consistency
 			if tfawserr.ErrMessageContains(err, glue.ErrCodeInvalidInputException, "should be given assume role permissions for Glue Service") {
@@ -247,29 +264,29 @@ func resourceDevEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateDevEndpoint(input)
+		_, err = conn.CreateDevEndpointWithContext(ctx, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating Glue Dev Endpoint: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating Glue Dev Endpoint: %w", err))
 	}
 
 	d.SetId(name)
 
 	log.Printf("[DEBUG] Waiting for Glue Dev Endpoint (%s) to become available", d.Id())
-	if _, err := waitGlueDevEndpointCreated(conn, d.Id()); err != nil {
-		return fmt.Errorf("error while waiting for Glue Dev Endpoint (%s) to become available: %w", d.Id(), err)
+	if _, err := waitGlueDevEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error while waiting for Glue Dev Endpoint (%s) to become available: %w", d.Id(), err))
 	}
 
-	return resourceDevEndpointRead(d, meta)
+	return resourceDevEndpointRead(ctx, d, meta)
 }
 
-func resourceDevEndpointRead(d *schema.ResourceData, meta interface{}) error {
+func resourceDevEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	endpoint, err := FindDevEndpointByName(conn, d.Id())
+	endpoint, err := FindDevEndpointByName(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Glue Dev Endpoint (%s) not found, removing from state", d.Id())
@@ -278,130 +295,124 @@ func resourceDevEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
-	endpointARN := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("devEndpoint/%s", d.Id()),
-	}.String()
+	endpointARN := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("devEndpoint/%s", d.Id()))
 
 	if err := d.Set("arn", endpointARN); err != nil {
-		return fmt.Errorf("error setting arn for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting arn for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("arguments", aws.StringValueMap(endpoint.Arguments)); err != nil {
-		return fmt.Errorf("error setting arguments for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting arguments for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("availability_zone", endpoint.AvailabilityZone); err != nil {
-		return fmt.Errorf("error setting availability_zone for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting availability_zone for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("extra_jars_s3_path", endpoint.ExtraJarsS3Path); err != nil {
-		return fmt.Errorf("error setting extra_jars_s3_path for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting extra_jars_s3_path for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("extra_python_libs_s3_path", endpoint.ExtraPythonLibsS3Path); err != nil {
-		return fmt.Errorf("error setting extra_python_libs_s3_path for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting extra_python_libs_s3_path for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("failure_reason", endpoint.FailureReason); err != nil {
-		return fmt.Errorf("error setting failure_reason for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting failure_reason for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("glue_version", endpoint.GlueVersion); err != nil {
-		return fmt.Errorf("error setting glue_version for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting glue_version for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("name", endpoint.EndpointName); err != nil {
-		return fmt.Errorf("error setting name for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting name for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("number_of_nodes", endpoint.NumberOfNodes); err != nil {
-		return fmt.Errorf("error setting number_of_nodes for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting number_of_nodes for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("number_of_workers", endpoint.NumberOfWorkers); err != nil {
-		return fmt.Errorf("error setting number_of_workers for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting number_of_workers for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("private_address", endpoint.PrivateAddress); err != nil {
-		return fmt.Errorf("error setting private_address for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting private_address for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("public_address", endpoint.PublicAddress); err != nil {
-		return fmt.Errorf("error setting public_address for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting public_address for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("public_key", endpoint.PublicKey); err != nil {
-		return fmt.Errorf("error setting public_key for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting public_key for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("public_keys", flex.FlattenStringSet(endpoint.PublicKeys)); err != nil {
-		return fmt.Errorf("error setting public_keys for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting public_keys for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("role_arn", endpoint.RoleArn); err != nil {
-		return fmt.Errorf("error setting role_arn for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting role_arn for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("security_configuration", endpoint.SecurityConfiguration); err != nil {
-		return fmt.Errorf("error setting security_configuration for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting security_configuration for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("security_group_ids", flex.FlattenStringSet(endpoint.SecurityGroupIds)); err != nil {
-		return fmt.Errorf("error setting security_group_ids for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting security_group_ids for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("status", endpoint.Status); err != nil {
-		return fmt.Errorf("error setting status for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting status for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("subnet_id", endpoint.SubnetId); err != nil {
-		return fmt.Errorf("error setting subnet_id for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting subnet_id for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("vpc_id", endpoint.VpcId); err != nil {
-		return fmt.Errorf("error setting vpc_id for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting vpc_id for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("worker_type", endpoint.WorkerType); err != nil {
-		return fmt.Errorf("error setting worker_type for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting worker_type for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	tags, err := ListTags(conn, endpointARN)
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for Glue Dev Endpoint (%s): %w", endpointARN, err)
+		return diag.FromErr(fmt.Errorf("error listing tags for Glue Dev Endpoint (%s): %w", endpointARN, err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	if err := d.Set("yarn_endpoint_address", endpoint.YarnEndpointAddress); err != nil {
-		return fmt.Errorf("error setting yarn_endpoint_address for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting yarn_endpoint_address for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("zeppelin_remote_spark_interpreter_port", endpoint.ZeppelinRemoteSparkInterpreterPort); err != nil {
-		return fmt.Errorf("error setting zeppelin_remote_spark_interpreter_port for Glue Dev Endpoint (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error setting zeppelin_remote_spark_interpreter_port for Glue Dev Endpoint (%s): %w", d.Id(), err))
 	}
 
 	return nil
 }
 
-func resourceDevEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceDevEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	input := &glue.UpdateDevEndpointInput{
@@ -475,8 +486,8 @@ func resourceDevEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if hasChanged {
 		log.Printf("[DEBUG] Updating Glue Dev Endpoint: %s", input)
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			_, err := conn.UpdateDevEndpoint(input)
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, err := conn.UpdateDevEndpointWithContext(ctx, input)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, glue.ErrCodeInvalidInputException, "another concurrent update operation") {
 					return resource.RetryableError(err)
@@ -488,29 +499,29 @@ func resourceDevEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateDevEndpoint(input)
+			_, err = conn.UpdateDevEndpointWithContext(ctx, input)
 		}
 
 		if err != nil {
-			return fmt.Errorf("error updating Glue Dev Endpoint: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating Glue Dev Endpoint: %w", err))
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
 		}
 	}
 
-	return resourceDevEndpointRead(d, meta)
+	return resourceDevEndpointRead(ctx, d, meta)
 }
 
-func resourceDevEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceDevEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	log.Printf("[INFO] Deleting Glue Dev Endpoint: %s", d.Id())
-	_, err := conn.DeleteDevEndpoint(&glue.DeleteDevEndpointInput{
+	_, err := conn.DeleteDevEndpointWithContext(ctx, &glue.DeleteDevEndpointInput{
 		EndpointName: aws.String(d.Id()),
 	})
 
@@ -519,12 +530,55 @@ func resourceDevEndpointDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Glue Dev Endpoint (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting Glue Dev Endpoint (%s): %s", d.Id(), err))
 	}
 
 	log.Printf("[DEBUG] Waiting for Glue Dev Endpoint (%s) to become terminated", d.Id())
-	if _, err := waitGlueDevEndpointDeleted(conn, d.Id()); err != nil {
-		return fmt.Errorf("error while waiting for Glue Dev Endpoint (%s) to become terminated: %w", d.Id(), err)
+	if _, err := waitGlueDevEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error while waiting for Glue Dev Endpoint (%s) to become terminated: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// resourceDevEndpointPreflightCustomizeDiff checks that creating a dev endpoint will not exceed the account's service quota.
+// The check is only performed if the provider is configured to perform preflight checks.
+func resourceDevEndpointPreflightCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || !client.PreflightChecks {
+		return nil
+	}
+
+	if diff.Id() != "" {
+		return nil
+	}
+
+	quota, err := tfservicequotas.FindServiceQuotaValueByName(client.ServiceQuotasConn, devEndpointServiceCode, devEndpointQuotaName)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read Service Quota (%s/%s): %s", devEndpointServiceCode, devEndpointQuotaName, err)
+		return nil
+	}
+
+	count := 0
+	err = client.GlueConn.GetDevEndpointsPagesWithContext(ctx, &glue.GetDevEndpointsInput{}, func(page *glue.GetDevEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		count += len(page.DevEndpoints)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Unable to list Glue Dev Endpoints: %s", err)
+		return nil
+	}
+
+	if float64(count) >= quota {
+		return fmt.Errorf("creating Glue Dev Endpoint (%s) would exceed the %q service quota (%d of %.0f in use)", diff.Get("name").(string), devEndpointQuotaName, count, quota)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package glue_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -49,6 +50,29 @@ func TestAccGlueDevEndpoint_basic(t *testing.T) {
 	})
 }
 
+func TestAccGlueDevEndpoint_preflightChecks(t *testing.T) {
+	var endpoint glue.DevEndpoint
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_dev_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDevEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The account's dev endpoint quota has headroom, so the preflight check passes.
+				Config: acctest.ConfigCompose(acctest.ConfigPreflightChecks(), testAccGlueDevEndpointConfig_Basic(rName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDevEndpointExists(resourceName, &endpoint),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueDevEndpoint_arguments(t *testing.T) {
 	var endpoint glue.DevEndpoint
 
@@ -574,7 +598,7 @@ func testAccCheckDevEndpointExists(resourceName string, v *glue.DevEndpoint) res
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
 
-		output, err := tfglue.FindDevEndpointByName(conn, rs.Primary.ID)
+		output, err := tfglue.FindDevEndpointByName(context.Background(), conn, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -594,7 +618,7 @@ func testAccCheckDevEndpointDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := tfglue.FindDevEndpointByName(conn, rs.Primary.ID)
+		_, err := tfglue.FindDevEndpointByName(context.Background(), conn, rs.Primary.ID)
 
 		if tfresource.NotFound(err) {
 			continue
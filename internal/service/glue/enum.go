@@ -6,3 +6,17 @@ const (
 	devEndpointStatusReady        = "READY"
 	devEndpointStatusTerminating  = "TERMINATING"
 )
+
+const (
+	tableOptimizerTypeCompaction         = "compaction"
+	tableOptimizerTypeOrphanFileDeletion = "orphan_file_deletion"
+	tableOptimizerTypeRetention          = "retention"
+)
+
+func tableOptimizerType_Values() []string {
+	return []string{
+		tableOptimizerTypeCompaction,
+		tableOptimizerTypeOrphanFileDeletion,
+		tableOptimizerTypeRetention,
+	}
+}
@@ -1,6 +1,8 @@
 package glue
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -8,12 +10,147 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func FindDevEndpointByName(conn *glue.Glue, name string) (*glue.DevEndpoint, error) {
+func FindBlueprintByName(ctx context.Context, conn *glue.Glue, name string) (*glue.Blueprint, error) {
+	input := &glue.GetBlueprintInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetBlueprintWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Blueprint == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Blueprint, nil
+}
+
+func FindCustomEntityTypeByName(ctx context.Context, conn *glue.Glue, name string) (*glue.GetCustomEntityTypeOutput, error) {
+	input := &glue.GetCustomEntityTypeInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCustomEntityTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// FindTableOptimizer returns the Table Optimizer corresponding to the specified catalog, database, table and type.
+func FindTableOptimizer(ctx context.Context, conn *glue.Glue, catalogID, dbName, tableName, optimizerType string) (*glue.TableOptimizer, error) {
+	input := &glue.GetTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	}
+
+	output, err := conn.GetTableOptimizerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TableOptimizer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TableOptimizer, nil
+}
+
+func FindTables(ctx context.Context, conn *glue.Glue, input *glue.GetTablesInput) ([]*glue.TableData, error) {
+	var output []*glue.TableData
+
+	err := conn.GetTablesPagesWithContext(ctx, input, func(page *glue.GetTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TableList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindDataQualityRulesetByName(conn *glue.Glue, name string) (*glue.GetDataQualityRulesetOutput, error) {
+	input := &glue.GetDataQualityRulesetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDataQualityRuleset(input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDevEndpointByName(ctx context.Context, conn *glue.Glue, name string) (*glue.DevEndpoint, error) {
 	input := &glue.GetDevEndpointInput{
 		EndpointName: aws.String(name),
 	}
 
-	output, err := conn.GetDevEndpoint(input)
+	output, err := conn.GetDevEndpointWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
 		return nil, &resource.NotFoundError{
@@ -67,12 +204,12 @@ func FindTriggerByName(conn *glue.Glue, name string) (*glue.GetTriggerOutput, er
 }
 
 // FindRegistryByID returns the Registry corresponding to the specified ID.
-func FindRegistryByID(conn *glue.Glue, id string) (*glue.GetRegistryOutput, error) {
+func FindRegistryByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetRegistryOutput, error) {
 	input := &glue.GetRegistryInput{
 		RegistryId: createRegistryID(id),
 	}
 
-	output, err := conn.GetRegistry(input)
+	output, err := conn.GetRegistryWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -81,12 +218,12 @@ func FindRegistryByID(conn *glue.Glue, id string) (*glue.GetRegistryOutput, erro
 }
 
 // FindSchemaByID returns the Schema corresponding to the specified ID.
-func FindSchemaByID(conn *glue.Glue, id string) (*glue.GetSchemaOutput, error) {
+func FindSchemaByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetSchemaOutput, error) {
 	input := &glue.GetSchemaInput{
 		SchemaId: createSchemaID(id),
 	}
 
-	output, err := conn.GetSchema(input)
+	output, err := conn.GetSchemaWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +232,7 @@ func FindSchemaByID(conn *glue.Glue, id string) (*glue.GetSchemaOutput, error) {
 }
 
 // FindSchemaVersionByID returns the Schema corresponding to the specified ID.
-func FindSchemaVersionByID(conn *glue.Glue, id string) (*glue.GetSchemaVersionOutput, error) {
+func FindSchemaVersionByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetSchemaVersionOutput, error) {
 	input := &glue.GetSchemaVersionInput{
 		SchemaId: createSchemaID(id),
 		SchemaVersionNumber: &glue.SchemaVersionNumber{
@@ -103,7 +240,7 @@ func FindSchemaVersionByID(conn *glue.Glue, id string) (*glue.GetSchemaVersionOu
 		},
 	}
 
-	output, err := conn.GetSchemaVersion(input)
+	output, err := conn.GetSchemaVersionWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +302,7 @@ func FindConnectionByName(conn *glue.Glue, name, catalogID string) (*glue.Connec
 }
 
 // FindPartitionIndexByName returns the Partition Index corresponding to the specified Partition Index Name.
-func FindPartitionIndexByName(conn *glue.Glue, id string) (*glue.PartitionIndexDescriptor, error) {
+func FindPartitionIndexByName(ctx context.Context, conn *glue.Glue, id string) (*glue.PartitionIndexDescriptor, error) {
 
 	catalogID, dbName, tableName, partIndex, err := readPartitionIndexID(id)
 	if err != nil {
@@ -180,7 +317,7 @@ func FindPartitionIndexByName(conn *glue.Glue, id string) (*glue.PartitionIndexD
 
 	var result *glue.PartitionIndexDescriptor
 
-	output, err := conn.GetPartitionIndexes(input)
+	output, err := conn.GetPartitionIndexesWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
 		return nil, &resource.NotFoundError{
@@ -0,0 +1,89 @@
+package glue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/importblock"
+)
+
+func init() {
+	importblock.Register("glue", listCatalogImportBlocks)
+}
+
+// listCatalogImportBlocks discovers the databases, and the tables within them, in the account's Data Catalog.
+func listCatalogImportBlocks(ctx context.Context, client *conns.AWSClient, filter importblock.Filter) ([]importblock.Resource, error) {
+	conn := client.GlueConn
+	catalogID := client.AccountID
+
+	var databases []string
+	var resources []importblock.Resource
+
+	err := conn.GetDatabasesPagesWithContext(ctx, &glue.GetDatabasesInput{CatalogId: aws.String(catalogID)}, func(page *glue.GetDatabasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatabaseList {
+			name := aws.StringValue(v.Name)
+
+			if !filter.Match(name) {
+				continue
+			}
+
+			databases = append(databases, name)
+			resources = append(resources, importblock.Resource{
+				Type: "aws_glue_catalog_database",
+				ID:   fmt.Sprintf("%s:%s", catalogID, name),
+				Name: name,
+				Arguments: map[string]interface{}{
+					"name": name,
+				},
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing Glue Catalog Databases: %w", err)
+	}
+
+	for _, database := range databases {
+		input := &glue.GetTablesInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(database),
+		}
+
+		err := conn.GetTablesPagesWithContext(ctx, input, func(page *glue.GetTablesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.TableList {
+				name := aws.StringValue(v.Name)
+
+				resources = append(resources, importblock.Resource{
+					Type: "aws_glue_catalog_table",
+					ID:   fmt.Sprintf("%s:%s:%s", catalogID, database, name),
+					Name: database + "_" + name,
+					Arguments: map[string]interface{}{
+						"database_name": database,
+						"name":          name,
+					},
+				})
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error listing Glue Catalog Tables (%s): %w", database, err)
+		}
+	}
+
+	return resources, nil
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -127,7 +126,7 @@ func ResourceJob() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -263,13 +262,7 @@ func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	jobARN := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("job/%s", d.Id()),
-	}.String()
+	jobARN := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("job/%s", d.Id()))
 	d.Set("arn", jobARN)
 
 	if err := d.Set("command", flattenGlueJobCommand(job.Command)); err != nil {
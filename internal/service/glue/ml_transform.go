@@ -1,13 +1,14 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,12 +18,12 @@ import (
 
 func ResourceMLTransform() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceMLTransformCreate,
-		Read:   resourceMLTransformRead,
-		Update: resourceMLTransformUpdate,
-		Delete: resourceMLTransformDelete,
+		CreateContext: resourceMLTransformCreate,
+		ReadContext:   resourceMLTransformRead,
+		UpdateContext: resourceMLTransformUpdate,
+		DeleteContext: resourceMLTransformDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -128,7 +129,7 @@ func ResourceMLTransform() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -175,7 +176,7 @@ func ResourceMLTransform() *schema.Resource {
 	}
 }
 
-func resourceMLTransformCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceMLTransformCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -214,17 +215,17 @@ func resourceMLTransformCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating Glue ML Transform: %s", input)
-	output, err := conn.CreateMLTransform(input)
+	output, err := conn.CreateMLTransformWithContext(ctx, input)
 	if err != nil {
-		return fmt.Errorf("error creating Glue ML Transform: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating Glue ML Transform: %w", err))
 	}
 
 	d.SetId(aws.StringValue(output.TransformId))
 
-	return resourceMLTransformRead(d, meta)
+	return resourceMLTransformRead(ctx, d, meta)
 }
 
-func resourceMLTransformRead(d *schema.ResourceData, meta interface{}) error {
+func resourceMLTransformRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -234,14 +235,14 @@ func resourceMLTransformRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Reading Glue ML Transform: %s", input)
-	output, err := conn.GetMLTransform(input)
+	output, err := conn.GetMLTransformWithContext(ctx, input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue ML Transform (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading Glue ML Transform (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading Glue ML Transform (%s): %w", d.Id(), err))
 	}
 
 	if output == nil {
@@ -252,13 +253,7 @@ func resourceMLTransformRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] setting Glue ML Transform: %#v", output)
 
-	mlTransformArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("mlTransform/%s", d.Id()),
-	}.String()
+	mlTransformArn := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("mlTransform/%s", d.Id()))
 	d.Set("arn", mlTransformArn)
 
 	d.Set("description", output.Description)
@@ -273,38 +268,38 @@ func resourceMLTransformRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("label_count", output.LabelCount)
 
 	if err := d.Set("input_record_tables", flattenGlueMLTransformInputRecordTables(output.InputRecordTables)); err != nil {
-		return fmt.Errorf("error setting input_record_tables: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting input_record_tables: %w", err))
 	}
 
 	if err := d.Set("parameters", flattenGlueMLTransformParameters(output.Parameters)); err != nil {
-		return fmt.Errorf("error setting parameters: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting parameters: %w", err))
 	}
 
 	if err := d.Set("schema", flattenGlueMLTransformSchemaColumns(output.Schema)); err != nil {
-		return fmt.Errorf("error setting schema: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting schema: %w", err))
 	}
 
 	tags, err := ListTags(conn, mlTransformArn)
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for Glue ML Transform (%s): %w", mlTransformArn, err)
+		return diag.FromErr(fmt.Errorf("error listing tags for Glue ML Transform (%s): %w", mlTransformArn, err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceMLTransformUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceMLTransformUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	if d.HasChanges("description", "glue_version", "max_capacity", "max_retries", "number_of_workers",
@@ -345,23 +340,23 @@ func resourceMLTransformUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating Glue ML Transform: %s", input)
-		_, err := conn.UpdateMLTransform(input)
+		_, err := conn.UpdateMLTransformWithContext(ctx, input)
 		if err != nil {
-			return fmt.Errorf("error updating Glue ML Transform (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating Glue ML Transform (%s): %w", d.Id(), err))
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
 		}
 	}
 
-	return resourceMLTransformRead(d, meta)
+	return resourceMLTransformRead(ctx, d, meta)
 }
 
-func resourceMLTransformDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceMLTransformDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	log.Printf("[DEBUG] Deleting Glue ML Trasform: %s", d.Id())
@@ -370,19 +365,19 @@ func resourceMLTransformDelete(d *schema.ResourceData, meta interface{}) error {
 		TransformId: aws.String(d.Id()),
 	}
 
-	_, err := conn.DeleteMLTransform(input)
+	_, err := conn.DeleteMLTransformWithContext(ctx, input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
-		return fmt.Errorf("error deleting Glue ML Transform (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting Glue ML Transform (%s): %w", d.Id(), err))
 	}
 
-	if _, err := waitMLTransformDeleted(conn, d.Id()); err != nil {
+	if _, err := waitMLTransformDeleted(ctx, conn, d.Id()); err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
-		return fmt.Errorf("error waiting for Glue ML Transform (%s) to be Deleted: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for Glue ML Transform (%s) to be Deleted: %w", d.Id(), err))
 	}
 
 	return nil
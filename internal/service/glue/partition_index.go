@@ -1,12 +1,14 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -16,11 +18,11 @@ import (
 
 func ResourcePartitionIndex() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePartitionIndexCreate,
-		Read:   resourcePartitionIndexRead,
-		Delete: resourcePartitionIndexDelete,
+		CreateContext: resourcePartitionIndexCreate,
+		ReadContext:   resourcePartitionIndexRead,
+		DeleteContext: resourcePartitionIndexDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +73,7 @@ func ResourcePartitionIndex() *schema.Resource {
 	}
 }
 
-func resourcePartitionIndexCreate(d *schema.ResourceData, meta interface{}) error {
+func resourcePartitionIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
 	dbName := d.Get("database_name").(string)
@@ -85,30 +87,30 @@ func resourcePartitionIndexCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[DEBUG] Creating Glue Partition Index: %#v", input)
-	_, err := conn.CreatePartitionIndex(input)
+	_, err := conn.CreatePartitionIndexWithContext(ctx, input)
 	if err != nil {
-		return fmt.Errorf("error creating Glue Partition Index: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating Glue Partition Index: %w", err))
 	}
 
 	d.SetId(createPartitionIndexID(catalogID, dbName, tableName, aws.StringValue(input.PartitionIndex.IndexName)))
 
-	if _, err := waitGluePartitionIndexCreated(conn, d.Id()); err != nil {
-		return fmt.Errorf("error while waiting for Glue Partition Index (%s) to become available: %w", d.Id(), err)
+	if _, err := waitGluePartitionIndexCreated(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error while waiting for Glue Partition Index (%s) to become available: %w", d.Id(), err))
 	}
 
-	return resourcePartitionIndexRead(d, meta)
+	return resourcePartitionIndexRead(ctx, d, meta)
 }
 
-func resourcePartitionIndexRead(d *schema.ResourceData, meta interface{}) error {
+func resourcePartitionIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	catalogID, dbName, tableName, _, tableErr := readPartitionIndexID(d.Id())
 	if tableErr != nil {
-		return tableErr
+		return diag.FromErr(tableErr)
 	}
 
 	log.Printf("[DEBUG] Reading Glue Partition Index: %s", d.Id())
-	partition, err := FindPartitionIndexByName(conn, d.Id())
+	partition, err := FindPartitionIndexByName(ctx, conn, d.Id())
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Glue Partition Index (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -116,7 +118,7 @@ func resourcePartitionIndexRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading Glue Partition Index (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading Glue Partition Index (%s): %w", d.Id(), err))
 	}
 
 	d.Set("table_name", tableName)
@@ -124,22 +126,22 @@ func resourcePartitionIndexRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("database_name", dbName)
 
 	if err := d.Set("partition_index", []map[string]interface{}{flattenGluePartitionIndex(partition)}); err != nil {
-		return fmt.Errorf("error setting partition_index: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting partition_index: %w", err))
 	}
 
 	return nil
 }
 
-func resourcePartitionIndexDelete(d *schema.ResourceData, meta interface{}) error {
+func resourcePartitionIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	catalogID, dbName, tableName, partIndex, tableErr := readPartitionIndexID(d.Id())
 	if tableErr != nil {
-		return tableErr
+		return diag.FromErr(tableErr)
 	}
 
 	log.Printf("[DEBUG] Deleting Glue Partition Index: %s", d.Id())
-	_, err := conn.DeletePartitionIndex(&glue.DeletePartitionIndexInput{
+	_, err := conn.DeletePartitionIndexWithContext(ctx, &glue.DeletePartitionIndexInput{
 		CatalogId:    aws.String(catalogID),
 		TableName:    aws.String(tableName),
 		DatabaseName: aws.String(dbName),
@@ -149,11 +151,11 @@ func resourcePartitionIndexDelete(d *schema.ResourceData, meta interface{}) erro
 		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Glue Partition Index: %w", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Glue Partition Index: %w", err))
 	}
 
-	if _, err := waitGluePartitionIndexDeleted(conn, d.Id()); err != nil {
-		return fmt.Errorf("error while waiting for Glue Partition Index (%s) to be deleted: %w", d.Id(), err)
+	if _, err := waitGluePartitionIndexDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error while waiting for Glue Partition Index (%s) to be deleted: %w", d.Id(), err))
 	}
 
 	return nil
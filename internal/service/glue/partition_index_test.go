@@ -1,6 +1,7 @@
 package glue_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -224,7 +225,7 @@ func testAccCheckGluePartitionIndexDestroy(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := tfglue.FindPartitionIndexByName(conn, rs.Primary.ID); err != nil {
+		if _, err := tfglue.FindPartitionIndexByName(context.Background(), conn, rs.Primary.ID); err != nil {
 			//Verify the error is what we want
 			if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 				continue
@@ -249,7 +250,7 @@ func testAccCheckGluePartitionIndexExists(name string) resource.TestCheckFunc {
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
-		out, err := tfglue.FindPartitionIndexByName(conn, rs.Primary.ID)
+		out, err := tfglue.FindPartitionIndexByName(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -1,6 +1,7 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,12 +19,12 @@ import (
 
 func ResourceRegistry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceRegistryCreate,
-		Read:   resourceRegistryRead,
-		Update: resourceRegistryUpdate,
-		Delete: resourceRegistryDelete,
+		CreateContext: resourceRegistryCreate,
+		ReadContext:   resourceRegistryRead,
+		UpdateContext: resourceRegistryUpdate,
+		DeleteContext: resourceRegistryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -52,7 +54,7 @@ func ResourceRegistry() *schema.Resource {
 	}
 }
 
-func resourceRegistryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceRegistryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -67,28 +69,28 @@ func resourceRegistryCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating Glue Registry: %s", input)
-	output, err := conn.CreateRegistry(input)
+	output, err := conn.CreateRegistryWithContext(ctx, input)
 	if err != nil {
-		return fmt.Errorf("error creating Glue Registry: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating Glue Registry: %w", err))
 	}
 	d.SetId(aws.StringValue(output.RegistryArn))
 
-	return resourceRegistryRead(d, meta)
+	return resourceRegistryRead(ctx, d, meta)
 }
 
-func resourceRegistryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	output, err := FindRegistryByID(conn, d.Id())
+	output, err := FindRegistryByID(ctx, conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Registry (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading Glue Registry (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading Glue Registry (%s): %w", d.Id(), err))
 	}
 
 	if output == nil {
@@ -105,24 +107,24 @@ func resourceRegistryRead(d *schema.ResourceData, meta interface{}) error {
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for Glue Registry (%s): %w", arn, err)
+		return diag.FromErr(fmt.Errorf("error listing tags for Glue Registry (%s): %w", arn, err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceRegistryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	if d.HasChanges("description") {
@@ -135,23 +137,23 @@ func resourceRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating Glue Registry: %#v", input)
-		_, err := conn.UpdateRegistry(input)
+		_, err := conn.UpdateRegistryWithContext(ctx, input)
 		if err != nil {
-			return fmt.Errorf("error updating Glue Registry (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating Glue Registry (%s): %w", d.Id(), err))
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %s", err))
 		}
 	}
 
-	return resourceRegistryRead(d, meta)
+	return resourceRegistryRead(ctx, d, meta)
 }
 
-func resourceRegistryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceRegistryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	log.Printf("[DEBUG] Deleting Glue Registry: %s", d.Id())
@@ -159,20 +161,20 @@ func resourceRegistryDelete(d *schema.ResourceData, meta interface{}) error {
 		RegistryId: createRegistryID(d.Id()),
 	}
 
-	_, err := conn.DeleteRegistry(input)
+	_, err := conn.DeleteRegistryWithContext(ctx, input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
-		return fmt.Errorf("error deleting Glue Registry (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting Glue Registry (%s): %w", d.Id(), err))
 	}
 
-	_, err = waitRegistryDeleted(conn, d.Id())
+	_, err = waitRegistryDeleted(ctx, conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
-		return fmt.Errorf("error waiting for Glue Registry (%s) to be deleted: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for Glue Registry (%s) to be deleted: %w", d.Id(), err))
 	}
 
 	return nil
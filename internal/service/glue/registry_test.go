@@ -1,6 +1,7 @@
 package glue_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -177,7 +178,7 @@ func testAccCheckRegistryExists(resourceName string, registry *glue.GetRegistryO
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
-		output, err := tfglue.FindRegistryByID(conn, rs.Primary.ID)
+		output, err := tfglue.FindRegistryByID(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -202,7 +203,7 @@ func testAccCheckRegistryDestroy(s *terraform.State) error {
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
-		output, err := tfglue.FindRegistryByID(conn, rs.Primary.ID)
+		output, err := tfglue.FindRegistryByID(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 				return nil
@@ -1,6 +1,7 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,12 +19,12 @@ import (
 
 func ResourceSchema() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSchemaCreate,
-		Read:   resourceSchemaRead,
-		Update: resourceSchemaUpdate,
-		Delete: resourceSchemaDelete,
+		CreateContext: resourceSchemaCreate,
+		ReadContext:   resourceSchemaRead,
+		UpdateContext: resourceSchemaUpdate,
+		DeleteContext: resourceSchemaDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -92,7 +94,7 @@ func ResourceSchema() *schema.Resource {
 	}
 }
 
-func resourceSchemaCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -117,33 +119,33 @@ func resourceSchemaCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating Glue Schema: %s", input)
-	output, err := conn.CreateSchema(input)
+	output, err := conn.CreateSchemaWithContext(ctx, input)
 	if err != nil {
-		return fmt.Errorf("error creating Glue Schema: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating Glue Schema: %w", err))
 	}
 	d.SetId(aws.StringValue(output.SchemaArn))
 
-	_, err = waitSchemaAvailable(conn, d.Id())
+	_, err = waitSchemaAvailable(ctx, conn, d.Id())
 	if err != nil {
-		return fmt.Errorf("error waiting for Glue Schema (%s) to be Available: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for Glue Schema (%s) to be Available: %w", d.Id(), err))
 	}
 
-	return resourceSchemaRead(d, meta)
+	return resourceSchemaRead(ctx, d, meta)
 }
 
-func resourceSchemaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	output, err := FindSchemaByID(conn, d.Id())
+	output, err := FindSchemaByID(ctx, conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Schema (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading Glue Schema (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading Glue Schema (%s): %w", d.Id(), err))
 	}
 
 	if output == nil {
@@ -167,23 +169,23 @@ func resourceSchemaRead(d *schema.ResourceData, meta interface{}) error {
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for Glue Schema (%s): %w", arn, err)
+		return diag.FromErr(fmt.Errorf("error listing tags for Glue Schema (%s): %w", arn, err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
-	schemeDefOutput, err := FindSchemaVersionByID(conn, d.Id())
+	schemeDefOutput, err := FindSchemaVersionByID(ctx, conn, d.Id())
 	if err != nil {
-		return fmt.Errorf("error reading Glue Schema Definition (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading Glue Schema Definition (%s): %w", d.Id(), err))
 	}
 
 	d.Set("schema_definition", schemeDefOutput.SchemaDefinition)
@@ -191,7 +193,7 @@ func resourceSchemaRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	input := &glue.UpdateSchemaInput{
@@ -214,21 +216,21 @@ func resourceSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if update {
 		log.Printf("[DEBUG] Updating Glue Schema: %#v", input)
-		_, err := conn.UpdateSchema(input)
+		_, err := conn.UpdateSchemaWithContext(ctx, input)
 		if err != nil {
-			return fmt.Errorf("error updating Glue Schema (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating Glue Schema (%s): %w", d.Id(), err))
 		}
 
-		_, err = waitSchemaAvailable(conn, d.Id())
+		_, err = waitSchemaAvailable(ctx, conn, d.Id())
 		if err != nil {
-			return fmt.Errorf("error waiting for Glue Schema (%s) to be Available: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for Glue Schema (%s) to be Available: %w", d.Id(), err))
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %s", err))
 		}
 	}
 
@@ -238,21 +240,21 @@ func resourceSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
 			SchemaDefinition: aws.String(d.Get("schema_definition").(string)),
 		}
 
-		_, err := conn.RegisterSchemaVersion(defInput)
+		_, err := conn.RegisterSchemaVersionWithContext(ctx, defInput)
 		if err != nil {
-			return fmt.Errorf("error updating Glue Schema Definition (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating Glue Schema Definition (%s): %w", d.Id(), err))
 		}
 
-		_, err = waitSchemaVersionAvailable(conn, d.Id())
+		_, err = waitSchemaVersionAvailable(ctx, conn, d.Id())
 		if err != nil {
-			return fmt.Errorf("error waiting for Glue Schema Version (%s) to be Available: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for Glue Schema Version (%s) to be Available: %w", d.Id(), err))
 		}
 	}
 
-	return resourceSchemaRead(d, meta)
+	return resourceSchemaRead(ctx, d, meta)
 }
 
-func resourceSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	log.Printf("[DEBUG] Deleting Glue Schema: %s", d.Id())
@@ -260,20 +262,20 @@ func resourceSchemaDelete(d *schema.ResourceData, meta interface{}) error {
 		SchemaId: createSchemaID(d.Id()),
 	}
 
-	_, err := conn.DeleteSchema(input)
+	_, err := conn.DeleteSchemaWithContext(ctx, input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
-		return fmt.Errorf("error deleting Glue Schema (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting Glue Schema (%s): %w", d.Id(), err))
 	}
 
-	_, err = waitSchemaDeleted(conn, d.Id())
+	_, err = waitSchemaDeleted(ctx, conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
-		return fmt.Errorf("error waiting for Glue Schema (%s) to be deleted: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for Glue Schema (%s) to be deleted: %w", d.Id(), err))
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package glue_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -313,7 +314,7 @@ func testAccCheckSchemaExists(resourceName string, schema *glue.GetSchemaOutput)
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
-		output, err := tfglue.FindSchemaByID(conn, rs.Primary.ID)
+		output, err := tfglue.FindSchemaByID(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -338,7 +339,7 @@ func testAccCheckSchemaDestroy(s *terraform.State) error {
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
-		output, err := tfglue.FindSchemaByID(conn, rs.Primary.ID)
+		output, err := tfglue.FindSchemaByID(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 				return nil
@@ -1,6 +1,8 @@
 package glue
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,13 +18,13 @@ const (
 )
 
 // statusMLTransform fetches the MLTransform and its Status
-func statusMLTransform(conn *glue.Glue, transformId string) resource.StateRefreshFunc {
+func statusMLTransform(ctx context.Context, conn *glue.Glue, transformId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &glue.GetMLTransformInput{
 			TransformId: aws.String(transformId),
 		}
 
-		output, err := conn.GetMLTransform(input)
+		output, err := conn.GetMLTransformWithContext(ctx, input)
 
 		if err != nil {
 			return nil, mlTransformStatusUnknown, err
@@ -37,9 +39,9 @@ func statusMLTransform(conn *glue.Glue, transformId string) resource.StateRefres
 }
 
 // statusRegistry fetches the Registry and its Status
-func statusRegistry(conn *glue.Glue, id string) resource.StateRefreshFunc {
+func statusRegistry(ctx context.Context, conn *glue.Glue, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindRegistryByID(conn, id)
+		output, err := FindRegistryByID(ctx, conn, id)
 		if err != nil {
 			return nil, registryStatusUnknown, err
 		}
@@ -53,9 +55,9 @@ func statusRegistry(conn *glue.Glue, id string) resource.StateRefreshFunc {
 }
 
 // statusSchema fetches the Schema and its Status
-func statusSchema(conn *glue.Glue, id string) resource.StateRefreshFunc {
+func statusSchema(ctx context.Context, conn *glue.Glue, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindSchemaByID(conn, id)
+		output, err := FindSchemaByID(ctx, conn, id)
 		if err != nil {
 			return nil, schemaStatusUnknown, err
 		}
@@ -69,9 +71,9 @@ func statusSchema(conn *glue.Glue, id string) resource.StateRefreshFunc {
 }
 
 // statusSchemaVersion fetches the Schema Version and its Status
-func statusSchemaVersion(conn *glue.Glue, id string) resource.StateRefreshFunc {
+func statusSchemaVersion(ctx context.Context, conn *glue.Glue, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindSchemaVersionByID(conn, id)
+		output, err := FindSchemaVersionByID(ctx, conn, id)
 		if err != nil {
 			return nil, schemaVersionStatusUnknown, err
 		}
@@ -85,13 +87,13 @@ func statusSchemaVersion(conn *glue.Glue, id string) resource.StateRefreshFunc {
 }
 
 // statusTrigger fetches the Trigger and its Status
-func statusTrigger(conn *glue.Glue, triggerName string) resource.StateRefreshFunc {
+func statusTrigger(ctx context.Context, conn *glue.Glue, triggerName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &glue.GetTriggerInput{
 			Name: aws.String(triggerName),
 		}
 
-		output, err := conn.GetTrigger(input)
+		output, err := conn.GetTriggerWithContext(ctx, input)
 
 		if err != nil {
 			return nil, triggerStatusUnknown, err
@@ -105,9 +107,25 @@ func statusTrigger(conn *glue.Glue, triggerName string) resource.StateRefreshFun
 	}
 }
 
-func statusGlueDevEndpoint(conn *glue.Glue, name string) resource.StateRefreshFunc {
+func statusBlueprint(ctx context.Context, conn *glue.Glue, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBlueprintByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusGlueDevEndpoint(ctx context.Context, conn *glue.Glue, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindDevEndpointByName(conn, name)
+		output, err := FindDevEndpointByName(ctx, conn, name)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -121,9 +139,9 @@ func statusGlueDevEndpoint(conn *glue.Glue, name string) resource.StateRefreshFu
 	}
 }
 
-func statusGluePartitionIndex(conn *glue.Glue, id string) resource.StateRefreshFunc {
+func statusGluePartitionIndex(ctx context.Context, conn *glue.Glue, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindPartitionIndexByName(conn, id)
+		output, err := FindPartitionIndexByName(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
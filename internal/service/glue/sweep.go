@@ -18,6 +18,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_glue_blueprint", &resource.Sweeper{
+		Name: "aws_glue_blueprint",
+		F:    sweepBlueprints,
+	})
+
 	resource.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
 		Name: "aws_glue_catalog_database",
 		F:    sweepCatalogDatabases,
@@ -38,6 +43,16 @@ func init() {
 		F:    sweepCrawlers,
 	})
 
+	resource.AddTestSweepers("aws_glue_custom_entity_type", &resource.Sweeper{
+		Name: "aws_glue_custom_entity_type",
+		F:    sweepCustomEntityTypes,
+	})
+
+	resource.AddTestSweepers("aws_glue_data_quality_ruleset", &resource.Sweeper{
+		Name: "aws_glue_data_quality_ruleset",
+		F:    sweepDataQualityRulesets,
+	})
+
 	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
 		Name: "aws_glue_dev_endpoint",
 		F:    sweepDevEndpoint,
@@ -79,6 +94,44 @@ func init() {
 	})
 }
 
+func sweepBlueprints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GlueConn
+
+	input := &glue.ListBlueprintsInput{}
+	err = conn.ListBlueprintsPages(input, func(page *glue.ListBlueprintsOutput, lastPage bool) bool {
+		if len(page.Blueprints) == 0 {
+			log.Printf("[INFO] No Glue Blueprints to sweep")
+			return false
+		}
+		for _, blueprint := range page.Blueprints {
+			name := aws.StringValue(blueprint)
+
+			r := ResourceBlueprint()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			err := sweep.DeleteResource(r, d, client)
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Glue Blueprint %s: %s", name, err)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Glue Blueprint sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Glue Blueprints: %s", err)
+	}
+
+	return nil
+}
+
 func sweepCatalogDatabases(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -249,6 +302,82 @@ func sweepCrawlers(region string) error {
 	return nil
 }
 
+func sweepCustomEntityTypes(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GlueConn
+
+	input := &glue.ListCustomEntityTypesInput{}
+	err = conn.ListCustomEntityTypesPages(input, func(page *glue.ListCustomEntityTypesOutput, lastPage bool) bool {
+		if len(page.CustomEntityTypes) == 0 {
+			log.Printf("[INFO] No Glue Custom Entity Types to sweep")
+			return false
+		}
+		for _, customEntityType := range page.CustomEntityTypes {
+			name := aws.StringValue(customEntityType.Name)
+
+			r := ResourceCustomEntityType()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			err := sweep.DeleteResource(r, d, client)
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Glue Custom Entity Type %s: %s", name, err)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Glue Custom Entity Type sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Glue Custom Entity Types: %s", err)
+	}
+
+	return nil
+}
+
+func sweepDataQualityRulesets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GlueConn
+
+	input := &glue.ListDataQualityRulesetsInput{}
+	err = conn.ListDataQualityRulesetsPages(input, func(page *glue.ListDataQualityRulesetsOutput, lastPage bool) bool {
+		if len(page.Rulesets) == 0 {
+			log.Printf("[INFO] No Glue Data Quality Rulesets to sweep")
+			return false
+		}
+		for _, ruleset := range page.Rulesets {
+			name := aws.StringValue(ruleset.Name)
+
+			r := ResourceDataQualityRuleset()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			err := r.Delete(d, client)
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Glue Data Quality Ruleset %s: %s", name, err)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Glue Data Quality Ruleset sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Glue Data Quality Rulesets: %s", err)
+	}
+
+	return nil
+}
+
 func sweepDevEndpoint(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -346,7 +475,7 @@ func sweepMLTransforms(region string) error {
 			r := ResourceMLTransform()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -390,7 +519,7 @@ func sweepRegistry(region string) error {
 		d := r.Data(nil)
 		d.SetId(arn)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Glue Registry %s: %s", arn, err)
 		}
@@ -420,7 +549,7 @@ func sweepSchema(region string) error {
 		d := r.Data(nil)
 		d.SetId(arn)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Glue Schema %s: %s", arn, err)
 		}
@@ -489,7 +618,7 @@ func sweepTriggers(region string) error {
 			r := ResourceTrigger()
 			d := r.Data(nil)
 			d.SetId(name)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Trigger %s: %s", name, err)
 			}
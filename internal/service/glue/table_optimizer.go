@@ -0,0 +1,229 @@
+package glue
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTableOptimizer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTableOptimizerCreate,
+		ReadContext:   resourceTableOptimizerRead,
+		UpdateContext: resourceTableOptimizerUpdate,
+		DeleteContext: resourceTableOptimizerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
+						},
+					},
+				},
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(tableOptimizerType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceTableOptimizerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
+	dbName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	optimizerType := d.Get("type").(string)
+	id := createTableOptimizerID(catalogID, dbName, tableName, optimizerType)
+
+	input := &glue.CreateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get("configuration").([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	log.Printf("[DEBUG] Creating Glue Table Optimizer: %s", input)
+	// Retry for IAM eventual consistency on the optimizer role.
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, tfiam.PropagationTimeout, func() (interface{}, error) {
+		return conn.CreateTableOptimizerWithContext(ctx, input)
+	}, glue.ErrCodeAccessDeniedException)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Glue Table Optimizer (%s): %w", id, err))
+	}
+
+	d.SetId(id)
+
+	return resourceTableOptimizerRead(ctx, d, meta)
+}
+
+func resourceTableOptimizerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tableOptimizer, err := FindTableOptimizer(ctx, conn, catalogID, dbName, tableName, optimizerType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Table Optimizer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Glue Table Optimizer (%s): %w", d.Id(), err))
+	}
+
+	d.Set("catalog_id", catalogID)
+	if err := d.Set("configuration", flattenTableOptimizerConfiguration(tableOptimizer.Configuration)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting configuration: %w", err))
+	}
+	d.Set("database_name", dbName)
+	d.Set("table_name", tableName)
+	d.Set("type", tableOptimizer.Type)
+
+	return nil
+}
+
+func resourceTableOptimizerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("configuration") {
+		input := &glue.UpdateTableOptimizerInput{
+			CatalogId:                   aws.String(catalogID),
+			DatabaseName:                aws.String(dbName),
+			TableName:                   aws.String(tableName),
+			TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get("configuration").([]interface{})),
+			Type:                        aws.String(optimizerType),
+		}
+
+		log.Printf("[DEBUG] Updating Glue Table Optimizer: %s", input)
+		_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, tfiam.PropagationTimeout, func() (interface{}, error) {
+			return conn.UpdateTableOptimizerWithContext(ctx, input)
+		}, glue.ErrCodeAccessDeniedException)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Glue Table Optimizer (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourceTableOptimizerRead(ctx, d, meta)
+}
+
+func resourceTableOptimizerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Table Optimizer: %s", d.Id())
+	_, err = conn.DeleteTableOptimizerWithContext(ctx, &glue.DeleteTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Glue Table Optimizer (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandTableOptimizerConfiguration(l []interface{}) *glue.TableOptimizerConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	apiObject := &glue.TableOptimizerConfiguration{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTableOptimizerConfiguration(apiObject *glue.TableOptimizerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":  aws.BoolValue(apiObject.Enabled),
+		"role_arn": aws.StringValue(apiObject.RoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
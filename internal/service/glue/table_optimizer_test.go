@@ -0,0 +1,218 @@
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlueTableOptimizer_basic(t *testing.T) {
+	var tableOptimizer glue.TableOptimizer
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_table_optimizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableOptimizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableOptimizerConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableOptimizerExists(resourceName, &tableOptimizer),
+					acctest.CheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "type", "compaction"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableOptimizerConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableOptimizerExists(resourceName, &tableOptimizer),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueTableOptimizer_disappears(t *testing.T) {
+	var tableOptimizer glue.TableOptimizer
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_table_optimizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableOptimizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableOptimizerConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableOptimizerExists(resourceName, &tableOptimizer),
+					acctest.CheckResourceDisappears(acctest.Provider, tfglue.ResourceTableOptimizer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableOptimizerExists(n string, v *glue.TableOptimizer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Table Optimizer ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+
+		output, err := tfglue.FindTableOptimizer(context.Background(), conn, rs.Primary.Attributes["catalog_id"], rs.Primary.Attributes["database_name"], rs.Primary.Attributes["table_name"], rs.Primary.Attributes["type"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTableOptimizerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_table_optimizer" {
+			continue
+		}
+
+		_, err := tfglue.FindTableOptimizer(context.Background(), conn, rs.Primary.Attributes["catalog_id"], rs.Primary.Attributes["database_name"], rs.Primary.Attributes["table_name"], rs.Primary.Attributes["type"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Table Optimizer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTableOptimizerConfig(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "glue:GetTable",
+        "glue:GetDatabase",
+        "glue:UpdateTable",
+        "s3:DeleteObject",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  open_table_format_input {
+    iceberg_input {
+      metadata_operation = "CREATE"
+      version            = "2"
+    }
+  }
+
+  storage_descriptor {
+    location = "s3://${aws_s3_bucket.test.bucket}/iceberg"
+
+    columns {
+      name = "my_column_1"
+      type = "int"
+    }
+  }
+}
+
+resource "aws_glue_table_optimizer" "test" {
+  catalog_id    = aws_glue_catalog_database.test.catalog_id
+  database_name = aws_glue_catalog_database.test.name
+  table_name    = aws_glue_catalog_table.test.name
+  type          = "compaction"
+
+  configuration {
+    role_arn = aws_iam_role.test.arn
+    enabled  = %[2]t
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, enabled)
+}
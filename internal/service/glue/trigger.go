@@ -1,14 +1,16 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,19 +23,23 @@ import (
 
 func ResourceTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTriggerCreate,
-		Read:   resourceTriggerRead,
-		Update: resourceTriggerUpdate,
-		Delete: resourceTriggerDelete,
+		CreateContext: resourceTriggerCreate,
+		ReadContext:   resourceTriggerRead,
+		UpdateContext: resourceTriggerUpdate,
+		DeleteContext: resourceTriggerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceTriggerPreflightCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"actions": {
@@ -178,7 +184,7 @@ func ResourceTrigger() *schema.Resource {
 	}
 }
 
-func resourceTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -221,8 +227,8 @@ func resourceTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating Glue Trigger: %s", input)
-	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateTrigger(input)
+	err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.CreateTriggerWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, glue.ErrCodeInvalidInputException, "Service is unable to assume role") {
 				return resource.RetryableError(err)
@@ -233,20 +239,20 @@ func resourceTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateTrigger(input)
+		_, err = conn.CreateTriggerWithContext(ctx, input)
 	}
 	if err != nil {
-		return fmt.Errorf("error creating Glue Trigger (%s): %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating Glue Trigger (%s): %w", name, err))
 	}
 
 	d.SetId(name)
 
 	log.Printf("[DEBUG] Waiting for Glue Trigger (%s) to create", d.Id())
-	if _, err := waitTriggerCreated(conn, d.Id()); err != nil {
+	if _, err := waitTriggerCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
-		return fmt.Errorf("error waiting for Glue Trigger (%s) to be Created: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for Glue Trigger (%s) to be Created: %w", d.Id(), err))
 	}
 
 	if d.Get("enabled").(bool) && triggerType == glue.TriggerTypeOnDemand {
@@ -255,16 +261,16 @@ func resourceTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Starting Glue Trigger: %s", input)
-		_, err := conn.StartTrigger(input)
+		_, err := conn.StartTriggerWithContext(ctx, input)
 		if err != nil {
-			return fmt.Errorf("error starting Glue Trigger (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error starting Glue Trigger (%s): %w", d.Id(), err))
 		}
 	}
 
-	return resourceTriggerRead(d, meta)
+	return resourceTriggerRead(ctx, d, meta)
 }
 
-func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -276,7 +282,7 @@ func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading Glue Trigger (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading Glue Trigger (%s): %w", d.Id(), err))
 	}
 
 	trigger := output.Trigger
@@ -287,16 +293,10 @@ func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("actions", flattenGlueActions(trigger.Actions)); err != nil {
-		return fmt.Errorf("error setting actions: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting actions: %w", err))
 	}
 
-	triggerARN := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("trigger/%s", d.Id()),
-	}.String()
+	triggerARN := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("trigger/%s", d.Id()))
 	d.Set("arn", triggerARN)
 
 	d.Set("description", trigger.Description)
@@ -313,7 +313,7 @@ func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("enabled", enabled)
 
 	if err := d.Set("predicate", flattenGluePredicate(trigger.Predicate)); err != nil {
-		return fmt.Errorf("error setting predicate: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting predicate: %w", err))
 	}
 
 	d.Set("name", trigger.Name)
@@ -322,18 +322,18 @@ func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	tags, err := ListTags(conn, triggerARN)
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for Glue Trigger (%s): %w", triggerARN, err)
+		return diag.FromErr(fmt.Errorf("error listing tags for Glue Trigger (%s): %w", triggerARN, err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	d.Set("type", trigger.Type)
@@ -342,7 +342,7 @@ func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	if d.HasChanges("actions", "description", "predicate", "schedule") {
@@ -367,13 +367,13 @@ func resourceTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating Glue Trigger: %s", input)
-		_, err := conn.UpdateTrigger(input)
+		_, err := conn.UpdateTriggerWithContext(ctx, input)
 		if err != nil {
-			return fmt.Errorf("error updating Glue Trigger (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating Glue Trigger (%s): %w", d.Id(), err))
 		}
 
-		if _, err := waitTriggerCreated(conn, d.Id()); err != nil {
-			return fmt.Errorf("error waiting for Glue Trigger (%s) to be Update: %w", d.Id(), err)
+		if _, err := waitTriggerCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for Glue Trigger (%s) to be Update: %w", d.Id(), err))
 		}
 	}
 
@@ -384,9 +384,9 @@ func resourceTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			log.Printf("[DEBUG] Starting Glue Trigger: %s", input)
-			_, err := conn.StartTrigger(input)
+			_, err := conn.StartTriggerWithContext(ctx, input)
 			if err != nil {
-				return fmt.Errorf("error starting Glue Trigger (%s): %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error starting Glue Trigger (%s): %w", d.Id(), err))
 			}
 		} else {
 			//Skip if Trigger is type is ON_DEMAND and is in CREATED state as this means the trigger is not running or has ran already.
@@ -396,9 +396,9 @@ func resourceTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 
 				log.Printf("[DEBUG] Stopping Glue Trigger: %s", input)
-				_, err := conn.StopTrigger(input)
+				_, err := conn.StopTriggerWithContext(ctx, input)
 				if err != nil {
-					return fmt.Errorf("error stopping Glue Trigger (%s): %w", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("error stopping Glue Trigger (%s): %w", d.Id(), err))
 				}
 			}
 		}
@@ -407,39 +407,69 @@ func resourceTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
 		}
 	}
 
 	return nil
 }
 
-func resourceTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	log.Printf("[DEBUG] Deleting Glue Trigger: %s", d.Id())
-	err := deleteGlueTrigger(conn, d.Id())
+	err := deleteGlueTrigger(ctx, conn, d.Id())
 	if err != nil {
-		return fmt.Errorf("error deleting Glue Trigger (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting Glue Trigger (%s): %w", d.Id(), err))
 	}
 
 	log.Printf("[DEBUG] Waiting for Glue Trigger (%s) to delete", d.Id())
-	if _, err := waitTriggerDeleted(conn, d.Id()); err != nil {
+	if _, err := waitTriggerDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
-		return fmt.Errorf("error waiting for Glue Trigger (%s) to be Deleted: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for Glue Trigger (%s) to be Deleted: %w", d.Id(), err))
 	}
 
 	return nil
 }
 
-func deleteGlueTrigger(conn *glue.Glue, Name string) error {
+// resourceTriggerPreflightCustomizeDiff checks that the trigger name is not already in use when a trigger is going to be created.
+// The check is only performed if the provider is configured to perform preflight checks.
+func resourceTriggerPreflightCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || !client.PreflightChecks {
+		return nil
+	}
+
+	if diff.Id() != "" || !diff.NewValueKnown("name") {
+		return nil
+	}
+
+	name := diff.Get("name").(string)
+
+	_, err := FindTriggerByName(client.GlueConn, name)
+
+	if err == nil {
+		return fmt.Errorf("Glue Trigger (%s) already exists", name)
+	}
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	log.Printf("[WARN] Unable to check availability of Glue Trigger name (%s): %s", name, err)
+
+	return nil
+}
+
+func deleteGlueTrigger(ctx context.Context, conn *glue.Glue, Name string) error {
 	input := &glue.DeleteTriggerInput{
 		Name: aws.String(Name),
 	}
 
-	_, err := conn.DeleteTrigger(input)
+	_, err := conn.DeleteTriggerWithContext(ctx, input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
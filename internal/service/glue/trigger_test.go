@@ -2,6 +2,7 @@ package glue_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -55,6 +56,33 @@ func TestAccGlueTrigger_basic(t *testing.T) {
 	})
 }
 
+func TestAccGlueTrigger_preflightChecksNameInUse(t *testing.T) {
+	var trigger glue.Trigger
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_trigger.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(acctest.ConfigPreflightChecks(), testAccTriggerConfig_OnDemand(rName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerExists(resourceName, &trigger),
+				),
+			},
+			{
+				Config:      acctest.ConfigCompose(acctest.ConfigPreflightChecks(), testAccTriggerConfig_duplicateName(rName)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Glue Trigger \(.+\) already exists`),
+			},
+		},
+	})
+}
+
 func TestAccGlueTrigger_crawler(t *testing.T) {
 	var trigger glue.Trigger
 
@@ -621,6 +649,19 @@ resource "aws_glue_trigger" "test" {
 `, rName))
 }
 
+func testAccTriggerConfig_duplicateName(rName string) string {
+	return acctest.ConfigCompose(testAccTriggerConfig_OnDemand(rName), fmt.Sprintf(`
+resource "aws_glue_trigger" "duplicate" {
+  name = %[1]q
+  type = "ON_DEMAND"
+
+  actions {
+    job_name = aws_glue_job.test.name
+  }
+}
+`, rName))
+}
+
 func testAccTriggerConfig_OnDemandEnabled(rName string, enabled bool) string {
 	return acctest.ConfigCompose(testAccJobConfig_Required(rName), fmt.Sprintf(`
 resource "aws_glue_trigger" "test" {
@@ -1,6 +1,7 @@
 package glue
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -17,20 +18,18 @@ const (
 	schemaAvailableTimeout        = 2 * time.Minute
 	schemaDeleteTimeout           = 2 * time.Minute
 	schemaVersionAvailableTimeout = 2 * time.Minute
-	triggerCreateTimeout          = 5 * time.Minute
-	triggerDeleteTimeout          = 5 * time.Minute
 )
 
 // waitMLTransformDeleted waits for an MLTransform to return Deleted
-func waitMLTransformDeleted(conn *glue.Glue, transformId string) (*glue.GetMLTransformOutput, error) {
+func waitMLTransformDeleted(ctx context.Context, conn *glue.Glue, transformId string) (*glue.GetMLTransformOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.TransformStatusTypeNotReady, glue.TransformStatusTypeReady, glue.TransformStatusTypeDeleting},
 		Target:  []string{},
-		Refresh: statusMLTransform(conn, transformId),
+		Refresh: statusMLTransform(ctx, conn, transformId),
 		Timeout: mlTransformDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.GetMLTransformOutput); ok {
 		return output, err
@@ -40,15 +39,15 @@ func waitMLTransformDeleted(conn *glue.Glue, transformId string) (*glue.GetMLTra
 }
 
 // waitRegistryDeleted waits for a Registry to return Deleted
-func waitRegistryDeleted(conn *glue.Glue, registryID string) (*glue.GetRegistryOutput, error) {
+func waitRegistryDeleted(ctx context.Context, conn *glue.Glue, registryID string) (*glue.GetRegistryOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.RegistryStatusDeleting},
 		Target:  []string{},
-		Refresh: statusRegistry(conn, registryID),
+		Refresh: statusRegistry(ctx, conn, registryID),
 		Timeout: registryDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.GetRegistryOutput); ok {
 		return output, err
@@ -58,15 +57,15 @@ func waitRegistryDeleted(conn *glue.Glue, registryID string) (*glue.GetRegistryO
 }
 
 // waitSchemaAvailable waits for a Schema to return Available
-func waitSchemaAvailable(conn *glue.Glue, registryID string) (*glue.GetSchemaOutput, error) { //nolint:unparam
+func waitSchemaAvailable(ctx context.Context, conn *glue.Glue, registryID string) (*glue.GetSchemaOutput, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.SchemaStatusPending},
 		Target:  []string{glue.SchemaStatusAvailable},
-		Refresh: statusSchema(conn, registryID),
+		Refresh: statusSchema(ctx, conn, registryID),
 		Timeout: schemaAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -76,15 +75,15 @@ func waitSchemaAvailable(conn *glue.Glue, registryID string) (*glue.GetSchemaOut
 }
 
 // waitSchemaDeleted waits for a Schema to return Deleted
-func waitSchemaDeleted(conn *glue.Glue, registryID string) (*glue.GetSchemaOutput, error) {
+func waitSchemaDeleted(ctx context.Context, conn *glue.Glue, registryID string) (*glue.GetSchemaOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.SchemaStatusDeleting},
 		Target:  []string{},
-		Refresh: statusSchema(conn, registryID),
+		Refresh: statusSchema(ctx, conn, registryID),
 		Timeout: schemaDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -94,15 +93,15 @@ func waitSchemaDeleted(conn *glue.Glue, registryID string) (*glue.GetSchemaOutpu
 }
 
 // waitSchemaVersionAvailable waits for a Schema to return Available
-func waitSchemaVersionAvailable(conn *glue.Glue, registryID string) (*glue.GetSchemaVersionOutput, error) {
+func waitSchemaVersionAvailable(ctx context.Context, conn *glue.Glue, registryID string) (*glue.GetSchemaVersionOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.SchemaVersionStatusPending},
 		Target:  []string{glue.SchemaVersionStatusAvailable},
-		Refresh: statusSchemaVersion(conn, registryID),
+		Refresh: statusSchemaVersion(ctx, conn, registryID),
 		Timeout: schemaVersionAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.GetSchemaVersionOutput); ok {
 		return output, err
@@ -112,7 +111,7 @@ func waitSchemaVersionAvailable(conn *glue.Glue, registryID string) (*glue.GetSc
 }
 
 // waitTriggerCreated waits for a Trigger to return Created
-func waitTriggerCreated(conn *glue.Glue, triggerName string) (*glue.GetTriggerOutput, error) { //nolint:unparam
+func waitTriggerCreated(ctx context.Context, conn *glue.Glue, triggerName string, timeout time.Duration) (*glue.GetTriggerOutput, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			glue.TriggerStateActivating,
@@ -123,11 +122,11 @@ func waitTriggerCreated(conn *glue.Glue, triggerName string) (*glue.GetTriggerOu
 			glue.TriggerStateActivated,
 			glue.TriggerStateCreated,
 		},
-		Refresh: statusTrigger(conn, triggerName),
-		Timeout: triggerCreateTimeout,
+		Refresh: statusTrigger(ctx, conn, triggerName),
+		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -137,15 +136,15 @@ func waitTriggerCreated(conn *glue.Glue, triggerName string) (*glue.GetTriggerOu
 }
 
 // waitTriggerDeleted waits for a Trigger to return Deleted
-func waitTriggerDeleted(conn *glue.Glue, triggerName string) (*glue.GetTriggerOutput, error) {
+func waitTriggerDeleted(ctx context.Context, conn *glue.Glue, triggerName string, timeout time.Duration) (*glue.GetTriggerOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.TriggerStateDeleting},
 		Target:  []string{},
-		Refresh: statusTrigger(conn, triggerName),
-		Timeout: triggerDeleteTimeout,
+		Refresh: statusTrigger(ctx, conn, triggerName),
+		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -154,15 +153,36 @@ func waitTriggerDeleted(conn *glue.Glue, triggerName string) (*glue.GetTriggerOu
 	return nil, err
 }
 
-func waitGlueDevEndpointCreated(conn *glue.Glue, name string) (*glue.DevEndpoint, error) {
+func waitBlueprintActive(ctx context.Context, conn *glue.Glue, name string, timeout time.Duration) (*glue.Blueprint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{glue.BlueprintStatusCreating, glue.BlueprintStatusUpdating},
+		Target:  []string{glue.BlueprintStatusActive},
+		Refresh: statusBlueprint(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*glue.Blueprint); ok {
+		if status := aws.StringValue(output.Status); status == glue.BlueprintStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlueDevEndpointCreated(ctx context.Context, conn *glue.Glue, name string, timeout time.Duration) (*glue.DevEndpoint, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{devEndpointStatusProvisioning},
 		Target:  []string{devEndpointStatusReady},
-		Refresh: statusGlueDevEndpoint(conn, name),
-		Timeout: 15 * time.Minute,
+		Refresh: statusGlueDevEndpoint(ctx, conn, name),
+		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
 		if status := aws.StringValue(output.Status); status == devEndpointStatusFailed {
@@ -175,15 +195,15 @@ func waitGlueDevEndpointCreated(conn *glue.Glue, name string) (*glue.DevEndpoint
 	return nil, err
 }
 
-func waitGlueDevEndpointDeleted(conn *glue.Glue, name string) (*glue.DevEndpoint, error) {
+func waitGlueDevEndpointDeleted(ctx context.Context, conn *glue.Glue, name string, timeout time.Duration) (*glue.DevEndpoint, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{devEndpointStatusTerminating},
 		Target:  []string{},
-		Refresh: statusGlueDevEndpoint(conn, name),
-		Timeout: 15 * time.Minute,
+		Refresh: statusGlueDevEndpoint(ctx, conn, name),
+		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
 		if status := aws.StringValue(output.Status); status == devEndpointStatusFailed {
@@ -196,15 +216,15 @@ func waitGlueDevEndpointDeleted(conn *glue.Glue, name string) (*glue.DevEndpoint
 	return nil, err
 }
 
-func waitGluePartitionIndexCreated(conn *glue.Glue, id string) (*glue.PartitionIndexDescriptor, error) {
+func waitGluePartitionIndexCreated(ctx context.Context, conn *glue.Glue, id string) (*glue.PartitionIndexDescriptor, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.PartitionIndexStatusCreating},
 		Target:  []string{glue.PartitionIndexStatusActive},
-		Refresh: statusGluePartitionIndex(conn, id),
+		Refresh: statusGluePartitionIndex(ctx, conn, id),
 		Timeout: 2 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
 		return output, err
@@ -213,15 +233,15 @@ func waitGluePartitionIndexCreated(conn *glue.Glue, id string) (*glue.PartitionI
 	return nil, err
 }
 
-func waitGluePartitionIndexDeleted(conn *glue.Glue, id string) (*glue.PartitionIndexDescriptor, error) {
+func waitGluePartitionIndexDeleted(ctx context.Context, conn *glue.Glue, id string) (*glue.PartitionIndexDescriptor, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.PartitionIndexStatusDeleting},
 		Target:  []string{},
-		Refresh: statusGluePartitionIndex(conn, id),
+		Refresh: statusGluePartitionIndex(ctx, conn, id),
 		Timeout: 2 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
 		return output, err
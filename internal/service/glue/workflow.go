@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -118,13 +117,7 @@ func resourceWorkflowRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	workFlowArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("workflow/%s", d.Id()),
-	}.String()
+	workFlowArn := meta.(*conns.AWSClient).RegionalARN("glue", fmt.Sprintf("workflow/%s", d.Id()))
 	d.Set("arn", workFlowArn)
 
 	if err := d.Set("default_run_properties", aws.StringValueMap(workflow.DefaultRunProperties)); err != nil {
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -136,13 +135,7 @@ func resourceDetectorRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Reading GuardDuty Detector '%s' failed: %s", d.Id(), err.Error())
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "guardduty",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("detector/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("guardduty", fmt.Sprintf("detector/%s", d.Id()))
 	d.Set("arn", arn)
 
 	d.Set("account_id", meta.(*conns.AWSClient).AccountID)
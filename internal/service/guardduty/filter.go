@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -193,13 +192,7 @@ func resourceFilterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading GuardDuty Filter '%s': %w", name, err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "guardduty",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("detector/%s/filter/%s", detectorID, name),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("guardduty", fmt.Sprintf("detector/%s/filter/%s", detectorID, name))
 	d.Set("arn", arn)
 
 	err = d.Set("finding_criteria", flattenFindingCriteria(filter.FindingCriteria))
@@ -45,7 +45,7 @@ func ResourcePublishingDestination() *schema.Resource {
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", ""),
 			},
 		},
 	}
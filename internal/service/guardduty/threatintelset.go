@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -138,13 +137,7 @@ func resourceThreatintelsetRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "guardduty",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("detector/%s/threatintelset/%s", detectorId, threatIntelSetId),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("guardduty", fmt.Sprintf("detector/%s/threatintelset/%s", detectorId, threatIntelSetId))
 	d.Set("arn", arn)
 
 	d.Set("detector_id", detectorId)
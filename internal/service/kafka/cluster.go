@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,6 +40,11 @@ func ResourceCluster() *schema.Resource {
 			customdiff.ForceNewIfChange("kafka_version", func(_ context.Context, old, new, meta interface{}) bool {
 				return new.(string) < old.(string)
 			}),
+			customdiff.ForceNewIfChange("broker_node_group_info.0.instance_type", func(_ context.Context, old, new, meta interface{}) bool {
+				// Brokers cannot be changed between standard and express broker types in-place.
+				return isExpressBrokerInstanceType(old.(string)) != isExpressBrokerInstanceType(new.(string))
+			}),
+			resourceClusterCustomizeDiffStorage,
 			verify.SetTagsDiff,
 		),
 		Schema: map[string]*schema.Schema{
@@ -97,7 +104,8 @@ func ResourceCluster() *schema.Resource {
 						},
 						"ebs_volume_size": {
 							Type:         schema.TypeInt,
-							Required:     true,
+							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.IntBetween(1, 16384),
 						},
 					},
@@ -364,6 +372,12 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"storage_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(kafka.StorageMode_Values(), false),
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"zookeeper_connect_string": {
@@ -398,6 +412,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		Tags:                 Tags(tags.IgnoreAWS()),
 	}
 
+	if v, ok := d.GetOk("storage_mode"); ok {
+		input.StorageMode = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateCluster(input)
 
 	if err != nil {
@@ -479,6 +497,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting open_monitoring: %w", err)
 	}
 
+	d.Set("storage_mode", cluster.StorageMode)
 	d.Set("zookeeper_connect_string", SortEndpointsString(aws.StringValue(cluster.ZookeeperConnectString)))
 	d.Set("zookeeper_connect_string_tls", SortEndpointsString(aws.StringValue(cluster.ZookeeperConnectStringTls)))
 
@@ -499,7 +518,31 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).KafkaConn
 
-	if d.HasChange("broker_node_group_info.0.ebs_volume_size") {
+	if d.HasChange("storage_mode") {
+		input := &kafka.UpdateStorageInput{
+			ClusterArn:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("current_version").(string)),
+			StorageMode:    aws.String(d.Get("storage_mode").(string)),
+		}
+
+		if d.HasChange("broker_node_group_info.0.ebs_volume_size") {
+			input.VolumeSizeGB = aws.Int64(int64(d.Get("broker_node_group_info.0.ebs_volume_size").(int)))
+		}
+
+		output, err := conn.UpdateStorage(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MSK Cluster (%s) storage: %w", d.Id(), err)
+		}
+
+		clusterOperationARN := aws.StringValue(output.ClusterOperationArn)
+
+		_, err = waitClusterOperationCompleted(conn, clusterOperationARN, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return fmt.Errorf("error waiting for MSK Cluster (%s) operation (%s): %w", d.Id(), clusterOperationARN, err)
+		}
+	} else if d.HasChange("broker_node_group_info.0.ebs_volume_size") {
 		input := &kafka.UpdateBrokerStorageInput{
 			ClusterArn:     aws.String(d.Id()),
 			CurrentVersion: aws.String(d.Get("current_version").(string)),
@@ -678,6 +721,54 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceClusterCustomizeDiffStorage(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	instanceType := diff.Get("broker_node_group_info.0.instance_type").(string)
+	kafkaVersion := diff.Get("kafka_version").(string)
+	storageMode := diff.Get("storage_mode").(string)
+
+	if isExpressBrokerInstanceType(instanceType) {
+		if v, ok := diff.GetOk("broker_node_group_info.0.ebs_volume_size"); ok && v.(int) > 0 {
+			return fmt.Errorf("ebs_volume_size cannot be specified for express broker instance type %q", instanceType)
+		}
+
+		if storageMode == kafka.StorageModeTiered {
+			return fmt.Errorf("storage_mode %q is not supported for express broker instance type %q", storageMode, instanceType)
+		}
+
+		if diff.NewValueKnown("kafka_version") && !kafkaVersionAtLeast(kafkaVersion, "3.6.0") {
+			return fmt.Errorf("express broker instance type %q requires kafka_version 3.6.0 or later, got %q", instanceType, kafkaVersion)
+		}
+	}
+
+	if storageMode == kafka.StorageModeTiered {
+		if instanceType == "kafka.t3.small" {
+			return fmt.Errorf("storage_mode %q is not supported for instance type %q", storageMode, instanceType)
+		}
+
+		if diff.NewValueKnown("kafka_version") && kafkaVersion != "2.8.2.tiered" && !kafkaVersionAtLeast(kafkaVersion, "3.6.0") {
+			return fmt.Errorf("storage_mode %q requires kafka_version 2.8.2.tiered or 3.6.0 or later, got %q", storageMode, kafkaVersion)
+		}
+	}
+
+	return nil
+}
+
+func isExpressBrokerInstanceType(instanceType string) bool {
+	return strings.HasPrefix(instanceType, "express.")
+}
+
+// kafkaVersionAtLeast returns whether the specified Kafka version is at least the minimum version.
+// Versions that cannot be parsed (e.g. "2.8.2.tiered") are never considered to meet the minimum.
+func kafkaVersionAtLeast(kafkaVersion, minimum string) bool {
+	v, err := version.NewVersion(kafkaVersion)
+
+	if err != nil {
+		return false
+	}
+
+	return v.GreaterThanOrEqual(version.Must(version.NewVersion(minimum)))
+}
+
 func expandClusterBrokerNodeGroupInfo(l []interface{}) *kafka.BrokerNodeGroupInfo {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -690,11 +781,15 @@ func expandClusterBrokerNodeGroupInfo(l []interface{}) *kafka.BrokerNodeGroupInf
 		ClientSubnets:        flex.ExpandStringSet(m["client_subnets"].(*schema.Set)),
 		InstanceType:         aws.String(m["instance_type"].(string)),
 		SecurityGroups:       flex.ExpandStringSet(m["security_groups"].(*schema.Set)),
-		StorageInfo: &kafka.StorageInfo{
+	}
+
+	// Express brokers do not use customer-provisioned EBS storage.
+	if v, ok := m["ebs_volume_size"].(int); ok && v > 0 {
+		bngi.StorageInfo = &kafka.StorageInfo{
 			EbsStorageInfo: &kafka.EBSStorageInfo{
-				VolumeSize: aws.Int64(int64(m["ebs_volume_size"].(int))),
+				VolumeSize: aws.Int64(int64(v)),
 			},
-		},
+		}
 	}
 
 	return bngi
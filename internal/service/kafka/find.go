@@ -83,6 +83,31 @@ func FindConfigurationByARN(conn *kafka.Kafka, arn string) (*kafka.DescribeConfi
 	return output, nil
 }
 
+func FindReplicatorByARN(conn *kafka.Kafka, arn string) (*kafka.DescribeReplicatorOutput, error) {
+	input := &kafka.DescribeReplicatorInput{
+		ReplicatorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeReplicator(input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 // FindScramSecrets returns the matching MSK Cluster's associated secrets
 func FindScramSecrets(conn *kafka.Kafka, clusterArn string) ([]*string, error) {
 	input := &kafka.ListScramSecretsInput{
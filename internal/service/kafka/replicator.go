@@ -0,0 +1,632 @@
+package kafka
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReplicator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceReplicatorCreate,
+		Read:   resourceReplicatorRead,
+		Update: resourceReplicatorUpdate,
+		Delete: resourceReplicatorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(replicatorCreateDefaultTimeout),
+			Update: schema.DefaultTimeout(replicatorUpdateDefaultTimeout),
+			Delete: schema.DefaultTimeout(replicatorDeleteDefaultTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"kafka_cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 2,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_msk_cluster": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"msk_cluster_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"vpc_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"security_groups_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replication_info_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"consumer_group_replication": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"consumer_groups_to_exclude": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsValidRegExp,
+										},
+									},
+									"consumer_groups_to_replicate": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsValidRegExp,
+										},
+									},
+									"detect_and_copy_new_consumer_groups": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"synchronise_consumer_group_offsets": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+						"source_kafka_cluster_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_kafka_cluster_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"target_compression_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(kafka.TargetCompressionType_Values(), false),
+						},
+						"target_kafka_cluster_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_kafka_cluster_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"topic_replication": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"copy_access_control_lists_for_topics": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"copy_topic_configurations": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"detect_and_copy_new_topics": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"starting_position": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(kafka.ReplicationStartingPositionType_Values(), false),
+												},
+											},
+										},
+									},
+									"topics_to_exclude": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsValidRegExp,
+										},
+									},
+									"topics_to_replicate": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsValidRegExp,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replicator_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"service_execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceReplicatorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("replicator_name").(string)
+	input := &kafka.CreateReplicatorInput{
+		KafkaClusters:           expandReplicatorKafkaClusters(d.Get("kafka_cluster").([]interface{})),
+		ReplicationInfoList:     expandReplicatorReplicationInfoList(d.Get("replication_info_list").([]interface{})),
+		ReplicatorName:          aws.String(name),
+		ServiceExecutionRoleArn: aws.String(d.Get("service_execution_role_arn").(string)),
+		Tags:                    Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MSK Replicator: %s", input)
+	output, err := conn.CreateReplicator(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MSK Replicator (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicatorArn))
+
+	if _, err := waitReplicatorCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MSK Replicator (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceReplicatorRead(d, meta)
+}
+
+func resourceReplicatorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	replicator, err := FindReplicatorByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK Replicator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Replicator (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", replicator.ReplicatorArn)
+	d.Set("current_version", replicator.CurrentVersion)
+	d.Set("description", replicator.ReplicatorDescription)
+
+	if err := d.Set("kafka_cluster", flattenReplicatorKafkaClusterDescriptions(replicator.KafkaClusters)); err != nil {
+		return fmt.Errorf("error setting kafka_cluster: %w", err)
+	}
+
+	// The replication information only includes cluster aliases, so map them back to cluster ARNs.
+	clusterARNs := make(map[string]string)
+	for _, v := range replicator.KafkaClusters {
+		if v == nil || v.AmazonMskCluster == nil {
+			continue
+		}
+		clusterARNs[aws.StringValue(v.KafkaClusterAlias)] = aws.StringValue(v.AmazonMskCluster.MskClusterArn)
+	}
+
+	if err := d.Set("replication_info_list", flattenReplicatorReplicationInfoDescriptions(replicator.ReplicationInfoList, clusterARNs)); err != nil {
+		return fmt.Errorf("error setting replication_info_list: %w", err)
+	}
+
+	d.Set("replicator_name", replicator.ReplicatorName)
+	d.Set("service_execution_role_arn", replicator.ServiceExecutionRoleArn)
+
+	tags := KeyValueTags(replicator.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceReplicatorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConn
+
+	if d.HasChange("replication_info_list") {
+		version := d.Get("current_version").(string)
+		input := &kafka.UpdateReplicationInfoInput{
+			CurrentVersion:        aws.String(version),
+			ReplicatorArn:         aws.String(d.Id()),
+			SourceKafkaClusterArn: aws.String(d.Get("replication_info_list.0.source_kafka_cluster_arn").(string)),
+			TargetKafkaClusterArn: aws.String(d.Get("replication_info_list.0.target_kafka_cluster_arn").(string)),
+		}
+
+		if d.HasChange("replication_info_list.0.consumer_group_replication") {
+			input.ConsumerGroupReplication = expandReplicatorConsumerGroupReplicationUpdate(d.Get("replication_info_list.0.consumer_group_replication").([]interface{}))
+		}
+
+		if d.HasChange("replication_info_list.0.topic_replication") {
+			input.TopicReplication = expandReplicatorTopicReplicationUpdate(d.Get("replication_info_list.0.topic_replication").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MSK Replicator replication info: %s", input)
+		_, err := conn.UpdateReplicationInfo(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MSK Replicator (%s) replication info: %w", d.Id(), err)
+		}
+
+		if _, err := waitReplicatorUpdated(conn, d.Id(), version, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for MSK Replicator (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating MSK Replicator (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceReplicatorRead(d, meta)
+}
+
+func resourceReplicatorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConn
+
+	log.Printf("[DEBUG] Deleting MSK Replicator: %s", d.Id())
+	_, err := conn.DeleteReplicator(&kafka.DeleteReplicatorInput{
+		ReplicatorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Replicator (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitReplicatorDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MSK Replicator (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandReplicatorKafkaClusters(l []interface{}) []*kafka.KafkaCluster {
+	var apiObjects []*kafka.KafkaCluster
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kafka.KafkaCluster{}
+
+		if v, ok := tfMap["amazon_msk_cluster"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AmazonMskCluster = &kafka.AmazonMskCluster{
+				MskClusterArn: aws.String(v[0].(map[string]interface{})["msk_cluster_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["vpc_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.VpcConfig = &kafka.KafkaClusterClientVpcConfig{
+				SubnetIds: flex.ExpandStringSet(m["subnet_ids"].(*schema.Set)),
+			}
+
+			if v, ok := m["security_groups_ids"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.VpcConfig.SecurityGroupIds = flex.ExpandStringSet(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandReplicatorReplicationInfoList(l []interface{}) []*kafka.ReplicationInfo {
+	var apiObjects []*kafka.ReplicationInfo
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kafka.ReplicationInfo{
+			ConsumerGroupReplication: expandReplicatorConsumerGroupReplication(tfMap["consumer_group_replication"].([]interface{})),
+			SourceKafkaClusterArn:    aws.String(tfMap["source_kafka_cluster_arn"].(string)),
+			TargetCompressionType:    aws.String(tfMap["target_compression_type"].(string)),
+			TargetKafkaClusterArn:    aws.String(tfMap["target_kafka_cluster_arn"].(string)),
+			TopicReplication:         expandReplicatorTopicReplication(tfMap["topic_replication"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandReplicatorConsumerGroupReplication(l []interface{}) *kafka.ConsumerGroupReplication {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	apiObject := &kafka.ConsumerGroupReplication{
+		ConsumerGroupsToReplicate:       flex.ExpandStringSet(m["consumer_groups_to_replicate"].(*schema.Set)),
+		DetectAndCopyNewConsumerGroups:  aws.Bool(m["detect_and_copy_new_consumer_groups"].(bool)),
+		SynchroniseConsumerGroupOffsets: aws.Bool(m["synchronise_consumer_group_offsets"].(bool)),
+	}
+
+	if v, ok := m["consumer_groups_to_exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ConsumerGroupsToExclude = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandReplicatorConsumerGroupReplicationUpdate(l []interface{}) *kafka.ConsumerGroupReplicationUpdate {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &kafka.ConsumerGroupReplicationUpdate{
+		ConsumerGroupsToExclude:         flex.ExpandStringSet(m["consumer_groups_to_exclude"].(*schema.Set)),
+		ConsumerGroupsToReplicate:       flex.ExpandStringSet(m["consumer_groups_to_replicate"].(*schema.Set)),
+		DetectAndCopyNewConsumerGroups:  aws.Bool(m["detect_and_copy_new_consumer_groups"].(bool)),
+		SynchroniseConsumerGroupOffsets: aws.Bool(m["synchronise_consumer_group_offsets"].(bool)),
+	}
+}
+
+func expandReplicatorTopicReplication(l []interface{}) *kafka.TopicReplication {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	apiObject := &kafka.TopicReplication{
+		CopyAccessControlListsForTopics: aws.Bool(m["copy_access_control_lists_for_topics"].(bool)),
+		CopyTopicConfigurations:         aws.Bool(m["copy_topic_configurations"].(bool)),
+		DetectAndCopyNewTopics:          aws.Bool(m["detect_and_copy_new_topics"].(bool)),
+		TopicsToReplicate:               flex.ExpandStringSet(m["topics_to_replicate"].(*schema.Set)),
+	}
+
+	if v, ok := m["starting_position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["type"].(string); ok && v != "" {
+			apiObject.StartingPosition = &kafka.ReplicationStartingPosition{
+				Type: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := m["topics_to_exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TopicsToExclude = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandReplicatorTopicReplicationUpdate(l []interface{}) *kafka.TopicReplicationUpdate {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &kafka.TopicReplicationUpdate{
+		CopyAccessControlListsForTopics: aws.Bool(m["copy_access_control_lists_for_topics"].(bool)),
+		CopyTopicConfigurations:         aws.Bool(m["copy_topic_configurations"].(bool)),
+		DetectAndCopyNewTopics:          aws.Bool(m["detect_and_copy_new_topics"].(bool)),
+		TopicsToExclude:                 flex.ExpandStringSet(m["topics_to_exclude"].(*schema.Set)),
+		TopicsToReplicate:               flex.ExpandStringSet(m["topics_to_replicate"].(*schema.Set)),
+	}
+}
+
+func flattenReplicatorKafkaClusterDescriptions(apiObjects []*kafka.KafkaClusterDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AmazonMskCluster; v != nil {
+			tfMap["amazon_msk_cluster"] = []interface{}{map[string]interface{}{
+				"msk_cluster_arn": aws.StringValue(v.MskClusterArn),
+			}}
+		}
+
+		if v := apiObject.VpcConfig; v != nil {
+			tfMap["vpc_config"] = []interface{}{map[string]interface{}{
+				"security_groups_ids": aws.StringValueSlice(v.SecurityGroupIds),
+				"subnet_ids":          aws.StringValueSlice(v.SubnetIds),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenReplicatorReplicationInfoDescriptions(apiObjects []*kafka.ReplicationInfoDescription, clusterARNs map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		sourceAlias := aws.StringValue(apiObject.SourceKafkaClusterAlias)
+		targetAlias := aws.StringValue(apiObject.TargetKafkaClusterAlias)
+
+		tfMap := map[string]interface{}{
+			"consumer_group_replication": flattenReplicatorConsumerGroupReplication(apiObject.ConsumerGroupReplication),
+			"source_kafka_cluster_alias": sourceAlias,
+			"source_kafka_cluster_arn":   clusterARNs[sourceAlias],
+			"target_compression_type":    aws.StringValue(apiObject.TargetCompressionType),
+			"target_kafka_cluster_alias": targetAlias,
+			"target_kafka_cluster_arn":   clusterARNs[targetAlias],
+			"topic_replication":          flattenReplicatorTopicReplication(apiObject.TopicReplication),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenReplicatorConsumerGroupReplication(apiObject *kafka.ConsumerGroupReplication) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"consumer_groups_to_exclude":          aws.StringValueSlice(apiObject.ConsumerGroupsToExclude),
+		"consumer_groups_to_replicate":        aws.StringValueSlice(apiObject.ConsumerGroupsToReplicate),
+		"detect_and_copy_new_consumer_groups": aws.BoolValue(apiObject.DetectAndCopyNewConsumerGroups),
+		"synchronise_consumer_group_offsets":  aws.BoolValue(apiObject.SynchroniseConsumerGroupOffsets),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenReplicatorTopicReplication(apiObject *kafka.TopicReplication) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"copy_access_control_lists_for_topics": aws.BoolValue(apiObject.CopyAccessControlListsForTopics),
+		"copy_topic_configurations":            aws.BoolValue(apiObject.CopyTopicConfigurations),
+		"detect_and_copy_new_topics":           aws.BoolValue(apiObject.DetectAndCopyNewTopics),
+		"topics_to_exclude":                    aws.StringValueSlice(apiObject.TopicsToExclude),
+		"topics_to_replicate":                  aws.StringValueSlice(apiObject.TopicsToReplicate),
+	}
+
+	if v := apiObject.StartingPosition; v != nil {
+		tfMap["starting_position"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v.Type),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,351 @@
+package kafka_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafka"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkafka "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKafkaReplicator_basic(t *testing.T) {
+	var replicator kafka.DescribeReplicatorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_replicator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafka.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicatorConfig_basic(rName, "test.*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicatorExists(resourceName, &replicator),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kafka", regexp.MustCompile(`replicator/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "kafka_cluster.0.amazon_msk_cluster.0.msk_cluster_arn", "aws_msk_cluster.source", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "kafka_cluster.1.amazon_msk_cluster.0.msk_cluster_arn", "aws_msk_cluster.target", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "replication_info_list.0.source_kafka_cluster_arn", "aws_msk_cluster.source", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "replication_info_list.0.target_kafka_cluster_arn", "aws_msk_cluster.target", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_info_list.0.source_kafka_cluster_alias"),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_info_list.0.target_kafka_cluster_alias"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.target_compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_replicate.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_replicate.*", "test.*"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.topic_replication.0.starting_position.0.type", "LATEST"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.consumer_group_replication.0.consumer_groups_to_replicate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replicator_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaReplicator_disappears(t *testing.T) {
+	var replicator kafka.DescribeReplicatorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_replicator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafka.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicatorConfig_basic(rName, "test.*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicatorExists(resourceName, &replicator),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkafka.ResourceReplicator(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaReplicator_update(t *testing.T) {
+	var replicator kafka.DescribeReplicatorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_replicator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafka.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicatorConfig_basic(rName, "test.*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicatorExists(resourceName, &replicator),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_replicate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_exclude.#", "0"),
+				),
+			},
+			{
+				Config: testAccReplicatorConfig_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicatorExists(resourceName, &replicator),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_replicate.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_replicate.*", "test.*"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_replicate.*", "example.*"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_exclude.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_info_list.0.topic_replication.0.topics_to_exclude.*", "test.internal.*"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.topic_replication.0.detect_and_copy_new_topics", "false"),
+					resource.TestCheckResourceAttr(resourceName, "replication_info_list.0.consumer_group_replication.0.synchronise_consumer_group_offsets", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplicatorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_msk_replicator" {
+			continue
+		}
+
+		_, err := tfkafka.FindReplicatorByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MSK Replicator %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckReplicatorExists(n string, v *kafka.DescribeReplicatorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK Replicator ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConn
+
+		output, err := tfkafka.FindReplicatorByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReplicatorBaseConfig(rName string) string {
+	return acctest.ConfigCompose(testAccClusterBaseConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_msk_cluster" "source" {
+  cluster_name           = "%[1]s-source"
+  kafka_version          = "2.8.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  client_authentication {
+    sasl {
+      iam = true
+    }
+  }
+}
+
+resource "aws_msk_cluster" "target" {
+  cluster_name           = "%[1]s-target"
+  kafka_version          = "2.8.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  client_authentication {
+    sasl {
+      iam = true
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "kafka.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "kafka-cluster:*",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccReplicatorConfig_basic(rName, topic string) string {
+	return acctest.ConfigCompose(testAccReplicatorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_msk_replicator" "test" {
+  replicator_name            = %[1]q
+  description                = "test"
+  service_execution_role_arn = aws_iam_role.test.arn
+
+  kafka_cluster {
+    amazon_msk_cluster {
+      msk_cluster_arn = aws_msk_cluster.source.arn
+    }
+
+    vpc_config {
+      subnet_ids          = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+      security_groups_ids = [aws_security_group.example_sg.id]
+    }
+  }
+
+  kafka_cluster {
+    amazon_msk_cluster {
+      msk_cluster_arn = aws_msk_cluster.target.arn
+    }
+
+    vpc_config {
+      subnet_ids          = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+      security_groups_ids = [aws_security_group.example_sg.id]
+    }
+  }
+
+  replication_info_list {
+    source_kafka_cluster_arn = aws_msk_cluster.source.arn
+    target_kafka_cluster_arn = aws_msk_cluster.target.arn
+    target_compression_type  = "NONE"
+
+    topic_replication {
+      topics_to_replicate = [%[2]q]
+
+      starting_position {
+        type = "LATEST"
+      }
+    }
+
+    consumer_group_replication {
+      consumer_groups_to_replicate = [".*"]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, topic))
+}
+
+func testAccReplicatorConfig_update(rName string) string {
+	return acctest.ConfigCompose(testAccReplicatorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_msk_replicator" "test" {
+  replicator_name            = %[1]q
+  description                = "test"
+  service_execution_role_arn = aws_iam_role.test.arn
+
+  kafka_cluster {
+    amazon_msk_cluster {
+      msk_cluster_arn = aws_msk_cluster.source.arn
+    }
+
+    vpc_config {
+      subnet_ids          = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+      security_groups_ids = [aws_security_group.example_sg.id]
+    }
+  }
+
+  kafka_cluster {
+    amazon_msk_cluster {
+      msk_cluster_arn = aws_msk_cluster.target.arn
+    }
+
+    vpc_config {
+      subnet_ids          = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+      security_groups_ids = [aws_security_group.example_sg.id]
+    }
+  }
+
+  replication_info_list {
+    source_kafka_cluster_arn = aws_msk_cluster.source.arn
+    target_kafka_cluster_arn = aws_msk_cluster.target.arn
+    target_compression_type  = "NONE"
+
+    topic_replication {
+      topics_to_replicate        = ["test.*", "example.*"]
+      topics_to_exclude          = ["test.internal.*"]
+      detect_and_copy_new_topics = false
+
+      starting_position {
+        type = "LATEST"
+      }
+    }
+
+    consumer_group_replication {
+      consumer_groups_to_replicate       = [".*"]
+      synchronise_consumer_group_offsets = false
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -54,3 +54,40 @@ func statusConfigurationState(conn *kafka.Kafka, arn string) resource.StateRefre
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func statusReplicatorState(conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicatorByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ReplicatorState), nil
+	}
+}
+
+// statusReplicatorUpdateState reports the Replicator as updating until its current version moves on from the specified version.
+func statusReplicatorUpdateState(conn *kafka.Kafka, arn, version string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicatorByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if state := aws.StringValue(output.ReplicatorState); state == kafka.ReplicatorStateRunning && aws.StringValue(output.CurrentVersion) == version {
+			return output, kafka.ReplicatorStateUpdating, nil
+		}
+
+		return output, aws.StringValue(output.ReplicatorState), nil
+	}
+}
@@ -20,6 +20,12 @@ const (
 	configurationDeletedTimeout = 5 * time.Minute
 )
 
+const (
+	replicatorCreateDefaultTimeout = 60 * time.Minute
+	replicatorUpdateDefaultTimeout = 180 * time.Minute
+	replicatorDeleteDefaultTimeout = 90 * time.Minute
+)
+
 func waitClusterCreated(conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.ClusterInfo, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kafka.ClusterStateCreating},
@@ -99,3 +105,68 @@ func waitConfigurationDeleted(conn *kafka.Kafka, arn string) (*kafka.DescribeCon
 
 	return nil, err
 }
+
+func waitReplicatorCreated(conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateCreating},
+		Target:  []string{kafka.ReplicatorStateRunning},
+		Refresh: statusReplicatorState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if state, stateInfo := aws.StringValue(output.ReplicatorState), output.StateInfo; state == kafka.ReplicatorStateFailed && stateInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitReplicatorUpdated waits for the Replicator to enter and then leave the UPDATING state,
+// and for its current version to differ from the version the update was requested against.
+func waitReplicatorUpdated(conn *kafka.Kafka, arn, version string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateUpdating},
+		Target:  []string{kafka.ReplicatorStateRunning},
+		Refresh: statusReplicatorUpdateState(conn, arn, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if state, stateInfo := aws.StringValue(output.ReplicatorState), output.StateInfo; state == kafka.ReplicatorStateFailed && stateInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicatorDeleted(conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateRunning, kafka.ReplicatorStateDeleting},
+		Target:  []string{},
+		Refresh: statusReplicatorState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if state, stateInfo := aws.StringValue(output.ReplicatorState), output.StateInfo; state == kafka.ReplicatorStateFailed && stateInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,967 @@
+package kafkaconnect
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	connectorScaleInPolicyCPUUtilizationPercentageDefault  = 20
+	connectorScaleOutPolicyCPUUtilizationPercentageDefault = 80
+)
+
+func ResourceConnector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConnectorCreate,
+		Read:   resourceConnectorRead,
+		Update: resourceConnectorUpdate,
+		Delete: resourceConnectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"autoscaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"mcu_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntInSlice([]int{1, 2, 4, 8}),
+									},
+									"min_worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"scale_in_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpu_utilization_percentage": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(1, 100),
+												},
+											},
+										},
+									},
+									"scale_out_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpu_utilization_percentage": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(1, 100),
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"capacity.0.autoscaling", "capacity.0.provisioned_capacity"},
+						},
+						"provisioned_capacity": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mcu_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntInSlice([]int{1, 2, 4, 8}),
+									},
+									"worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"capacity.0.autoscaling", "capacity.0.provisioned_capacity"},
+						},
+					},
+				},
+			},
+			"connector_configuration": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kafka_cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apache_kafka_cluster": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bootstrap_servers": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"vpc": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"security_groups": {
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"subnets": {
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kafka_cluster_client_authentication": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      kafkaconnect.KafkaClusterClientAuthenticationTypeNone,
+							ValidateFunc: validation.StringInSlice(kafkaconnect.KafkaClusterClientAuthenticationType_Values(), false),
+						},
+					},
+				},
+			},
+			"kafka_cluster_encryption_in_transit": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      kafkaconnect.KafkaClusterEncryptionInTransitTypePlaintext,
+							ValidateFunc: validation.StringInSlice(kafkaconnect.KafkaClusterEncryptionInTransitType_Values(), false),
+						},
+					},
+				},
+			},
+			"kafkaconnect_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"log_delivery": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"worker_log_delivery": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloudwatch_logs": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"log_group": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"firehose": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delivery_stream": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plugin": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_plugin": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"revision": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"worker_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"revision": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceConnectorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	name := d.Get("name").(string)
+	input := &kafkaconnect.CreateConnectorInput{
+		Capacity:                         expandCapacity(d.Get("capacity").([]interface{})),
+		ConnectorConfiguration:           flex.ExpandStringMap(d.Get("connector_configuration").(map[string]interface{})),
+		ConnectorName:                    aws.String(name),
+		KafkaCluster:                     expandCluster(d.Get("kafka_cluster").([]interface{})),
+		KafkaClusterClientAuthentication: expandClusterClientAuthentication(d.Get("kafka_cluster_client_authentication").([]interface{})),
+		KafkaClusterEncryptionInTransit:  expandClusterEncryptionInTransit(d.Get("kafka_cluster_encryption_in_transit").([]interface{})),
+		KafkaConnectVersion:              aws.String(d.Get("kafkaconnect_version").(string)),
+		Plugins:                          expandPlugins(d.Get("plugin").(*schema.Set).List()),
+		ServiceExecutionRoleArn:          aws.String(d.Get("service_execution_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ConnectorDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_delivery"); ok {
+		input.LogDelivery = expandLogDelivery(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("worker_configuration"); ok {
+		input.WorkerConfiguration = expandWorkerConfiguration(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MSK Connect Connector: %s", input)
+	output, err := conn.CreateConnector(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MSK Connect Connector (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectorArn))
+
+	_, err = waitConnectorCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for MSK Connect Connector (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceConnectorRead(d, meta)
+}
+
+func resourceConnectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	connector, err := FindConnectorByARN(conn, d.Id())
+
+	if tfresource.NotFound(err) && !d.IsNewResource() {
+		log.Printf("[WARN] MSK Connect Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Connect Connector (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", connector.ConnectorArn)
+
+	if connector.Capacity != nil {
+		if err := d.Set("capacity", []interface{}{flattenCapacityDescription(connector.Capacity)}); err != nil {
+			return fmt.Errorf("error setting capacity: %w", err)
+		}
+	} else {
+		d.Set("capacity", nil)
+	}
+
+	d.Set("connector_configuration", aws.StringValueMap(connector.ConnectorConfiguration))
+	d.Set("description", connector.ConnectorDescription)
+
+	if connector.KafkaCluster != nil {
+		if err := d.Set("kafka_cluster", []interface{}{flattenClusterDescription(connector.KafkaCluster)}); err != nil {
+			return fmt.Errorf("error setting kafka_cluster: %w", err)
+		}
+	} else {
+		d.Set("kafka_cluster", nil)
+	}
+
+	if connector.KafkaClusterClientAuthentication != nil {
+		if err := d.Set("kafka_cluster_client_authentication", []interface{}{map[string]interface{}{
+			"authentication_type": aws.StringValue(connector.KafkaClusterClientAuthentication.AuthenticationType),
+		}}); err != nil {
+			return fmt.Errorf("error setting kafka_cluster_client_authentication: %w", err)
+		}
+	} else {
+		d.Set("kafka_cluster_client_authentication", nil)
+	}
+
+	if connector.KafkaClusterEncryptionInTransit != nil {
+		if err := d.Set("kafka_cluster_encryption_in_transit", []interface{}{map[string]interface{}{
+			"encryption_type": aws.StringValue(connector.KafkaClusterEncryptionInTransit.EncryptionType),
+		}}); err != nil {
+			return fmt.Errorf("error setting kafka_cluster_encryption_in_transit: %w", err)
+		}
+	} else {
+		d.Set("kafka_cluster_encryption_in_transit", nil)
+	}
+
+	d.Set("kafkaconnect_version", connector.KafkaConnectVersion)
+
+	if connector.LogDelivery != nil {
+		if err := d.Set("log_delivery", []interface{}{flattenLogDeliveryDescription(connector.LogDelivery)}); err != nil {
+			return fmt.Errorf("error setting log_delivery: %w", err)
+		}
+	} else {
+		d.Set("log_delivery", nil)
+	}
+
+	d.Set("name", connector.ConnectorName)
+
+	if err := d.Set("plugin", flattenPluginDescriptions(connector.Plugins)); err != nil {
+		return fmt.Errorf("error setting plugin: %w", err)
+	}
+
+	d.Set("service_execution_role_arn", connector.ServiceExecutionRoleArn)
+	d.Set("version", connector.CurrentVersion)
+
+	if connector.WorkerConfiguration != nil {
+		if err := d.Set("worker_configuration", []interface{}{map[string]interface{}{
+			"arn":      aws.StringValue(connector.WorkerConfiguration.WorkerConfigurationArn),
+			"revision": aws.Int64Value(connector.WorkerConfiguration.Revision),
+		}}); err != nil {
+			return fmt.Errorf("error setting worker_configuration: %w", err)
+		}
+	} else {
+		d.Set("worker_configuration", nil)
+	}
+
+	return nil
+}
+
+func resourceConnectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	if d.HasChanges("capacity", "connector_configuration") {
+		// UpdateConnector requires the capacity to always be specified.
+		input := &kafkaconnect.UpdateConnectorInput{
+			Capacity:       expandCapacityUpdate(d.Get("capacity").([]interface{})),
+			ConnectorArn:   aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("version").(string)),
+		}
+
+		var opts []request.Option
+
+		if d.HasChange("connector_configuration") {
+			opts = append(opts, withConnectorConfiguration(flex.ExpandStringMap(d.Get("connector_configuration").(map[string]interface{}))))
+		}
+
+		log.Printf("[DEBUG] Updating MSK Connect Connector: %s", input)
+		_, err := conn.UpdateConnectorWithContext(aws.BackgroundContext(), input, opts...)
+
+		if err != nil {
+			return fmt.Errorf("error updating MSK Connect Connector (%s): %w", d.Id(), err)
+		}
+
+		_, err = waitConnectorUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return fmt.Errorf("error waiting for MSK Connect Connector (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceConnectorRead(d, meta)
+}
+
+func resourceConnectorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	log.Printf("[DEBUG] Deleting MSK Connect Connector: %s", d.Id())
+	_, err := conn.DeleteConnector(&kafkaconnect.DeleteConnectorInput{
+		ConnectorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafkaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Connect Connector (%s): %w", d.Id(), err)
+	}
+
+	_, err = waitConnectorDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for MSK Connect Connector (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// withConnectorConfiguration returns a request option that adds the
+// connectorConfiguration member to the JSON body of an UpdateConnector request.
+// The member is not modeled in this version of the AWS SDK for Go.
+func withConnectorConfiguration(configuration map[string]*string) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBack(func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			body, err := io.ReadAll(r.GetBody())
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			m := map[string]interface{}{}
+			if len(body) > 0 {
+				if err := json.Unmarshal(body, &m); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			m["connectorConfiguration"] = configuration
+
+			body, err = json.Marshal(m)
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetBufferBody(body)
+		})
+	}
+}
+
+func expandCapacity(tfList []interface{}) *kafkaconnect.Capacity {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &kafkaconnect.Capacity{}
+
+	if v, ok := tfMap["autoscaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.AutoScaling = &kafkaconnect.AutoScaling{
+			MaxWorkerCount: aws.Int64(int64(m["max_worker_count"].(int))),
+			McuCount:       aws.Int64(int64(m["mcu_count"].(int))),
+			MinWorkerCount: aws.Int64(int64(m["min_worker_count"].(int))),
+		}
+
+		if v, ok := m["scale_in_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["cpu_utilization_percentage"].(int); ok && v != 0 {
+				apiObject.AutoScaling.ScaleInPolicy = &kafkaconnect.ScaleInPolicy{
+					CpuUtilizationPercentage: aws.Int64(int64(v)),
+				}
+			}
+		}
+
+		if v, ok := m["scale_out_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["cpu_utilization_percentage"].(int); ok && v != 0 {
+				apiObject.AutoScaling.ScaleOutPolicy = &kafkaconnect.ScaleOutPolicy{
+					CpuUtilizationPercentage: aws.Int64(int64(v)),
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["provisioned_capacity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.ProvisionedCapacity = &kafkaconnect.ProvisionedCapacity{
+			McuCount:    aws.Int64(int64(m["mcu_count"].(int))),
+			WorkerCount: aws.Int64(int64(m["worker_count"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func expandCapacityUpdate(tfList []interface{}) *kafkaconnect.CapacityUpdate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &kafkaconnect.CapacityUpdate{}
+
+	if v, ok := tfMap["autoscaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.AutoScaling = &kafkaconnect.AutoScalingUpdate{
+			MaxWorkerCount: aws.Int64(int64(m["max_worker_count"].(int))),
+			McuCount:       aws.Int64(int64(m["mcu_count"].(int))),
+			MinWorkerCount: aws.Int64(int64(m["min_worker_count"].(int))),
+			ScaleInPolicy:  &kafkaconnect.ScaleInPolicyUpdate{},
+			ScaleOutPolicy: &kafkaconnect.ScaleOutPolicyUpdate{},
+		}
+
+		// Scaling policies are required on update. Fall back to the service defaults
+		// if they are not yet known, e.g. when switching from provisioned capacity.
+		apiObject.AutoScaling.ScaleInPolicy.CpuUtilizationPercentage = aws.Int64(connectorScaleInPolicyCPUUtilizationPercentageDefault)
+		apiObject.AutoScaling.ScaleOutPolicy.CpuUtilizationPercentage = aws.Int64(connectorScaleOutPolicyCPUUtilizationPercentageDefault)
+
+		if v, ok := m["scale_in_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["cpu_utilization_percentage"].(int); ok && v != 0 {
+				apiObject.AutoScaling.ScaleInPolicy.CpuUtilizationPercentage = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := m["scale_out_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["cpu_utilization_percentage"].(int); ok && v != 0 {
+				apiObject.AutoScaling.ScaleOutPolicy.CpuUtilizationPercentage = aws.Int64(int64(v))
+			}
+		}
+	}
+
+	if v, ok := tfMap["provisioned_capacity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.ProvisionedCapacity = &kafkaconnect.ProvisionedCapacityUpdate{
+			McuCount:    aws.Int64(int64(m["mcu_count"].(int))),
+			WorkerCount: aws.Int64(int64(m["worker_count"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func expandCluster(tfList []interface{}) *kafkaconnect.KafkaCluster {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &kafkaconnect.KafkaCluster{}
+
+	if v, ok := tfMap["apache_kafka_cluster"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.ApacheKafkaCluster = &kafkaconnect.ApacheKafkaCluster{
+			BootstrapServers: aws.String(m["bootstrap_servers"].(string)),
+		}
+
+		if v, ok := m["vpc"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.ApacheKafkaCluster.Vpc = &kafkaconnect.Vpc{
+				SecurityGroups: flex.ExpandStringSet(m["security_groups"].(*schema.Set)),
+				Subnets:        flex.ExpandStringSet(m["subnets"].(*schema.Set)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandClusterClientAuthentication(tfList []interface{}) *kafkaconnect.KafkaClusterClientAuthentication {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &kafkaconnect.KafkaClusterClientAuthentication{
+		AuthenticationType: aws.String(tfMap["authentication_type"].(string)),
+	}
+}
+
+func expandClusterEncryptionInTransit(tfList []interface{}) *kafkaconnect.KafkaClusterEncryptionInTransit {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &kafkaconnect.KafkaClusterEncryptionInTransit{
+		EncryptionType: aws.String(tfMap["encryption_type"].(string)),
+	}
+}
+
+func expandPlugins(tfList []interface{}) []*kafkaconnect.Plugin {
+	var apiObjects []*kafkaconnect.Plugin
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kafkaconnect.Plugin{}
+
+		if v, ok := tfMap["custom_plugin"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.CustomPlugin = &kafkaconnect.CustomPlugin{
+				CustomPluginArn: aws.String(m["arn"].(string)),
+				Revision:        aws.Int64(int64(m["revision"].(int))),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLogDelivery(tfList []interface{}) *kafkaconnect.LogDelivery {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &kafkaconnect.LogDelivery{}
+
+	if v, ok := tfMap["worker_log_delivery"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.WorkerLogDelivery = &kafkaconnect.WorkerLogDelivery{}
+
+		if v, ok := m["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.WorkerLogDelivery.CloudWatchLogs = &kafkaconnect.CloudWatchLogsLogDelivery{
+				Enabled: aws.Bool(m["enabled"].(bool)),
+			}
+
+			if v, ok := m["log_group"].(string); ok && v != "" {
+				apiObject.WorkerLogDelivery.CloudWatchLogs.LogGroup = aws.String(v)
+			}
+		}
+
+		if v, ok := m["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.WorkerLogDelivery.Firehose = &kafkaconnect.FirehoseLogDelivery{
+				Enabled: aws.Bool(m["enabled"].(bool)),
+			}
+
+			if v, ok := m["delivery_stream"].(string); ok && v != "" {
+				apiObject.WorkerLogDelivery.Firehose.DeliveryStream = aws.String(v)
+			}
+		}
+
+		if v, ok := m["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.WorkerLogDelivery.S3 = &kafkaconnect.S3LogDelivery{
+				Enabled: aws.Bool(m["enabled"].(bool)),
+			}
+
+			if v, ok := m["bucket"].(string); ok && v != "" {
+				apiObject.WorkerLogDelivery.S3.Bucket = aws.String(v)
+			}
+
+			if v, ok := m["prefix"].(string); ok && v != "" {
+				apiObject.WorkerLogDelivery.S3.Prefix = aws.String(v)
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandWorkerConfiguration(tfList []interface{}) *kafkaconnect.WorkerConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &kafkaconnect.WorkerConfiguration{
+		Revision:               aws.Int64(int64(tfMap["revision"].(int))),
+		WorkerConfigurationArn: aws.String(tfMap["arn"].(string)),
+	}
+}
+
+func flattenCapacityDescription(apiObject *kafkaconnect.CapacityDescription) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutoScaling; v != nil {
+		m := map[string]interface{}{
+			"max_worker_count": aws.Int64Value(v.MaxWorkerCount),
+			"mcu_count":        aws.Int64Value(v.McuCount),
+			"min_worker_count": aws.Int64Value(v.MinWorkerCount),
+		}
+
+		if v := v.ScaleInPolicy; v != nil {
+			m["scale_in_policy"] = []interface{}{map[string]interface{}{
+				"cpu_utilization_percentage": aws.Int64Value(v.CpuUtilizationPercentage),
+			}}
+		}
+
+		if v := v.ScaleOutPolicy; v != nil {
+			m["scale_out_policy"] = []interface{}{map[string]interface{}{
+				"cpu_utilization_percentage": aws.Int64Value(v.CpuUtilizationPercentage),
+			}}
+		}
+
+		tfMap["autoscaling"] = []interface{}{m}
+	}
+
+	if v := apiObject.ProvisionedCapacity; v != nil {
+		tfMap["provisioned_capacity"] = []interface{}{map[string]interface{}{
+			"mcu_count":    aws.Int64Value(v.McuCount),
+			"worker_count": aws.Int64Value(v.WorkerCount),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenClusterDescription(apiObject *kafkaconnect.KafkaClusterDescription) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ApacheKafkaCluster; v != nil {
+		m := map[string]interface{}{
+			"bootstrap_servers": aws.StringValue(v.BootstrapServers),
+		}
+
+		if v := v.Vpc; v != nil {
+			m["vpc"] = []interface{}{map[string]interface{}{
+				"security_groups": aws.StringValueSlice(v.SecurityGroups),
+				"subnets":         aws.StringValueSlice(v.Subnets),
+			}}
+		}
+
+		tfMap["apache_kafka_cluster"] = []interface{}{m}
+	}
+
+	return tfMap
+}
+
+func flattenLogDeliveryDescription(apiObject *kafkaconnect.LogDeliveryDescription) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.WorkerLogDelivery; v != nil {
+		m := map[string]interface{}{}
+
+		if v := v.CloudWatchLogs; v != nil {
+			m["cloudwatch_logs"] = []interface{}{map[string]interface{}{
+				"enabled":   aws.BoolValue(v.Enabled),
+				"log_group": aws.StringValue(v.LogGroup),
+			}}
+		}
+
+		if v := v.Firehose; v != nil {
+			m["firehose"] = []interface{}{map[string]interface{}{
+				"delivery_stream": aws.StringValue(v.DeliveryStream),
+				"enabled":         aws.BoolValue(v.Enabled),
+			}}
+		}
+
+		if v := v.S3; v != nil {
+			m["s3"] = []interface{}{map[string]interface{}{
+				"bucket":  aws.StringValue(v.Bucket),
+				"enabled": aws.BoolValue(v.Enabled),
+				"prefix":  aws.StringValue(v.Prefix),
+			}}
+		}
+
+		tfMap["worker_log_delivery"] = []interface{}{m}
+	}
+
+	return tfMap
+}
+
+func flattenPluginDescriptions(apiObjects []*kafkaconnect.PluginDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.CustomPlugin; v != nil {
+			tfMap["custom_plugin"] = []interface{}{map[string]interface{}{
+				"arn":      aws.StringValue(v.CustomPluginArn),
+				"revision": aws.Int64Value(v.Revision),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,410 @@
+package kafkaconnect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkafkaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKafkaConnectConnector_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Providers:    acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.max_worker_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.mcu_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.min_worker_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_in_policy.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "capacity.0.autoscaling.0.scale_in_policy.0.cpu_utilization_percentage"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_out_policy.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "capacity.0.autoscaling.0.scale_out_policy.0.cpu_utilization_percentage"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.connector.class", "com.mongodb.kafka.connect.MongoSinkConnector"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.tasks.max", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.topics", "t1"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.0.apache_kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "kafka_cluster.0.apache_kafka_cluster.0.bootstrap_servers"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.0.apache_kafka_cluster.0.vpc.0.security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.0.apache_kafka_cluster.0.vpc.0.subnets.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster_client_authentication.0.authentication_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster_encryption_in_transit.0.encryption_type", "PLAINTEXT"),
+					resource.TestCheckResourceAttr(resourceName, "kafkaconnect_version", "2.7.1"),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "plugin.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "worker_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaConnectConnector_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Providers:    acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkafkaconnect.ResourceConnector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaConnectConnector_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Providers:    acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.max_worker_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.%", "3"),
+				),
+			},
+			{
+				Config: testAccConnectorConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.max_worker_count", "6"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.mcu_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.min_worker_count", "4"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_in_policy.0.cpu_utilization_percentage", "25"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_out_policy.0.cpu_utilization_percentage", "75"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.tasks.max", "2"),
+				),
+			},
+			{
+				Config: testAccConnectorConfigProvisionedCapacity(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.0.mcu_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.0.worker_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK Connect Connector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+
+		_, err := tfkafkaconnect.FindConnectorByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckConnectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mskconnect_connector" {
+			continue
+		}
+
+		_, err := tfkafkaconnect.FindConnectorByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MSK Connect Connector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConnectorBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), testAccCustomPluginConfigBasic(rName), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 3
+
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.7.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = aws_subnet.test[*].id
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.test.id]
+  }
+
+  encryption_info {
+    encryption_in_transit {
+      client_broker = "PLAINTEXT"
+    }
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "kafkaconnect.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccConnectorConfigBasic(rName string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_mskconnect_connector" "test" {
+  name = %[1]q
+
+  kafkaconnect_version = "2.7.1"
+
+  capacity {
+    autoscaling {
+      min_worker_count = 1
+      max_worker_count = 2
+    }
+  }
+
+  connector_configuration = {
+    "connector.class" = "com.mongodb.kafka.connect.MongoSinkConnector"
+    "tasks.max"       = "1"
+    "topics"          = "t1"
+  }
+
+  kafka_cluster {
+    apache_kafka_cluster {
+      bootstrap_servers = aws_msk_cluster.test.bootstrap_brokers
+
+      vpc {
+        security_groups = [aws_security_group.test.id]
+        subnets         = aws_subnet.test[*].id
+      }
+    }
+  }
+
+  kafka_cluster_client_authentication {
+    authentication_type = "NONE"
+  }
+
+  kafka_cluster_encryption_in_transit {
+    encryption_type = "PLAINTEXT"
+  }
+
+  plugin {
+    custom_plugin {
+      arn      = aws_mskconnect_custom_plugin.test.arn
+      revision = aws_mskconnect_custom_plugin.test.latest_revision
+    }
+  }
+
+  service_execution_role_arn = aws_iam_role.test.arn
+}
+`, rName))
+}
+
+func testAccConnectorConfigUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_mskconnect_connector" "test" {
+  name = %[1]q
+
+  kafkaconnect_version = "2.7.1"
+
+  capacity {
+    autoscaling {
+      mcu_count        = 2
+      min_worker_count = 4
+      max_worker_count = 6
+
+      scale_in_policy {
+        cpu_utilization_percentage = 25
+      }
+
+      scale_out_policy {
+        cpu_utilization_percentage = 75
+      }
+    }
+  }
+
+  connector_configuration = {
+    "connector.class" = "com.mongodb.kafka.connect.MongoSinkConnector"
+    "tasks.max"       = "2"
+    "topics"          = "t1"
+  }
+
+  kafka_cluster {
+    apache_kafka_cluster {
+      bootstrap_servers = aws_msk_cluster.test.bootstrap_brokers
+
+      vpc {
+        security_groups = [aws_security_group.test.id]
+        subnets         = aws_subnet.test[*].id
+      }
+    }
+  }
+
+  kafka_cluster_client_authentication {
+    authentication_type = "NONE"
+  }
+
+  kafka_cluster_encryption_in_transit {
+    encryption_type = "PLAINTEXT"
+  }
+
+  plugin {
+    custom_plugin {
+      arn      = aws_mskconnect_custom_plugin.test.arn
+      revision = aws_mskconnect_custom_plugin.test.latest_revision
+    }
+  }
+
+  service_execution_role_arn = aws_iam_role.test.arn
+}
+`, rName))
+}
+
+func testAccConnectorConfigProvisionedCapacity(rName string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_mskconnect_connector" "test" {
+  name = %[1]q
+
+  kafkaconnect_version = "2.7.1"
+
+  capacity {
+    provisioned_capacity {
+      worker_count = 2
+    }
+  }
+
+  connector_configuration = {
+    "connector.class" = "com.mongodb.kafka.connect.MongoSinkConnector"
+    "tasks.max"       = "2"
+    "topics"          = "t1"
+  }
+
+  kafka_cluster {
+    apache_kafka_cluster {
+      bootstrap_servers = aws_msk_cluster.test.bootstrap_brokers
+
+      vpc {
+        security_groups = [aws_security_group.test.id]
+        subnets         = aws_subnet.test[*].id
+      }
+    }
+  }
+
+  kafka_cluster_client_authentication {
+    authentication_type = "NONE"
+  }
+
+  kafka_cluster_encryption_in_transit {
+    encryption_type = "PLAINTEXT"
+  }
+
+  plugin {
+    custom_plugin {
+      arn      = aws_mskconnect_custom_plugin.test.arn
+      revision = aws_mskconnect_custom_plugin.test.latest_revision
+    }
+  }
+
+  service_execution_role_arn = aws_iam_role.test.arn
+}
+`, rName))
+}
@@ -66,7 +66,7 @@ func ResourceCustomPlugin() *schema.Resource {
 										Type:         schema.TypeString,
 										Required:     true,
 										ForceNew:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("s3", ""),
 									},
 									"file_key": {
 										Type:     schema.TypeString,
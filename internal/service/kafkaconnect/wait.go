@@ -1,10 +1,13 @@
 package kafkaconnect
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitCustomPluginCreated(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeCustomPluginOutput, error) {
@@ -23,3 +26,66 @@ func waitCustomPluginCreated(conn *kafkaconnect.KafkaConnect, arn string, timeou
 
 	return nil, err
 }
+
+func waitConnectorCreated(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeConnectorOutput, error) {
+	stateconf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateCreating},
+		Target:  []string{kafkaconnect.ConnectorStateRunning},
+		Refresh: statusConnectorState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateconf.WaitForState()
+
+	if output, ok := outputRaw.(*kafkaconnect.DescribeConnectorOutput); ok {
+		if state, stateDescription := aws.StringValue(output.ConnectorState), output.StateDescription; state == kafkaconnect.ConnectorStateFailed && stateDescription != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateDescription.Code), aws.StringValue(stateDescription.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectorUpdated(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeConnectorOutput, error) {
+	stateconf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateUpdating},
+		Target:  []string{kafkaconnect.ConnectorStateRunning},
+		Refresh: statusConnectorState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateconf.WaitForState()
+
+	if output, ok := outputRaw.(*kafkaconnect.DescribeConnectorOutput); ok {
+		if state, stateDescription := aws.StringValue(output.ConnectorState), output.StateDescription; state == kafkaconnect.ConnectorStateFailed && stateDescription != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateDescription.Code), aws.StringValue(stateDescription.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectorDeleted(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeConnectorOutput, error) {
+	stateconf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateDeleting},
+		Target:  []string{},
+		Refresh: statusConnectorState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateconf.WaitForState()
+
+	if output, ok := outputRaw.(*kafkaconnect.DescribeConnectorOutput); ok {
+		if state, stateDescription := aws.StringValue(output.ConnectorState), output.StateDescription; state == kafkaconnect.ConnectorStateFailed && stateDescription != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateDescription.Code), aws.StringValue(stateDescription.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,16 +1,58 @@
 package kinesis
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 )
 
+// FlattenShardLevelMetrics returns the distinct shard-level metrics enabled across all EnhancedMonitoring entries.
 func FlattenShardLevelMetrics(list []*kinesis.EnhancedMetrics) []string {
-	if len(list) == 0 {
-		return []string{}
-	}
-	strs := make([]string, 0, len(list[0].ShardLevelMetrics))
-	for _, s := range list[0].ShardLevelMetrics {
-		strs = append(strs, *s)
+	strs := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, v := range list {
+		if v == nil {
+			continue
+		}
+		for _, s := range aws.StringValueSlice(v.ShardLevelMetrics) {
+			if seen[s] {
+				continue
+			}
+			seen[s] = true
+			strs = append(strs, s)
+		}
 	}
 	return strs
 }
+
+// shardLevelMetricsToSet returns the shard-level metrics to store in state.
+// When "ALL" is configured the API reports each individual metric, so collapse them back to "ALL".
+func shardLevelMetricsToSet(configured []string, metrics []string) []string {
+	configuredAll := false
+	for _, v := range configured {
+		if v == kinesis.MetricsNameAll {
+			configuredAll = true
+			break
+		}
+	}
+
+	if !configuredAll {
+		return metrics
+	}
+
+	enabled := make(map[string]bool)
+	for _, v := range metrics {
+		enabled[v] = true
+	}
+
+	if enabled[kinesis.MetricsNameAll] {
+		return []string{kinesis.MetricsNameAll}
+	}
+
+	for _, v := range kinesis.MetricsName_Values() {
+		if v != kinesis.MetricsNameAll && !enabled[v] {
+			return metrics
+		}
+	}
+
+	return []string{kinesis.MetricsNameAll}
+}
@@ -1,6 +1,7 @@
 package kinesis
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -27,3 +28,84 @@ func TestFlattenShardLevelMetrics(t *testing.T) {
 		t.Fatalf("expected element 0 to be IncomingRecords, but was %s", result[1])
 	}
 }
+
+func TestFlattenShardLevelMetrics_multiple(t *testing.T) {
+	expanded := []*kinesis.EnhancedMetrics{
+		{
+			ShardLevelMetrics: []*string{
+				aws.String("IncomingBytes"),
+				aws.String("IncomingRecords"),
+			},
+		},
+		{
+			ShardLevelMetrics: []*string{
+				aws.String("IncomingRecords"),
+				aws.String("OutgoingBytes"),
+			},
+		},
+	}
+	result := FlattenShardLevelMetrics(expanded)
+	if len(result) != 3 {
+		t.Fatalf("expected result had %d elements, but got %d", 3, len(result))
+	}
+	if result[2] != "OutgoingBytes" {
+		t.Fatalf("expected element 2 to be OutgoingBytes, but was %s", result[2])
+	}
+}
+
+func TestShardLevelMetricsToSet(t *testing.T) {
+	all := []string{
+		"IncomingBytes",
+		"IncomingRecords",
+		"OutgoingBytes",
+		"OutgoingRecords",
+		"WriteProvisionedThroughputExceeded",
+		"ReadProvisionedThroughputExceeded",
+		"IteratorAgeMilliseconds",
+	}
+
+	cases := []struct {
+		Name       string
+		Configured []string
+		Metrics    []string
+		Expected   []string
+	}{
+		{
+			Name:       "not all configured",
+			Configured: []string{"IncomingBytes"},
+			Metrics:    []string{"IncomingBytes"},
+			Expected:   []string{"IncomingBytes"},
+		},
+		{
+			Name:       "individual metrics configured",
+			Configured: all,
+			Metrics:    all,
+			Expected:   all,
+		},
+		{
+			Name:       "all configured and enabled",
+			Configured: []string{"ALL"},
+			Metrics:    all,
+			Expected:   []string{"ALL"},
+		},
+		{
+			Name:       "all configured and reported",
+			Configured: []string{"ALL"},
+			Metrics:    []string{"ALL"},
+			Expected:   []string{"ALL"},
+		},
+		{
+			Name:       "all configured and partially enabled",
+			Configured: []string{"ALL"},
+			Metrics:    []string{"IncomingBytes"},
+			Expected:   []string{"IncomingBytes"},
+		},
+	}
+
+	for _, tc := range cases {
+		result := shardLevelMetricsToSet(tc.Configured, tc.Metrics)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tc.Name, tc.Expected, result)
+		}
+	}
+}
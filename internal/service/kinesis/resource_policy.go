@@ -0,0 +1,148 @@
+package kinesis
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourcePolicyPut,
+		Read:   resourceResourcePolicyRead,
+		Update: resourceResourcePolicyPut,
+		Delete: resourceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceResourcePolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KinesisConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	arn := d.Get("resource_arn").(string)
+	input := &kinesis.PutResourcePolicyInput{
+		Policy:      aws.String(policy),
+		ResourceARN: aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Putting Kinesis Resource Policy: %s", input)
+	_, err = conn.PutResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Kinesis Resource Policy (%s): %w", arn, err)
+	}
+
+	d.SetId(arn)
+
+	return resourceResourcePolicyRead(d, meta)
+}
+
+func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KinesisConn
+
+	policy, err := FindResourcePolicyByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policy)
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("resource_arn", d.Id())
+
+	return nil
+}
+
+func resourceResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KinesisConn
+
+	log.Printf("[DEBUG] Deleting Kinesis Resource Policy: %s", d.Id())
+	_, err := conn.DeleteResourcePolicy(&kinesis.DeleteResourcePolicyInput{
+		ResourceARN: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kinesis.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindResourcePolicyByARN(conn *kinesis.Kinesis, arn string) (string, error) {
+	input := &kinesis.GetResourcePolicyInput{
+		ResourceARN: aws.String(arn),
+	}
+
+	output, err := conn.GetResourcePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, kinesis.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	// GetResourcePolicy returns an empty policy document when no policy is attached.
+	if output == nil || aws.StringValue(output.Policy) == "" || aws.StringValue(output.Policy) == "{}" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Policy), nil
+}
@@ -113,7 +113,10 @@ func ResourceStream() *schema.Resource {
 			"shard_level_metrics": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(kinesis.MetricsName_Values(), false),
+				},
 			},
 			"stream_mode_details": {
 				Type:     schema.TypeList,
@@ -280,11 +283,9 @@ func resourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("shard_count", nil)
 	}
 
-	var shardLevelMetrics []*string
-	for _, v := range stream.EnhancedMonitoring {
-		shardLevelMetrics = append(shardLevelMetrics, v.ShardLevelMetrics...)
-	}
-	d.Set("shard_level_metrics", aws.StringValueSlice(shardLevelMetrics))
+	shardLevelMetrics := FlattenShardLevelMetrics(stream.EnhancedMonitoring)
+	configuredShardLevelMetrics := aws.StringValueSlice(flex.ExpandStringSet(d.Get("shard_level_metrics").(*schema.Set)))
+	d.Set("shard_level_metrics", shardLevelMetricsToSet(configuredShardLevelMetrics, shardLevelMetrics))
 
 	if details := stream.StreamModeDetails; details != nil {
 		if err := d.Set("stream_mode_details", []interface{}{flattenStreamModeDetails(details)}); err != nil {
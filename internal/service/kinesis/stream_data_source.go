@@ -125,11 +125,7 @@ func dataSourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("retention_period", stream.RetentionPeriodHours)
 
-	var shardLevelMetrics []*string
-	for _, v := range stream.EnhancedMonitoring {
-		shardLevelMetrics = append(shardLevelMetrics, v.ShardLevelMetrics...)
-	}
-	d.Set("shard_level_metrics", aws.StringValueSlice(shardLevelMetrics))
+	d.Set("shard_level_metrics", FlattenShardLevelMetrics(stream.EnhancedMonitoring))
 
 	d.Set("status", stream.StreamStatus)
 
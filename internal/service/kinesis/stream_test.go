@@ -317,6 +317,14 @@ func TestAccKinesisStream_shardLevelMetrics(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(resourceName, "shard_level_metrics.*", "IteratorAgeMilliseconds"),
 				),
 			},
+			{
+				Config: testAccKinesisStreamConfigShardLevelMetricAll(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "shard_level_metrics.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "shard_level_metrics.*", "ALL"),
+				),
+			},
 			{
 				Config: testAccKinesisStreamConfigSingleShardLevelMetric(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -758,6 +766,19 @@ resource "aws_kinesis_stream" "test" {
 `, rName)
 }
 
+func testAccKinesisStreamConfigShardLevelMetricAll(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 2
+
+  shard_level_metrics = [
+    "ALL",
+  ]
+}
+`, rName)
+}
+
 func testAccKinesisStreamConfigSingleShardLevelMetric(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kinesis_stream" "test" {
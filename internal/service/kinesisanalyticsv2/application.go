@@ -83,7 +83,7 @@ func ResourceApplication() *schema.Resource {
 															"bucket_arn": {
 																Type:         schema.TypeString,
 																Required:     true,
-																ValidateFunc: verify.ValidARN,
+																ValidateFunc: verify.ValidARNForService("s3", ""),
 															},
 
 															"file_key": {
@@ -766,7 +766,7 @@ func ResourceApplication() *schema.Resource {
 															"bucket_arn": {
 																Type:         schema.TypeString,
 																Required:     true,
-																ValidateFunc: verify.ValidARN,
+																ValidateFunc: verify.ValidARNForService("s3", ""),
 															},
 
 															"file_key": {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -81,6 +82,9 @@ func ResourceCodeSigningConfig() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		// Allowed publishers are AWS Signer signing profiles.
+		CustomizeDiff: verify.UnsupportedInPartitions("allowed_publishers", verify.PartitionsWithoutService(signer.EndpointsID)...),
 	}
 }
 
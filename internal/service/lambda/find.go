@@ -1,10 +1,16 @@
 package lambda
 
 import (
+	"context"
+	"net/http"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindEventSourceMappingConfigurationByID returns the event source mapping corresponding to the specified ID.
@@ -43,3 +49,67 @@ func FindEventSourceMappingConfiguration(conn *lambda.Lambda, input *lambda.GetE
 
 	return output, nil
 }
+
+// FindFunctionURLByNameAndQualifier returns the function URL configuration corresponding to the specified function name and qualifier.
+// Returns NotFoundError if no function URL configuration is found.
+func FindFunctionURLByNameAndQualifier(ctx context.Context, conn *lambda.Lambda, name, qualifier string) (*lambda.GetFunctionUrlConfigOutput, error) {
+	input := &lambda.GetFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetFunctionUrlConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// FindFunctionCodeS3ObjectETag returns the ETag of the S3 object containing a function's deployment package.
+// The object's metadata is retrieved without downloading its content.
+// Returns NotFoundError if no S3 object is found.
+func FindFunctionCodeS3ObjectETag(conn *s3.S3, bucket, key, version string) (string, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if version != "" {
+		input.VersionId = aws.String(version)
+	}
+
+	output, err := conn.HeadObject(input)
+
+	if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.ETag == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return strings.Trim(aws.StringValue(output.ETag), `"`), nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -79,6 +80,10 @@ func ResourceFunction() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"filename", "image_uri"},
 			},
+			"s3_object_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -286,10 +291,34 @@ func ResourceFunction() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"source_code_hash_from_s3_etag": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "image_uri", "source_code_hash"},
+				RequiredWith:  []string{"s3_bucket", "s3_key"},
+			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"snap_start": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_on": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lambda.SnapStartApplyOn_Values(), false),
+						},
+						"optimization_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"environment": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -325,7 +354,7 @@ func ResourceFunction() *schema.Resource {
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", ""),
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -333,7 +362,11 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateS3ObjectETag,
 			updateComputedAttributesOnPublish,
+			// Code signing verifies signatures made with AWS Signer signing profiles.
+			verify.UnsupportedInPartitions("code_signing_config_arn", verify.PartitionsWithoutService(signer.EndpointsID)...),
+			verify.ARNsInPartition("code_signing_config_arn", "kms_key_arn", "role"),
 			verify.SetTagsDiff,
 		),
 	}
@@ -350,6 +383,37 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateS3ObjectETag plans a code update when the ETag of the S3 object containing the
+// deployment package differs from the one recorded at the last deployment.
+func updateS3ObjectETag(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("source_code_hash_from_s3_etag").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown("s3_bucket") || !d.NewValueKnown("s3_key") || !d.NewValueKnown("s3_object_version") {
+		return d.SetNewComputed("s3_object_etag")
+	}
+
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	etag, err := FindFunctionCodeS3ObjectETag(conn, d.Get("s3_bucket").(string), d.Get("s3_key").(string), d.Get("s3_object_version").(string))
+
+	// The object may be uploaded in the same apply.
+	if tfresource.NotFound(err) {
+		return d.SetNewComputed("s3_object_etag")
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Function deployment package S3 object ETag: %w", err)
+	}
+
+	if etag != d.Get("s3_object_etag").(string) {
+		return d.SetNew("s3_object_etag", etag)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := hasConfigChanges(d)
 	functionCodeUpdated := needsFunctionCodeUpdate(d)
@@ -381,7 +445,8 @@ func hasConfigChanges(d verify.ResourceDiffer) bool {
 		d.HasChange("vpc_config.0.security_group_ids") ||
 		d.HasChange("vpc_config.0.subnet_ids") ||
 		d.HasChange("runtime") ||
-		d.HasChange("environment")
+		d.HasChange("environment") ||
+		d.HasChange("snap_start")
 }
 
 // resourceAwsLambdaFunction maps to:
@@ -526,12 +591,18 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		params.KMSKeyArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.SnapStart = expandSnapStart(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if len(tags) > 0 {
 		params.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	var output *lambda.FunctionConfiguration
 	err := resource.Retry(lambdaFunctionCreateTimeout, func() *resource.RetryError { // nosem: helper-schema-resource-Retry-without-TimeoutError-check
-		_, err := conn.CreateFunction(params)
+		var err error
+		output, err = conn.CreateFunction(params)
 
 		if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "The role defined for the function cannot be assumed by Lambda") {
 			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
@@ -561,7 +632,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateFunction(params)
+		output, err = conn.CreateFunction(params)
 	}
 
 	if err != nil {
@@ -570,7 +641,8 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		err := resource.Retry(lambdaFunctionExtraThrottlingTimeout, func() *resource.RetryError {
-			_, err := conn.CreateFunction(params)
+			var err error
+			output, err = conn.CreateFunction(params)
 
 			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "throttled by EC2") {
 				log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
@@ -585,7 +657,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.CreateFunction(params)
+			output, err = conn.CreateFunction(params)
 		}
 
 		if err != nil {
@@ -595,10 +667,20 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("function_name").(string))
 
+	if err := setS3ObjectETag(d, meta); err != nil {
+		return err
+	}
+
 	if err := waitForFunctionCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for Lambda Function (%s) creation: %w", d.Id(), err)
 	}
 
+	if version := aws.StringValue(output.Version); d.Get("publish").(bool) && snapStartEnabled(d) && version != "" {
+		if _, err := waitFunctionVersionSnapStartOptimized(conn, d.Id(), version, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lambda Function (%s) version (%s) SnapStart optimization: %w", d.Id(), version, err)
+		}
+	}
+
 	if reservedConcurrentExecutions >= 0 {
 
 		log.Printf("[DEBUG] Setting Concurrency to %d for the Lambda Function %s", reservedConcurrentExecutions, functionName)
@@ -741,6 +823,10 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting code size for Lambda Function: %w", err)
 	}
 
+	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
+		return fmt.Errorf("error setting snap_start for Lambda Function (%s): %w", d.Id(), err)
+	}
+
 	// Add Signing Profile Version ARN
 	if err := d.Set("signing_profile_version_arn", function.SigningProfileVersionArn); err != nil {
 		return fmt.Errorf("error setting signing profile version arn for Lambda Function: %w", err)
@@ -928,6 +1014,7 @@ func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
 func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
+		d.HasChange("s3_object_etag") ||
 		d.HasChange("s3_bucket") ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
@@ -1049,6 +1136,15 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("runtime") {
 		configReq.Runtime = aws.String(d.Get("runtime").(string))
 	}
+	if d.HasChange("snap_start") {
+		if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			configReq.SnapStart = expandSnapStart(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			configReq.SnapStart = &lambda.SnapStart{
+				ApplyOn: aws.String(lambda.SnapStartApplyOnNone),
+			}
+		}
+	}
 	if d.HasChange("environment") {
 		if v, ok := d.GetOk("environment"); ok {
 			environments := v.([]interface{})
@@ -1188,6 +1284,10 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error modifying Lambda Function (%s) Code: %w", d.Id(), err)
 		}
 
+		if err := setS3ObjectETag(d, meta); err != nil {
+			return err
+		}
+
 		if err := waitForFunctionUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting for Lambda Function (%s) code update: %w", d.Id(), err)
 		}
@@ -1260,6 +1360,12 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("while waiting for function (%s) update: %w", d.Id(), err)
 		}
+
+		if snapStartEnabled(d) {
+			if _, err := waitFunctionVersionSnapStartOptimized(conn, d.Id(), aws.StringValue(output.Version), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Lambda Function (%s) version (%s) SnapStart optimization: %w", d.Id(), aws.StringValue(output.Version), err)
+			}
+		}
 	}
 
 	return resourceFunctionRead(d, meta)
@@ -1377,6 +1483,57 @@ func waitForFunctionUpdate(conn *lambda.Lambda, functionName string, timeout tim
 	return err
 }
 
+// setS3ObjectETag records the ETag of the deployed S3 object when it was not known at plan time.
+func setS3ObjectETag(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("source_code_hash_from_s3_etag").(bool) || d.Get("s3_object_etag").(string) != "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	etag, err := FindFunctionCodeS3ObjectETag(conn, d.Get("s3_bucket").(string), d.Get("s3_key").(string), d.Get("s3_object_version").(string))
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Function (%s) deployment package S3 object ETag: %w", d.Id(), err)
+	}
+
+	d.Set("s3_object_etag", etag)
+
+	return nil
+}
+
+func snapStartEnabled(d *schema.ResourceData) bool {
+	return d.Get("snap_start.0.apply_on").(string) == lambda.SnapStartApplyOnPublishedVersions
+}
+
+func expandSnapStart(tfMap map[string]interface{}) *lambda.SnapStart {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.SnapStart{}
+
+	if v, ok := tfMap["apply_on"].(string); ok && v != "" {
+		apiObject.ApplyOn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSnapStart(apiObject *lambda.SnapStartResponse) []interface{} {
+	// SnapStart is reported as applying to no versions when it is not configured.
+	if apiObject == nil || aws.StringValue(apiObject.ApplyOn) == lambda.SnapStartApplyOnNone {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"apply_on":            aws.StringValue(apiObject.ApplyOn),
+		"optimization_status": aws.StringValue(apiObject.OptimizationStatus),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenEnvironment(apiObject *lambda.EnvironmentResponse) []interface{} {
 	if apiObject == nil {
 		return nil
@@ -1245,6 +1245,49 @@ func TestAccLambdaFunction_tracing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_snapStart(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_snap_start_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_snap_start_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_snap_start_%s", rString)
+	sgName := fmt.Sprintf("tf_acc_sg_lambda_func_snap_start_%s", rString)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWithSnapStartConfig(funcName, policyName, roleName, sgName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckFunctionName(&conf, funcName),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.apply_on", lambda.SnapStartApplyOnPublishedVersions),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+			{
+				Config: testAccWithSnapStartDisabledConfig(funcName, policyName, roleName, sgName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckFunctionName(&conf, funcName),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 // This test is to verify the existing behavior in the Lambda API where the KMS Key ARN
 // is not returned if environment variables are not in use. If the API begins saving this
 // value and the kms_key_arn check begins failing, the documentation should be updated.
@@ -1744,6 +1787,54 @@ func TestAccLambdaFunction_s3(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceCodeHashFromS3ETag(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	rString := sdkacctest.RandString(8)
+	bucketName := fmt.Sprintf("tf-acc-bucket-lambda-func-s3-etag-%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_s3_etag_%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_s3_etag_%s", rString)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3ETagConfig(bucketName, roleName, funcName, "test-fixtures/lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_object_etag", "aws_s3_object.lambda_code", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "source_code_hash_from_s3_etag", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key", "s3_object_etag", "source_code_hash_from_s3_etag", "publish"},
+			},
+			// The object is replaced after the function has been planned, so the new ETag is only detected by the next plan.
+			{
+				Config: testAccS3ETagConfig(bucketName, roleName, funcName, "test-fixtures/lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccS3ETagConfig(bucketName, roleName, funcName, "test-fixtures/lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_object_etag", "aws_s3_object.lambda_code", "etag"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -2998,6 +3089,36 @@ resource "aws_lambda_function" "test" {
 `, funcName)
 }
 
+func testAccWithSnapStartConfig(funcName, policyName, roleName, sgName string) string {
+	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%s"
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "example.Handler::handleRequest"
+  runtime       = "java11"
+  publish       = true
+
+  snap_start {
+    apply_on = "PublishedVersions"
+  }
+}
+`, funcName)
+}
+
+func testAccWithSnapStartDisabledConfig(funcName, policyName, roleName, sgName string) string {
+	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%s"
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "example.Handler::handleRequest"
+  runtime       = "java11"
+  publish       = true
+}
+`, funcName)
+}
+
 func testAccWithTracingUpdatedConfig(funcName, policyName, roleName, sgName string) string {
 	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
 resource "aws_lambda_function" "test" {
@@ -3306,6 +3427,51 @@ resource "aws_lambda_function" "test" {
 `, bucketName, roleName, funcName)
 }
 
+func testAccS3ETagConfig(bucketName, roleName, funcName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "lambda_bucket" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "lambda_code" {
+  bucket = aws_s3_bucket.lambda_bucket.id
+  key    = "lambdatest.zip"
+  source = %[4]q
+  etag   = filemd5(%[4]q)
+}
+
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[2]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  s3_bucket                     = aws_s3_bucket.lambda_bucket.id
+  s3_key                        = aws_s3_object.lambda_code.key
+  source_code_hash_from_s3_etag = true
+  function_name                 = %[3]q
+  role                          = aws_iam_role.iam_for_lambda.arn
+  handler                       = "exports.example"
+  runtime                       = "nodejs12.x"
+}
+`, bucketName, roleName, funcName, source)
+}
+
 func testAccTagsConfig(funcName, policyName, roleName, sgName string) string {
 	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
 resource "aws_lambda_function" "test" {
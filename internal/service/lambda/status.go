@@ -1,8 +1,11 @@
 package lambda
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -32,3 +35,33 @@ func statusEventSourceMappingState(conn *lambda.Lambda, id string) resource.Stat
 		return eventSourceMappingConfiguration, aws.StringValue(eventSourceMappingConfiguration.State), nil
 	}
 }
+
+func statusFunctionVersionSnapStartOptimization(conn *lambda.Lambda, functionName, version string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &lambda.GetFunctionConfigurationInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(version),
+		}
+
+		output, err := conn.GetFunctionConfiguration(input)
+
+		if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.SnapStart == nil {
+			return nil, "", nil
+		}
+
+		// Optimization failures are reported in the version's state.
+		if state := aws.StringValue(output.State); state == lambda.StateFailed {
+			return output, state, fmt.Errorf("%s: %s", aws.StringValue(output.StateReasonCode), aws.StringValue(output.StateReason))
+		}
+
+		return output, aws.StringValue(output.SnapStart.OptimizationStatus), nil
+	}
+}
@@ -84,3 +84,21 @@ func waitEventSourceMappingUpdate(conn *lambda.Lambda, id string) (*lambda.Event
 
 	return nil, err
 }
+
+func waitFunctionVersionSnapStartOptimized(conn *lambda.Lambda, functionName, version string, timeout time.Duration) (*lambda.FunctionConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lambda.SnapStartOptimizationStatusOff},
+		Target:  []string{lambda.SnapStartOptimizationStatusOn},
+		Refresh: statusFunctionVersionSnapStartOptimization(conn, functionName, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lambda.FunctionConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
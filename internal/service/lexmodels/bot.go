@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -287,14 +286,8 @@ func resourceBotRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading Lex Bot (%s): %w", d.Id(), err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "lex",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("bot:%s", d.Id()),
-	}
-	d.Set("arn", arn.String())
+	arn := meta.(*conns.AWSClient).RegionalARN("lex", fmt.Sprintf("bot:%s", d.Id()))
+	d.Set("arn", arn)
 
 	// Process behavior is not returned from the API but is used for create and update.
 	// Manually write to state file to avoid un-expected diffs.
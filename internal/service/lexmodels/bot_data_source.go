@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
@@ -96,14 +95,8 @@ func dataSourceBotRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading Lex Bot (%s/%s): %w", name, version, err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "lex",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("bot:%s", name),
-	}
-	d.Set("arn", arn.String())
+	arn := meta.(*conns.AWSClient).RegionalARN("lex", fmt.Sprintf("bot:%s", name))
+	d.Set("arn", arn)
 	d.Set("checksum", output.Checksum)
 	d.Set("child_directed", output.ChildDirected)
 	d.Set("created_date", output.CreatedDate.Format(time.RFC3339))
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -75,14 +74,8 @@ func dataSourceIntentRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error getting intent %s: %w", intentName, err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "lex",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("intent:%s", d.Get("name").(string)),
-	}
-	d.Set("arn", arn.String())
+	arn := meta.(*conns.AWSClient).RegionalARN("lex", fmt.Sprintf("intent:%s", d.Get("name").(string)))
+	d.Set("arn", arn)
 
 	d.Set("checksum", resp.Checksum)
 	d.Set("created_date", resp.CreatedDate.Format(time.RFC3339))
@@ -113,7 +113,7 @@ func ResourceSnapshot() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", ""),
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -0,0 +1,199 @@
+package networkmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCoreNetworkPolicyAttachmentCreate,
+		Read:   resourceCoreNetworkPolicyAttachmentRead,
+		Update: resourceCoreNetworkPolicyAttachmentUpdate,
+		Delete: resourceCoreNetworkPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"core_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 50),
+					validation.StringMatch(regexp.MustCompile(`^core-network-([0-9a-f]{8,17})$`), "must be a valid Core Network ID"),
+				),
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.All(validation.StringIsJSON, validation.StringLenBetween(0, 10000000)),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCoreNetworkPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	coreNetworkID := d.Get("core_network_id").(string)
+
+	if err := putAndExecuteCoreNetworkPolicy(conn, coreNetworkID, d.Get("policy_document").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(coreNetworkID)
+
+	return resourceCoreNetworkPolicyAttachmentRead(d, meta)
+}
+
+func resourceCoreNetworkPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	coreNetwork, err := FindCoreNetworkByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Core Network %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Core Network (%s): %w", d.Id(), err)
+	}
+
+	if state := aws.StringValue(coreNetwork.State); state == networkmanager.CoreNetworkStateDeleting {
+		log.Printf("[WARN] Network Manager Core Network %s is %s, removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("core_network_id", coreNetwork.CoreNetworkId)
+	d.Set("state", coreNetwork.State)
+
+	policy, err := FindCoreNetworkPolicyByAlias(conn, d.Id(), networkmanager.CoreNetworkPolicyAliasLive)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Core Network (%s) LIVE policy not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Core Network (%s) LIVE policy: %w", d.Id(), err)
+	}
+
+	document, err := json.Marshal(policy.PolicyDocument)
+
+	if err != nil {
+		return fmt.Errorf("error marshalling Network Manager Core Network (%s) LIVE policy: %w", d.Id(), err)
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy_document").(string), string(document))
+
+	if err != nil {
+		return fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err)
+	}
+
+	d.Set("policy_document", policyToSet)
+
+	return nil
+}
+
+func resourceCoreNetworkPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChange("policy_document") {
+		if err := putAndExecuteCoreNetworkPolicy(conn, d.Id(), d.Get("policy_document").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCoreNetworkPolicyAttachmentRead(d, meta)
+}
+
+func resourceCoreNetworkPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	// A core network always has a policy. Removing this resource leaves the LIVE policy in place.
+	log.Printf("[WARN] Network Manager Core Network (%s) policy is not removed on destroy", d.Id())
+
+	return nil
+}
+
+// putAndExecuteCoreNetworkPolicy creates a new policy version for the specified core network
+// and executes its change set, making it the LIVE policy.
+func putAndExecuteCoreNetworkPolicy(conn *networkmanager.NetworkManager, coreNetworkID, document string, timeout time.Duration) error {
+	var policyDocument aws.JSONValue
+
+	if err := json.Unmarshal([]byte(document), &policyDocument); err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", document, err)
+	}
+
+	input := &networkmanager.PutCoreNetworkPolicyInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		CoreNetworkId:  aws.String(coreNetworkID),
+		PolicyDocument: policyDocument,
+	}
+
+	log.Printf("[DEBUG] Putting Network Manager Core Network policy: %s", input)
+	output, err := conn.PutCoreNetworkPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Network Manager Core Network (%s) policy: %w", coreNetworkID, err)
+	}
+
+	policyVersionID := aws.Int64Value(output.CoreNetworkPolicy.PolicyVersionId)
+
+	policy, err := waitCoreNetworkPolicyChangeSetGenerated(conn, coreNetworkID, policyVersionID)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Network Manager Core Network (%s) policy version (%d) change set: %w", coreNetworkID, policyVersionID, err)
+	}
+
+	// Nothing to do if the policy version is identical to the LIVE policy.
+	if aws.StringValue(policy.ChangeSetState) == networkmanager.ChangeSetStateExecutionSucceeded {
+		return nil
+	}
+
+	_, err = conn.ExecuteCoreNetworkChangeSet(&networkmanager.ExecuteCoreNetworkChangeSetInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Network Manager Core Network (%s) policy version (%d) change set: %w", coreNetworkID, policyVersionID, err)
+	}
+
+	if _, err := waitCoreNetworkAvailable(conn, coreNetworkID, timeout); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Core Network (%s) update: %w", coreNetworkID, err)
+	}
+
+	return nil
+}
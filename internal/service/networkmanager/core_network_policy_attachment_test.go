@@ -0,0 +1,98 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmanager "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+)
+
+func TestAccNetworkManagerCoreNetworkPolicyAttachment_basic(t *testing.T) {
+	// The provider does not yet manage core networks, so the test runs against an existing one.
+	coreNetworkID := os.Getenv("NETWORKMANAGER_CORE_NETWORK_ID")
+	if coreNetworkID == "" {
+		t.Skip("Environment variable NETWORKMANAGER_CORE_NETWORK_ID is not set")
+	}
+
+	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig(coreNetworkID, "64512-64555"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "core_network_id", coreNetworkID),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_document"),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig(coreNetworkID, "65022-65534"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_document"),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCoreNetworkPolicyAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Core Network ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+		_, err := tfnetworkmanager.FindCoreNetworkPolicyByAlias(conn, rs.Primary.ID, networkmanager.CoreNetworkPolicyAliasLive)
+
+		return err
+	}
+}
+
+func testAccCoreNetworkPolicyAttachmentConfig(coreNetworkID, asnRange string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = [%[2]q]
+
+    edge_locations {
+      location = data.aws_region.current.name
+    }
+  }
+
+  segments {
+    name = "test"
+  }
+}
+
+resource "aws_networkmanager_core_network_policy_attachment" "test" {
+  core_network_id = %[1]q
+  policy_document = data.aws_networkmanager_core_network_policy_document.test.json
+}
+`, coreNetworkID, asnRange)
+}
@@ -0,0 +1,738 @@
+package networkmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	coreNetworkPolicyAssociationMethodConstant = "constant"
+	coreNetworkPolicyAssociationMethodTag      = "tag"
+
+	coreNetworkPolicyConditionTypeAccountID      = "account-id"
+	coreNetworkPolicyConditionTypeAny            = "any"
+	coreNetworkPolicyConditionTypeAttachmentType = "attachment-type"
+	coreNetworkPolicyConditionTypeRegion         = "region"
+	coreNetworkPolicyConditionTypeResourceID     = "resource-id"
+	coreNetworkPolicyConditionTypeTagExists      = "tag-exists"
+	coreNetworkPolicyConditionTypeTagValue       = "tag-value"
+
+	coreNetworkPolicySegmentActionCreateRoute = "create-route"
+	coreNetworkPolicySegmentActionShare       = "share"
+)
+
+var coreNetworkPolicySegmentNameRegexp = regexp.MustCompile(`^[a-zA-Z][A-Za-z0-9]{0,63}$`)
+
+func DataSourceCoreNetworkPolicyDocument() *schema.Resource {
+	setOfString := &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	setOfCIDRBlock := &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: verify.ValidCIDRNetworkAddress,
+		},
+	}
+
+	validSegmentName := validation.StringMatch(coreNetworkPolicySegmentNameRegexp, "must begin with a letter and contain only up to 64 alphanumeric characters")
+
+	return &schema.Resource{
+		Read: dataSourceCoreNetworkPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"attachment_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"association_method": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											coreNetworkPolicyAssociationMethodConstant,
+											coreNetworkPolicyAssociationMethodTag,
+										}, false),
+									},
+									"require_acceptance": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"segment": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validSegmentName,
+									},
+									"tag_value_of_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"condition_logic": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+						},
+						"conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"begins-with",
+											"contains",
+											"equals",
+											"not-equals",
+										}, false),
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											coreNetworkPolicyConditionTypeAccountID,
+											coreNetworkPolicyConditionTypeAny,
+											coreNetworkPolicyConditionTypeAttachmentType,
+											coreNetworkPolicyConditionTypeRegion,
+											coreNetworkPolicyConditionTypeResourceID,
+											coreNetworkPolicyConditionTypeTagExists,
+											coreNetworkPolicyConditionTypeTagValue,
+										}, false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"rule_number": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+					},
+				},
+			},
+			"core_network_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn_ranges": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+-\d+$`), "must be a range of ASNs, e.g. 64512-64555"),
+							},
+						},
+						"edge_locations": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"asn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validCoreNetworkPolicyASN,
+									},
+									"inside_cidr_blocks": setOfCIDRBlock,
+									"location": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
+						"inside_cidr_blocks": setOfCIDRBlock,
+						"vpn_ecmp_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								coreNetworkPolicySegmentActionCreateRoute,
+								coreNetworkPolicySegmentActionShare,
+							}, false),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination_cidr_blocks": setOfCIDRBlock,
+						"destinations":            setOfString,
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"attachment-route"}, false),
+						},
+						"segment": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validSegmentName,
+						},
+						"share_with":        setOfString,
+						"share_with_except": setOfString,
+					},
+				},
+			},
+			"segments": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_filter": setOfString,
+						"deny_filter":  setOfString,
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"edge_locations": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"isolate_attachments": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validSegmentName,
+						},
+						"require_attachment_acceptance": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "2021.12",
+				ValidateFunc: validation.StringInSlice([]string{"2021.12"}, false),
+			},
+		},
+	}
+}
+
+func dataSourceCoreNetworkPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	doc := &coreNetworkPolicyDocument{
+		Version: d.Get("version").(string),
+	}
+
+	if v, ok := d.GetOk("core_network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		coreNetworkConfiguration, err := expandCoreNetworkPolicyCoreNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return err
+		}
+
+		doc.CoreNetworkConfiguration = coreNetworkConfiguration
+	}
+
+	if v, ok := d.GetOk("segments"); ok {
+		doc.Segments = expandCoreNetworkPolicySegments(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("segment_actions"); ok {
+		doc.SegmentActions = expandCoreNetworkPolicySegmentActions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("attachment_policies"); ok {
+		doc.AttachmentPolicies = expandCoreNetworkPolicyAttachmentPolicies(v.([]interface{}))
+	}
+
+	if err := doc.validate(); err != nil {
+		return err
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		// should never happen if the above code is correct
+		return err
+	}
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return nil
+}
+
+type coreNetworkPolicyDocument struct {
+	Version                  string                                     `json:"version"`
+	CoreNetworkConfiguration *coreNetworkPolicyCoreNetworkConfiguration `json:"core-network-configuration"`
+	Segments                 []*coreNetworkPolicySegment                `json:"segments"`
+	SegmentActions           []*coreNetworkPolicySegmentAction          `json:"segment-actions,omitempty"`
+	AttachmentPolicies       []*coreNetworkPolicyAttachmentPolicy       `json:"attachment-policies,omitempty"`
+}
+
+type coreNetworkPolicyCoreNetworkConfiguration struct {
+	AsnRanges        []string                         `json:"asn-ranges"`
+	InsideCidrBlocks []string                         `json:"inside-cidr-blocks,omitempty"`
+	VpnEcmpSupport   bool                             `json:"vpn-ecmp-support"`
+	EdgeLocations    []*coreNetworkPolicyEdgeLocation `json:"edge-locations"`
+}
+
+type coreNetworkPolicyEdgeLocation struct {
+	Location         string   `json:"location"`
+	Asn              int64    `json:"asn,omitempty"`
+	InsideCidrBlocks []string `json:"inside-cidr-blocks,omitempty"`
+}
+
+type coreNetworkPolicySegment struct {
+	Name                        string   `json:"name"`
+	Description                 string   `json:"description,omitempty"`
+	EdgeLocations               []string `json:"edge-locations,omitempty"`
+	IsolateAttachments          bool     `json:"isolate-attachments"`
+	RequireAttachmentAcceptance bool     `json:"require-attachment-acceptance"`
+	DenyFilter                  []string `json:"deny-filter,omitempty"`
+	AllowFilter                 []string `json:"allow-filter,omitempty"`
+}
+
+type coreNetworkPolicySegmentAction struct {
+	Action                string      `json:"action"`
+	Segment               string      `json:"segment"`
+	Mode                  string      `json:"mode,omitempty"`
+	ShareWith             interface{} `json:"share-with,omitempty"`
+	DestinationCidrBlocks []string    `json:"destination-cidr-blocks,omitempty"`
+	Destinations          []string    `json:"destinations,omitempty"`
+	Description           string      `json:"description,omitempty"`
+}
+
+type coreNetworkPolicySegmentActionShareWithExcept struct {
+	Except []string `json:"except"`
+}
+
+type coreNetworkPolicyAttachmentPolicy struct {
+	RuleNumber     int                                           `json:"rule-number"`
+	Description    string                                        `json:"description,omitempty"`
+	ConditionLogic string                                        `json:"condition-logic,omitempty"`
+	Conditions     []*coreNetworkPolicyAttachmentPolicyCondition `json:"conditions"`
+	Action         *coreNetworkPolicyAttachmentPolicyAction      `json:"action"`
+}
+
+type coreNetworkPolicyAttachmentPolicyCondition struct {
+	Type     string `json:"type"`
+	Operator string `json:"operator,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+type coreNetworkPolicyAttachmentPolicyAction struct {
+	AssociationMethod string `json:"association-method"`
+	Segment           string `json:"segment,omitempty"`
+	TagValueOfKey     string `json:"tag-value-of-key,omitempty"`
+	RequireAcceptance bool   `json:"require-acceptance,omitempty"`
+}
+
+// validate checks the cross-references within the policy document that cannot be expressed in the schema.
+func (doc *coreNetworkPolicyDocument) validate() error {
+	edgeLocations := make(map[string]struct{})
+
+	if doc.CoreNetworkConfiguration != nil {
+		for _, edgeLocation := range doc.CoreNetworkConfiguration.EdgeLocations {
+			if _, ok := edgeLocations[edgeLocation.Location]; ok {
+				return fmt.Errorf("duplicate edge location (%s) in core_network_configuration", edgeLocation.Location)
+			}
+
+			edgeLocations[edgeLocation.Location] = struct{}{}
+		}
+	}
+
+	segments := make(map[string]struct{})
+
+	for _, segment := range doc.Segments {
+		if _, ok := segments[segment.Name]; ok {
+			return fmt.Errorf("duplicate segment name (%s)", segment.Name)
+		}
+
+		segments[segment.Name] = struct{}{}
+	}
+
+	for _, segment := range doc.Segments {
+		for _, edgeLocation := range segment.EdgeLocations {
+			if _, ok := edgeLocations[edgeLocation]; !ok {
+				return fmt.Errorf("segment (%s) edge location (%s) is not defined in core_network_configuration", segment.Name, edgeLocation)
+			}
+		}
+
+		if len(segment.AllowFilter) > 0 && len(segment.DenyFilter) > 0 {
+			return fmt.Errorf("segment (%s): only one of allow_filter or deny_filter can be specified", segment.Name)
+		}
+
+		for _, name := range append(segment.AllowFilter, segment.DenyFilter...) {
+			if _, ok := segments[name]; !ok {
+				return fmt.Errorf("segment (%s) filter references undefined segment (%s)", segment.Name, name)
+			}
+		}
+	}
+
+	for i, segmentAction := range doc.SegmentActions {
+		if _, ok := segments[segmentAction.Segment]; !ok {
+			return fmt.Errorf("segment_actions[%d] references undefined segment (%s)", i, segmentAction.Segment)
+		}
+
+		switch segmentAction.Action {
+		case coreNetworkPolicySegmentActionCreateRoute:
+			if len(segmentAction.DestinationCidrBlocks) == 0 || len(segmentAction.Destinations) == 0 {
+				return fmt.Errorf("segment_actions[%d]: destination_cidr_blocks and destinations are required for action %q", i, segmentAction.Action)
+			}
+
+			if segmentAction.Mode != "" || segmentAction.ShareWith != nil {
+				return fmt.Errorf("segment_actions[%d]: mode, share_with and share_with_except cannot be specified for action %q", i, segmentAction.Action)
+			}
+
+		case coreNetworkPolicySegmentActionShare:
+			if segmentAction.Mode == "" {
+				return fmt.Errorf("segment_actions[%d]: mode is required for action %q", i, segmentAction.Action)
+			}
+
+			if len(segmentAction.DestinationCidrBlocks) > 0 || len(segmentAction.Destinations) > 0 {
+				return fmt.Errorf("segment_actions[%d]: destination_cidr_blocks and destinations cannot be specified for action %q", i, segmentAction.Action)
+			}
+
+			var names []string
+
+			switch v := segmentAction.ShareWith.(type) {
+			case nil:
+				return fmt.Errorf("segment_actions[%d]: one of share_with or share_with_except is required for action %q", i, segmentAction.Action)
+			case string:
+				// "*"
+			case []string:
+				names = v
+			case *coreNetworkPolicySegmentActionShareWithExcept:
+				names = v.Except
+			}
+
+			for _, name := range names {
+				if _, ok := segments[name]; !ok {
+					return fmt.Errorf("segment_actions[%d] shares with undefined segment (%s)", i, name)
+				}
+			}
+		}
+	}
+
+	ruleNumbers := make(map[int]struct{})
+
+	for _, attachmentPolicy := range doc.AttachmentPolicies {
+		ruleNumber := attachmentPolicy.RuleNumber
+
+		if _, ok := ruleNumbers[ruleNumber]; ok {
+			return fmt.Errorf("duplicate attachment policy rule number (%d)", ruleNumber)
+		}
+
+		ruleNumbers[ruleNumber] = struct{}{}
+
+		if len(attachmentPolicy.Conditions) > 1 && attachmentPolicy.ConditionLogic == "" {
+			return fmt.Errorf("attachment policy (%d): condition_logic is required when more than one condition is specified", ruleNumber)
+		}
+
+		for _, condition := range attachmentPolicy.Conditions {
+			if err := condition.validate(); err != nil {
+				return fmt.Errorf("attachment policy (%d): %w", ruleNumber, err)
+			}
+		}
+
+		if action := attachmentPolicy.Action; action != nil {
+			switch action.AssociationMethod {
+			case coreNetworkPolicyAssociationMethodConstant:
+				if action.Segment == "" {
+					return fmt.Errorf("attachment policy (%d): segment is required when association_method is %q", ruleNumber, action.AssociationMethod)
+				}
+
+				if _, ok := segments[action.Segment]; !ok {
+					return fmt.Errorf("attachment policy (%d) references undefined segment (%s)", ruleNumber, action.Segment)
+				}
+
+				if action.TagValueOfKey != "" {
+					return fmt.Errorf("attachment policy (%d): tag_value_of_key cannot be specified when association_method is %q", ruleNumber, action.AssociationMethod)
+				}
+
+			case coreNetworkPolicyAssociationMethodTag:
+				if action.TagValueOfKey == "" {
+					return fmt.Errorf("attachment policy (%d): tag_value_of_key is required when association_method is %q", ruleNumber, action.AssociationMethod)
+				}
+
+				if action.Segment != "" {
+					return fmt.Errorf("attachment policy (%d): segment cannot be specified when association_method is %q", ruleNumber, action.AssociationMethod)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (condition *coreNetworkPolicyAttachmentPolicyCondition) validate() error {
+	switch condition.Type {
+	case coreNetworkPolicyConditionTypeAny:
+		if condition.Key != "" || condition.Operator != "" || condition.Value != "" {
+			return fmt.Errorf("condition type %q does not support key, operator or value", condition.Type)
+		}
+
+	case coreNetworkPolicyConditionTypeTagExists:
+		if condition.Key == "" {
+			return fmt.Errorf("condition type %q requires key", condition.Type)
+		}
+
+		if condition.Operator != "" || condition.Value != "" {
+			return fmt.Errorf("condition type %q does not support operator or value", condition.Type)
+		}
+
+	case coreNetworkPolicyConditionTypeTagValue:
+		if condition.Key == "" || condition.Operator == "" || condition.Value == "" {
+			return fmt.Errorf("condition type %q requires key, operator and value", condition.Type)
+		}
+
+	default:
+		if condition.Operator == "" || condition.Value == "" {
+			return fmt.Errorf("condition type %q requires operator and value", condition.Type)
+		}
+
+		if condition.Key != "" {
+			return fmt.Errorf("condition type %q does not support key", condition.Type)
+		}
+	}
+
+	return nil
+}
+
+func expandCoreNetworkPolicyCoreNetworkConfiguration(tfMap map[string]interface{}) (*coreNetworkPolicyCoreNetworkConfiguration, error) {
+	apiObject := &coreNetworkPolicyCoreNetworkConfiguration{
+		AsnRanges:        expandCoreNetworkPolicyStringSet(tfMap["asn_ranges"]),
+		InsideCidrBlocks: expandCoreNetworkPolicyStringSet(tfMap["inside_cidr_blocks"]),
+		VpnEcmpSupport:   tfMap["vpn_ecmp_support"].(bool),
+	}
+
+	for _, tfMapRaw := range tfMap["edge_locations"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		edgeLocation := &coreNetworkPolicyEdgeLocation{
+			Location:         tfMap["location"].(string),
+			InsideCidrBlocks: expandCoreNetworkPolicyStringSet(tfMap["inside_cidr_blocks"]),
+		}
+
+		if v, ok := tfMap["asn"].(string); ok && v != "" {
+			asn, err := strconv.ParseInt(v, 10, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("edge location (%s) ASN (%s) is invalid: %w", edgeLocation.Location, v, err)
+			}
+
+			edgeLocation.Asn = asn
+		}
+
+		apiObject.EdgeLocations = append(apiObject.EdgeLocations, edgeLocation)
+	}
+
+	return apiObject, nil
+}
+
+func expandCoreNetworkPolicySegments(tfList []interface{}) []*coreNetworkPolicySegment {
+	var apiObjects []*coreNetworkPolicySegment
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &coreNetworkPolicySegment{
+			Name:                        tfMap["name"].(string),
+			Description:                 tfMap["description"].(string),
+			EdgeLocations:               expandCoreNetworkPolicyStringSet(tfMap["edge_locations"]),
+			IsolateAttachments:          tfMap["isolate_attachments"].(bool),
+			RequireAttachmentAcceptance: tfMap["require_attachment_acceptance"].(bool),
+			DenyFilter:                  expandCoreNetworkPolicyStringSet(tfMap["deny_filter"]),
+			AllowFilter:                 expandCoreNetworkPolicyStringSet(tfMap["allow_filter"]),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandCoreNetworkPolicySegmentActions(tfList []interface{}) []*coreNetworkPolicySegmentAction {
+	var apiObjects []*coreNetworkPolicySegmentAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &coreNetworkPolicySegmentAction{
+			Action:                tfMap["action"].(string),
+			Segment:               tfMap["segment"].(string),
+			Mode:                  tfMap["mode"].(string),
+			DestinationCidrBlocks: expandCoreNetworkPolicyStringSet(tfMap["destination_cidr_blocks"]),
+			Destinations:          expandCoreNetworkPolicyStringSet(tfMap["destinations"]),
+			Description:           tfMap["description"].(string),
+		}
+
+		if v := expandCoreNetworkPolicyStringSet(tfMap["share_with"]); len(v) > 0 {
+			if len(v) == 1 && v[0] == "*" {
+				apiObject.ShareWith = v[0]
+			} else {
+				apiObject.ShareWith = v
+			}
+		} else if v := expandCoreNetworkPolicyStringSet(tfMap["share_with_except"]); len(v) > 0 {
+			apiObject.ShareWith = &coreNetworkPolicySegmentActionShareWithExcept{
+				Except: v,
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCoreNetworkPolicyAttachmentPolicies(tfList []interface{}) []*coreNetworkPolicyAttachmentPolicy {
+	var apiObjects []*coreNetworkPolicyAttachmentPolicy
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &coreNetworkPolicyAttachmentPolicy{
+			RuleNumber:     tfMap["rule_number"].(int),
+			Description:    tfMap["description"].(string),
+			ConditionLogic: tfMap["condition_logic"].(string),
+		}
+
+		for _, tfMapRaw := range tfMap["conditions"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Conditions = append(apiObject.Conditions, &coreNetworkPolicyAttachmentPolicyCondition{
+				Type:     tfMap["type"].(string),
+				Operator: tfMap["operator"].(string),
+				Key:      tfMap["key"].(string),
+				Value:    tfMap["value"].(string),
+			})
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Action = &coreNetworkPolicyAttachmentPolicyAction{
+				AssociationMethod: tfMap["association_method"].(string),
+				Segment:           tfMap["segment"].(string),
+				TagValueOfKey:     tfMap["tag_value_of_key"].(string),
+				RequireAcceptance: tfMap["require_acceptance"].(bool),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandCoreNetworkPolicyStringSet returns the sorted elements of a string set so that the generated JSON is stable.
+func expandCoreNetworkPolicyStringSet(v interface{}) []string {
+	set, ok := v.(*schema.Set)
+
+	if !ok || set.Len() == 0 {
+		return nil
+	}
+
+	var values []string
+
+	for _, v := range set.List() {
+		if v, ok := v.(string); ok && v != "" {
+			values = append(values, v)
+		}
+	}
+
+	sort.Strings(values)
+
+	return values
+}
+
+func validCoreNetworkPolicyASN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	asn, err := strconv.ParseInt(value, 10, 64)
+
+	if err != nil || asn < 1 || asn > 4294967295 {
+		errors = append(errors, fmt.Errorf("%q (%q) must be a 2-byte or 4-byte ASN", k, value))
+	}
+
+	return
+}
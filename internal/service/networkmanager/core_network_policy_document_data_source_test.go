@@ -0,0 +1,319 @@
+package networkmanager_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_basic(t *testing.T) {
+	// This really ought to be able to be a unit test rather than an
+	// acceptance test, but just instantiating the AWS provider requires
+	// some AWS API calls, and so this needs valid AWS credentials to work.
+	dataSourceName := "data.aws_networkmanager_core_network_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccCoreNetworkPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_validation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_duplicateSegment,
+				ExpectError: regexp.MustCompile(`duplicate segment name \(shared\)`),
+			},
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_undefinedEdgeLocation,
+				ExpectError: regexp.MustCompile(`edge location \(eu-west-1\) is not defined in core_network_configuration`),
+			},
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_undefinedSegment,
+				ExpectError: regexp.MustCompile(`attachment policy \(100\) references undefined segment \(missing\)`),
+			},
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_tagValueCondition,
+				ExpectError: regexp.MustCompile(`condition type "tag-value" requires key, operator and value`),
+			},
+		},
+	})
+}
+
+const testAccCoreNetworkPolicyDocumentDataSourceConfig_basic = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    vpn_ecmp_support   = false
+    asn_ranges         = ["64512-64555"]
+    inside_cidr_blocks = ["172.16.0.0/16"]
+
+    edge_locations {
+      location           = "us-east-1"
+      asn                = "64512"
+      inside_cidr_blocks = ["172.16.0.0/18"]
+    }
+
+    edge_locations {
+      location = "us-west-2"
+    }
+  }
+
+  segments {
+    name                          = "shared"
+    description                   = "SegmentForSharedServices"
+    require_attachment_acceptance = true
+  }
+
+  segments {
+    name                = "isolated"
+    edge_locations      = ["us-east-1"]
+    isolate_attachments = true
+    deny_filter         = ["shared"]
+  }
+
+  segment_actions {
+    action     = "share"
+    mode       = "attachment-route"
+    segment    = "shared"
+    share_with = ["*"]
+  }
+
+  segment_actions {
+    action                  = "create-route"
+    segment                 = "isolated"
+    destination_cidr_blocks = ["0.0.0.0/0"]
+    destinations            = ["attachment-12355678901234567"]
+  }
+
+  attachment_policies {
+    rule_number     = 100
+    condition_logic = "or"
+
+    conditions {
+      type     = "tag-value"
+      operator = "equals"
+      key      = "segment"
+      value    = "shared"
+    }
+
+    conditions {
+      type = "tag-exists"
+      key  = "shared"
+    }
+
+    action {
+      association_method = "constant"
+      segment            = "shared"
+    }
+  }
+
+  attachment_policies {
+    rule_number = 200
+
+    conditions {
+      type = "any"
+    }
+
+    action {
+      association_method = "tag"
+      tag_value_of_key   = "segment"
+      require_acceptance = true
+    }
+  }
+}
+`
+
+const testAccCoreNetworkPolicyDocumentDataSourceExpectedJSON_basic = `{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "asn-ranges": ["64512-64555"],
+    "inside-cidr-blocks": ["172.16.0.0/16"],
+    "vpn-ecmp-support": false,
+    "edge-locations": [
+      {
+        "location": "us-east-1",
+        "asn": 64512,
+        "inside-cidr-blocks": ["172.16.0.0/18"]
+      },
+      {
+        "location": "us-west-2"
+      }
+    ]
+  },
+  "segments": [
+    {
+      "name": "shared",
+      "description": "SegmentForSharedServices",
+      "isolate-attachments": false,
+      "require-attachment-acceptance": true
+    },
+    {
+      "name": "isolated",
+      "edge-locations": ["us-east-1"],
+      "isolate-attachments": true,
+      "require-attachment-acceptance": false,
+      "deny-filter": ["shared"]
+    }
+  ],
+  "segment-actions": [
+    {
+      "action": "share",
+      "segment": "shared",
+      "mode": "attachment-route",
+      "share-with": "*"
+    },
+    {
+      "action": "create-route",
+      "segment": "isolated",
+      "destination-cidr-blocks": ["0.0.0.0/0"],
+      "destinations": ["attachment-12355678901234567"]
+    }
+  ],
+  "attachment-policies": [
+    {
+      "rule-number": 100,
+      "condition-logic": "or",
+      "conditions": [
+        {
+          "type": "tag-value",
+          "operator": "equals",
+          "key": "segment",
+          "value": "shared"
+        },
+        {
+          "type": "tag-exists",
+          "key": "shared"
+        }
+      ],
+      "action": {
+        "association-method": "constant",
+        "segment": "shared"
+      }
+    },
+    {
+      "rule-number": 200,
+      "conditions": [
+        {
+          "type": "any"
+        }
+      ],
+      "action": {
+        "association-method": "tag",
+        "tag-value-of-key": "segment",
+        "require-acceptance": true
+      }
+    }
+  ]
+}`
+
+const testAccCoreNetworkPolicyDocumentDataSourceConfig_duplicateSegment = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-64555"]
+
+    edge_locations {
+      location = "us-east-1"
+    }
+  }
+
+  segments {
+    name = "shared"
+  }
+
+  segments {
+    name = "shared"
+  }
+}
+`
+
+const testAccCoreNetworkPolicyDocumentDataSourceConfig_undefinedEdgeLocation = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-64555"]
+
+    edge_locations {
+      location = "us-east-1"
+    }
+  }
+
+  segments {
+    name           = "shared"
+    edge_locations = ["eu-west-1"]
+  }
+}
+`
+
+const testAccCoreNetworkPolicyDocumentDataSourceConfig_undefinedSegment = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-64555"]
+
+    edge_locations {
+      location = "us-east-1"
+    }
+  }
+
+  segments {
+    name = "shared"
+  }
+
+  attachment_policies {
+    rule_number = 100
+
+    conditions {
+      type = "any"
+    }
+
+    action {
+      association_method = "constant"
+      segment            = "missing"
+    }
+  }
+}
+`
+
+const testAccCoreNetworkPolicyDocumentDataSourceConfig_tagValueCondition = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-64555"]
+
+    edge_locations {
+      location = "us-east-1"
+    }
+  }
+
+  segments {
+    name = "shared"
+  }
+
+  attachment_policies {
+    rule_number = 100
+
+    conditions {
+      type = "tag-value"
+      key  = "segment"
+    }
+
+    action {
+      association_method = "constant"
+      segment            = "shared"
+    }
+  }
+}
+`
@@ -0,0 +1,74 @@
+package networkmanager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCoreNetworkByID(conn *networkmanager.NetworkManager, id string) (*networkmanager.CoreNetwork, error) {
+	input := &networkmanager.GetCoreNetworkInput{
+		CoreNetworkId: aws.String(id),
+	}
+
+	output, err := conn.GetCoreNetwork(input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetwork == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetwork, nil
+}
+
+func findCoreNetworkPolicy(conn *networkmanager.NetworkManager, input *networkmanager.GetCoreNetworkPolicyInput) (*networkmanager.CoreNetworkPolicy, error) {
+	output, err := conn.GetCoreNetworkPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetworkPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetworkPolicy, nil
+}
+
+// FindCoreNetworkPolicyByAlias returns the LIVE or LATEST policy version of the specified core network.
+func FindCoreNetworkPolicyByAlias(conn *networkmanager.NetworkManager, coreNetworkID, alias string) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		Alias:         aws.String(alias),
+		CoreNetworkId: aws.String(coreNetworkID),
+	}
+
+	return findCoreNetworkPolicy(conn, input)
+}
+
+func FindCoreNetworkPolicyByTwoPartKey(conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	}
+
+	return findCoreNetworkPolicy(conn, input)
+}
@@ -0,0 +1,74 @@
+package networkmanager
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	coreNetworkPolicyChangeSetGeneratedTimeout = 5 * time.Minute
+)
+
+func waitCoreNetworkAvailable(conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateCreating, networkmanager.CoreNetworkStateUpdating},
+		Target:  []string{networkmanager.CoreNetworkStateAvailable},
+		Refresh: statusCoreNetworkState(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCoreNetworkPolicyChangeSetGenerated(conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) (*networkmanager.CoreNetworkPolicy, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ChangeSetStatePendingGeneration},
+		Target:  []string{networkmanager.ChangeSetStateReadyToExecute, networkmanager.ChangeSetStateExecutionSucceeded},
+		Refresh: statusCoreNetworkPolicyChangeSetState(conn, coreNetworkID, policyVersionID),
+		Timeout: coreNetworkPolicyChangeSetGeneratedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
+		if state := aws.StringValue(output.ChangeSetState); state == networkmanager.ChangeSetStateFailedGeneration {
+			tfresource.SetLastError(err, coreNetworkPolicyError(output.PolicyErrors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func coreNetworkPolicyError(apiObjects []*networkmanager.CoreNetworkPolicyError) error {
+	var errs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.Message)))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, "\n"))
+}
@@ -0,0 +1,364 @@
+package opensearch
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandAdvancedSecurityOptions(m []interface{}) *opensearchservice.AdvancedSecurityOptionsInput_ {
+	config := opensearchservice.AdvancedSecurityOptionsInput_{}
+	group := m[0].(map[string]interface{})
+
+	if advancedSecurityEnabled, ok := group["enabled"]; ok {
+		config.Enabled = aws.Bool(advancedSecurityEnabled.(bool))
+
+		if advancedSecurityEnabled.(bool) {
+			if v, ok := group["internal_user_database_enabled"].(bool); ok {
+				config.InternalUserDatabaseEnabled = aws.Bool(v)
+			}
+
+			if v, ok := group["master_user_options"].([]interface{}); ok {
+				if len(v) > 0 && v[0] != nil {
+					muo := opensearchservice.MasterUserOptions{}
+					masterUserOptions := v[0].(map[string]interface{})
+
+					if v, ok := masterUserOptions["master_user_arn"].(string); ok && v != "" {
+						muo.MasterUserARN = aws.String(v)
+					}
+
+					if v, ok := masterUserOptions["master_user_name"].(string); ok && v != "" {
+						muo.MasterUserName = aws.String(v)
+					}
+
+					if v, ok := masterUserOptions["master_user_password"].(string); ok && v != "" {
+						muo.MasterUserPassword = aws.String(v)
+					}
+
+					config.SetMasterUserOptions(&muo)
+				}
+			}
+		}
+	}
+
+	return &config
+}
+
+func expandAutoTuneOptions(tfMap map[string]interface{}) *opensearchservice.AutoTuneOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := &opensearchservice.AutoTuneOptions{}
+
+	autoTuneOptionsInput := expandAutoTuneOptionsInput(tfMap)
+
+	options.DesiredState = autoTuneOptionsInput.DesiredState
+	options.MaintenanceSchedules = autoTuneOptionsInput.MaintenanceSchedules
+	options.UseOffPeakWindow = autoTuneOptionsInput.UseOffPeakWindow
+
+	options.RollbackOnDisable = aws.String(tfMap["rollback_on_disable"].(string))
+
+	return options
+}
+
+func expandAutoTuneOptionsInput(tfMap map[string]interface{}) *opensearchservice.AutoTuneOptionsInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := &opensearchservice.AutoTuneOptionsInput_{}
+
+	options.DesiredState = aws.String(tfMap["desired_state"].(string))
+
+	if v, ok := tfMap["maintenance_schedule"].(*schema.Set); ok && v.Len() > 0 {
+		options.MaintenanceSchedules = expandAutoTuneMaintenanceSchedules(v.List())
+	}
+
+	options.UseOffPeakWindow = aws.Bool(tfMap["use_off_peak_window"].(bool))
+
+	return options
+}
+
+func expandAutoTuneMaintenanceSchedules(tfList []interface{}) []*opensearchservice.AutoTuneMaintenanceSchedule {
+	var autoTuneMaintenanceSchedules []*opensearchservice.AutoTuneMaintenanceSchedule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, _ := tfMapRaw.(map[string]interface{})
+
+		autoTuneMaintenanceSchedule := &opensearchservice.AutoTuneMaintenanceSchedule{}
+
+		startAt, _ := time.Parse(time.RFC3339, tfMap["start_at"].(string))
+		autoTuneMaintenanceSchedule.StartAt = aws.Time(startAt)
+
+		if v, ok := tfMap["duration"].([]interface{}); ok {
+			autoTuneMaintenanceSchedule.Duration = expandAutoTuneMaintenanceScheduleDuration(v[0].(map[string]interface{}))
+		}
+
+		autoTuneMaintenanceSchedule.CronExpressionForRecurrence = aws.String(tfMap["cron_expression_for_recurrence"].(string))
+
+		autoTuneMaintenanceSchedules = append(autoTuneMaintenanceSchedules, autoTuneMaintenanceSchedule)
+	}
+
+	return autoTuneMaintenanceSchedules
+}
+
+func expandAutoTuneMaintenanceScheduleDuration(tfMap map[string]interface{}) *opensearchservice.Duration {
+	autoTuneMaintenanceScheduleDuration := &opensearchservice.Duration{
+		Value: aws.Int64(int64(tfMap["value"].(int))),
+		Unit:  aws.String(tfMap["unit"].(string)),
+	}
+
+	return autoTuneMaintenanceScheduleDuration
+}
+
+func flattenAdvancedSecurityOptions(advancedSecurityOptions *opensearchservice.AdvancedSecurityOptions) []map[string]interface{} {
+	if advancedSecurityOptions == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+	m["enabled"] = aws.BoolValue(advancedSecurityOptions.Enabled)
+	if aws.BoolValue(advancedSecurityOptions.Enabled) {
+		m["internal_user_database_enabled"] = aws.BoolValue(advancedSecurityOptions.InternalUserDatabaseEnabled)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenAutoTuneOptions(autoTuneOptions *opensearchservice.AutoTuneOptions) map[string]interface{} {
+	if autoTuneOptions == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	m["desired_state"] = aws.StringValue(autoTuneOptions.DesiredState)
+
+	if v := autoTuneOptions.MaintenanceSchedules; v != nil {
+		m["maintenance_schedule"] = flattenAutoTuneMaintenanceSchedules(v)
+	}
+
+	m["rollback_on_disable"] = aws.StringValue(autoTuneOptions.RollbackOnDisable)
+	m["use_off_peak_window"] = aws.BoolValue(autoTuneOptions.UseOffPeakWindow)
+
+	return m
+}
+
+func flattenAutoTuneMaintenanceSchedules(autoTuneMaintenanceSchedules []*opensearchservice.AutoTuneMaintenanceSchedule) []interface{} {
+	if len(autoTuneMaintenanceSchedules) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, autoTuneMaintenanceSchedule := range autoTuneMaintenanceSchedules {
+		m := map[string]interface{}{}
+
+		m["start_at"] = aws.TimeValue(autoTuneMaintenanceSchedule.StartAt).Format(time.RFC3339)
+
+		m["duration"] = []interface{}{flattenAutoTuneMaintenanceScheduleDuration(autoTuneMaintenanceSchedule.Duration)}
+
+		m["cron_expression_for_recurrence"] = aws.StringValue(autoTuneMaintenanceSchedule.CronExpressionForRecurrence)
+
+		tfList = append(tfList, m)
+	}
+
+	return tfList
+}
+
+func flattenAutoTuneMaintenanceScheduleDuration(autoTuneMaintenanceScheduleDuration *opensearchservice.Duration) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	m["value"] = aws.Int64Value(autoTuneMaintenanceScheduleDuration.Value)
+	m["unit"] = aws.StringValue(autoTuneMaintenanceScheduleDuration.Unit)
+
+	return m
+}
+
+func expandAIMLOptions(tfMap map[string]interface{}) *opensearchservice.AIMLOptionsInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := &opensearchservice.AIMLOptionsInput_{}
+
+	if v, ok := tfMap["natural_language_query_generation_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		options.NaturalLanguageQueryGenerationOptions = expandNaturalLanguageQueryGenerationOptions(v[0].(map[string]interface{}))
+	}
+
+	return options
+}
+
+func expandNaturalLanguageQueryGenerationOptions(tfMap map[string]interface{}) *opensearchservice.NaturalLanguageQueryGenerationOptionsInput_ {
+	options := &opensearchservice.NaturalLanguageQueryGenerationOptionsInput_{}
+
+	if v, ok := tfMap["desired_state"].(string); ok && v != "" {
+		options.DesiredState = aws.String(v)
+	}
+
+	return options
+}
+
+func flattenAIMLOptions(aimlOptions *opensearchservice.AIMLOptionsOutput_) []interface{} {
+	if aimlOptions == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := aimlOptions.NaturalLanguageQueryGenerationOptions; v != nil {
+		m["natural_language_query_generation_options"] = []interface{}{
+			map[string]interface{}{
+				"desired_state": aws.StringValue(v.DesiredState),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandOffPeakWindowOptions(tfMap map[string]interface{}) *opensearchservice.OffPeakWindowOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := &opensearchservice.OffPeakWindowOptions{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		options.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["off_peak_window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		options.OffPeakWindow = expandOffPeakWindow(v[0].(map[string]interface{}))
+	}
+
+	return options
+}
+
+func expandOffPeakWindow(tfMap map[string]interface{}) *opensearchservice.OffPeakWindow {
+	offPeakWindow := &opensearchservice.OffPeakWindow{}
+
+	if v, ok := tfMap["window_start_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		offPeakWindow.WindowStartTime = &opensearchservice.WindowStartTime{
+			Hours:   aws.Int64(int64(m["hours"].(int))),
+			Minutes: aws.Int64(int64(m["minutes"].(int))),
+		}
+	}
+
+	return offPeakWindow
+}
+
+// flattenOffPeakWindowOptions returns the off-peak window in effect for the domain.
+// Domains created after February 16, 2023 get a default window even if none is configured.
+func flattenOffPeakWindowOptions(offPeakWindowOptions *opensearchservice.OffPeakWindowOptions) []interface{} {
+	if offPeakWindowOptions == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(offPeakWindowOptions.Enabled),
+	}
+
+	if v := offPeakWindowOptions.OffPeakWindow; v != nil && v.WindowStartTime != nil {
+		m["off_peak_window"] = []interface{}{
+			map[string]interface{}{
+				"window_start_time": []interface{}{
+					map[string]interface{}{
+						"hours":   aws.Int64Value(v.WindowStartTime.Hours),
+						"minutes": aws.Int64Value(v.WindowStartTime.Minutes),
+					},
+				},
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandSoftwareUpdateOptions(tfMap map[string]interface{}) *opensearchservice.SoftwareUpdateOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := &opensearchservice.SoftwareUpdateOptions{}
+
+	if v, ok := tfMap["auto_software_update_enabled"].(bool); ok {
+		options.AutoSoftwareUpdateEnabled = aws.Bool(v)
+	}
+
+	return options
+}
+
+func flattenSoftwareUpdateOptions(softwareUpdateOptions *opensearchservice.SoftwareUpdateOptions) []interface{} {
+	if softwareUpdateOptions == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"auto_software_update_enabled": aws.BoolValue(softwareUpdateOptions.AutoSoftwareUpdateEnabled),
+	}
+
+	return []interface{}{m}
+}
+
+func getMasterUserOptions(d *schema.ResourceData) []interface{} {
+	if v, ok := d.GetOk("advanced_security_options"); ok {
+		options := v.([]interface{})
+		if len(options) > 0 && options[0] != nil {
+			m := options[0].(map[string]interface{})
+			if opts, ok := m["master_user_options"]; ok {
+				return opts.([]interface{})
+			}
+		}
+	}
+	return []interface{}{}
+}
+
+func getUserDBEnabled(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("advanced_security_options"); ok {
+		options := v.([]interface{})
+		if len(options) > 0 && options[0] != nil {
+			m := options[0].(map[string]interface{})
+			if enabled, ok := m["internal_user_database_enabled"]; ok {
+				return enabled.(bool)
+			}
+		}
+	}
+	return false
+}
+
+func expandLogPublishingOptions(m *schema.Set) map[string]*opensearchservice.LogPublishingOption {
+	options := make(map[string]*opensearchservice.LogPublishingOption)
+
+	for _, vv := range m.List() {
+		lo := vv.(map[string]interface{})
+		options[lo["log_type"].(string)] = &opensearchservice.LogPublishingOption{
+			CloudWatchLogsLogGroupArn: aws.String(lo["cloudwatch_log_group_arn"].(string)),
+			Enabled:                   aws.Bool(lo["enabled"].(bool)),
+		}
+	}
+
+	return options
+}
+
+func flattenLogPublishingOptions(o map[string]*opensearchservice.LogPublishingOption) []map[string]interface{} {
+	m := make([]map[string]interface{}, 0)
+	for logType, val := range o {
+		mm := map[string]interface{}{
+			"log_type": logType,
+			"enabled":  aws.BoolValue(val.Enabled),
+		}
+
+		if val.CloudWatchLogsLogGroupArn != nil {
+			mm["cloudwatch_log_group_arn"] = aws.StringValue(val.CloudWatchLogsLogGroupArn)
+		}
+
+		m = append(m, mm)
+	}
+	return m
+}
@@ -0,0 +1,157 @@
+package opensearchserverless
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessPolicyCreate,
+		Read:   resourceAccessPolicyRead,
+		Update: resourceAccessPolicyUpdate,
+		Delete: resourceAccessPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: policyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 20480), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"policy_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(opensearchserverless.AccessPolicyType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateAccessPolicyInput{
+		Name:   aws.String(name),
+		Policy: aws.String(d.Get("policy").(string)),
+		Type:   aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Serverless Access Policy: %s", input)
+	output, err := conn.CreateAccessPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating OpenSearch Serverless Access Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AccessPolicyDetail.Name))
+	d.Set("policy_version", output.AccessPolicyDetail.PolicyVersion)
+
+	return resourceAccessPolicyRead(d, meta)
+}
+
+func resourceAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	policy, err := FindAccessPolicyByNameAndType(conn, d.Id(), d.Get("type").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Access Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading OpenSearch Serverless Access Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("description", policy.Description)
+	d.Set("name", policy.Name)
+	setPolicyVersion(d, policy.PolicyVersion)
+	d.Set("type", policy.Type)
+
+	return nil
+}
+
+func resourceAccessPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	input := &opensearchserverless.UpdateAccessPolicyInput{
+		Name:          aws.String(d.Id()),
+		PolicyVersion: aws.String(d.Get("policy_version").(string)),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("policy") {
+		input.Policy = aws.String(d.Get("policy").(string))
+	}
+
+	log.Printf("[DEBUG] Updating OpenSearch Serverless Access Policy: %s", input)
+	output, err := conn.UpdateAccessPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating OpenSearch Serverless Access Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_version", output.AccessPolicyDetail.PolicyVersion)
+
+	return resourceAccessPolicyRead(d, meta)
+}
+
+func resourceAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch Serverless Access Policy: %s", d.Id())
+	_, err := conn.DeleteAccessPolicy(&opensearchserverless.DeleteAccessPolicyInput{
+		Name: aws.String(d.Id()),
+		Type: aws.String(d.Get("type").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting OpenSearch Serverless Access Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
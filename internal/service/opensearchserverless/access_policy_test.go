@@ -0,0 +1,139 @@
+package opensearchserverless_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchServerlessAccessPolicy_basic(t *testing.T) {
+	resourceName := "aws_opensearchserverless_access_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_version"),
+					resource.TestCheckResourceAttr(resourceName, "type", "data"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+			{
+				Config: testAccAccessPolicyConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessAccessPolicy_disappears(t *testing.T) {
+	resourceName := "aws_opensearchserverless_access_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearchserverless.ResourceAccessPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearchserverless_access_policy" {
+			continue
+		}
+
+		_, err := tfopensearchserverless.FindAccessPolicyByNameAndType(conn, rs.Primary.ID, rs.Primary.Attributes["type"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Serverless Access Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAccessPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Serverless Access Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+		_, err := tfopensearchserverless.FindAccessPolicyByNameAndType(conn, rs.Primary.ID, rs.Primary.Attributes["type"])
+
+		return err
+	}
+}
+
+func testAccAccessPolicyConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_opensearchserverless_access_policy" "test" {
+  name        = %[1]q
+  type        = "data"
+  description = %[2]q
+
+  policy = jsonencode([{
+    Rules = [{
+      ResourceType = "index"
+      Resource     = ["index/%[1]s/*"]
+      Permission   = ["aoss:*"]
+    }]
+    Principal = [data.aws_caller_identity.current.arn]
+  }])
+}
+`, rName, description)
+}
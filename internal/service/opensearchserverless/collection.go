@@ -0,0 +1,223 @@
+package opensearchserverless
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCollectionCreate,
+		Read:   resourceCollectionRead,
+		Update: resourceCollectionUpdate,
+		Delete: resourceCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dashboard_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"standby_replicas": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(opensearchserverless.StandbyReplicas_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(opensearchserverless.CollectionType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateCollectionInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("standby_replicas"); ok {
+		input.StandbyReplicas = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Serverless Collection: %s", input)
+	output, err := conn.CreateCollection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating OpenSearch Serverless Collection (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CreateCollectionDetail.Id))
+
+	if _, err := waitCollectionCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Serverless Collection (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceCollectionRead(d, meta)
+}
+
+func resourceCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	collection, err := FindCollectionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading OpenSearch Serverless Collection (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(collection.Arn)
+	d.Set("arn", arn)
+	d.Set("collection_endpoint", collection.CollectionEndpoint)
+	d.Set("dashboard_endpoint", collection.DashboardEndpoint)
+	d.Set("description", collection.Description)
+	d.Set("kms_key_arn", collection.KmsKeyArn)
+	d.Set("name", collection.Name)
+	d.Set("standby_replicas", collection.StandbyReplicas)
+	d.Set("type", collection.Type)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for OpenSearch Serverless Collection (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	if d.HasChange("description") {
+		input := &opensearchserverless.UpdateCollectionInput{
+			Description: aws.String(d.Get("description").(string)),
+			Id:          aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating OpenSearch Serverless Collection: %s", input)
+		_, err := conn.UpdateCollection(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating OpenSearch Serverless Collection (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating OpenSearch Serverless Collection (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCollectionRead(d, meta)
+}
+
+func resourceCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch Serverless Collection: %s", d.Id())
+	_, err := conn.DeleteCollection(&opensearchserverless.DeleteCollectionInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting OpenSearch Serverless Collection (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitCollectionDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Serverless Collection (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package opensearchserverless_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchServerlessCollection_basic(t *testing.T) {
+	resourceName := "aws_opensearchserverless_collection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "aoss", regexp.MustCompile(`collection/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "collection_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "standby_replicas", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "SEARCH"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCollectionConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessCollection_disappears(t *testing.T) {
+	resourceName := "aws_opensearchserverless_collection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearchserverless.ResourceCollection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessCollection_tags(t *testing.T) {
+	resourceName := "aws_opensearchserverless_collection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCollectionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCollectionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessCollection_type(t *testing.T) {
+	resourceName := "aws_opensearchserverless_collection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig_type(rName, "VECTORSEARCH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "standby_replicas", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "type", "VECTORSEARCH"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCollectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearchserverless_collection" {
+			continue
+		}
+
+		_, err := tfopensearchserverless.FindCollectionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Serverless Collection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCollectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Serverless Collection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+		_, err := tfopensearchserverless.FindCollectionByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCollectionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_security_policy" "test" {
+  name = %[1]q
+  type = "encryption"
+
+  policy = jsonencode({
+    Rules = [{
+      Resource     = ["collection/%[1]s"]
+      ResourceType = "collection"
+    }]
+    AWSOwnedKey = true
+  })
+}
+`, rName)
+}
+
+func testAccCollectionConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccCollectionConfigBase(rName), fmt.Sprintf(`
+resource "aws_opensearchserverless_collection" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  depends_on = [aws_opensearchserverless_security_policy.test]
+}
+`, rName, description))
+}
+
+func testAccCollectionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCollectionConfigBase(rName), fmt.Sprintf(`
+resource "aws_opensearchserverless_collection" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_opensearchserverless_security_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccCollectionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCollectionConfigBase(rName), fmt.Sprintf(`
+resource "aws_opensearchserverless_collection" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_opensearchserverless_security_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccCollectionConfig_type(rName, collectionType string) string {
+	return acctest.ConfigCompose(testAccCollectionConfigBase(rName), fmt.Sprintf(`
+resource "aws_opensearchserverless_collection" "test" {
+  name             = %[1]q
+  standby_replicas = "DISABLED"
+  type             = %[2]q
+
+  depends_on = [aws_opensearchserverless_security_policy.test]
+}
+`, rName, collectionType))
+}
@@ -0,0 +1,120 @@
+package opensearchserverless
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAccessPolicyByNameAndType(conn *opensearchserverless.OpenSearchServerless, name, policyType string) (*opensearchserverless.AccessPolicyDetail, error) {
+	input := &opensearchserverless.GetAccessPolicyInput{
+		Name: aws.String(name),
+		Type: aws.String(policyType),
+	}
+
+	output, err := conn.GetAccessPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessPolicyDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccessPolicyDetail, nil
+}
+
+func FindCollectionByID(conn *opensearchserverless.OpenSearchServerless, id string) (*opensearchserverless.CollectionDetail, error) {
+	input := &opensearchserverless.BatchGetCollectionInput{
+		Ids: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.BatchGetCollection(input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Unknown collection IDs are reported in CollectionErrorDetails rather than as an error.
+	if output == nil || len(output.CollectionDetails) == 0 || output.CollectionDetails[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.CollectionDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.CollectionDetails[0], nil
+}
+
+func FindSecurityPolicyByNameAndType(conn *opensearchserverless.OpenSearchServerless, name, policyType string) (*opensearchserverless.SecurityPolicyDetail, error) {
+	input := &opensearchserverless.GetSecurityPolicyInput{
+		Name: aws.String(name),
+		Type: aws.String(policyType),
+	}
+
+	output, err := conn.GetSecurityPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityPolicyDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityPolicyDetail, nil
+}
+
+func FindVPCEndpointByID(conn *opensearchserverless.OpenSearchServerless, id string) (*opensearchserverless.VpcEndpointDetail, error) {
+	input := &opensearchserverless.BatchGetVpcEndpointInput{
+		Ids: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.BatchGetVpcEndpoint(input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.VpcEndpointDetails) == 0 || output.VpcEndpointDetails[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.VpcEndpointDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.VpcEndpointDetails[0], nil
+}
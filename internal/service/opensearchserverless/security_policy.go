@@ -0,0 +1,185 @@
+package opensearchserverless
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityPolicyCreate,
+		Read:   resourceSecurityPolicyRead,
+		Update: resourceSecurityPolicyUpdate,
+		Delete: resourceSecurityPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: policyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 20480), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"policy_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(opensearchserverless.SecurityPolicyType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceSecurityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateSecurityPolicyInput{
+		Name:   aws.String(name),
+		Policy: aws.String(d.Get("policy").(string)),
+		Type:   aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Serverless Security Policy: %s", input)
+	output, err := conn.CreateSecurityPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating OpenSearch Serverless Security Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityPolicyDetail.Name))
+	d.Set("policy_version", output.SecurityPolicyDetail.PolicyVersion)
+
+	return resourceSecurityPolicyRead(d, meta)
+}
+
+func resourceSecurityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	policy, err := FindSecurityPolicyByNameAndType(conn, d.Id(), d.Get("type").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Security Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading OpenSearch Serverless Security Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("description", policy.Description)
+	d.Set("name", policy.Name)
+	setPolicyVersion(d, policy.PolicyVersion)
+	d.Set("type", policy.Type)
+
+	return nil
+}
+
+func resourceSecurityPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	input := &opensearchserverless.UpdateSecurityPolicyInput{
+		Name:          aws.String(d.Id()),
+		PolicyVersion: aws.String(d.Get("policy_version").(string)),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("policy") {
+		input.Policy = aws.String(d.Get("policy").(string))
+	}
+
+	log.Printf("[DEBUG] Updating OpenSearch Serverless Security Policy: %s", input)
+	output, err := conn.UpdateSecurityPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating OpenSearch Serverless Security Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_version", output.SecurityPolicyDetail.PolicyVersion)
+
+	return resourceSecurityPolicyRead(d, meta)
+}
+
+func resourceSecurityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch Serverless Security Policy: %s", d.Id())
+	_, err := conn.DeleteSecurityPolicy(&opensearchserverless.DeleteSecurityPolicyInput{
+		Name: aws.String(d.Id()),
+		Type: aws.String(d.Get("type").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting OpenSearch Serverless Security Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// policyImport imports security and access policies using an ID of the form NAME/TYPE.
+func policyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected NAME/TYPE", d.Id())
+	}
+
+	d.SetId(parts[0])
+	d.Set("type", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setPolicyVersion sets the policy version of a security or access policy.
+// The policy document is not modeled in the AWS SDK for Go v1 responses, so it cannot be read back.
+// Instead, a policy version other than the one last written by Terraform means that the policy was
+// changed outside of Terraform, and the policy document is cleared so that the plan applies the configured one again.
+func setPolicyVersion(d *schema.ResourceData, policyVersion *string) {
+	if v := d.Get("policy_version").(string); v != "" && v != aws.StringValue(policyVersion) {
+		log.Printf("[WARN] OpenSearch Serverless Policy (%s) version changed outside of Terraform from %s to %s", d.Id(), v, aws.StringValue(policyVersion))
+		d.Set("policy", "")
+	}
+
+	d.Set("policy_version", policyVersion)
+}
@@ -0,0 +1,251 @@
+package opensearchserverless_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchServerlessSecurityPolicy_basic(t *testing.T) {
+	resourceName := "aws_opensearchserverless_security_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityPolicyConfig_encryption(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_version"),
+					resource.TestCheckResourceAttr(resourceName, "type", "encryption"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+			{
+				Config: testAccSecurityPolicyConfig_encryption(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessSecurityPolicy_disappears(t *testing.T) {
+	resourceName := "aws_opensearchserverless_security_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityPolicyConfig_encryption(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityPolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearchserverless.ResourceSecurityPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessSecurityPolicy_policyDrift(t *testing.T) {
+	resourceName := "aws_opensearchserverless_security_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityPolicyConfig_encryption(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityPolicyExists(resourceName),
+					testAccCheckSecurityPolicyUpdatePolicy(resourceName, fmt.Sprintf(`{"Rules":[{"Resource":["collection/%[1]s","collection/%[1]s-other"],"ResourceType":"collection"}],"AWSOwnedKey":true}`, rName)),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccSecurityPolicyConfig_encryption(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityPolicyExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessSecurityPolicy_network(t *testing.T) {
+	resourceName := "aws_opensearchserverless_security_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityPolicyConfig_network(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "type", "network"),
+				),
+			},
+			{
+				Config: testAccSecurityPolicyConfig_network(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "type", "network"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearchserverless_security_policy" {
+			continue
+		}
+
+		_, err := tfopensearchserverless.FindSecurityPolicyByNameAndType(conn, rs.Primary.ID, rs.Primary.Attributes["type"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Serverless Security Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSecurityPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Serverless Security Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+		_, err := tfopensearchserverless.FindSecurityPolicyByNameAndType(conn, rs.Primary.ID, rs.Primary.Attributes["type"])
+
+		return err
+	}
+}
+
+// testAccCheckSecurityPolicyUpdatePolicy replaces the policy document outside of Terraform.
+func testAccCheckSecurityPolicyUpdatePolicy(n, policy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+		_, err := conn.UpdateSecurityPolicy(&opensearchserverless.UpdateSecurityPolicyInput{
+			Name:          aws.String(rs.Primary.ID),
+			Policy:        aws.String(policy),
+			PolicyVersion: aws.String(rs.Primary.Attributes["policy_version"]),
+			Type:          aws.String(rs.Primary.Attributes["type"]),
+		})
+
+		return err
+	}
+}
+
+func testAccPolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["type"]), nil
+	}
+}
+
+func testAccSecurityPolicyConfig_encryption(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_security_policy" "test" {
+  name        = %[1]q
+  type        = "encryption"
+  description = %[2]q
+
+  policy = jsonencode({
+    Rules = [{
+      Resource     = ["collection/%[1]s"]
+      ResourceType = "collection"
+    }]
+    AWSOwnedKey = true
+  })
+}
+`, rName, description)
+}
+
+func testAccSecurityPolicyConfig_network(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_security_policy" "test" {
+  name        = %[1]q
+  type        = "network"
+  description = %[2]q
+
+  policy = jsonencode([{
+    Rules = [
+      {
+        Resource     = ["collection/%[1]s"]
+        ResourceType = "collection"
+      },
+      {
+        Resource     = ["collection/%[1]s"]
+        ResourceType = "dashboard"
+      },
+    ]
+    AllowFromPublic = true
+  }])
+}
+`, rName, description)
+}
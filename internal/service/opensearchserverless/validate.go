@@ -0,0 +1,12 @@
+package opensearchserverless
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validName = validation.All(
+	validation.StringLenBetween(3, 32),
+	validation.StringMatch(regexp.MustCompile(`^[a-z][0-9a-z-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+)
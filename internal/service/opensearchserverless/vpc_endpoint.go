@@ -0,0 +1,188 @@
+package opensearchserverless
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceVPCEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCEndpointCreate,
+		Read:   resourceVPCEndpointRead,
+		Update: resourceVPCEndpointUpdate,
+		Delete: resourceVPCEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 6,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceVPCEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateVpcEndpointInput{
+		Name:      aws.String(name),
+		SubnetIds: flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		VpcId:     aws.String(d.Get("vpc_id").(string)),
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Serverless VPC Endpoint: %s", input)
+	output, err := conn.CreateVpcEndpoint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating OpenSearch Serverless VPC Endpoint (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CreateVpcEndpointDetail.Id))
+
+	if _, err := waitVPCEndpointCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Serverless VPC Endpoint (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceVPCEndpointRead(d, meta)
+}
+
+func resourceVPCEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	endpoint, err := FindVPCEndpointByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless VPC Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading OpenSearch Serverless VPC Endpoint (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", endpoint.Name)
+	d.Set("security_group_ids", aws.StringValueSlice(endpoint.SecurityGroupIds))
+	d.Set("subnet_ids", aws.StringValueSlice(endpoint.SubnetIds))
+	d.Set("vpc_id", endpoint.VpcId)
+
+	return nil
+}
+
+func resourceVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	input := &opensearchserverless.UpdateVpcEndpointInput{
+		Id: aws.String(d.Id()),
+	}
+
+	if d.HasChange("security_group_ids") {
+		o, n := d.GetChange("security_group_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.AddSecurityGroupIds = flex.ExpandStringSet(add)
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input.RemoveSecurityGroupIds = flex.ExpandStringSet(del)
+		}
+	}
+
+	if d.HasChange("subnet_ids") {
+		o, n := d.GetChange("subnet_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.AddSubnetIds = flex.ExpandStringSet(add)
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input.RemoveSubnetIds = flex.ExpandStringSet(del)
+		}
+	}
+
+	log.Printf("[DEBUG] Updating OpenSearch Serverless VPC Endpoint: %s", input)
+	_, err := conn.UpdateVpcEndpoint(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating OpenSearch Serverless VPC Endpoint (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitVPCEndpointUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Serverless VPC Endpoint (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceVPCEndpointRead(d, meta)
+}
+
+func resourceVPCEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch Serverless VPC Endpoint: %s", d.Id())
+	_, err := conn.DeleteVpcEndpoint(&opensearchserverless.DeleteVpcEndpointInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting OpenSearch Serverless VPC Endpoint (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitVPCEndpointDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Serverless VPC Endpoint (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package opensearchserverless
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitCollectionCreated(conn *opensearchserverless.OpenSearchServerless, id string, timeout time.Duration) (*opensearchserverless.CollectionDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchserverless.CollectionStatusCreating},
+		Target:  []string{opensearchserverless.CollectionStatusActive},
+		Refresh: statusCollection(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchserverless.CollectionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCollectionDeleted(conn *opensearchserverless.OpenSearchServerless, id string, timeout time.Duration) (*opensearchserverless.CollectionDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchserverless.CollectionStatusDeleting},
+		Target:  []string{},
+		Refresh: statusCollection(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchserverless.CollectionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointCreated(conn *opensearchserverless.OpenSearchServerless, id string, timeout time.Duration) (*opensearchserverless.VpcEndpointDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchserverless.VpcEndpointStatusPending},
+		Target:  []string{opensearchserverless.VpcEndpointStatusActive},
+		Refresh: statusVPCEndpoint(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchserverless.VpcEndpointDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointUpdated(conn *opensearchserverless.OpenSearchServerless, id string, timeout time.Duration) (*opensearchserverless.VpcEndpointDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchserverless.VpcEndpointStatusPending},
+		Target:  []string{opensearchserverless.VpcEndpointStatusActive},
+		Refresh: statusVPCEndpoint(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchserverless.VpcEndpointDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointDeleted(conn *opensearchserverless.OpenSearchServerless, id string, timeout time.Duration) (*opensearchserverless.VpcEndpointDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchserverless.VpcEndpointStatusDeleting, opensearchserverless.VpcEndpointStatusActive},
+		Target:  []string{},
+		Refresh: statusVPCEndpoint(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchserverless.VpcEndpointDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
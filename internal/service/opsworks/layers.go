@@ -6,7 +6,6 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -515,13 +514,7 @@ func (lt *opsworksLayerType) Create(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "opsworks",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("layer/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("opsworks", fmt.Sprintf("layer/%s", d.Id()))
 
 	if len(tags) > 0 {
 		if err := UpdateTags(conn, arn, nil, tags); err != nil {
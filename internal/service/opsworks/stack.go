@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -576,13 +575,7 @@ func resourceStackUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Service:   "opsworks",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("stack/%s/", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("opsworks", fmt.Sprintf("stack/%s/", d.Id()))
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
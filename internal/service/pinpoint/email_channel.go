@@ -49,7 +49,7 @@ func ResourceEmailChannel() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"messages_per_second": {
 				Type:     schema.TypeInt,
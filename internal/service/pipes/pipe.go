@@ -0,0 +1,311 @@
+package pipes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePipe() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePipeCreate,
+		Read:   resourcePipeRead,
+		Update: resourcePipeUpdate,
+		Delete: resourcePipeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"desired_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      pipes.RequestedPipeStateRunning,
+				ValidateFunc: validation.StringInSlice(pipes.RequestedPipeState_Values(), false),
+			},
+			"enrichment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"enrichment_parameters": enrichmentParametersSchema(),
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64),
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64-resource.UniqueIDSuffixLength),
+				ConflictsWith: []string{"name"},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_parameters": sourceParametersSchema(),
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_parameters": targetParametersSchema(),
+			"tags":              tftags.TagsSchema(),
+			"tags_all":          tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePipeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PipesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &pipes.CreatePipeInput{
+		DesiredState: aws.String(d.Get("desired_state").(string)),
+		Name:         aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		Source:       aws.String(d.Get("source").(string)),
+		Target:       aws.String(d.Get("target").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment"); ok {
+		input.Enrichment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceParameters = expandPipeSourceParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("target_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TargetParameters = expandPipeTargetParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Pipes Pipe: %s", input)
+	_, err := tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreatePipe(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, pipes.ErrCodeValidationException, "assume") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating EventBridge Pipes Pipe (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitPipeCreated(conn, d.Id(), d.Get("desired_state").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EventBridge Pipes Pipe (%s) create: %w", d.Id(), err)
+	}
+
+	return resourcePipeRead(d, meta)
+}
+
+func resourcePipeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PipesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPipeByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Pipes Pipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Pipes Pipe (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("desired_state", output.DesiredState)
+	d.Set("enrichment", output.Enrichment)
+	if output.EnrichmentParameters != nil {
+		if err := d.Set("enrichment_parameters", []interface{}{flattenPipeEnrichmentParameters(output.EnrichmentParameters)}); err != nil {
+			return fmt.Errorf("error setting enrichment_parameters: %w", err)
+		}
+	} else {
+		d.Set("enrichment_parameters", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("role_arn", output.RoleArn)
+	d.Set("source", output.Source)
+	if output.SourceParameters != nil {
+		if err := d.Set("source_parameters", []interface{}{flattenPipeSourceParameters(output.SourceParameters)}); err != nil {
+			return fmt.Errorf("error setting source_parameters: %w", err)
+		}
+	} else {
+		d.Set("source_parameters", nil)
+	}
+	d.Set("target", output.Target)
+	if output.TargetParameters != nil {
+		if err := d.Set("target_parameters", []interface{}{flattenPipeTargetParameters(output.TargetParameters)}); err != nil {
+			return fmt.Errorf("error setting target_parameters: %w", err)
+		}
+	} else {
+		d.Set("target_parameters", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePipeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PipesConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &pipes.UpdatePipeInput{
+			Description:  aws.String(d.Get("description").(string)),
+			DesiredState: aws.String(d.Get("desired_state").(string)),
+			Name:         aws.String(d.Id()),
+			RoleArn:      aws.String(d.Get("role_arn").(string)),
+			Target:       aws.String(d.Get("target").(string)),
+			// Omitting the enrichment leaves it unchanged; an empty value removes it.
+			Enrichment: aws.String(d.Get("enrichment").(string)),
+		}
+
+		if d.HasChange("enrichment_parameters") {
+			if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.EnrichmentParameters = &pipes.PipeEnrichmentParameters{}
+			}
+		}
+
+		if d.HasChange("source_parameters") {
+			if v, ok := d.GetOk("source_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SourceParameters = expandUpdatePipeSourceParameters(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("target_parameters") {
+			if v, ok := d.GetOk("target_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.TargetParameters = expandPipeTargetParameters(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.TargetParameters = &pipes.PipeTargetParameters{}
+			}
+		}
+
+		log.Printf("[DEBUG] Updating EventBridge Pipes Pipe: %s", input)
+		_, err := conn.UpdatePipe(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EventBridge Pipes Pipe (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitPipeUpdated(conn, d.Id(), d.Get("desired_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EventBridge Pipes Pipe (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating EventBridge Pipes Pipe (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourcePipeRead(d, meta)
+}
+
+func resourcePipeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PipesConn
+
+	log.Printf("[DEBUG] Deleting EventBridge Pipes Pipe: %s", d.Id())
+	_, err := conn.DeletePipe(&pipes.DeletePipeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pipes.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EventBridge Pipes Pipe (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitPipeDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EventBridge Pipes Pipe (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,615 @@
+package pipes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pipes"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfpipes "github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPipesPipe_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "pipes", fmt.Sprintf("pipe/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", pipes.RequestedPipeStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "enrichment", ""),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "aws_sqs_queue.source", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.filter_criteria.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.sqs_queue_parameters.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target", "aws_sqs_queue.target", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpipes.ResourcePipe(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_namePrefix(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig_namePrefix(rName, "tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					create.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_desiredState(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig_desiredState(rName, pipes.RequestedPipeStateStopped),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", pipes.RequestedPipeStateStopped),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeConfig_desiredState(rName, pipes.RequestedPipeStateRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", pipes.RequestedPipeStateRunning),
+				),
+			},
+			{
+				Config: testAccPipeConfig_desiredState(rName, pipes.RequestedPipeStateStopped),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", pipes.RequestedPipeStateStopped),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_filterCriteria(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig_filterCriteria(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.filter_criteria.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.filter_criteria.0.filter.0.pattern", `{"source":["test1"]}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeConfig_filterCriteria(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.filter_criteria.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.filter_criteria.0.filter.0.pattern", `{"source":["test2"]}`),
+				),
+			},
+			{
+				Config: testAccPipeConfig_sqsSourceParameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_parameters.0.filter_criteria.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_enrichment(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig_enrichment(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "enrichment", "aws_cloudwatch_event_api_destination.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.header_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.header_parameters.X-Test", "test1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.input_template", `{"test":"test1"}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeConfig_enrichment(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.header_parameters.X-Test", "test2"),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.input_template", `{"test":"test2"}`),
+				),
+			},
+			{
+				Config: testAccPipeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enrichment", ""),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_targetParameters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig_targetParameters(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.input_template", `{"test":"test1"}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeConfig_targetParameters(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.input_template", `{"test":"test2"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPipesPipe_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pipes.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pipes.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPipeConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPipeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PipesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pipes_pipe" {
+			continue
+		}
+
+		_, err := tfpipes.FindPipeByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EventBridge Pipes Pipe %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPipeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Pipes Pipe ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PipesConn
+
+		_, err := tfpipes.FindPipeByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPipeConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = {
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "pipes.${data.aws_partition.current.dns_suffix}"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }
+  })
+}
+
+resource "aws_iam_role_policy" "source" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "sqs:DeleteMessage",
+          "sqs:GetQueueAttributes",
+          "sqs:ReceiveMessage",
+        ],
+        Resource = [
+          aws_sqs_queue.source.arn,
+        ]
+      },
+    ]
+  })
+}
+
+resource "aws_sqs_queue" "source" {
+  name = "%[1]s-source"
+}
+
+resource "aws_iam_role_policy" "target" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "sqs:SendMessage",
+        ],
+        Resource = [
+          aws_sqs_queue.target.arn,
+        ]
+      },
+    ]
+  })
+}
+
+resource "aws_sqs_queue" "target" {
+  name = "%[1]s-target"
+}
+`, rName)
+}
+
+func testAccPipeConfig(rName string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+}
+`, rName))
+}
+
+func testAccPipeConfig_namePrefix(rName, namePrefix string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name_prefix = %[1]q
+  role_arn    = aws_iam_role.test.arn
+  source      = aws_sqs_queue.source.arn
+  target      = aws_sqs_queue.target.arn
+}
+`, namePrefix))
+}
+
+func testAccPipeConfig_desiredState(rName, state string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  desired_state = %[2]q
+  name          = %[1]q
+  role_arn      = aws_iam_role.test.arn
+  source        = aws_sqs_queue.source.arn
+  target        = aws_sqs_queue.target.arn
+}
+`, rName, state))
+}
+
+func testAccPipeConfig_filterCriteria(rName, source string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  source_parameters {
+    filter_criteria {
+      filter {
+        pattern = jsonencode({
+          source = [%[2]q]
+        })
+      }
+    }
+  }
+}
+`, rName, source))
+}
+
+func testAccPipeConfig_sqsSourceParameters(rName string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  source_parameters {
+    sqs_queue_parameters {
+      batch_size                         = 10
+      maximum_batching_window_in_seconds = 60
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPipeConfig_enrichment(rName, value string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "API_KEY"
+
+  auth_parameters {
+    api_key {
+      key   = "testKey"
+      value = "testValue"
+    }
+  }
+}
+
+resource "aws_cloudwatch_event_api_destination" "test" {
+  name                = %[1]q
+  invocation_endpoint = "https://example.com/"
+  http_method         = "POST"
+  connection_arn      = aws_cloudwatch_event_connection.test.arn
+}
+
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name       = %[1]q
+  role_arn   = aws_iam_role.test.arn
+  source     = aws_sqs_queue.source.arn
+  target     = aws_sqs_queue.target.arn
+  enrichment = aws_cloudwatch_event_api_destination.test.arn
+
+  enrichment_parameters {
+    input_template = jsonencode({
+      test = %[2]q
+    })
+
+    http_parameters {
+      header_parameters = {
+        "X-Test" = %[2]q
+      }
+    }
+  }
+}
+`, rName, value))
+}
+
+func testAccPipeConfig_targetParameters(rName, value string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  target_parameters {
+    input_template = jsonencode({
+      test = %[2]q
+    })
+  }
+}
+`, rName, value))
+}
+
+func testAccPipeConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPipeConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipeConfig_base(rName), fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,786 @@
+package pipes
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func sourceParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dynamodb_stream_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					ConflictsWith: []string{
+						"source_parameters.0.kinesis_stream_parameters",
+						"source_parameters.0.managed_streaming_kafka_parameters",
+						"source_parameters.0.sqs_queue_parameters",
+					},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"dead_letter_config": deadLetterConfigSchema(),
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"maximum_record_age_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 604800),
+							},
+							"maximum_retry_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 10000),
+							},
+							"on_partial_batch_item_failure": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(pipes.OnPartialBatchItemFailureStreams_Values(), false),
+							},
+							"parallelization_factor": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"starting_position": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(pipes.DynamoDBStreamStartPosition_Values(), false),
+							},
+						},
+					},
+				},
+				"filter_criteria": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"filter": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 5,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"pattern": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(0, 4096),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"kinesis_stream_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					ConflictsWith: []string{
+						"source_parameters.0.dynamodb_stream_parameters",
+						"source_parameters.0.managed_streaming_kafka_parameters",
+						"source_parameters.0.sqs_queue_parameters",
+					},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"dead_letter_config": deadLetterConfigSchema(),
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"maximum_record_age_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 604800),
+							},
+							"maximum_retry_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 10000),
+							},
+							"on_partial_batch_item_failure": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(pipes.OnPartialBatchItemFailureStreams_Values(), false),
+							},
+							"parallelization_factor": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"starting_position": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(pipes.KinesisStreamStartPosition_Values(), false),
+							},
+							"starting_position_timestamp": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+							},
+						},
+					},
+				},
+				"managed_streaming_kafka_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					ConflictsWith: []string{
+						"source_parameters.0.dynamodb_stream_parameters",
+						"source_parameters.0.kinesis_stream_parameters",
+						"source_parameters.0.sqs_queue_parameters",
+					},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"consumer_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+							"credentials": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"client_certificate_tls_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"sasl_scram_512_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"starting_position": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(pipes.MSKStartPosition_Values(), false),
+							},
+							"topic_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 249),
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					ConflictsWith: []string{
+						"source_parameters.0.dynamodb_stream_parameters",
+						"source_parameters.0.kinesis_stream_parameters",
+						"source_parameters.0.managed_streaming_kafka_parameters",
+					},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func deadLetterConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func expandPipeSourceParameters(tfMap map[string]interface{}) *pipes.PipeSourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceParameters{}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDBStreamParameters = expandPipeSourceDynamoDBStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FilterCriteria = expandFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisStreamParameters = expandPipeSourceKinesisStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedStreamingKafkaParameters = expandPipeSourceManagedStreamingKafkaParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SqsQueueParameters = expandPipeSourceSqsQueueParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceParameters(tfMap map[string]interface{}) *pipes.UpdatePipeSourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.UpdatePipeSourceParameters{}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDBStreamParameters = expandUpdatePipeSourceDynamoDBStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	// Sending empty filter criteria removes any existing filters.
+	apiObject.FilterCriteria = &pipes.FilterCriteria{}
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FilterCriteria = expandFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisStreamParameters = expandUpdatePipeSourceKinesisStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedStreamingKafkaParameters = expandUpdatePipeSourceManagedStreamingKafkaParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SqsQueueParameters = expandUpdatePipeSourceSqsQueueParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandFilterCriteria(tfMap map[string]interface{}) *pipes.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.FilterCriteria{}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Filters = append(apiObject.Filters, &pipes.Filter{
+				Pattern: aws.String(tfMap["pattern"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandDeadLetterConfig(tfList []interface{}) *pipes.DeadLetterConfig {
+	apiObject := &pipes.DeadLetterConfig{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeSourceDynamoDBStreamParameters(tfMap map[string]interface{}) *pipes.PipeSourceDynamoDBStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceDynamoDBStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v)
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = aws.String(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceDynamoDBStreamParameters(tfMap map[string]interface{}) *pipes.UpdatePipeSourceDynamoDBStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.UpdatePipeSourceDynamoDBStreamParameters{
+		// An empty dead-letter configuration removes any existing one.
+		DeadLetterConfig: expandDeadLetterConfig(tfMap["dead_letter_config"].([]interface{})),
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = aws.String(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandPipeSourceKinesisStreamParameters(tfMap map[string]interface{}) *pipes.PipeSourceKinesisStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceKinesisStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v)
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = aws.String(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = aws.String(v)
+	}
+
+	if v, ok := tfMap["starting_position_timestamp"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.StartingPositionTimestamp = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceKinesisStreamParameters(tfMap map[string]interface{}) *pipes.UpdatePipeSourceKinesisStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.UpdatePipeSourceKinesisStreamParameters{
+		// An empty dead-letter configuration removes any existing one.
+		DeadLetterConfig: expandDeadLetterConfig(tfMap["dead_letter_config"].([]interface{})),
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = aws.String(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandPipeSourceManagedStreamingKafkaParameters(tfMap map[string]interface{}) *pipes.PipeSourceManagedStreamingKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceManagedStreamingKafkaParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["consumer_group_id"].(string); ok && v != "" {
+		apiObject.ConsumerGroupID = aws.String(v)
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMSKAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = aws.String(v)
+	}
+
+	if v, ok := tfMap["topic_name"].(string); ok && v != "" {
+		apiObject.TopicName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceManagedStreamingKafkaParameters(tfMap map[string]interface{}) *pipes.UpdatePipeSourceManagedStreamingKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.UpdatePipeSourceManagedStreamingKafkaParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMSKAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandMSKAccessCredentials(tfMap map[string]interface{}) *pipes.MSKAccessCredentials {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.MSKAccessCredentials{}
+
+	if v, ok := tfMap["client_certificate_tls_auth"].(string); ok && v != "" {
+		apiObject.ClientCertificateTlsAuth = aws.String(v)
+	}
+
+	if v, ok := tfMap["sasl_scram_512_auth"].(string); ok && v != "" {
+		apiObject.SaslScram512Auth = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeSourceSqsQueueParameters(tfMap map[string]interface{}) *pipes.PipeSourceSqsQueueParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceSqsQueueParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceSqsQueueParameters(tfMap map[string]interface{}) *pipes.UpdatePipeSourceSqsQueueParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.UpdatePipeSourceSqsQueueParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenPipeSourceParameters(apiObject *pipes.PipeSourceParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DynamoDBStreamParameters; v != nil {
+		tfMap["dynamodb_stream_parameters"] = []interface{}{flattenPipeSourceDynamoDBStreamParameters(v)}
+	}
+
+	if v := apiObject.FilterCriteria; v != nil && len(v.Filters) > 0 {
+		tfMap["filter_criteria"] = []interface{}{flattenFilterCriteria(v)}
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{flattenPipeSourceKinesisStreamParameters(v)}
+	}
+
+	if v := apiObject.ManagedStreamingKafkaParameters; v != nil {
+		tfMap["managed_streaming_kafka_parameters"] = []interface{}{flattenPipeSourceManagedStreamingKafkaParameters(v)}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{flattenPipeSourceSqsQueueParameters(v)}
+	}
+
+	return tfMap
+}
+
+func flattenFilterCriteria(apiObject *pipes.FilterCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.Filters {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"pattern": aws.StringValue(apiObject.Pattern),
+		})
+	}
+
+	return map[string]interface{}{
+		"filter": tfList,
+	}
+}
+
+func flattenDeadLetterConfig(apiObject *pipes.DeadLetterConfig) []interface{} {
+	if apiObject == nil || apiObject.Arn == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"arn": aws.StringValue(apiObject.Arn),
+	}}
+}
+
+func flattenPipeSourceDynamoDBStreamParameters(apiObject *pipes.PipeSourceDynamoDBStreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"dead_letter_config":                 flattenDeadLetterConfig(apiObject.DeadLetterConfig),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"maximum_record_age_in_seconds":      aws.Int64Value(apiObject.MaximumRecordAgeInSeconds),
+		"maximum_retry_attempts":             aws.Int64Value(apiObject.MaximumRetryAttempts),
+		"on_partial_batch_item_failure":      aws.StringValue(apiObject.OnPartialBatchItemFailure),
+		"parallelization_factor":             aws.Int64Value(apiObject.ParallelizationFactor),
+		"starting_position":                  aws.StringValue(apiObject.StartingPosition),
+	}
+}
+
+func flattenPipeSourceKinesisStreamParameters(apiObject *pipes.PipeSourceKinesisStreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"dead_letter_config":                 flattenDeadLetterConfig(apiObject.DeadLetterConfig),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"maximum_record_age_in_seconds":      aws.Int64Value(apiObject.MaximumRecordAgeInSeconds),
+		"maximum_retry_attempts":             aws.Int64Value(apiObject.MaximumRetryAttempts),
+		"on_partial_batch_item_failure":      aws.StringValue(apiObject.OnPartialBatchItemFailure),
+		"parallelization_factor":             aws.Int64Value(apiObject.ParallelizationFactor),
+		"starting_position":                  aws.StringValue(apiObject.StartingPosition),
+	}
+
+	if v := apiObject.StartingPositionTimestamp; v != nil {
+		tfMap["starting_position_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenPipeSourceManagedStreamingKafkaParameters(apiObject *pipes.PipeSourceManagedStreamingKafkaParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"consumer_group_id":                  aws.StringValue(apiObject.ConsumerGroupID),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"starting_position":                  aws.StringValue(apiObject.StartingPosition),
+		"topic_name":                         aws.StringValue(apiObject.TopicName),
+	}
+
+	if v := apiObject.Credentials; v != nil {
+		tfMap["credentials"] = []interface{}{map[string]interface{}{
+			"client_certificate_tls_auth": aws.StringValue(v.ClientCertificateTlsAuth),
+			"sasl_scram_512_auth":         aws.StringValue(v.SaslScram512Auth),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenPipeSourceSqsQueueParameters(apiObject *pipes.PipeSourceSqsQueueParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+	}
+}
@@ -0,0 +1,174 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func targetParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"input_template": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 8192),
+				},
+				"kinesis_stream_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"partition_key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+							},
+						},
+					},
+				},
+				"lambda_function_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(pipes.PipeTargetInvocationType_Values(), false),
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"message_deduplication_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"message_group_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"step_function_state_machine_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(pipes.PipeTargetInvocationType_Values(), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandPipeTargetParameters(tfMap map[string]interface{}) *pipes.PipeTargetParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetParameters{}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.KinesisStreamParameters = &pipes.PipeTargetKinesisStreamParameters{
+			PartitionKey: aws.String(tfMap["partition_key"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["lambda_function_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.LambdaFunctionParameters = &pipes.PipeTargetLambdaFunctionParameters{
+			InvocationType: aws.String(tfMap["invocation_type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		sqsQueueParameters := &pipes.PipeTargetSqsQueueParameters{}
+
+		if v, ok := tfMap["message_deduplication_id"].(string); ok && v != "" {
+			sqsQueueParameters.MessageDeduplicationId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+			sqsQueueParameters.MessageGroupId = aws.String(v)
+		}
+
+		apiObject.SqsQueueParameters = sqsQueueParameters
+	}
+
+	if v, ok := tfMap["step_function_state_machine_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.StepFunctionStateMachineParameters = &pipes.PipeTargetStateMachineParameters{
+			InvocationType: aws.String(tfMap["invocation_type"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenPipeTargetParameters(apiObject *pipes.PipeTargetParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_template": aws.StringValue(apiObject.InputTemplate),
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{map[string]interface{}{
+			"partition_key": aws.StringValue(v.PartitionKey),
+		}}
+	}
+
+	if v := apiObject.LambdaFunctionParameters; v != nil {
+		tfMap["lambda_function_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": aws.StringValue(v.InvocationType),
+		}}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{map[string]interface{}{
+			"message_deduplication_id": aws.StringValue(v.MessageDeduplicationId),
+			"message_group_id":         aws.StringValue(v.MessageGroupId),
+		}}
+	}
+
+	if v := apiObject.StepFunctionStateMachineParameters; v != nil {
+		tfMap["step_function_state_machine_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": aws.StringValue(v.InvocationType),
+		}}
+	}
+
+	return tfMap
+}
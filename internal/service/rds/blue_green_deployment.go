@@ -0,0 +1,319 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBlueGreenDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlueGreenDeploymentCreate,
+		Read:   resourceBlueGreenDeploymentRead,
+		Update: resourceBlueGreenDeploymentUpdate,
+		Delete: resourceBlueGreenDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blue_green_deployment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"delete_target": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARNForService(rds.ServiceName, ""),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switchover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"switchover_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_member": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_member": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"switchover_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_db_cluster_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_db_instance_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_db_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"upgrade_target_storage_config": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBlueGreenDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("blue_green_deployment_name").(string)
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(name),
+		Source:                  aws.String(d.Get("source").(string)),
+	}
+
+	if v, ok := d.GetOk("target_db_cluster_parameter_group_name"); ok {
+		input.TargetDBClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_db_instance_class"); ok {
+		input.TargetDBInstanceClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_db_parameter_group_name"); ok {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_engine_version"); ok {
+		input.TargetEngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("upgrade_target_storage_config"); ok {
+		input.UpgradeTargetStorageConfig = aws.Bool(v.(bool))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating RDS Blue/Green Deployment: %s", input)
+	output, err := conn.CreateBlueGreenDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating RDS Blue/Green Deployment (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier))
+
+	if _, err := waitBlueGreenDeploymentAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) create: %w", d.Id(), err)
+	}
+
+	if d.Get("switchover").(bool) {
+		if err := blueGreenDeploymentSwitchover(conn, d.Id(), d.Get("switchover_timeout").(int), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceBlueGreenDeploymentRead(d, meta)
+}
+
+func resourceBlueGreenDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	deployment, err := FindBlueGreenDeploymentByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Blue/Green Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Blue/Green Deployment (%s): %w", d.Id(), err)
+	}
+
+	arn := meta.(*conns.AWSClient).RegionalARN(rds.ServiceName, fmt.Sprintf("deployment:%s", d.Id()))
+	d.Set("arn", arn)
+	d.Set("blue_green_deployment_name", deployment.BlueGreenDeploymentName)
+	d.Set("source", deployment.Source)
+	d.Set("status", deployment.Status)
+	d.Set("status_details", deployment.StatusDetails)
+	if err := d.Set("switchover_details", flattenSwitchoverDetails(deployment.SwitchoverDetails)); err != nil {
+		return fmt.Errorf("error setting switchover_details: %w", err)
+	}
+	d.Set("target", deployment.Target)
+
+	tags := KeyValueTags(deployment.TagList).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBlueGreenDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	if d.HasChange("switchover") && d.Get("switchover").(bool) {
+		if err := blueGreenDeploymentSwitchover(conn, d.Id(), d.Get("switchover_timeout").(int), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating RDS Blue/Green Deployment (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceBlueGreenDeploymentRead(d, meta)
+}
+
+func resourceBlueGreenDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	input := &rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(d.Id()),
+	}
+
+	// The green environment can't be deleted once it has become the production environment.
+	if d.Get("delete_target").(bool) && d.Get("status").(string) != BlueGreenDeploymentStatusSwitchoverCompleted {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", d.Id())
+	_, err := conn.DeleteBlueGreenDeployment(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RDS Blue/Green Deployment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func blueGreenDeploymentSwitchover(conn *rds.RDS, id string, switchoverTimeout int, timeout time.Duration) error {
+	input := &rds.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+		SwitchoverTimeout:             aws.Int64(int64(switchoverTimeout)),
+	}
+
+	log.Printf("[DEBUG] Switching over RDS Blue/Green Deployment: %s", input)
+	_, err := conn.SwitchoverBlueGreenDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error switching over RDS Blue/Green Deployment (%s): %w", id, err)
+	}
+
+	if _, err := waitBlueGreenDeploymentSwitchoverCompleted(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) switchover: %w", id, err)
+	}
+
+	return nil
+}
+
+func flattenSwitchoverDetails(apiObjects []*rds.SwitchoverDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source_member": aws.StringValue(apiObject.SourceMember),
+			"status":        aws.StringValue(apiObject.Status),
+			"target_member": aws.StringValue(apiObject.TargetMember),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,204 @@
+package rds_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSBlueGreenDeployment_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBlueGreenDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`deployment:bgd-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "aws_db_instance.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.BlueGreenDeploymentStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "false"),
+					resource.TestCheckResourceAttr(resourceName, "switchover_details.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "switchover_details.0.source_member", "aws_db_instance.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "switchover_details.0.target_member"),
+					resource.TestCheckResourceAttrSet(resourceName, "target"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_target", "switchover", "switchover_timeout", "target_engine_version"},
+			},
+		},
+	})
+}
+
+func TestAccRDSBlueGreenDeployment_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBlueGreenDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrds.ResourceBlueGreenDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSBlueGreenDeployment_switchover(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBlueGreenDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.BlueGreenDeploymentStatusAvailable),
+				),
+			},
+			{
+				Config: testAccBlueGreenDeploymentConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.BlueGreenDeploymentStatusSwitchoverCompleted),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "true"),
+					resource.TestCheckResourceAttr(resourceName, "switchover_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "switchover_details.0.status", tfrds.BlueGreenDeploymentStatusSwitchoverCompleted),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlueGreenDeploymentExists(n string, v *rds.BlueGreenDeployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Blue/Green Deployment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindBlueGreenDeploymentByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBlueGreenDeploymentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rds_blue_green_deployment" {
+			continue
+		}
+
+		_, err := tfrds.FindBlueGreenDeploymentByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS Blue/Green Deployment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBlueGreenDeploymentConfig(rName string, switchover bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = "mysql"
+  engine_version             = "8.0.32"
+  preferred_instance_classes = ["db.t3.micro", "db.t3.small", "db.t2.micro"]
+}
+
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  name                    = "test"
+  username                = "tfacctest"
+  password                = "avoid-plaintext-passwords"
+  skip_final_snapshot     = true
+
+  lifecycle {
+    ignore_changes = [engine_version]
+  }
+}
+
+resource "aws_rds_blue_green_deployment" "test" {
+  blue_green_deployment_name = %[1]q
+  source                     = aws_db_instance.test.arn
+  target_engine_version      = "8.0.33"
+  delete_target              = true
+  switchover                 = %[2]t
+}
+`, rName, switchover)
+}
@@ -73,3 +73,22 @@ func ExportableLogType_Values() []string {
 		ExportableLogTypeUpgrade,
 	}
 }
+
+const (
+	BlueGreenDeploymentStatusAvailable            = "AVAILABLE"
+	BlueGreenDeploymentStatusDeleting             = "DELETING"
+	BlueGreenDeploymentStatusInvalidConfiguration = "INVALID_CONFIGURATION"
+	BlueGreenDeploymentStatusProvisioning         = "PROVISIONING"
+	BlueGreenDeploymentStatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	BlueGreenDeploymentStatusSwitchoverFailed     = "SWITCHOVER_FAILED"
+	BlueGreenDeploymentStatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+)
+
+const (
+	ExportTaskStatusCanceled   = "CANCELED"
+	ExportTaskStatusCanceling  = "CANCELING"
+	ExportTaskStatusComplete   = "COMPLETE"
+	ExportTaskStatusFailed     = "FAILED"
+	ExportTaskStatusInProgress = "IN_PROGRESS"
+	ExportTaskStatusStarting   = "STARTING"
+)
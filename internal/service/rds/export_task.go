@@ -0,0 +1,229 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceExportTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceExportTaskCreate,
+		Read:   resourceExportTaskRead,
+		Delete: resourceExportTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"export_only": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"export_task_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validIdentifier,
+				),
+			},
+			"failure_cause": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"percent_progress": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"snapshot_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARNForService(rds.ServiceName, ""),
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceExportTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	id := d.Get("export_task_identifier").(string)
+	input := &rds.StartExportTaskInput{
+		ExportTaskIdentifier: aws.String(id),
+		IamRoleArn:           aws.String(d.Get("iam_role_arn").(string)),
+		KmsKeyId:             aws.String(d.Get("kms_key_id").(string)),
+		S3BucketName:         aws.String(d.Get("s3_bucket_name").(string)),
+		SourceArn:            aws.String(d.Get("source_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("export_only"); ok && v.(*schema.Set).Len() > 0 {
+		input.ExportOnly = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("s3_prefix"); ok {
+		input.S3Prefix = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting RDS Export Task: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(tfiam.PropagationTimeout, func() (interface{}, error) {
+		return conn.StartExportTask(input)
+	}, rds.ErrCodeIamRoleMissingPermissionsFault, rds.ErrCodeIamRoleNotFoundFault)
+
+	if err != nil {
+		return fmt.Errorf("error starting RDS Export Task (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitExportTaskCompleted(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RDS Export Task (%s) to complete: %w", d.Id(), err)
+	}
+
+	return resourceExportTaskRead(d, meta)
+}
+
+func resourceExportTaskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	output, err := FindExportTaskByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Export Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Export Task (%s): %w", d.Id(), err)
+	}
+
+	d.Set("export_only", aws.StringValueSlice(output.ExportOnly))
+	d.Set("export_task_identifier", output.ExportTaskIdentifier)
+	d.Set("failure_cause", output.FailureCause)
+	d.Set("iam_role_arn", output.IamRoleArn)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("percent_progress", output.PercentProgress)
+	d.Set("s3_bucket_name", output.S3Bucket)
+	d.Set("s3_prefix", output.S3Prefix)
+	if output.SnapshotTime != nil {
+		d.Set("snapshot_time", aws.TimeValue(output.SnapshotTime).Format(time.RFC3339))
+	} else {
+		d.Set("snapshot_time", nil)
+	}
+	d.Set("source_arn", output.SourceArn)
+	d.Set("source_type", output.SourceType)
+	d.Set("status", output.Status)
+	if output.TaskEndTime != nil {
+		d.Set("task_end_time", aws.TimeValue(output.TaskEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("task_end_time", nil)
+	}
+	if output.TaskStartTime != nil {
+		d.Set("task_start_time", aws.TimeValue(output.TaskStartTime).Format(time.RFC3339))
+	} else {
+		d.Set("task_start_time", nil)
+	}
+	d.Set("warning_message", output.WarningMessage)
+
+	return nil
+}
+
+func resourceExportTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	// Only in-progress export tasks can be canceled.
+	// The data already exported to S3 is not removed.
+	switch status := d.Get("status").(string); status {
+	case ExportTaskStatusInProgress, ExportTaskStatusStarting:
+	default:
+		log.Printf("[DEBUG] RDS Export Task (%s) has status %s, removing from state", d.Id(), status)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Canceling RDS Export Task: %s", d.Id())
+	_, err := conn.CancelExportTask(&rds.CancelExportTaskInput{
+		ExportTaskIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeExportTaskNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error canceling RDS Export Task (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitExportTaskCanceled(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for RDS Export Task (%s) to cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -46,6 +46,20 @@ func flattenRDSScalingConfigurationInfo(scalingConfigurationInfo *rds.ScalingCon
 	return []interface{}{m}
 }
 
+func flattenManagedMasterUserSecret(apiObject *rds.MasterUserSecret) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"kms_key_id":    aws.StringValue(apiObject.KmsKeyId),
+		"secret_arn":    aws.StringValue(apiObject.SecretArn),
+		"secret_status": aws.StringValue(apiObject.SecretStatus),
+	}
+
+	return []interface{}{m}
+}
+
 func expandOptionConfiguration(configured []interface{}) []*rds.OptionConfiguration {
 	var option []*rds.OptionConfiguration
 
@@ -264,6 +264,37 @@ func ResourceInstance() *schema.Resource {
 				},
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"manage_master_user_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"master_user_secret": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"master_user_secret_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"manage_master_user_password"},
+			},
 			"max_allocated_storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -313,9 +344,10 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password"},
 			},
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
@@ -700,7 +732,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		if _, ok := d.GetOk("engine"); !ok {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string))
 		}
-		if _, ok := d.GetOk("password"); !ok {
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string))
 		}
 		if _, ok := d.GetOk("username"); !ok {
@@ -722,7 +754,6 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			S3Prefix:                aws.String(s3_bucket["bucket_prefix"].(string)),
 			S3IngestionRoleArn:      aws.String(s3_bucket["ingestion_role"].(string)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
 			SourceEngine:            aws.String(s3_bucket["source_engine"].(string)),
@@ -730,6 +761,16 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			Tags:                    Tags(tags.IgnoreAWS()),
 		}
 
+		if attr, ok := d.GetOk("manage_master_user_password"); ok {
+			opts.ManageMasterUserPassword = aws.Bool(attr.(bool))
+
+			if attr, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				opts.MasterUserSecretKmsKeyId = aws.String(attr.(string))
+			}
+		} else {
+			opts.MasterUserPassword = aws.String(d.Get("password").(string))
+		}
+
 		if attr, ok := d.GetOk("multi_az"); ok {
 			opts.MultiAZ = aws.Bool(attr.(bool))
 		}
@@ -1004,6 +1045,15 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbInstance = true
 		}
 
+		if attr, ok := d.GetOk("manage_master_user_password"); ok {
+			modifyDbInstanceInput.ManageMasterUserPassword = aws.Bool(attr.(bool))
+			requiresModifyDbInstance = true
+
+			if attr, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbInstanceInput.MasterUserSecretKmsKeyId = aws.String(attr.(string))
+			}
+		}
+
 		if attr, ok := d.GetOk("port"); ok {
 			opts.Port = aws.Int64(int64(attr.(int)))
 		}
@@ -1164,7 +1214,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		if _, ok := d.GetOk("engine"); !ok {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string))
 		}
-		if _, ok := d.GetOk("password"); !ok {
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string))
 		}
 		if _, ok := d.GetOk("username"); !ok {
@@ -1178,7 +1228,6 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			DBInstanceIdentifier:    aws.String(identifier),
 			DeletionProtection:      aws.Bool(d.Get("deletion_protection").(bool)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			Engine:                  aws.String(d.Get("engine").(string)),
 			EngineVersion:           aws.String(d.Get("engine_version").(string)),
 			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
@@ -1188,6 +1237,16 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			CopyTagsToSnapshot:      aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 		}
 
+		if attr, ok := d.GetOk("manage_master_user_password"); ok {
+			opts.ManageMasterUserPassword = aws.Bool(attr.(bool))
+
+			if attr, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				opts.MasterUserSecretKmsKeyId = aws.String(attr.(string))
+			}
+		} else {
+			opts.MasterUserPassword = aws.String(d.Get("password").(string))
+		}
+
 		attr := d.Get("backup_retention_period")
 		opts.BackupRetentionPeriod = aws.Int64(int64(attr.(int)))
 
@@ -1321,7 +1380,9 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "") {
-				opts.MasterUserPassword = aws.String("********")
+				if opts.MasterUserPassword != nil {
+					opts.MasterUserPassword = aws.String("********")
+				}
 				return fmt.Errorf("Error creating DB Instance: %w, %+v", err, opts)
 			}
 			return fmt.Errorf("Error creating DB Instance: %w", err)
@@ -1409,6 +1470,13 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("identifier_prefix", create.NamePrefixFromName(aws.StringValue(v.DBInstanceIdentifier)))
 	d.Set("resource_id", v.DbiResourceId)
 	d.Set("username", v.MasterUsername)
+	d.Set("manage_master_user_password", v.MasterUserSecret != nil)
+	if v.MasterUserSecret != nil {
+		d.Set("master_user_secret_kms_key_id", v.MasterUserSecret.KmsKeyId)
+	}
+	if err := d.Set("master_user_secret", flattenManagedMasterUserSecret(v.MasterUserSecret)); err != nil {
+		return fmt.Errorf("error setting master_user_secret: %w", err)
+	}
 	d.Set("deletion_protection", v.DeletionProtection)
 	d.Set("engine", v.Engine)
 	d.Set("allocated_storage", v.AllocatedStorage)
@@ -1657,6 +1725,16 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		req.MasterUserPassword = aws.String(d.Get("password").(string))
 		requestUpdate = true
 	}
+	if d.HasChange("manage_master_user_password") {
+		req.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
+		requestUpdate = true
+	}
+	if d.HasChange("master_user_secret_kms_key_id") && d.Get("manage_master_user_password").(bool) {
+		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+			req.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			requestUpdate = true
+		}
+	}
 	if d.HasChange("multi_az") {
 		req.MultiAZ = aws.Bool(d.Get("multi_az").(bool))
 		requestUpdate = true
@@ -65,7 +65,7 @@ func ResourceProxy() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
@@ -1,10 +1,13 @@
 package rds
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -199,3 +202,113 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitBlueGreenDeploymentAvailable(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{BlueGreenDeploymentStatusProvisioning},
+		Target:     []string{BlueGreenDeploymentStatusAvailable},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentSwitchoverCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{BlueGreenDeploymentStatusAvailable, BlueGreenDeploymentStatusSwitchoverInProgress},
+		Target:     []string{BlueGreenDeploymentStatusSwitchoverCompleted},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BlueGreenDeploymentStatusAvailable,
+			BlueGreenDeploymentStatusDeleting,
+			BlueGreenDeploymentStatusInvalidConfiguration,
+			BlueGreenDeploymentStatusProvisioning,
+			BlueGreenDeploymentStatusSwitchoverCompleted,
+			BlueGreenDeploymentStatusSwitchoverFailed,
+		},
+		Target:     []string{},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusInProgress, ExportTaskStatusStarting},
+		Target:     []string{ExportTaskStatusComplete},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureCause)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCanceled(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusCanceling, ExportTaskStatusInProgress, ExportTaskStatusStarting},
+		Target:     []string{ExportTaskStatusCanceled},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureCause)))
+
+		return output, err
+	}
+
+	return nil, err
+}
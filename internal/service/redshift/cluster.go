@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -138,6 +137,39 @@ func ResourceCluster() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`(?i)^[a-z_]`), "first character must be a letter or underscore"),
 				),
 			},
+			"deferred_maintenance_window": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"defer_maintenance_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 45),
+							ConflictsWith: []string{"deferred_maintenance_window.0.end_time"},
+						},
+						"end_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppressEquivalentTime,
+							ConflictsWith:    []string{"deferred_maintenance_window.0.duration"},
+						},
+						"start_time": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppressEquivalentTime,
+						},
+					},
+				},
+			},
 			"dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -230,6 +262,11 @@ func ResourceCluster() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`(?i)^[a-z_]`), "first character must be a letter"),
 				),
 			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -390,6 +427,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			restoreOpts.IamRoles = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
+		if v, ok := d.GetOk("multi_az"); ok {
+			restoreOpts.MultiAZ = aws.Bool(v.(bool))
+		}
+
 		log.Printf("[DEBUG] Redshift Cluster restore cluster options: %s", restoreOpts)
 
 		resp, err := conn.RestoreFromClusterSnapshot(restoreOpts)
@@ -474,6 +515,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.IamRoles = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
+		if v, ok := d.GetOk("multi_az"); ok {
+			createOpts.MultiAZ = aws.Bool(v.(bool))
+		}
+
 		log.Printf("[DEBUG] Redshift Cluster create options: %s", createOpts)
 		resp, err := conn.CreateCluster(createOpts)
 		if err != nil {
@@ -511,6 +556,12 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := d.GetOk("deferred_maintenance_window"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := deferClusterMaintenance(conn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceClusterRead(d, meta)
 }
 
@@ -540,13 +591,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("allow_version_upgrade", rsc.AllowVersionUpgrade)
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "redshift",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("cluster:%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("redshift", fmt.Sprintf("cluster:%s", d.Id()))
 	d.Set("arn", arn)
 	d.Set("automated_snapshot_retention_period", rsc.AutomatedSnapshotRetentionPeriod)
 	d.Set("availability_zone", rsc.AvailabilityZone)
@@ -565,6 +610,9 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("cluster_version", rsc.ClusterVersion)
 	d.Set("database_name", rsc.DBName)
+	if err := d.Set("deferred_maintenance_window", flattenDeferredMaintenanceWindows(rsc.DeferredMaintenanceWindows, d.Get("deferred_maintenance_window").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting deferred_maintenance_window: %w", err)
+	}
 	d.Set("encrypted", rsc.Encrypted)
 	d.Set("enhanced_vpc_routing", rsc.EnhancedVpcRouting)
 	d.Set("kms_key_id", rsc.KmsKeyId)
@@ -572,6 +620,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting logging: %w", err)
 	}
 	d.Set("master_username", rsc.MasterUsername)
+	d.Set("multi_az", strings.EqualFold(aws.StringValue(rsc.MultiAZ), multiAZEnabled))
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
 	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
@@ -721,6 +770,11 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChange("multi_az") {
+		req.MultiAZ = aws.Bool(d.Get("multi_az").(bool))
+		requestUpdate = true
+	}
+
 	if requestUpdate {
 		log.Printf("[INFO] Modifying Redshift Cluster: %s", d.Id())
 		log.Printf("[DEBUG] Redshift Cluster Modify options: %s", req)
@@ -793,6 +847,39 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("deferred_maintenance_window") {
+		o, n := d.GetChange("deferred_maintenance_window")
+
+		if v := n.([]interface{}); len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			// Modify the existing deferment rather than creating another one.
+			if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+				tfMap["defer_maintenance_identifier"] = v[0].(map[string]interface{})["defer_maintenance_identifier"]
+			}
+
+			if err := deferClusterMaintenance(conn, d.Id(), tfMap); err != nil {
+				return err
+			}
+		} else if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+			input := &redshift.ModifyClusterMaintenanceInput{
+				ClusterIdentifier: aws.String(d.Id()),
+				DeferMaintenance:  aws.Bool(false),
+			}
+
+			if v, ok := v[0].(map[string]interface{})["defer_maintenance_identifier"].(string); ok && v != "" {
+				input.DeferMaintenanceIdentifier = aws.String(v)
+			}
+
+			log.Printf("[DEBUG] Modifying Redshift Cluster maintenance: %s", input)
+			_, err := conn.ModifyClusterMaintenance(input)
+
+			if err != nil {
+				return fmt.Errorf("error cancelling Redshift Cluster (%s) deferred maintenance: %w", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("logging") {
 		if loggingEnabled, ok := d.GetOk("logging.0.enable"); ok && loggingEnabled.(bool) {
 			log.Printf("[INFO] Enabling Logging for Redshift Cluster %q", d.Id())
@@ -873,6 +960,48 @@ func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.
 	return nil
 }
 
+func deferClusterMaintenance(conn *redshift.Redshift, id string, tfMap map[string]interface{}) error {
+	input := &redshift.ModifyClusterMaintenanceInput{
+		ClusterIdentifier: aws.String(id),
+		DeferMaintenance:  aws.Bool(true),
+	}
+
+	if v, ok := tfMap["defer_maintenance_identifier"].(string); ok && v != "" {
+		input.DeferMaintenanceIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["duration"].(int); ok && v != 0 {
+		input.DeferMaintenanceDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" && input.DeferMaintenanceDuration == nil {
+		t, _ := time.Parse(time.RFC3339, v)
+
+		input.DeferMaintenanceEndTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["start_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+
+		input.DeferMaintenanceStartTime = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Modifying Redshift Cluster maintenance: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(
+		clusterInvalidClusterStateFaultTimeout,
+		func() (interface{}, error) {
+			return conn.ModifyClusterMaintenance(input)
+		},
+		redshift.ErrCodeInvalidClusterStateFault,
+	)
+
+	if err != nil {
+		return fmt.Errorf("error deferring Redshift Cluster (%s) maintenance: %w", id, err)
+	}
+
+	return nil
+}
+
 func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RedshiftConn
 
@@ -990,3 +1119,48 @@ func flattenRedshiftClusterNodes(apiObjects []*redshift.ClusterNode) []interface
 
 	return tfList
 }
+
+// flattenDeferredMaintenanceWindows returns the first deferred maintenance window.
+// The deferment duration is not returned by the API and is carried over from configuration.
+func flattenDeferredMaintenanceWindows(apiObjects []*redshift.DeferredMaintenanceWindow, tfList []interface{}) []interface{} {
+	if len(apiObjects) == 0 || apiObjects[0] == nil {
+		return nil
+	}
+
+	apiObject := apiObjects[0]
+	tfMap := map[string]interface{}{
+		"defer_maintenance_identifier": aws.StringValue(apiObject.DeferMaintenanceIdentifier),
+	}
+
+	if v := apiObject.DeferMaintenanceEndTime; v != nil {
+		tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.DeferMaintenanceStartTime; v != nil {
+		tfMap["start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if len(tfList) > 0 && tfList[0] != nil {
+		if v, ok := tfList[0].(map[string]interface{})["duration"].(int); ok {
+			tfMap["duration"] = v
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
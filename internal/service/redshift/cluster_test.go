@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
@@ -546,6 +547,88 @@ func TestAccRedshiftCluster_changeEncryption2(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftCluster_multiAZ(t *testing.T) {
+	var v redshift.Cluster
+	resourceName := "aws_redshift_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_multiAZ(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"final_snapshot_identifier",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccClusterConfig_multiAZ(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftCluster_deferredMaintenanceWindow(t *testing.T) {
+	var v redshift.Cluster
+	resourceName := "aws_redshift_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	startTime := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	endTime1 := startTime.Add(7 * 24 * time.Hour).Format(time.RFC3339)
+	endTime2 := startTime.Add(14 * 24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_deferredMaintenanceWindow(rName, startTime.Format(time.RFC3339), endTime1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "deferred_maintenance_window.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "deferred_maintenance_window.0.defer_maintenance_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "deferred_maintenance_window.0.end_time", endTime1),
+					resource.TestCheckResourceAttr(resourceName, "deferred_maintenance_window.0.start_time", startTime.Format(time.RFC3339)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_deferredMaintenanceWindow(rName, startTime.Format(time.RFC3339), endTime2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "deferred_maintenance_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deferred_maintenance_window.0.end_time", endTime2),
+				),
+			},
+			{
+				Config: testAccClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "deferred_maintenance_window.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
 
@@ -1278,3 +1361,43 @@ resource "aws_redshift_cluster" "test" {
 }
 `, rName))
 }
+
+func testAccClusterConfig_multiAZ(rName string, multiAZ bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "ra3.xlplus"
+  number_of_nodes                     = 2
+  encrypted                           = true
+  publicly_accessible                 = false
+  multi_az                            = %[2]t
+  automated_snapshot_retention_period = 1
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rName, multiAZ))
+}
+
+func testAccClusterConfig_deferredMaintenanceWindow(rName, startTime, endTime string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc2.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+
+  deferred_maintenance_window {
+    start_time = %[2]q
+    end_time   = %[3]q
+  }
+}
+`, rName, startTime, endTime))
+}
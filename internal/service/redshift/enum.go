@@ -25,6 +25,10 @@ const (
 	clusterStatusUpdatingHSM            = "updating-hsm"
 )
 
+const (
+	multiAZEnabled = "Enabled"
+)
+
 const (
 	clusterTypeMultiNode  = "multi-node"
 	clusterTypeSingleNode = "single-node"
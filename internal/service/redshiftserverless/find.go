@@ -0,0 +1,72 @@
+package redshiftserverless
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindScheduledActionByName(conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.ScheduledActionResponse, error) {
+	input := &redshiftserverless.GetScheduledActionInput{
+		ScheduledActionName: aws.String(name),
+	}
+
+	output, err := conn.GetScheduledAction(input)
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ScheduledAction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ScheduledAction, nil
+}
+
+func FindSnapshotCopyConfigurationByID(conn *redshiftserverless.RedshiftServerless, id string) (*redshiftserverless.SnapshotCopyConfiguration, error) {
+	input := &redshiftserverless.ListSnapshotCopyConfigurationsInput{}
+	var output *redshiftserverless.SnapshotCopyConfiguration
+
+	err := conn.ListSnapshotCopyConfigurationsPages(input, func(page *redshiftserverless.ListSnapshotCopyConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SnapshotCopyConfigurations {
+			if v != nil && aws.StringValue(v.SnapshotCopyConfigurationId) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
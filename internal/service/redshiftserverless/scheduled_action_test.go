@@ -0,0 +1,189 @@
+package redshiftserverless_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccPreCheckNamespace(t *testing.T) string {
+	namespaceName := os.Getenv("AWS_REDSHIFTSERVERLESS_NAMESPACE_NAME")
+
+	if namespaceName == "" {
+		t.Skip("Environment variable AWS_REDSHIFTSERVERLESS_NAMESPACE_NAME is not set")
+	}
+
+	return namespaceName
+}
+
+func TestAccRedshiftServerlessScheduledAction_basic(t *testing.T) {
+	namespaceName := testAccPreCheckNamespace(t)
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_basic(rName, namespaceName, "cron(00 * * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "namespace_name", namespaceName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron", "cron(00 * * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.0.snapshot_name_prefix", "tf-acc-test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledActionConfig_basic(rName, namespaceName, "cron(30 * * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron", "cron(30 * * * ? *)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessScheduledAction_disappears(t *testing.T) {
+	namespaceName := testAccPreCheckNamespace(t)
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_basic(rName, namespaceName, "cron(00 * * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshiftserverless.ResourceScheduledAction(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledActionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshiftserverless_scheduled_action" {
+			continue
+		}
+
+		_, err := tfredshiftserverless.FindScheduledActionByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Serverless Scheduled Action %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckScheduledActionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Serverless Scheduled Action ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+		_, err := tfredshiftserverless.FindScheduledActionByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccScheduledActionConfig_basic(rName, namespaceName, cron string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "scheduler.redshift.${data.aws_partition.current.dns_suffix}",
+          "redshift-serverless.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+  })
+
+  inline_policy {
+    name = %[1]q
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action   = "redshift-serverless:CreateSnapshot"
+        Effect   = "Allow"
+        Resource = "*"
+      }]
+    })
+  }
+}
+
+resource "aws_redshiftserverless_scheduled_action" "test" {
+  name           = %[1]q
+  namespace_name = %[2]q
+  role_arn       = aws_iam_role.test.arn
+
+  schedule {
+    cron = %[3]q
+  }
+
+  target_action {
+    create_snapshot {
+      snapshot_name_prefix = "tf-acc-test"
+    }
+  }
+}
+`, rName, namespaceName, cron)
+}
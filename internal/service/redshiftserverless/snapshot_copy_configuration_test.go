@@ -0,0 +1,126 @@
+package redshiftserverless_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftServerlessSnapshotCopyConfiguration_basic(t *testing.T) {
+	namespaceName := testAccPreCheckNamespace(t)
+	resourceName := "aws_redshiftserverless_snapshot_copy_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotCopyConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfigurationConfig_basic(namespaceName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCopyConfigurationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "redshift-serverless", regexp.MustCompile(`snapshotcopyconfiguration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "destination_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "namespace_name", namespaceName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_period", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSnapshotCopyConfigurationConfig_basic(namespaceName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCopyConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_period", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessSnapshotCopyConfiguration_disappears(t *testing.T) {
+	namespaceName := testAccPreCheckNamespace(t)
+	resourceName := "aws_redshiftserverless_snapshot_copy_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotCopyConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfigurationConfig_basic(namespaceName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCopyConfigurationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshiftserverless.ResourceSnapshotCopyConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotCopyConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshiftserverless_snapshot_copy_configuration" {
+			continue
+		}
+
+		_, err := tfredshiftserverless.FindSnapshotCopyConfigurationByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Serverless Snapshot Copy Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSnapshotCopyConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Serverless Snapshot Copy Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+		_, err := tfredshiftserverless.FindSnapshotCopyConfigurationByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSnapshotCopyConfigurationConfig_basic(namespaceName string, retentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_snapshot_copy_configuration" "test" {
+  namespace_name            = %[1]q
+  destination_region        = %[2]q
+  snapshot_retention_period = %[3]d
+}
+`, namespaceName, acctest.AlternateRegion(), retentionPeriod)
+}
@@ -0,0 +1,252 @@
+package route53
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+const (
+	// Route 53 accepts up to 1,000 changes in a single ChangeResourceRecordSets request.
+	maxChangesPerChangeBatch = 1000
+
+	// Route 53 accepts up to 1,000 ResourceRecord elements, with a combined length of their values of
+	// up to 32,000 characters, in a single ChangeResourceRecordSets request. Those of UPSERT changes count twice.
+	// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+	maxResourceRecordsPerChangeBatch = 1000
+	maxValueLengthPerChangeBatch     = 32000
+
+	// Route 53 accepts change batch comments of up to 256 characters.
+	maxChangeBatchCommentLength = 256
+)
+
+var defaultChangeBatcher = newChangeBatcher(func(conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	return conn.ChangeResourceRecordSets(input)
+})
+
+type changeBatchSendFunc func(*route53.Route53, *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
+
+type changeBatchKey struct {
+	conn         *route53.Route53
+	hostedZoneID string
+}
+
+type changeBatchRequest struct {
+	input  *route53.ChangeResourceRecordSetsInput
+	result chan changeBatchResult
+}
+
+type changeBatchResult struct {
+	output *route53.ChangeResourceRecordSetsOutput
+	err    error
+}
+
+// changeBatcher coalesces concurrent ChangeResourceRecordSets requests for the
+// same hosted zone into as few API calls as possible. Terraform applies
+// independent aws_route53_record resources in parallel, so requests arriving
+// within the batch window are combined into a single change batch.
+//
+// A request for a hosted zone with no open batch window is sent immediately
+// and opens a window. Requests arriving while the window is open are held
+// and sent together when it closes, so only changes made in quick succession
+// wait for up to the window's length.
+type changeBatcher struct {
+	send changeBatchSendFunc
+
+	mu      sync.Mutex
+	pending map[changeBatchKey][]*changeBatchRequest
+}
+
+func newChangeBatcher(send changeBatchSendFunc) *changeBatcher {
+	return &changeBatcher{
+		send:    send,
+		pending: make(map[changeBatchKey][]*changeBatchRequest),
+	}
+}
+
+// changeResourceRecordSets submits the input's changes, possibly together with
+// other pending changes for the same hosted zone, and blocks until the changes
+// have been submitted. A window of zero disables batching.
+// All requests in a successful batch share the same ChangeInfo.
+func (b *changeBatcher) changeResourceRecordSets(conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput, window time.Duration) (*route53.ChangeResourceRecordSetsOutput, error) {
+	if window <= 0 || input.ChangeBatch == nil {
+		return b.send(conn, input)
+	}
+
+	key := changeBatchKey{
+		conn:         conn,
+		hostedZoneID: aws.StringValue(input.HostedZoneId),
+	}
+
+	b.mu.Lock()
+	requests, ok := b.pending[key]
+	if !ok {
+		// Nothing has been submitted for the hosted zone recently: send now and
+		// hold any requests that follow until the window closes.
+		b.pending[key] = nil
+		time.AfterFunc(window, func() { b.flush(key) })
+		b.mu.Unlock()
+
+		return b.send(conn, input)
+	}
+	request := &changeBatchRequest{
+		input:  input,
+		result: make(chan changeBatchResult, 1),
+	}
+	b.pending[key] = append(requests, request)
+	b.mu.Unlock()
+
+	result := <-request.result
+
+	return result.output, result.err
+}
+
+func (b *changeBatcher) flush(key changeBatchKey) {
+	b.mu.Lock()
+	requests := b.pending[key]
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	for len(requests) > 0 {
+		n, size := 0, changeBatchSize{}
+		for _, request := range requests {
+			s := size.add(changeBatchSizeOf(request.input.ChangeBatch.Changes))
+			if n > 0 && s.exceedsLimits() {
+				break
+			}
+			n++
+			size = s
+		}
+
+		b.submit(key, requests[:n])
+		requests = requests[n:]
+	}
+}
+
+func (b *changeBatcher) submit(key changeBatchKey, requests []*changeBatchRequest) {
+	if len(requests) == 1 {
+		output, err := b.send(key.conn, requests[0].input)
+		requests[0].result <- changeBatchResult{output: output, err: err}
+		return
+	}
+
+	var changes []*route53.Change
+	for _, request := range requests {
+		changes = append(changes, request.input.ChangeBatch.Changes...)
+	}
+
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: changeBatchComment(requests),
+			Changes: changes,
+		},
+		HostedZoneId: aws.String(key.hostedZoneID),
+	}
+
+	log.Printf("[DEBUG] Submitting %d batched changes (%d requests) for Route 53 Hosted Zone (%s)", len(changes), len(requests), key.hostedZoneID)
+	output, err := b.send(key.conn, input)
+
+	if isChangeBatchRejected(err) {
+		// A single invalid change fails the whole batch.
+		// Submit each request on its own so that errors are reported against the resource that caused them.
+		log.Printf("[WARN] Batched changes for Route 53 Hosted Zone (%s) failed, submitting individually: %s", key.hostedZoneID, err)
+
+		for _, request := range requests {
+			output, err := b.send(key.conn, request.input)
+			request.result <- changeBatchResult{output: output, err: err}
+		}
+
+		return
+	}
+
+	// Any other error, e.g. throttling once the SDK's retries are exhausted, applies to every request
+	// and is returned as is so that callers handle it as they would for an unbatched request.
+	for _, request := range requests {
+		request.result <- changeBatchResult{output: output, err: err}
+	}
+}
+
+// changeBatchSize is the size of a change batch as measured by the ChangeResourceRecordSets request limits.
+type changeBatchSize struct {
+	changes         int
+	resourceRecords int
+	valueLength     int
+}
+
+func changeBatchSizeOf(changes []*route53.Change) changeBatchSize {
+	size := changeBatchSize{changes: len(changes)}
+
+	for _, change := range changes {
+		if change == nil || change.ResourceRecordSet == nil {
+			continue
+		}
+
+		n := 1
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			n = 2
+		}
+
+		for _, record := range change.ResourceRecordSet.ResourceRecords {
+			size.resourceRecords += n
+			size.valueLength += n * len(aws.StringValue(record.Value))
+		}
+	}
+
+	return size
+}
+
+func (s changeBatchSize) add(other changeBatchSize) changeBatchSize {
+	return changeBatchSize{
+		changes:         s.changes + other.changes,
+		resourceRecords: s.resourceRecords + other.resourceRecords,
+		valueLength:     s.valueLength + other.valueLength,
+	}
+}
+
+func (s changeBatchSize) exceedsLimits() bool {
+	return s.changes > maxChangesPerChangeBatch ||
+		s.resourceRecords > maxResourceRecordsPerChangeBatch ||
+		s.valueLength > maxValueLengthPerChangeBatch
+}
+
+// isChangeBatchRejected returns whether the error indicates that Route 53 rejected the
+// contents of the change batch, e.g. a change that conflicts with an existing record.
+func isChangeBatchRejected(err error) bool {
+	return tfawserr.ErrCodeEquals(err, route53.ErrCodeInvalidChangeBatch, route53.ErrCodeInvalidInput)
+}
+
+// changeBatchComment returns the distinct comments of the batched requests joined together.
+func changeBatchComment(requests []*changeBatchRequest) *string {
+	var comments []string
+	seen := make(map[string]bool)
+
+	for _, request := range requests {
+		comment := aws.StringValue(request.input.ChangeBatch.Comment)
+
+		if comment == "" || seen[comment] {
+			continue
+		}
+
+		seen[comment] = true
+		comments = append(comments, comment)
+	}
+
+	if len(comments) == 0 {
+		return nil
+	}
+
+	sort.Strings(comments)
+	comment := strings.Join(comments, "; ")
+
+	if len(comment) > maxChangeBatchCommentLength {
+		comment = comment[:maxChangeBatchCommentLength]
+	}
+
+	return aws.String(comment)
+}
@@ -0,0 +1,405 @@
+package route53
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+type testChangeBatchSender struct {
+	mu     sync.Mutex
+	inputs []*route53.ChangeResourceRecordSetsInput
+	fail   func(*route53.ChangeResourceRecordSetsInput) error
+}
+
+func (s *testChangeBatchSender) send(conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inputs = append(s.inputs, input)
+
+	if s.fail != nil {
+		if err := s.fail(input); err != nil {
+			return nil, err
+		}
+	}
+
+	return &route53.ChangeResourceRecordSetsOutput{
+		ChangeInfo: &route53.ChangeInfo{
+			Id: aws.String(fmt.Sprintf("C%d", len(s.inputs))),
+		},
+	}, nil
+}
+
+func (s *testChangeBatchSender) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inputs = nil
+}
+
+const testChangeBatchWindowOpener = "opener.example.com"
+
+// testChangeBatcherOpenWindow submits a request for each hosted zone so that the requests that follow are held
+// until the batch window closes.
+func testChangeBatcherOpenWindow(t *testing.T, b *changeBatcher, window time.Duration, hostedZoneIDs ...string) {
+	for _, hostedZoneID := range hostedZoneIDs {
+		if _, err := b.changeResourceRecordSets(nil, testChangeBatchInput(hostedZoneID, testChangeBatchWindowOpener), window); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func testChangeBatchInput(hostedZoneID string, names ...string) *route53.ChangeResourceRecordSetsInput {
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch:  &route53.ChangeBatch{},
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+
+	for _, name := range names {
+		input.ChangeBatch.Changes = append(input.ChangeBatch.Changes, &route53.Change{
+			Action: aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: aws.String(name),
+				Type: aws.String(route53.RRTypeA),
+			},
+		})
+	}
+
+	return input
+}
+
+func testChangeBatcherSubmitAll(b *changeBatcher, window time.Duration, inputs []*route53.ChangeResourceRecordSetsInput) ([]*route53.ChangeResourceRecordSetsOutput, []error) {
+	outputs := make([]*route53.ChangeResourceRecordSetsOutput, len(inputs))
+	errs := make([]error, len(inputs))
+
+	var wg sync.WaitGroup
+	for i, input := range inputs {
+		wg.Add(1)
+		go func(i int, input *route53.ChangeResourceRecordSetsInput) {
+			defer wg.Done()
+			outputs[i], errs[i] = b.changeResourceRecordSets(nil, input, window)
+		}(i, input)
+	}
+	wg.Wait()
+
+	return outputs, errs
+}
+
+func TestChangeBatcher_disabled(t *testing.T) {
+	sender := &testChangeBatchSender{}
+	b := newChangeBatcher(sender.send)
+
+	_, errs := testChangeBatcherSubmitAll(b, 0, []*route53.ChangeResourceRecordSetsInput{
+		testChangeBatchInput("Z1", "a.example.com"),
+		testChangeBatchInput("Z1", "b.example.com"),
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := len(sender.inputs), 2; got != want {
+		t.Errorf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+}
+
+func TestChangeBatcher_loneRequestNotDelayed(t *testing.T) {
+	sender := &testChangeBatchSender{}
+	b := newChangeBatcher(sender.send)
+	input := testChangeBatchInput("Z1", "a.example.com")
+	input.ChangeBatch.Comment = aws.String("Deleted by Terraform")
+
+	start := time.Now()
+	_, errs := testChangeBatcherSubmitAll(b, 1*time.Hour, []*route53.ChangeResourceRecordSetsInput{input})
+
+	if err := errs[0]; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("request took %s, want it to be sent without waiting for the batch window", elapsed)
+	}
+
+	if got, want := len(sender.inputs), 1; got != want {
+		t.Fatalf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+
+	if got, want := aws.StringValue(sender.inputs[0].ChangeBatch.Comment), "Deleted by Terraform"; got != want {
+		t.Errorf("got comment %q, want %q", got, want)
+	}
+}
+
+func TestChangeBatcher_comments(t *testing.T) {
+	sender := &testChangeBatchSender{}
+	b := newChangeBatcher(sender.send)
+	window := 100 * time.Millisecond
+
+	testChangeBatcherOpenWindow(t, b, window, "Z1")
+	sender.reset()
+
+	var inputs []*route53.ChangeResourceRecordSetsInput
+	for i, comment := range []string{"Managed by Terraform", "Deleted by Terraform", "Managed by Terraform", ""} {
+		input := testChangeBatchInput("Z1", fmt.Sprintf("r%d.example.com", i))
+		if comment != "" {
+			input.ChangeBatch.Comment = aws.String(comment)
+		}
+		inputs = append(inputs, input)
+	}
+
+	_, errs := testChangeBatcherSubmitAll(b, window, inputs)
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := len(sender.inputs), 1; got != want {
+		t.Fatalf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+
+	if got, want := aws.StringValue(sender.inputs[0].ChangeBatch.Comment), "Deleted by Terraform; Managed by Terraform"; got != want {
+		t.Errorf("got comment %q, want %q", got, want)
+	}
+}
+
+func TestChangeBatcher_coalescesSameZone(t *testing.T) {
+	sender := &testChangeBatchSender{}
+	b := newChangeBatcher(sender.send)
+	window := 100 * time.Millisecond
+
+	testChangeBatcherOpenWindow(t, b, window, "Z1", "Z2")
+	sender.reset()
+
+	outputs, errs := testChangeBatcherSubmitAll(b, window, []*route53.ChangeResourceRecordSetsInput{
+		testChangeBatchInput("Z1", "a.example.com"),
+		testChangeBatchInput("Z1", "b.example.com", "c.example.com"),
+		testChangeBatchInput("Z1", "d.example.com"),
+		testChangeBatchInput("Z2", "e.example.org"),
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := len(sender.inputs), 2; got != want {
+		t.Fatalf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+
+	for _, input := range sender.inputs {
+		switch zoneID := aws.StringValue(input.HostedZoneId); zoneID {
+		case "Z1":
+			if got, want := len(input.ChangeBatch.Changes), 4; got != want {
+				t.Errorf("got %d changes for hosted zone %s, want %d", got, zoneID, want)
+			}
+		case "Z2":
+			if got, want := len(input.ChangeBatch.Changes), 1; got != want {
+				t.Errorf("got %d changes for hosted zone %s, want %d", got, zoneID, want)
+			}
+		default:
+			t.Errorf("unexpected hosted zone %s", zoneID)
+		}
+	}
+
+	for i := 1; i < 3; i++ {
+		if got, want := aws.StringValue(outputs[i].ChangeInfo.Id), aws.StringValue(outputs[0].ChangeInfo.Id); got != want {
+			t.Errorf("got change ID %s for request %d, want %s", got, i, want)
+		}
+	}
+}
+
+func TestChangeBatcher_fallbackOnError(t *testing.T) {
+	sender := &testChangeBatchSender{
+		fail: func(input *route53.ChangeResourceRecordSetsInput) error {
+			for _, change := range input.ChangeBatch.Changes {
+				if aws.StringValue(change.ResourceRecordSet.Name) == "bad.example.com" {
+					return awserr.New(route53.ErrCodeInvalidChangeBatch, "Tried to create resource record set but it already exists", nil)
+				}
+			}
+			return nil
+		},
+	}
+	b := newChangeBatcher(sender.send)
+	window := 100 * time.Millisecond
+
+	testChangeBatcherOpenWindow(t, b, window, "Z1")
+	sender.reset()
+
+	_, errs := testChangeBatcherSubmitAll(b, window, []*route53.ChangeResourceRecordSetsInput{
+		testChangeBatchInput("Z1", "a.example.com"),
+		testChangeBatchInput("Z1", "bad.example.com"),
+		testChangeBatchInput("Z1", "c.example.com"),
+	})
+
+	for i, err := range errs {
+		if i == 1 {
+			if err == nil {
+				t.Errorf("expected error for request %d", i)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for request %d: %s", i, err)
+		}
+	}
+
+	// One failed batch followed by three individual requests.
+	if got, want := len(sender.inputs), 4; got != want {
+		t.Errorf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+}
+
+func TestChangeBatcher_noFallbackOnThrottling(t *testing.T) {
+	sender := &testChangeBatchSender{
+		fail: func(input *route53.ChangeResourceRecordSetsInput) error {
+			if aws.StringValue(input.ChangeBatch.Changes[0].ResourceRecordSet.Name) == testChangeBatchWindowOpener {
+				return nil
+			}
+			return awserr.New("Throttling", "Rate exceeded", nil)
+		},
+	}
+	b := newChangeBatcher(sender.send)
+	window := 100 * time.Millisecond
+
+	testChangeBatcherOpenWindow(t, b, window, "Z1")
+	sender.reset()
+
+	_, errs := testChangeBatcherSubmitAll(b, window, []*route53.ChangeResourceRecordSetsInput{
+		testChangeBatchInput("Z1", "a.example.com"),
+		testChangeBatchInput("Z1", "b.example.com"),
+		testChangeBatchInput("Z1", "c.example.com"),
+	})
+
+	for i, err := range errs {
+		if !tfawserr.ErrCodeEquals(err, "Throttling") {
+			t.Errorf("expected Throttling error for request %d, got: %v", i, err)
+		}
+	}
+
+	// The throttled batch is not resubmitted as individual requests.
+	if got, want := len(sender.inputs), 1; got != want {
+		t.Errorf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+}
+
+func TestChangeBatcher_maxChanges(t *testing.T) {
+	sender := &testChangeBatchSender{}
+	b := newChangeBatcher(sender.send)
+	window := 100 * time.Millisecond
+
+	testChangeBatcherOpenWindow(t, b, window, "Z1")
+	sender.reset()
+
+	var inputs []*route53.ChangeResourceRecordSetsInput
+	for i := 0; i < maxChangesPerChangeBatch+1; i++ {
+		inputs = append(inputs, testChangeBatchInput("Z1", fmt.Sprintf("r%d.example.com", i)))
+	}
+
+	_, errs := testChangeBatcherSubmitAll(b, window, inputs)
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := len(sender.inputs), 2; got != want {
+		t.Fatalf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+
+	for _, input := range sender.inputs {
+		if got := len(input.ChangeBatch.Changes); got > maxChangesPerChangeBatch {
+			t.Errorf("got %d changes in a single batch, want at most %d", got, maxChangesPerChangeBatch)
+		}
+	}
+}
+
+func TestChangeBatcher_maxResourceRecords(t *testing.T) {
+	sender := &testChangeBatchSender{}
+	b := newChangeBatcher(sender.send)
+	window := 100 * time.Millisecond
+
+	testChangeBatcherOpenWindow(t, b, window, "Z1")
+	sender.reset()
+
+	// Each UPSERT of 3 records counts as 6 ResourceRecord elements.
+	var inputs []*route53.ChangeResourceRecordSetsInput
+	for i := 0; i < 200; i++ {
+		input := testChangeBatchInput("Z1", fmt.Sprintf("r%d.example.com", i))
+		input.ChangeBatch.Changes[0].ResourceRecordSet.ResourceRecords = []*route53.ResourceRecord{
+			{Value: aws.String("192.0.2.1")},
+			{Value: aws.String("192.0.2.2")},
+			{Value: aws.String("192.0.2.3")},
+		}
+		inputs = append(inputs, input)
+	}
+
+	_, errs := testChangeBatcherSubmitAll(b, window, inputs)
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := len(sender.inputs), 2; got != want {
+		t.Fatalf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+
+	for _, input := range sender.inputs {
+		if got := changeBatchSizeOf(input.ChangeBatch.Changes); got.exceedsLimits() {
+			t.Errorf("got batch of %d ResourceRecord elements, want at most %d", got.resourceRecords, maxResourceRecordsPerChangeBatch)
+		}
+	}
+}
+
+func TestChangeBatcher_maxValueLength(t *testing.T) {
+	sender := &testChangeBatchSender{}
+	b := newChangeBatcher(sender.send)
+	window := 100 * time.Millisecond
+
+	testChangeBatcherOpenWindow(t, b, window, "Z1")
+	sender.reset()
+
+	// Each UPSERT of a 4,000 character TXT value counts as 8,000 characters.
+	var inputs []*route53.ChangeResourceRecordSetsInput
+	for i := 0; i < 5; i++ {
+		input := testChangeBatchInput("Z1", fmt.Sprintf("r%d.example.com", i))
+		input.ChangeBatch.Changes[0].ResourceRecordSet.Type = aws.String(route53.RRTypeTxt)
+		input.ChangeBatch.Changes[0].ResourceRecordSet.ResourceRecords = []*route53.ResourceRecord{
+			{Value: aws.String(strings.Repeat("a", 4000))},
+		}
+		inputs = append(inputs, input)
+	}
+
+	_, errs := testChangeBatcherSubmitAll(b, window, inputs)
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := len(sender.inputs), 2; got != want {
+		t.Fatalf("got %d ChangeResourceRecordSets calls, want %d", got, want)
+	}
+
+	for _, input := range sender.inputs {
+		if got := changeBatchSizeOf(input.ChangeBatch.Changes); got.exceedsLimits() {
+			t.Errorf("got batch with values of %d characters, want at most %d", got.valueLength, maxValueLengthPerChangeBatch)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package route53
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCIDRCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCIDRCollectionCreate,
+		Read:   resourceCIDRCollectionRead,
+		Delete: resourceCIDRCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_\-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCIDRCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	name := d.Get("name").(string)
+	input := &route53.CreateCidrCollectionInput{
+		CallerReference: aws.String(resource.UniqueId()),
+		Name:            aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 CIDR Collection: %s", input)
+	output, err := conn.CreateCidrCollection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 CIDR Collection (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Collection.Id))
+
+	return resourceCIDRCollectionRead(d, meta)
+}
+
+func resourceCIDRCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	output, err := FindCIDRCollectionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 CIDR Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 CIDR Collection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("name", output.Name)
+	d.Set("version", output.Version)
+
+	return nil
+}
+
+func resourceCIDRCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	log.Printf("[DEBUG] Deleting Route 53 CIDR Collection: %s", d.Id())
+	_, err := conn.DeleteCidrCollection(&route53.DeleteCidrCollectionInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 CIDR Collection (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package route53
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCIDRLocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCIDRLocationCreate,
+		Read:   resourceCIDRLocationRead,
+		Update: resourceCIDRLocationUpdate,
+		Delete: resourceCIDRLocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"cidr_collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 16),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_\-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+		},
+	}
+}
+
+func resourceCIDRLocationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID := d.Get("cidr_collection_id").(string)
+	name := d.Get("name").(string)
+	id := CIDRLocationCreateResourceID(collectionID, name)
+	input := &route53.ChangeCidrCollectionInput{
+		Changes: []*route53.CidrCollectionChange{{
+			Action:       aws.String(route53.CidrCollectionChangeActionPut),
+			CidrList:     flex.ExpandStringSet(d.Get("cidr_blocks").(*schema.Set)),
+			LocationName: aws.String(name),
+		}},
+		Id: aws.String(collectionID),
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 CIDR Location: %s", input)
+	_, err := conn.ChangeCidrCollection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 CIDR Location (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceCIDRLocationRead(d, meta)
+}
+
+func resourceCIDRLocationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	cidrBlocks, err := FindCIDRLocationByTwoPartKey(conn, collectionID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 CIDR Location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 CIDR Location (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cidr_blocks", cidrBlocks)
+	d.Set("cidr_collection_id", collectionID)
+	d.Set("name", name)
+
+	return nil
+}
+
+func resourceCIDRLocationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("cidr_blocks")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	if add := ns.Difference(os); add.Len() > 0 {
+		input := &route53.ChangeCidrCollectionInput{
+			Changes: []*route53.CidrCollectionChange{{
+				Action:       aws.String(route53.CidrCollectionChangeActionPut),
+				CidrList:     flex.ExpandStringSet(add),
+				LocationName: aws.String(name),
+			}},
+			Id: aws.String(collectionID),
+		}
+
+		log.Printf("[DEBUG] Adding Route 53 CIDR Location CIDR blocks: %s", input)
+		_, err := conn.ChangeCidrCollection(input)
+
+		if err != nil {
+			return fmt.Errorf("error adding CIDR blocks to Route 53 CIDR Location (%s): %w", d.Id(), err)
+		}
+	}
+
+	if del := os.Difference(ns); del.Len() > 0 {
+		input := &route53.ChangeCidrCollectionInput{
+			Changes: []*route53.CidrCollectionChange{{
+				Action:       aws.String(route53.CidrCollectionChangeActionDeleteIfExists),
+				CidrList:     flex.ExpandStringSet(del),
+				LocationName: aws.String(name),
+			}},
+			Id: aws.String(collectionID),
+		}
+
+		log.Printf("[DEBUG] Removing Route 53 CIDR Location CIDR blocks: %s", input)
+		_, err := conn.ChangeCidrCollection(input)
+
+		if err != nil {
+			return fmt.Errorf("error removing CIDR blocks from Route 53 CIDR Location (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceCIDRLocationRead(d, meta)
+}
+
+func resourceCIDRLocationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 CIDR Location: %s", d.Id())
+	_, err = conn.ChangeCidrCollection(&route53.ChangeCidrCollectionInput{
+		Changes: []*route53.CidrCollectionChange{{
+			Action:       aws.String(route53.CidrCollectionChangeActionDeleteIfExists),
+			CidrList:     flex.ExpandStringSet(d.Get("cidr_blocks").(*schema.Set)),
+			LocationName: aws.String(name),
+		}},
+		Id: aws.String(collectionID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException, route53.ErrCodeNoSuchCidrLocationException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 CIDR Location (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
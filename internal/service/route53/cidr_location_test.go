@@ -0,0 +1,152 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRoute53CIDRLocation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := sdkacctest.RandString(16)
+	resourceName := "aws_route53_cidr_location.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCIDRLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCIDRLocationConfig(rName, locationName, `"200.5.3.0/24", "200.6.3.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCIDRLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.5.3.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.6.3.0/24"),
+					resource.TestCheckResourceAttrPair(resourceName, "cidr_collection_id", "aws_route53_cidr_collection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", locationName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCIDRLocationConfig(rName, locationName, `"200.5.2.0/24", "200.6.3.0/24", "2001:db8::/48"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCIDRLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.5.2.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.6.3.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "2001:db8::/48"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53CIDRLocation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := sdkacctest.RandString(16)
+	resourceName := "aws_route53_cidr_location.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCIDRLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCIDRLocationConfig(rName, locationName, `"200.5.3.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCIDRLocationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfroute53.ResourceCIDRLocation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCIDRLocationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_cidr_location" {
+			continue
+		}
+
+		collectionID, name, err := tfroute53.CIDRLocationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfroute53.FindCIDRLocationByTwoPartKey(conn, collectionID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 CIDR Location %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCIDRLocationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 CIDR Location ID is set")
+		}
+
+		collectionID, name, err := tfroute53.CIDRLocationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		_, err = tfroute53.FindCIDRLocationByTwoPartKey(conn, collectionID, name)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCIDRLocationConfig(rName, locationName, cidrBlocks string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_cidr_location" "test" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = %[2]q
+  cidr_blocks        = [%[3]s]
+}
+`, rName, locationName, cidrBlocks)
+}
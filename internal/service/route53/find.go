@@ -10,6 +10,84 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func FindCIDRCollectionByID(conn *route53.Route53, id string) (*route53.CollectionSummary, error) {
+	input := &route53.ListCidrCollectionsInput{}
+	var output *route53.CollectionSummary
+
+	err := conn.ListCidrCollectionsPages(input, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Id) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindCIDRLocationByTwoPartKey(conn *route53.Route53, collectionID, locationName string) ([]string, error) {
+	input := &route53.ListCidrBlocksInput{
+		CollectionId: aws.String(collectionID),
+		LocationName: aws.String(locationName),
+	}
+	var output []string
+
+	err := conn.ListCidrBlocksPages(input, func(page *route53.ListCidrBlocksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrBlocks {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, aws.StringValue(v.CidrBlock))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException, route53.ErrCodeNoSuchCidrLocationException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindHealthCheckByID(conn *route53.Route53, id string) (*route53.HealthCheck, error) {
 	input := &route53.GetHealthCheckInput{
 		HealthCheckId: aws.String(id),
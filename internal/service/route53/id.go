@@ -5,6 +5,25 @@ import (
 	"strings"
 )
 
+const CIDRLocationResourceIDSeparator = ","
+
+func CIDRLocationCreateResourceID(collectionID, locationName string) string {
+	parts := []string{collectionID, locationName}
+	id := strings.Join(parts, CIDRLocationResourceIDSeparator)
+
+	return id
+}
+
+func CIDRLocationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, CIDRLocationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected collection-id%[2]slocation-name", id, CIDRLocationResourceIDSeparator)
+}
+
 const KeySigningKeyResourceIDSeparator = ","
 
 func KeySigningKeyCreateResourceID(transitGatewayRouteTableID string, prefixListID string) string {
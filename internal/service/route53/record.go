@@ -117,10 +117,36 @@ func ResourceRecord() *schema.Resource {
 				Set: resourceAliasRecordHash,
 			},
 
+			"cidr_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"failover_routing_policy",
+					"geolocation_routing_policy",
+					"latency_routing_policy",
+					"multivalue_answer_routing_policy",
+					"weighted_routing_policy",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"collection_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"location_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"failover_routing_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
@@ -147,6 +173,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"weighted_routing_policy",
@@ -166,6 +193,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
@@ -193,6 +221,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
@@ -212,6 +241,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
@@ -290,6 +320,20 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Likewise for a cidr_routing_policy.
+	if v, _ := d.GetChange("cidr_routing_policy"); v != nil {
+		if o, ok := v.([]interface{}); ok {
+			if len(o) == 1 {
+				if v, ok := o[0].(map[string]interface{}); ok {
+					oldRec.CidrRoutingConfig = &route53.CidrRoutingConfig{
+						CollectionId: aws.String(v["collection_id"].(string)),
+						LocationName: aws.String(v["location_name"].(string)),
+					}
+				}
+			}
+		}
+	}
+
 	if v, _ := d.GetChange("ttl"); v.(int) != 0 {
 		oldRec.TTL = aws.Int64(int64(v.(int)))
 	}
@@ -355,7 +399,7 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating resource records for zone: %s, name: %s\n\n%s",
 		zone, aws.StringValue(rec.Name), input)
 
-	respRaw, err := ChangeRecordSet(conn, input)
+	respRaw, err := ChangeRecordSet(conn, input, meta.(*conns.AWSClient).Route53ChangeBatchWindow)
 	if err != nil {
 		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
 	}
@@ -433,7 +477,7 @@ func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Creating resource records for zone: %s, name: %s\n\n%s",
 		zone, aws.StringValue(rec.Name), req)
 
-	respRaw, err := ChangeRecordSet(conn, req)
+	respRaw, err := ChangeRecordSet(conn, req, meta.(*conns.AWSClient).Route53ChangeBatchWindow)
 	if err != nil {
 		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
 	}
@@ -461,11 +505,11 @@ func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
-func ChangeRecordSet(conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (interface{}, error) {
+func ChangeRecordSet(conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput, batchWindow time.Duration) (interface{}, error) {
 	var out *route53.ChangeResourceRecordSetsOutput
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 		var err error
-		out, err = conn.ChangeResourceRecordSets(input)
+		out, err = defaultChangeBatcher.changeResourceRecordSets(conn, input, batchWindow)
 		if tfawserr.ErrMessageContains(err, route53.ErrCodeNoSuchHostedZone, "") {
 			log.Print("[DEBUG] Hosted Zone not found, retrying...")
 			return resource.RetryableError(err)
@@ -476,7 +520,7 @@ func ChangeRecordSet(conn *route53.Route53, input *route53.ChangeResourceRecordS
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		out, err = conn.ChangeResourceRecordSets(input)
+		out, err = defaultChangeBatcher.changeResourceRecordSets(conn, input, batchWindow)
 	}
 
 	return out, err
@@ -551,6 +595,16 @@ func resourceRecordRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("ttl", record.TTL)
 
+	if record.CidrRoutingConfig != nil {
+		v := []map[string]interface{}{{
+			"collection_id": aws.StringValue(record.CidrRoutingConfig.CollectionId),
+			"location_name": aws.StringValue(record.CidrRoutingConfig.LocationName),
+		}}
+		if err := d.Set("cidr_routing_policy", v); err != nil {
+			return fmt.Errorf("Error setting CIDR records for: %s, error: %w", d.Id(), err)
+		}
+	}
+
 	if record.Failover != nil {
 		v := []map[string]interface{}{{
 			"type": aws.StringValue(record.Failover),
@@ -749,7 +803,7 @@ func resourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
 		ChangeBatch:  changeBatch,
 	}
 
-	respRaw, err := DeleteRecordSet(conn, req)
+	respRaw, err := DeleteRecordSet(conn, req, meta.(*conns.AWSClient).Route53ChangeBatchWindow)
 	if err != nil {
 		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
 	}
@@ -764,8 +818,8 @@ func resourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
-func DeleteRecordSet(conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (interface{}, error) {
-	out, err := conn.ChangeResourceRecordSets(input)
+func DeleteRecordSet(conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput, batchWindow time.Duration) (interface{}, error) {
+	out, err := defaultChangeBatcher.changeResourceRecordSets(conn, input, batchWindow)
 	if tfawserr.ErrMessageContains(err, route53.ErrCodeInvalidChangeBatch, "") {
 		return out, nil
 	}
@@ -819,6 +873,18 @@ func resourceRecordBuildSet(d *schema.ResourceData, zoneName string) (*route53.R
 		}
 	}
 
+	if v, ok := d.GetOk("cidr_routing_policy"); ok {
+		if _, ok := d.GetOk("set_identifier"); !ok {
+			return nil, fmt.Errorf(`provider.aws: aws_route53_record: %s: "set_identifier": required field is not set when "cidr_routing_policy" is set`, d.Get("name").(string))
+		}
+		cidr := v.([]interface{})[0].(map[string]interface{})
+
+		rec.CidrRoutingConfig = &route53.CidrRoutingConfig{
+			CollectionId: aws.String(cidr["collection_id"].(string)),
+			LocationName: aws.String(cidr["location_name"].(string)),
+		}
+	}
+
 	if v, ok := d.GetOk("failover_routing_policy"); ok {
 		if _, ok := d.GetOk("set_identifier"); !ok {
 			return nil, fmt.Errorf(`provider.aws: aws_route53_record: %s: "set_identifier": required field is not set when "failover_routing_policy" is set`, d.Get("name").(string))
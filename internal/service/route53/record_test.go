@@ -1025,6 +1025,38 @@ func TestAccRoute53Record_MultiValueAnswer_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_CIDR_basic(t *testing.T) {
+	var record route53.ResourceRecordSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_record.test"
+	locationName := sdkacctest.RandString(16)
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53RecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53RecordConfigCIDR(rName, locationName, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cidr_routing_policy.0.collection_id", "aws_route53_cidr_collection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.0.location_name", locationName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite"},
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_doNotAllowOverwrite(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1148,7 +1180,7 @@ func testAccCheckRoute53RecordDisappears(zone *route53.GetHostedZoneOutput, reso
 			},
 		}
 
-		respRaw, err := tfroute53.DeleteRecordSet(conn, input)
+		respRaw, err := tfroute53.DeleteRecordSet(conn, input, 0)
 		if err != nil {
 			return fmt.Errorf("error deleting resource record set: %s", err)
 		}
@@ -1758,6 +1790,38 @@ resource "aws_elb" "main" {
 }
 `
 
+func testAccRoute53RecordConfigCIDR(rName, locationName, zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_cidr_location" "test" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = %[2]q
+  cidr_blocks        = ["200.5.3.0/24", "200.6.3.0/24"]
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[3]q
+}
+
+resource "aws_route53_record" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "cidr"
+  type           = "CNAME"
+  ttl            = "5"
+  records        = ["www.example.com"]
+  set_identifier = "cidr"
+
+  cidr_routing_policy {
+    collection_id = aws_route53_cidr_collection.test.id
+    location_name = aws_route53_cidr_location.test.name
+  }
+}
+`, rName, locationName, zoneName)
+}
+
 func testAccRoute53RecordConfigAliasS3(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_zone" "main" {
@@ -403,7 +403,7 @@ func deleteAllRecordsInHostedZoneId(hostedZoneId, hostedZoneName string, conn *r
 		}
 
 		var resp interface{}
-		resp, lastDeleteErr = DeleteRecordSet(conn, req)
+		resp, lastDeleteErr = DeleteRecordSet(conn, req, 0)
 		if out, ok := resp.(*route53.ChangeResourceRecordSetsOutput); ok {
 			log.Printf("[DEBUG] Waiting for change batch to become INSYNC: %#v", out)
 			if out.ChangeInfo != nil && out.ChangeInfo.Id != nil {
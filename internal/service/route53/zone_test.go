@@ -493,7 +493,7 @@ func testAccCreateRandomRoute53RecordsInZoneIdWithProvider(providerF func() *sch
 			},
 		}
 		log.Printf("[DEBUG] Change set: %s\n", *req)
-		resp, err := tfroute53.ChangeRecordSet(conn, req)
+		resp, err := tfroute53.ChangeRecordSet(conn, req, 0)
 		if err != nil {
 			return err
 		}
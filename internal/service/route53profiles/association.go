@@ -0,0 +1,132 @@
+package route53profiles
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAssociationCreate,
+		Read:   resourceAssociationRead,
+		Delete: resourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	name := d.Get("name").(string)
+	input := &route53profiles.AssociateProfileInput{
+		Name:       aws.String(name),
+		ProfileId:  aws.String(d.Get("profile_id").(string)),
+		ResourceId: aws.String(d.Get("resource_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Profile Association: %s", input)
+	output, err := conn.AssociateProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 Profile Association (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProfileAssociation.Id))
+
+	if _, err := waitProfileAssociationCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Profile Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAssociationRead(d, meta)
+}
+
+func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	association, err := FindProfileAssociationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Profile Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Profile Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", association.Name)
+	d.Set("owner_id", association.OwnerId)
+	d.Set("profile_id", association.ProfileId)
+	d.Set("resource_id", association.ResourceId)
+	d.Set("status", association.Status)
+	d.Set("status_message", association.StatusMessage)
+
+	return nil
+}
+
+func resourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	log.Printf("[DEBUG] Deleting Route 53 Profile Association: %s", d.Id())
+	_, err := conn.DisassociateProfile(&route53profiles.DisassociateProfileInput{
+		ProfileId:  aws.String(d.Get("profile_id").(string)),
+		ResourceId: aws.String(d.Get("resource_id").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53profiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 Profile Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitProfileAssociationDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Profile Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
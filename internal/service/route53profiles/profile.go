@@ -0,0 +1,180 @@
+package route53profiles
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProfileCreate,
+		Read:   resourceProfileRead,
+		Update: resourceProfileUpdate,
+		Delete: resourceProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"share_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &route53profiles.CreateProfileInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Profile: %s", input)
+	output, err := conn.CreateProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 Profile (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Profile.Id))
+
+	profile, err := waitProfileCreated(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Route 53 Profile (%s) create: %w", d.Id(), err)
+	}
+
+	if len(tags) > 0 {
+		if err := UpdateTags(conn, aws.StringValue(profile.Arn), nil, tags); err != nil {
+			return fmt.Errorf("error adding Route 53 Profile (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceProfileRead(d, meta)
+}
+
+func resourceProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	profile, err := FindProfileByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Profile (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(profile.Arn)
+	d.Set("arn", arn)
+	d.Set("name", profile.Name)
+	d.Set("owner_id", profile.OwnerId)
+	d.Set("share_status", profile.ShareStatus)
+	d.Set("status", profile.Status)
+	d.Set("status_message", profile.StatusMessage)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Profile (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Route 53 Profile (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceProfileRead(d, meta)
+}
+
+func resourceProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	log.Printf("[DEBUG] Deleting Route 53 Profile: %s", d.Id())
+	_, err := conn.DeleteProfile(&route53profiles.DeleteProfileInput{
+		ProfileId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53profiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 Profile (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitProfileDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Profile (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
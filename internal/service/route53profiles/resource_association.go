@@ -0,0 +1,185 @@
+package route53profiles
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceAssociationCreate,
+		Read:   resourceResourceAssociationRead,
+		Update: resourceResourceAssociationUpdate,
+		Delete: resourceResourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_properties": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	name := d.Get("name").(string)
+	input := &route53profiles.AssociateResourceToProfileInput{
+		Name:        aws.String(name),
+		ProfileId:   aws.String(d.Get("profile_id").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_properties"); ok {
+		input.ResourceProperties = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Profile Resource Association: %s", input)
+	output, err := conn.AssociateResourceToProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 Profile Resource Association (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProfileResourceAssociation.Id))
+
+	if _, err := waitProfileResourceAssociationCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Profile Resource Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceResourceAssociationRead(d, meta)
+}
+
+func resourceResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	association, err := FindProfileResourceAssociationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Profile Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Profile Resource Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", association.Name)
+	d.Set("owner_id", association.OwnerId)
+	d.Set("profile_id", association.ProfileId)
+	d.Set("resource_arn", association.ResourceArn)
+	d.Set("resource_properties", association.ResourceProperties)
+	d.Set("resource_type", association.ResourceType)
+	d.Set("status", association.Status)
+	d.Set("status_message", association.StatusMessage)
+
+	return nil
+}
+
+func resourceResourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	input := &route53profiles.UpdateProfileResourceAssociationInput{
+		ProfileResourceAssociationId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("resource_properties") {
+		input.ResourceProperties = aws.String(d.Get("resource_properties").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Profile Resource Association: %s", input)
+	_, err := conn.UpdateProfileResourceAssociation(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Profile Resource Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitProfileResourceAssociationUpdated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Profile Resource Association (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceResourceAssociationRead(d, meta)
+}
+
+func resourceResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	log.Printf("[DEBUG] Deleting Route 53 Profile Resource Association: %s", d.Id())
+	_, err := conn.DisassociateResourceFromProfile(&route53profiles.DisassociateResourceFromProfileInput{
+		ProfileId:   aws.String(d.Get("profile_id").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53profiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 Profile Resource Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitProfileResourceAssociationDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Profile Resource Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package route53profiles
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusProfile(conn *route53profiles.Route53Profiles, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProfileByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusProfileAssociation(conn *route53profiles.Route53Profiles, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProfileAssociationByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusProfileResourceAssociation(conn *route53profiles.Route53Profiles, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProfileResourceAssociationByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
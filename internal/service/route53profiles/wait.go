@@ -0,0 +1,168 @@
+package route53profiles
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	profileCreatedTimeout                    = 5 * time.Minute
+	profileDeletedTimeout                    = 5 * time.Minute
+	profileAssociationCreatedTimeout         = 15 * time.Minute
+	profileAssociationDeletedTimeout         = 15 * time.Minute
+	profileResourceAssociationCreatedTimeout = 15 * time.Minute
+	profileResourceAssociationUpdatedTimeout = 15 * time.Minute
+	profileResourceAssociationDeletedTimeout = 15 * time.Minute
+)
+
+func waitProfileCreated(conn *route53profiles.Route53Profiles, id string) (*route53profiles.Profile, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusCreating},
+		Target:  []string{route53profiles.ProfileStatusComplete},
+		Refresh: statusProfile(conn, id),
+		Timeout: profileCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53profiles.Profile); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileDeleted(conn *route53profiles.Route53Profiles, id string) (*route53profiles.Profile, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProfile(conn, id),
+		Timeout: profileDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53profiles.Profile); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileAssociationCreated(conn *route53profiles.Route53Profiles, id string) (*route53profiles.ProfileAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusCreating},
+		Target:  []string{route53profiles.ProfileStatusComplete},
+		Refresh: statusProfileAssociation(conn, id),
+		Timeout: profileAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53profiles.ProfileAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileAssociationDeleted(conn *route53profiles.Route53Profiles, id string) (*route53profiles.ProfileAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProfileAssociation(conn, id),
+		Timeout: profileAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53profiles.ProfileAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileResourceAssociationCreated(conn *route53profiles.Route53Profiles, id string) (*route53profiles.ProfileResourceAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusCreating},
+		Target:  []string{route53profiles.ProfileStatusComplete},
+		Refresh: statusProfileResourceAssociation(conn, id),
+		Timeout: profileResourceAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53profiles.ProfileResourceAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileResourceAssociationUpdated(conn *route53profiles.Route53Profiles, id string) (*route53profiles.ProfileResourceAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusUpdating},
+		Target:  []string{route53profiles.ProfileStatusComplete},
+		Refresh: statusProfileResourceAssociation(conn, id),
+		Timeout: profileResourceAssociationUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53profiles.ProfileResourceAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileResourceAssociationDeleted(conn *route53profiles.Route53Profiles, id string) (*route53profiles.ProfileResourceAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProfileResourceAssociation(conn, id),
+		Timeout: profileResourceAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53profiles.ProfileResourceAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
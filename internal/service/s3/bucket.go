@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -245,6 +246,7 @@ func ResourceBucket() *schema.Resource {
 			"logging": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -665,7 +667,10 @@ func ResourceBucket() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceBucketPreflightCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -749,29 +754,29 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		// Retry due to S3 eventual consistency
-		_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		_, err := retryBucketConfigurationWriteWhen(meta, d.Id(), func() (interface{}, error) {
 			terr := BucketUpdateTags(conn, d.Id(), o, n)
 			return nil, terr
-		})
+		}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 		if err != nil {
 			return fmt.Errorf("error updating S3 Bucket (%s) tags: %s", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("policy") {
-		if err := resourceBucketPolicyUpdate(conn, d); err != nil {
+		if err := resourceBucketPolicyUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("cors_rule") {
-		if err := resourceBucketCorsUpdate(conn, d); err != nil {
+		if err := resourceBucketCorsUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("website") {
-		if err := resourceBucketWebsiteUpdate(conn, d); err != nil {
+		if err := resourceBucketWebsiteUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
@@ -781,68 +786,68 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if d.IsNewResource() {
 			if versioning := expandVersioningWhenIsNewResource(v); versioning != nil {
-				err := resourceBucketInternalVersioningUpdate(conn, d.Id(), versioning)
+				err := resourceBucketInternalVersioningUpdate(conn, d.Id(), versioning, meta)
 				if err != nil {
 					return err
 				}
 			}
 		} else {
-			if err := resourceBucketInternalVersioningUpdate(conn, d.Id(), expandVersioning(v)); err != nil {
+			if err := resourceBucketInternalVersioningUpdate(conn, d.Id(), expandVersioning(v), meta); err != nil {
 				return err
 			}
 		}
 	}
 
 	if d.HasChange("acl") && !d.IsNewResource() {
-		if err := resourceBucketACLUpdate(conn, d); err != nil {
+		if err := resourceBucketACLUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("grant") {
-		if err := resourceBucketGrantsUpdate(conn, d); err != nil {
+		if err := resourceBucketGrantsUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("logging") {
-		if err := resourceBucketLoggingUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalLoggingUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("lifecycle_rule") {
-		if err := resourceBucketLifecycleUpdate(conn, d); err != nil {
+		if err := resourceBucketLifecycleUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("acceleration_status") {
-		if err := resourceBucketAccelerationUpdate(conn, d); err != nil {
+		if err := resourceBucketAccelerationUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("request_payer") {
-		if err := resourceBucketRequestPayerUpdate(conn, d); err != nil {
+		if err := resourceBucketRequestPayerUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("replication_configuration") {
-		if err := resourceBucketInternalReplicationConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalReplicationConfigurationUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("server_side_encryption_configuration") {
-		if err := resourceBucketServerSideEncryptionConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketServerSideEncryptionConfigurationUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("object_lock_configuration") {
-		if err := resourceObjectLockConfigurationUpdate(conn, d); err != nil {
+		if err := resourceObjectLockConfigurationUpdate(conn, d, meta); err != nil {
 			return err
 		}
 	}
@@ -859,27 +864,9 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 		Bucket: aws.String(d.Id()),
 	}
 
-	err := resource.Retry(bucketCreatedTimeout, func() *resource.RetryError {
-		_, err := conn.HeadBucket(input)
-
-		if d.IsNewResource() && tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
-			return resource.RetryableError(err)
-		}
-
-		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.HeadBucket(input)
-	}
+	_, err := retryWhenNewResourceNotFound(meta, d, bucketCreatedTimeout, func() (interface{}, error) {
+		return conn.HeadBucket(input)
+	}, ErrCodeNotFound, s3.ErrCodeNoSuchBucket)
 
 	if !d.IsNewResource() && tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -907,7 +894,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the policy
 	if _, ok := d.GetOk("policy"); ok {
 
-		pol, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		pol, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 			return conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
 				Bucket: aws.String(d.Id()),
 			})
@@ -946,7 +933,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error resetting grant %s", err)
 		}
 	} else {
-		apResponse, err := verify.RetryOnAWSCode("NoSuchBucket", func() (interface{}, error) {
+		apResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 			return conn.GetBucketAcl(&s3.GetBucketAclInput{
 				Bucket: aws.String(d.Id()),
 			})
@@ -962,7 +949,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the CORS
-	corsResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	corsResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketCors(&s3.GetBucketCorsInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -994,7 +981,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the website configuration
-	wsResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	wsResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1061,7 +1048,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the versioning configuration
 
-	versioningResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	versioningResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1079,7 +1066,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the acceleration status
 
-	accelerateResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	accelerateResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1095,7 +1082,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the request payer configuration.
 
-	payerResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	payerResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1110,7 +1097,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the logging configuration
-	loggingResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	loggingResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketLogging(&s3.GetBucketLoggingInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1138,7 +1125,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the lifecycle configuration
 
-	lifecycleResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	lifecycleResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1267,7 +1254,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the bucket replication configuration
 
-	replicationResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	replicationResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketReplication(&s3.GetBucketReplicationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1286,7 +1273,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the bucket server side encryption configuration
 
-	encryptionResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	encryptionResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1304,7 +1291,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Object Lock configuration.
-	conf, err := readS3ObjectLockConfiguration(conn, d.Id())
+	conf, err := readS3ObjectLockConfiguration(conn, d.Id(), meta)
 
 	// Object lock not supported in all partitions (extra guard, also guards in read func)
 	if err != nil && (meta.(*conns.AWSClient).Partition == endpoints.AwsPartitionID || meta.(*conns.AWSClient).Partition == endpoints.AwsUsGovPartitionID) {
@@ -1376,7 +1363,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Retry due to S3 eventual consistency
-	tagsRaw, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	tagsRaw, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return BucketListTags(conn, d.Id())
 	})
 
@@ -1458,7 +1445,50 @@ func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceBucketPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
+// resourceBucketPreflightCustomizeDiff checks that the bucket name is available when a bucket is going to be created.
+// The check is only performed if the provider is configured to perform preflight checks.
+func resourceBucketPreflightCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || !client.PreflightChecks {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("bucket") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("bucket") {
+		return nil
+	}
+
+	bucket := diff.Get("bucket").(string)
+
+	if bucket == "" {
+		return nil
+	}
+
+	_, err := client.S3Conn.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+
+	switch {
+	case err == nil:
+		return fmt.Errorf("S3 Bucket (%s) already exists", bucket)
+	case tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound):
+		return nil
+	case tfawserr.ErrStatusCodeEquals(err, http.StatusForbidden):
+		return fmt.Errorf("S3 Bucket name (%s) is already in use by another AWS account", bucket)
+	case tfawserr.ErrStatusCodeEquals(err, http.StatusMovedPermanently):
+		return fmt.Errorf("S3 Bucket (%s) already exists in another region", bucket)
+	}
+
+	log.Printf("[WARN] Unable to check availability of S3 Bucket name (%s): %s", bucket, err)
+
+	return nil
+}
+
+func resourceBucketPolicyUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
@@ -1475,29 +1505,37 @@ func resourceBucketPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
 			Policy: aws.String(policy),
 		}
 
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.PutBucketPolicy(params)
-			if tfawserr.ErrMessageContains(err, "MalformedPolicy", "") || tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
-				return resource.RetryableError(err)
+		// As for aws_s3_bucket_policy, a policy that references IAM principals which have not propagated yet is retried.
+		var start time.Time
+		bucketNotFound := bucketNotFoundRetryable(meta, propagationTimeout)
+		_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
+			if start.IsZero() {
+				start = time.Now()
 			}
-			if err != nil {
-				return resource.NonRetryableError(err)
+
+			return conn.PutBucketPolicy(params)
+		}, func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, "MalformedPolicy", "") && time.Since(start) < propagationTimeout {
+				return true, err
+			}
+
+			if bucketNotFound != nil {
+				return bucketNotFound(err)
 			}
-			return nil
+
+			return false, err
 		})
-		if tfresource.TimedOut(err) {
-			_, err = conn.PutBucketPolicy(params)
-		}
+
 		if err != nil {
 			return fmt.Errorf("Error putting S3 policy: %s", err)
 		}
 	} else {
 		log.Printf("[DEBUG] S3 bucket: %s, delete policy: %s", bucket, policy)
-		_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 			return conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
 				Bucket: aws.String(bucket),
 			})
-		})
+		}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 
 		if err != nil {
 			return fmt.Errorf("Error deleting S3 policy: %s", err)
@@ -1507,17 +1545,17 @@ func resourceBucketPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	rawGrants := d.Get("grant").(*schema.Set).List()
 
 	if len(rawGrants) == 0 {
 		log.Printf("[DEBUG] S3 bucket: %s, Grants fallback to canned ACL", bucket)
-		if err := resourceBucketACLUpdate(conn, d); err != nil {
+		if err := resourceBucketACLUpdate(conn, d, meta); err != nil {
 			return fmt.Errorf("Error fallback to canned ACL, %s", err)
 		}
 	} else {
-		apResponse, err := verify.RetryOnAWSCode("NoSuchBucket", func() (interface{}, error) {
+		apResponse, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 			return conn.GetBucketAcl(&s3.GetBucketAclInput{
 				Bucket: aws.String(d.Id()),
 			})
@@ -1564,9 +1602,9 @@ func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 
 		log.Printf("[DEBUG] S3 bucket: %s, put Grants: %#v", bucket, grantsInput)
 
-		_, err = verify.RetryOnAWSCode("NoSuchBucket", func() (interface{}, error) {
+		_, err = retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 			return conn.PutBucketAcl(grantsInput)
-		})
+		}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 
 		if err != nil {
 			return fmt.Errorf("Error putting S3 Grants: %s", err)
@@ -1575,7 +1613,7 @@ func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	rawCors := d.Get("cors_rule").([]interface{})
 
@@ -1583,11 +1621,11 @@ func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 		// Delete CORS
 		log.Printf("[DEBUG] S3 bucket: %s, delete CORS", bucket)
 
-		_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 			return conn.DeleteBucketCors(&s3.DeleteBucketCorsInput{
 				Bucket: aws.String(bucket),
 			})
-		})
+		}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 		if err != nil {
 			return fmt.Errorf("Error deleting S3 CORS: %s", err)
 		}
@@ -1630,9 +1668,9 @@ func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 		}
 		log.Printf("[DEBUG] S3 bucket: %s, put CORS: %#v", bucket, corsInput)
 
-		_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 			return conn.PutBucketCors(corsInput)
-		})
+		}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 		if err != nil {
 			return fmt.Errorf("Error putting S3 CORS: %s", err)
 		}
@@ -1641,11 +1679,11 @@ func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketWebsiteUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketWebsiteUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	ws := d.Get("website").([]interface{})
 
 	if len(ws) == 0 {
-		return resourceBucketWebsiteDelete(conn, d)
+		return resourceBucketWebsiteDelete(conn, d, meta)
 	}
 
 	var w map[string]interface{}
@@ -1654,10 +1692,10 @@ func resourceBucketWebsiteUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	} else {
 		w = make(map[string]interface{})
 	}
-	return resourceBucketWebsitePut(conn, d, w)
+	return resourceBucketWebsitePut(conn, d, w, meta)
 }
 
-func resourceBucketWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
+func resourceBucketWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[string]interface{}, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
@@ -1721,9 +1759,9 @@ func resourceBucketWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[s
 
 	log.Printf("[DEBUG] S3 put bucket website: %#v", putInput)
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketWebsite(putInput)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 	if err != nil {
 		return fmt.Errorf("Error putting S3 website: %s", err)
 	}
@@ -1731,15 +1769,15 @@ func resourceBucketWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[s
 	return nil
 }
 
-func resourceBucketWebsiteDelete(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketWebsiteDelete(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
 	log.Printf("[DEBUG] S3 delete bucket website: %#v", deleteInput)
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.DeleteBucketWebsite(deleteInput)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 	if err != nil {
 		return fmt.Errorf("Error deleting S3 website: %s", err)
 	}
@@ -1761,7 +1799,7 @@ func websiteEndpoint(client *conns.AWSClient, d *schema.ResourceData) (*S3Websit
 
 	// Lookup the region for this bucket
 
-	locationResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	locationResponse, err := retryWhenBucketNotFound(client, bucketCreatedTimeout, func() (interface{}, error) {
 		return client.S3Conn.GetBucketLocation(
 			&s3.GetBucketLocationInput{
 				Bucket: aws.String(bucket),
@@ -1831,7 +1869,7 @@ func isOldRegion(region string) bool {
 	return false
 }
 
-func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("bucket").(string)
 
@@ -1841,9 +1879,9 @@ func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	}
 	log.Printf("[DEBUG] S3 put bucket ACL: %#v", i)
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketAcl(i)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 	if err != nil {
 		return fmt.Errorf("Error putting S3 ACL: %s", err)
 	}
@@ -1851,15 +1889,15 @@ func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketInternalVersioningUpdate(conn *s3.S3, bucket string, versioningConfig *s3.VersioningConfiguration) error {
+func resourceBucketInternalVersioningUpdate(conn *s3.S3, bucket string, versioningConfig *s3.VersioningConfiguration, meta interface{}) error {
 	input := &s3.PutBucketVersioningInput{
 		Bucket:                  aws.String(bucket),
 		VersioningConfiguration: versioningConfig,
 	}
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketVersioning(input)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 
 	if err != nil {
 		return fmt.Errorf("error putting S3 versioning for bucket (%s): %w", bucket, err)
@@ -1868,7 +1906,7 @@ func resourceBucketInternalVersioningUpdate(conn *s3.S3, bucket string, versioni
 	return nil
 }
 
-func resourceBucketLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalLoggingUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
@@ -1893,9 +1931,9 @@ func resourceBucketLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	}
 	log.Printf("[DEBUG] S3 put bucket logging: %#v", i)
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketLogging(i)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 	if err != nil {
 		return fmt.Errorf("Error putting S3 logging: %s", err)
 	}
@@ -1903,7 +1941,7 @@ func resourceBucketLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketAccelerationUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	enableAcceleration := d.Get("acceleration_status").(string)
 
@@ -1915,9 +1953,9 @@ func resourceBucketAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error
 	}
 	log.Printf("[DEBUG] S3 put bucket acceleration: %#v", i)
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketAccelerateConfiguration(i)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 	if err != nil {
 		return fmt.Errorf("Error putting S3 acceleration: %s", err)
 	}
@@ -1925,7 +1963,7 @@ func resourceBucketAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	payer := d.Get("request_payer").(string)
 
@@ -1937,9 +1975,9 @@ func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error
 	}
 	log.Printf("[DEBUG] S3 put bucket request payer: %#v", i)
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketRequestPayment(i)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 	if err != nil {
 		return fmt.Errorf("Error putting S3 request payer: %s", err)
 	}
@@ -1947,7 +1985,7 @@ func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
 	if len(serverSideEncryptionConfiguration) == 0 {
@@ -1956,7 +1994,9 @@ func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schem
 			Bucket: aws.String(bucket),
 		}
 
-		_, err := conn.DeleteBucketEncryption(i)
+		_, err := retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+			return conn.DeleteBucketEncryption(i)
+		})
 		if err != nil {
 			return fmt.Errorf("error removing S3 bucket server side encryption: %s", err)
 		}
@@ -1998,14 +2038,9 @@ func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schem
 	}
 	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(
-		propagationTimeout,
-		func() (interface{}, error) {
-			return conn.PutBucketEncryption(i)
-		},
-		s3.ErrCodeNoSuchBucket,
-		ErrCodeOperationAborted,
-	)
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketEncryption(i)
+	}, bucketNotFoundRetryable(meta, propagationTimeout))
 
 	if err != nil {
 		return fmt.Errorf("error putting S3 server side encryption configuration: %s", err)
@@ -2014,16 +2049,18 @@ func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schem
 	return nil
 }
 
-func resourceObjectLockConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceObjectLockConfigurationUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+
 	// S3 Object Lock configuration cannot be deleted, only updated.
 	req := &s3.PutObjectLockConfigurationInput{
-		Bucket:                  aws.String(d.Get("bucket").(string)),
+		Bucket:                  aws.String(bucket),
 		ObjectLockConfiguration: expandS3ObjectLockConfiguration(d.Get("object_lock_configuration").([]interface{})),
 	}
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutObjectLockConfiguration(req)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 	if err != nil {
 		return fmt.Errorf("error putting S3 object lock configuration: %s", err)
 	}
@@ -2031,7 +2068,7 @@ func resourceObjectLockConfigurationUpdate(conn *s3.S3, d *schema.ResourceData)
 	return nil
 }
 
-func resourceBucketInternalReplicationConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalReplicationConfigurationUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	replicationConfiguration := d.Get("replication_configuration").([]interface{})
 
@@ -2040,7 +2077,9 @@ func resourceBucketInternalReplicationConfigurationUpdate(conn *s3.S3, d *schema
 			Bucket: aws.String(bucket),
 		}
 
-		_, err := conn.DeleteBucketReplication(i)
+		_, err := retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+			return conn.DeleteBucketReplication(i)
+		})
 		if err != nil {
 			return fmt.Errorf("Error removing S3 bucket replication: %s", err)
 		}
@@ -2192,19 +2231,8 @@ func resourceBucketInternalReplicationConfigurationUpdate(conn *s3.S3, d *schema
 	}
 	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
 
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.PutBucketReplication(i)
-		if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") || tfawserr.ErrMessageContains(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") {
-			return resource.RetryableError(err)
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketReplication(i)
-	}
+	err := putBucketReplication(conn, meta, i)
+
 	if err != nil {
 		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
 	}
@@ -2212,7 +2240,7 @@ func resourceBucketInternalReplicationConfigurationUpdate(conn *s3.S3, d *schema
 	return nil
 }
 
-func resourceBucketLifecycleUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketLifecycleUpdate(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 
 	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
@@ -2222,7 +2250,9 @@ func resourceBucketLifecycleUpdate(conn *s3.S3, d *schema.ResourceData) error {
 			Bucket: aws.String(bucket),
 		}
 
-		_, err := conn.DeleteBucketLifecycle(i)
+		_, err := retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+			return conn.DeleteBucketLifecycle(i)
+		})
 		if err != nil {
 			return fmt.Errorf("Error removing S3 lifecycle: %s", err)
 		}
@@ -2361,9 +2391,9 @@ func resourceBucketLifecycleUpdate(conn *s3.S3, d *schema.ResourceData) error {
 		},
 	}
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketLifecycleConfiguration(i)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 	if err != nil {
 		return fmt.Errorf("Error putting S3 lifecycle: %s", err)
 	}
@@ -2560,6 +2590,9 @@ func normalizeRegion(region string) string {
 // Buckets outside of this region have to be DNS-compliant. After the same restrictions are
 // applied to buckets in the us-east-1 region, this function can be refactored as a SchemaValidateFunc
 func ValidBucketName(value string, region string) error {
+	if strings.HasSuffix(value, "--x-s3") {
+		return fmt.Errorf("%q is a directory bucket name, use the aws_s3_directory_bucket resource to manage directory buckets", value)
+	}
 	if region != endpoints.UsEast1RegionID {
 		if (len(value) < 3) || (len(value) > 63) {
 			return fmt.Errorf("%q must contain from 3 to 63 characters", value)
@@ -2802,8 +2835,8 @@ type S3Website struct {
 // S3 Object Lock functions.
 //
 
-func readS3ObjectLockConfiguration(conn *s3.S3, bucket string) ([]interface{}, error) {
-	resp, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+func readS3ObjectLockConfiguration(conn *s3.S3, bucket string, meta interface{}) ([]interface{}, error) {
+	resp, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return conn.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
 			Bucket: aws.String(bucket),
 		})
@@ -92,7 +92,7 @@ func ResourceBucketAnalyticsConfiguration() *schema.Resource {
 															"bucket_arn": {
 																Type:         schema.TypeString,
 																Required:     true,
-																ValidateFunc: verify.ValidARN,
+																ValidateFunc: verify.ValidARNForService("s3", ""),
 															},
 															"bucket_account_id": {
 																Type:         schema.TypeString,
@@ -147,22 +147,9 @@ func resourceBucketAnalyticsConfigurationPut(d *schema.ResourceData, meta interf
 		AnalyticsConfiguration: analyticsConfiguration,
 	}
 
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.PutBucketAnalyticsConfiguration(input)
-
-		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketAnalyticsConfiguration(input)
-	}
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketAnalyticsConfiguration(input)
+	}, bucketNotFoundRetryable(meta, propagationTimeout))
 
 	if err != nil {
 		return fmt.Errorf("error adding S3 Bucket Analytics Configuration: %w", err)
@@ -237,7 +224,9 @@ func resourceBucketAnalyticsConfigurationDelete(d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Deleting S3 bucket analytics configuration: %s", input)
-	_, err = conn.DeleteBucketAnalyticsConfiguration(input)
+	_, err = retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.DeleteBucketAnalyticsConfiguration(input)
+	})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") || tfawserr.ErrMessageContains(err, "NoSuchConfiguration", "The specified configuration does not exist.") {
 			return nil
@@ -98,9 +98,9 @@ func resourceBucketCorsConfigurationCreate(ctx context.Context, d *schema.Resour
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketCorsWithContext(ctx, input)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating S3 bucket (%s) CORS configuration: %w", bucket, err))
@@ -177,7 +177,9 @@ func resourceBucketCorsConfigurationUpdate(ctx context.Context, d *schema.Resour
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	_, err = conn.PutBucketCorsWithContext(ctx, input)
+	_, err = retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketCorsWithContext(ctx, input)
+	})
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating S3 bucket CORS configuration (%s): %w", d.Id(), err))
@@ -202,7 +204,9 @@ func resourceBucketCorsConfigurationDelete(ctx context.Context, d *schema.Resour
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	_, err = conn.DeleteBucketCorsWithContext(ctx, input)
+	_, err = retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.DeleteBucketCorsWithContext(ctx, input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 		return nil
@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -26,6 +27,8 @@ func ResourceBucketIntelligentTieringConfiguration() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceBucketIntelligentTieringConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -111,9 +114,9 @@ func resourceBucketIntelligentTieringConfigurationPut(d *schema.ResourceData, me
 	}
 
 	log.Printf("[DEBUG] Creating S3 Intelligent-Tiering Configuration: %s", input)
-	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucketName, func() (interface{}, error) {
 		return conn.PutBucketIntelligentTieringConfiguration(input)
-	})
+	}, bucketNotFoundRetryable(meta, propagationTimeout))
 
 	if err != nil {
 		return fmt.Errorf("error creating S3 Intelligent-Tiering Configuration (%s): %w", resourceID, err)
@@ -172,9 +175,11 @@ func resourceBucketIntelligentTieringConfigurationDelete(d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] Deleting S3 Intelligent-Tiering Configuration: (%s)", d.Id())
-	_, err = conn.DeleteBucketIntelligentTieringConfiguration(&s3.DeleteBucketIntelligentTieringConfigurationInput{
-		Bucket: aws.String(bucketName),
-		Id:     aws.String(configurationName),
+	_, err = retryBucketConfigurationWrite(meta, bucketName, func() (interface{}, error) {
+		return conn.DeleteBucketIntelligentTieringConfiguration(&s3.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: aws.String(bucketName),
+			Id:     aws.String(configurationName),
+		})
 	})
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchConfiguration) {
@@ -296,6 +301,49 @@ func expandTiering(tfMap map[string]interface{}) *s3.Tiering {
 	return apiObject
 }
 
+// resourceBucketIntelligentTieringConfigurationCustomizeDiff validates the tierings at plan time.
+// Each access tier can be configured at most once and its days must be within the range that S3 accepts.
+func resourceBucketIntelligentTieringConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	const maxDays = 730
+	minDays := map[string]int{
+		s3.IntelligentTieringAccessTierArchiveAccess:     90,
+		s3.IntelligentTieringAccessTierDeepArchiveAccess: 180,
+	}
+	days := make(map[string]int)
+
+	for _, tfMapRaw := range diff.Get("tiering").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		accessTier, _ := tfMap["access_tier"].(string)
+		v, _ := tfMap["days"].(int)
+
+		// Unknown values are not validated.
+		if accessTier == "" || v == 0 {
+			continue
+		}
+
+		if _, ok := days[accessTier]; ok {
+			return fmt.Errorf("tiering: access_tier %s can only be configured once", accessTier)
+		}
+
+		days[accessTier] = v
+
+		if min := minDays[accessTier]; v < min || v > maxDays {
+			return fmt.Errorf("tiering: days for access_tier %s must be between %d and %d, got: %d", accessTier, min, maxDays, v)
+		}
+	}
+
+	if archive, deepArchive := days[s3.IntelligentTieringAccessTierArchiveAccess], days[s3.IntelligentTieringAccessTierDeepArchiveAccess]; archive > 0 && deepArchive > 0 && deepArchive <= archive {
+		return fmt.Errorf("tiering: days for access_tier %s (%d) must be greater than days for access_tier %s (%d)", s3.IntelligentTieringAccessTierDeepArchiveAccess, deepArchive, s3.IntelligentTieringAccessTierArchiveAccess, archive)
+	}
+
+	return nil
+}
+
 func expandTierings(tfList []interface{}) []*s3.Tiering {
 	if len(tfList) == 0 {
 		return nil
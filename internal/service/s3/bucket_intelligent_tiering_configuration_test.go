@@ -2,6 +2,7 @@ package s3_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -71,6 +72,27 @@ func TestAccS3BucketIntelligentTieringConfiguration_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketIntelligentTieringConfiguration_Tiering_invalidDays(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationTieringConfig(rName, 30, 180),
+				ExpectError: regexp.MustCompile(`days for access_tier ARCHIVE_ACCESS must be between 90 and 730`),
+			},
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationTieringConfig(rName, 200, 180),
+				ExpectError: regexp.MustCompile(`must be greater than days for access_tier ARCHIVE_ACCESS`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketIntelligentTieringConfiguration_Filter(t *testing.T) {
 	var itc s3.IntelligentTieringConfiguration
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -205,6 +227,29 @@ resource "aws_s3_bucket" "test" {
 `, rName)
 }
 
+func testAccBucketIntelligentTieringConfigurationTieringConfig(rName string, archiveDays, deepArchiveDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = %[2]d
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = %[3]d
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, archiveDays, deepArchiveDays)
+}
+
 func testAccBucketIntelligentTieringConfigurationFilterPrefixConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
@@ -8,12 +8,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -83,7 +81,7 @@ func ResourceBucketInventory() *schema.Resource {
 									"bucket_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("s3", ""),
 									},
 									"account_id": {
 										Type:         schema.TypeString,
@@ -224,23 +222,9 @@ func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Putting S3 bucket inventory configuration: %s", input)
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err := conn.PutBucketInventoryConfiguration(input)
-
-		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketInventoryConfiguration(input)
-	}
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketInventoryConfiguration(input)
+	}, bucketNotFoundRetryable(meta, propagationTimeout))
 
 	if err != nil {
 		return fmt.Errorf("error putting S3 Bucket Inventory Configuration: %w", err)
@@ -265,7 +249,9 @@ func resourceBucketInventoryDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	log.Printf("[DEBUG] Deleting S3 bucket inventory configuration: %s", input)
-	_, err = conn.DeleteBucketInventoryConfiguration(input)
+	_, err = retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.DeleteBucketInventoryConfiguration(input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 		return nil
@@ -299,28 +285,13 @@ func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Reading S3 bucket inventory configuration: %s", input)
-	var output *s3.GetBucketInventoryConfigurationOutput
-	err = resource.Retry(propagationTimeout, func() *resource.RetryError {
-		var err error
-		output, err = conn.GetBucketInventoryConfiguration(input)
+	outputRaw, err := retryWhenNewResourceNotFound(meta, d, propagationTimeout, func() (interface{}, error) {
+		return conn.GetBucketInventoryConfiguration(input)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchConfiguration)
 
-		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-			return resource.RetryableError(err)
-		}
-
-		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchConfiguration) {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		output, err = conn.GetBucketInventoryConfiguration(input)
+	var output *s3.GetBucketInventoryConfigurationOutput
+	if outputRaw != nil {
+		output = outputRaw.(*s3.GetBucketInventoryConfigurationOutput)
 	}
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
@@ -8,11 +8,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceBucketMetric() *schema.Resource {
@@ -83,23 +81,9 @@ func resourceBucketMetricPut(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Putting S3 Bucket Metrics Configuration: %s", input)
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err := conn.PutBucketMetricsConfiguration(input)
-
-		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketMetricsConfiguration(input)
-	}
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketMetricsConfiguration(input)
+	}, bucketNotFoundRetryable(meta, propagationTimeout))
 
 	if err != nil {
 		return fmt.Errorf("error putting S3 Bucket Metrics Configuration: %w", err)
@@ -124,7 +108,9 @@ func resourceBucketMetricDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Deleting S3 Bucket Metrics Configuration: %s", input)
-	_, err = conn.DeleteBucketMetricsConfiguration(input)
+	_, err = retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.DeleteBucketMetricsConfiguration(input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 		return nil
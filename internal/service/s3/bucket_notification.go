@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceBucketNotification() *schema.Resource {
@@ -326,23 +325,9 @@ func resourceBucketNotificationPut(d *schema.ResourceData, meta interface{}) err
 	}
 
 	log.Printf("[DEBUG] S3 bucket: %s, Putting notification: %v", bucket, i)
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err := conn.PutBucketNotificationConfiguration(i)
-
-		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketNotificationConfiguration(i)
-	}
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketNotificationConfiguration(i)
+	}, bucketNotFoundRetryable(meta, propagationTimeout))
 
 	if err != nil {
 		return fmt.Errorf("error putting S3 Bucket Notification Configuration: %w", err)
@@ -362,7 +347,9 @@ func resourceBucketNotificationDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] S3 bucket: %s, Deleting notification: %v", d.Id(), i)
-	_, err := conn.PutBucketNotificationConfiguration(i)
+	_, err := retryBucketConfigurationWrite(meta, d.Id(), func() (interface{}, error) {
+		return conn.PutBucketNotificationConfiguration(i)
+	})
 
 	if err != nil {
 		return fmt.Errorf("error deleting S3 Bucket Notification Configuration (%s): %w", d.Id(), err)
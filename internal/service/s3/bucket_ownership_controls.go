@@ -61,7 +61,9 @@ func resourceBucketOwnershipControlsCreate(d *schema.ResourceData, meta interfac
 		},
 	}
 
-	_, err := conn.PutBucketOwnershipControls(input)
+	_, err := retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketOwnershipControls(input)
+	})
 
 	if err != nil {
 		return fmt.Errorf("error creating S3 Bucket (%s) Ownership Controls: %w", bucket, err)
@@ -124,7 +126,9 @@ func resourceBucketOwnershipControlsUpdate(d *schema.ResourceData, meta interfac
 		},
 	}
 
-	_, err := conn.PutBucketOwnershipControls(input)
+	_, err := retryBucketConfigurationWrite(meta, d.Id(), func() (interface{}, error) {
+		return conn.PutBucketOwnershipControls(input)
+	})
 
 	if err != nil {
 		return fmt.Errorf("error updating S3 Bucket (%s) Ownership Controls: %w", d.Id(), err)
@@ -140,7 +144,9 @@ func resourceBucketOwnershipControlsDelete(d *schema.ResourceData, meta interfac
 		Bucket: aws.String(d.Id()),
 	}
 
-	_, err := conn.DeleteBucketOwnershipControls(input)
+	_, err := retryBucketConfigurationWrite(meta, d.Id(), func() (interface{}, error) {
+		return conn.DeleteBucketOwnershipControls(input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 		return nil
@@ -9,12 +9,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -63,19 +61,23 @@ func resourceBucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
 		Policy: aws.String(policy),
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.PutBucketPolicy(params)
-		if tfawserr.ErrMessageContains(err, "MalformedPolicy", "") {
-			return resource.RetryableError(err)
+	// S3 reports a policy that references IAM principals which have not propagated yet as malformed.
+	// This is retried for up to propagationTimeout after the first attempt.
+	var start time.Time
+	_, err = retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
+		if start.IsZero() {
+			start = time.Now()
 		}
-		if err != nil {
-			return resource.NonRetryableError(err)
+
+		return conn.PutBucketPolicy(params)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrMessageContains(err, "MalformedPolicy", "") && time.Since(start) < propagationTimeout {
+			return true, err
 		}
-		return nil
+
+		return false, err
 	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketPolicy(params)
-	}
+
 	if err != nil {
 		return fmt.Errorf("Error putting S3 policy: %s", err)
 	}
@@ -127,8 +129,10 @@ func resourceBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error
 	bucket := d.Get("bucket").(string)
 
 	log.Printf("[DEBUG] S3 bucket: %s, delete policy", bucket)
-	_, err := conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
-		Bucket: aws.String(bucket),
+	_, err := retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
+			Bucket: aws.String(bucket),
+		})
 	})
 
 	if err != nil {
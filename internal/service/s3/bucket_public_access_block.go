@@ -3,15 +3,12 @@ package s3
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceBucketPublicAccessBlock() *schema.Resource {
@@ -73,22 +70,10 @@ func resourceBucketPublicAccessBlockCreate(d *schema.ResourceData, meta interfac
 	}
 
 	log.Printf("[DEBUG] S3 bucket: %s, public access block: %v", bucket, input.PublicAccessBlockConfiguration)
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.PutPublicAccessBlock(input)
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
+		return conn.PutPublicAccessBlock(input)
+	}, bucketNotFoundRetryable(meta, propagationTimeout))
 
-		if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutPublicAccessBlock(input)
-	}
 	if err != nil {
 		return fmt.Errorf("error creating public access block policy for S3 bucket (%s): %s", bucket, err)
 	}
@@ -105,28 +90,13 @@ func resourceBucketPublicAccessBlockRead(d *schema.ResourceData, meta interface{
 	}
 
 	// Retry for eventual consistency on creation
-	var output *s3.GetPublicAccessBlockOutput
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		var err error
-		output, err = conn.GetPublicAccessBlock(input)
-
-		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-			return resource.RetryableError(err)
-		}
-
-		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchPublicAccessBlockConfiguration) {
-			return resource.RetryableError(err)
-		}
+	outputRaw, err := retryWhenNewResourceNotFound(meta, d, propagationTimeout, func() (interface{}, error) {
+		return conn.GetPublicAccessBlock(input)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchPublicAccessBlockConfiguration)
 
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		output, err = conn.GetPublicAccessBlock(input)
+	var output *s3.GetPublicAccessBlockOutput
+	if outputRaw != nil {
+		output = outputRaw.(*s3.GetPublicAccessBlockOutput)
 	}
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchPublicAccessBlockConfiguration) {
@@ -172,7 +142,9 @@ func resourceBucketPublicAccessBlockUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	log.Printf("[DEBUG] Updating S3 bucket Public Access Block: %s", input)
-	_, err := conn.PutPublicAccessBlock(input)
+	_, err := retryBucketConfigurationWrite(meta, d.Id(), func() (interface{}, error) {
+		return conn.PutPublicAccessBlock(input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchPublicAccessBlockConfiguration) {
 		log.Printf("[WARN] S3 Bucket Public Access Block (%s) not found, removing from state", d.Id())
@@ -211,7 +183,9 @@ func resourceBucketPublicAccessBlockDelete(d *schema.ResourceData, meta interfac
 	}
 
 	log.Printf("[DEBUG] S3 bucket: %s, delete public access block", d.Id())
-	_, err := conn.DeletePublicAccessBlock(input)
+	_, err := retryBucketConfigurationWrite(meta, d.Id(), func() (interface{}, error) {
+		return conn.DeletePublicAccessBlock(input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchPublicAccessBlockConfiguration) {
 		return nil
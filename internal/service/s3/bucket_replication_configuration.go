@@ -3,16 +3,15 @@ package s3
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -41,7 +40,6 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 			"rule": {
 				Type:     schema.TypeSet,
 				Required: true,
-				Set:      rulesHash,
 				MaxItems: 1000,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -311,20 +309,7 @@ func resourceBucketReplicationConfigurationCreate(d *schema.ResourceData, meta i
 		ReplicationConfiguration: rc,
 	}
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err := conn.PutBucketReplication(input)
-		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrMessageContains(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") {
-			return resource.RetryableError(err)
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketReplication(input)
-	}
+	err := putBucketReplication(conn, meta, input)
 
 	if err != nil {
 		return fmt.Errorf("error creating S3 replication configuration for bucket (%s): %w", bucket, err)
@@ -343,7 +328,7 @@ func resourceBucketReplicationConfigurationRead(d *schema.ResourceData, meta int
 	}
 
 	// Read the bucket replication configuration
-	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+	output, err := retryWhenBucketNotFound(meta, propagationTimeout, func() (interface{}, error) {
 		return conn.GetBucketReplication(input)
 	})
 
@@ -367,7 +352,7 @@ func resourceBucketReplicationConfigurationRead(d *schema.ResourceData, meta int
 
 	d.Set("bucket", d.Id())
 	d.Set("role", r.Role)
-	if err := d.Set("rule", schema.NewSet(rulesHash, FlattenRules(r.Rules))); err != nil {
+	if err := d.Set("rule", FlattenRules(r.Rules)); err != nil {
 		return fmt.Errorf("error setting rule: %w", err)
 	}
 
@@ -387,20 +372,7 @@ func resourceBucketReplicationConfigurationUpdate(d *schema.ResourceData, meta i
 		ReplicationConfiguration: rc,
 	}
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err := conn.PutBucketReplication(input)
-		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrMessageContains(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") {
-			return resource.RetryableError(err)
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketReplication(input)
-	}
+	err := putBucketReplication(conn, meta, input)
 
 	if err != nil {
 		return fmt.Errorf("error updating S3 replication configuration for bucket (%s): %w", d.Id(), err)
@@ -416,7 +388,9 @@ func resourceBucketReplicationConfigurationDelete(d *schema.ResourceData, meta i
 		Bucket: aws.String(d.Id()),
 	}
 
-	_, err := conn.DeleteBucketReplication(input)
+	_, err := retryBucketConfigurationWrite(meta, d.Id(), func() (interface{}, error) {
+		return conn.DeleteBucketReplication(input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, ErrCodeReplicationConfigurationNotFound, s3.ErrCodeNoSuchBucket) {
 		return nil
@@ -428,3 +402,29 @@ func resourceBucketReplicationConfigurationDelete(d *schema.ResourceData, meta i
 
 	return nil
 }
+
+// putBucketReplication puts the bucket's replication configuration behind the bucket configuration lock.
+// Versioning enabled in the same apply, and a newly created bucket, may not be visible yet, so both are retried.
+func putBucketReplication(conn *s3.S3, meta interface{}, input *s3.PutBucketReplicationInput) error {
+	var start time.Time
+	bucketNotFound := bucketNotFoundRetryable(meta, propagationTimeout)
+	_, err := retryBucketConfigurationWriteWhen(meta, aws.StringValue(input.Bucket), func() (interface{}, error) {
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		return conn.PutBucketReplication(input)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrMessageContains(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") && time.Since(start) < propagationTimeout {
+			return true, err
+		}
+
+		if bucketNotFound != nil {
+			return bucketNotFound(err)
+		}
+
+		return false, err
+	})
+
+	return err
+}
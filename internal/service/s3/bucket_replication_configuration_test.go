@@ -444,7 +444,7 @@ func TestAccS3BucketReplicationConfiguration_replicationTimeControl(t *testing.T
 		CheckDestroy:      acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroy, &providers),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBucketReplicationConfigurationRTC(rName),
+				Config: testAccBucketReplicationConfigurationRTC(rName, s3.DeleteMarkerReplicationStatusEnabled, s3.ReplicationTimeStatusEnabled, s3.MetricsStatusEnabled),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketReplicationConfigurationExists(resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, "role", iamRoleResourceName, "arn"),
@@ -474,6 +474,35 @@ func TestAccS3BucketReplicationConfiguration_replicationTimeControl(t *testing.T
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccBucketReplicationConfigurationRTC(rName, s3.DeleteMarkerReplicationStatusDisabled, s3.ReplicationTimeStatusDisabled, s3.MetricsStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"id":                                      "foobar",
+						"delete_marker_replication.#":             "1",
+						"delete_marker_replication.0.status":      s3.DeleteMarkerReplicationStatusDisabled,
+						"destination.0.replication_time.#":        "1",
+						"destination.0.replication_time.0.status": s3.ReplicationTimeStatusDisabled,
+						"destination.0.metrics.#":                 "1",
+						"destination.0.metrics.0.status":          s3.MetricsStatusDisabled,
+					}),
+				),
+			},
+			{
+				Config: testAccBucketReplicationConfigurationRTC(rName, s3.DeleteMarkerReplicationStatusEnabled, s3.ReplicationTimeStatusEnabled, s3.MetricsStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"id":                                 "foobar",
+						"delete_marker_replication.0.status": s3.DeleteMarkerReplicationStatusEnabled,
+						"destination.0.replication_time.0.status": s3.ReplicationTimeStatusEnabled,
+						"destination.0.metrics.0.status":          s3.MetricsStatusEnabled,
+					}),
+				),
+			},
 		},
 	})
 }
@@ -1016,9 +1045,8 @@ resource "aws_s3_bucket_replication_configuration" "test" {
 }`, storageClass)
 }
 
-func testAccBucketReplicationConfigurationRTC(rName string) string {
-	return acctest.ConfigCompose(testAccBucketReplicationConfigurationBase(rName),
-		`
+func testAccBucketReplicationConfigurationRTC(rName, deleteMarkerReplicationStatus, replicationTimeStatus, metricsStatus string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationBase(rName), fmt.Sprintf(`
 resource "aws_s3_bucket_replication_configuration" "test" {
   bucket = aws_s3_bucket.source.id
   role   = aws_iam_role.test.arn
@@ -1030,25 +1058,25 @@ resource "aws_s3_bucket_replication_configuration" "test" {
     }
     status = "Enabled"
     delete_marker_replication {
-      status = "Enabled"
+      status = %[1]q
     }
     destination {
       bucket = aws_s3_bucket.destination.arn
       replication_time {
-        status = "Enabled"
+        status = %[2]q
         time {
           minutes = 15
         }
       }
       metrics {
-        status = "Enabled"
+        status = %[3]q
         event_threshold {
           minutes = 15
         }
       }
     }
   }
-}`)
+}`, deleteMarkerReplicationStatus, replicationTimeStatus, metricsStatus))
 }
 
 func testAccBucketReplicationConfigurationReplicaMods(rName string) string {
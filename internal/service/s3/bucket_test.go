@@ -81,6 +81,31 @@ func TestAccS3Bucket_Basic_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3Bucket_Basic_preflightChecksNameInUse(t *testing.T) {
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	resourceName := "aws_s3_bucket.bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(acctest.ConfigPreflightChecks(), testAccBucketConfig_Basic(bucketName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+				),
+			},
+			{
+				Config:      acctest.ConfigCompose(acctest.ConfigPreflightChecks(), testAccBucketConfig_duplicateName(bucketName)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`S3 Bucket \(.+\) already exists`),
+			},
+		},
+	})
+}
+
 // Support for common Terraform 0.11 pattern
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/7868
 func TestAccS3Bucket_Basic_emptyString(t *testing.T) {
@@ -2650,6 +2675,7 @@ func TestBucketName(t *testing.T) {
 		"bar.",
 		"foo_bar",
 		strings.Repeat("x", 64),
+		"foobar--usw2-az1--x-s3",
 	}
 
 	for _, v := range invalidDnsNames {
@@ -3467,6 +3493,14 @@ resource "aws_s3_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccBucketConfig_duplicateName(bucketName string) string {
+	return acctest.ConfigCompose(testAccBucketConfig_Basic(bucketName), fmt.Sprintf(`
+resource "aws_s3_bucket" "duplicate" {
+  bucket = %[1]q
+}
+`, bucketName))
+}
+
 func testAccBucketConfig_withNoTags(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
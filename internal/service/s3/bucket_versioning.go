@@ -84,9 +84,9 @@ func resourceBucketVersioningCreate(ctx context.Context, d *schema.ResourceData,
 		input.MFA = aws.String(v.(string))
 	}
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWhen(meta, bucket, func() (interface{}, error) {
 		return conn.PutBucketVersioningWithContext(ctx, input)
-	})
+	}, bucketNotFoundRetryable(meta, bucketCreatedTimeout))
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating S3 bucket versioning for %s: %w", bucket, err))
@@ -164,7 +164,9 @@ func resourceBucketVersioningUpdate(ctx context.Context, d *schema.ResourceData,
 		input.MFA = aws.String(v.(string))
 	}
 
-	_, err = conn.PutBucketVersioningWithContext(ctx, input)
+	_, err = retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketVersioningWithContext(ctx, input)
+	})
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating S3 bucket versioning (%s): %w", d.Id(), err))
@@ -194,7 +196,9 @@ func resourceBucketVersioningDelete(ctx context.Context, d *schema.ResourceData,
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	_, err = conn.PutBucketVersioningWithContext(ctx, input)
+	_, err = retryBucketConfigurationWrite(meta, bucket, func() (interface{}, error) {
+		return conn.PutBucketVersioningWithContext(ctx, input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 		return nil
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -26,6 +28,13 @@ func ResourceBucketWebsiteConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(bucketCreatedTimeout),
+			Read:   schema.DefaultTimeout(propagationTimeout),
+			Update: schema.DefaultTimeout(bucketLockDefaultTimeout),
+			Delete: schema.DefaultTimeout(bucketLockDefaultTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -73,6 +82,7 @@ func ResourceBucketWebsiteConfiguration() *schema.Resource {
 					"error_document",
 					"index_document",
 					"routing_rule",
+					"routing_rules",
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -89,8 +99,10 @@ func ResourceBucketWebsiteConfiguration() *schema.Resource {
 				},
 			},
 			"routing_rule": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"routing_rules"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"condition": {
@@ -143,6 +155,17 @@ func ResourceBucketWebsiteConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"routing_rules": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"redirect_all_requests_to", "routing_rule"},
+				ValidateFunc:  validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 		},
 	}
 }
@@ -171,6 +194,14 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 		websiteConfig.RoutingRules = expandS3BucketWebsiteConfigurationRoutingRules(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("routing_rules"); ok {
+		var unmarshalledRules []*s3.RoutingRule
+		if err := json.Unmarshal([]byte(v.(string)), &unmarshalledRules); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating S3 bucket (%s) website configuration: %w", bucket, err))
+		}
+		websiteConfig.RoutingRules = unmarshalledRules
+	}
+
 	input := &s3.PutBucketWebsiteInput{
 		Bucket:               aws.String(bucket),
 		WebsiteConfiguration: websiteConfig,
@@ -180,9 +211,9 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryBucketConfigurationWriteWithTimeout(bucket, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		return conn.PutBucketWebsiteWithContext(ctx, input)
-	})
+	}, bucketNotFoundRetryable(meta, d.Timeout(schema.TimeoutCreate)))
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating S3 bucket (%s) website configuration: %w", bucket, err))
@@ -209,7 +240,15 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	output, err := conn.GetBucketWebsiteWithContext(ctx, input)
+	// A newly created website configuration may not be visible immediately.
+	outputRaw, err := retryWhenNewResourceNotFoundContext(ctx, meta, d, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
+		return conn.GetBucketWebsiteWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchWebsiteConfiguration)
+
+	var output *s3.GetBucketWebsiteOutput
+	if outputRaw != nil {
+		output = outputRaw.(*s3.GetBucketWebsiteOutput)
+	}
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchWebsiteConfiguration) {
 		log.Printf("[WARN] S3 Bucket Website Configuration (%s) not found, removing from state", d.Id())
@@ -245,6 +284,16 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 		return diag.FromErr(fmt.Errorf("error setting routing_rule: %w", err))
 	}
 
+	if output.RoutingRules != nil {
+		rr, err := normalizeRoutingRules(output.RoutingRules)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error while marshaling routing rules: %w", err))
+		}
+		d.Set("routing_rules", rr)
+	} else {
+		d.Set("routing_rules", nil)
+	}
+
 	return nil
 }
 
@@ -270,8 +319,17 @@ func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.Res
 		websiteConfig.RedirectAllRequestsTo = expandS3BucketWebsiteConfigurationRedirectAllRequestsTo(v.([]interface{}))
 	}
 
-	if v, ok := d.GetOk("routing_rule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		websiteConfig.RoutingRules = expandS3BucketWebsiteConfigurationRoutingRules(v.([]interface{}))
+	// routing_rule and routing_rules are both computed, so the one that has been changed is the one in configuration.
+	if d.HasChange("routing_rule") {
+		if v, ok := d.GetOk("routing_rule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			websiteConfig.RoutingRules = expandS3BucketWebsiteConfigurationRoutingRules(v.([]interface{}))
+		}
+	} else if v, ok := d.GetOk("routing_rules"); ok {
+		var unmarshalledRules []*s3.RoutingRule
+		if err := json.Unmarshal([]byte(v.(string)), &unmarshalledRules); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating S3 bucket website configuration (%s): %w", d.Id(), err))
+		}
+		websiteConfig.RoutingRules = unmarshalledRules
 	}
 
 	input := &s3.PutBucketWebsiteInput{
@@ -283,7 +341,9 @@ func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.Res
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	_, err = conn.PutBucketWebsiteWithContext(ctx, input)
+	_, err = retryBucketConfigurationWriteWithTimeout(bucket, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.PutBucketWebsiteWithContext(ctx, input)
+	}, nil)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating S3 bucket website configuration (%s): %w", d.Id(), err))
@@ -308,7 +368,9 @@ func resourceBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.Res
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	_, err = conn.DeleteBucketWebsiteWithContext(ctx, input)
+	_, err = retryBucketConfigurationWriteWithTimeout(bucket, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteBucketWebsiteWithContext(ctx, input)
+	}, nil)
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchWebsiteConfiguration) {
 		return nil
@@ -0,0 +1,81 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketWebsiteConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_website_configuration.test"
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketWebsiteConfigurationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", resourceName, "bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "error_document.#", resourceName, "error_document.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "error_document.0.key", resourceName, "error_document.0.key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_document.#", resourceName, "index_document.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_document.0.suffix", resourceName, "index_document.0.suffix"),
+					resource.TestCheckResourceAttr(dataSourceName, "redirect_all_requests_to.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routing_rule.#", resourceName, "routing_rule.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routing_rule.0.condition.0.key_prefix_equals", resourceName, "routing_rule.0.condition.0.key_prefix_equals"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routing_rule.0.redirect.0.replace_key_prefix_with", resourceName, "routing_rule.0.redirect.0.replace_key_prefix_with"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "website_domain"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "website_endpoint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketWebsiteConfigurationDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+  acl    = "public-read"
+
+  lifecycle {
+    ignore_changes = [
+      website
+    ]
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  index_document {
+    suffix = "index.html"
+  }
+
+  error_document {
+    key = "error.html"
+  }
+
+  routing_rule {
+    condition {
+      key_prefix_equals = "docs/"
+    }
+    redirect {
+      replace_key_prefix_with = "documents/"
+    }
+  }
+}
+
+data "aws_s3_bucket_website_configuration" "test" {
+  bucket = aws_s3_bucket_website_configuration.test.bucket
+}
+`, rName)
+}
@@ -272,6 +272,45 @@ func TestAccS3BucketWebsiteConfiguration_RoutingRules_RedirectOnly(t *testing.T)
 	})
 }
 
+func TestAccS3BucketWebsiteConfiguration_routingRulesJSON(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBucketWebsiteConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketWebsiteConfigurationConfig_RoutingRulesJSON(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketWebsiteConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "routing_rule.*", map[string]string{
+						"condition.#":                        "1",
+						"condition.0.key_prefix_equals":      "docs/",
+						"redirect.#":                         "1",
+						"redirect.0.replace_key_prefix_with": "documents/",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "routing_rule.*", map[string]string{
+						"condition.#": "1",
+						"condition.0.http_error_code_returned_equals": "404",
+						"redirect.#":                  "1",
+						"redirect.0.replace_key_with": "errorpage.html",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "routing_rules"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckBucketWebsiteConfigurationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
 
@@ -592,3 +631,49 @@ resource "aws_s3_bucket_website_configuration" "test" {
 }
 `, rName)
 }
+
+func testAccBucketWebsiteConfigurationConfig_RoutingRulesJSON(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+  acl    = "public-read"
+
+  lifecycle {
+    ignore_changes = [
+      website
+    ]
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  index_document {
+    suffix = "index.html"
+  }
+
+  error_document {
+    key = "error.html"
+  }
+
+  routing_rules = jsonencode([
+    {
+      Condition = {
+        KeyPrefixEquals = "docs/"
+      }
+      Redirect = {
+        ReplaceKeyPrefixWith = "documents/"
+      }
+    },
+    {
+      Condition = {
+        HttpErrorCodeReturnedEquals = "404"
+      }
+      Redirect = {
+        ReplaceKeyWith = "errorpage.html"
+      }
+    },
+  ])
+}
+`, rName)
+}
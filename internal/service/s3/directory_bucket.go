@@ -0,0 +1,213 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceDirectoryBucket() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectoryBucketCreate,
+		ReadContext:   resourceDirectoryBucketRead,
+		DeleteContext: resourceDirectoryBucketDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceDirectoryBucketCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validDirectoryBucketName,
+			},
+			"data_redundancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.DataRedundancySingleAvailabilityZone,
+				ValidateFunc: validation.StringInSlice(s3.DataRedundancy_Values(), false),
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      s3.LocationTypeAvailabilityZone,
+							ValidateFunc: validation.StringInSlice(s3.LocationType_Values(), false),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.BucketTypeDirectory,
+				ValidateFunc: validation.StringInSlice(s3.BucketType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectoryBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ExpressConn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			Bucket: &s3.BucketInfo{
+				DataRedundancy: aws.String(d.Get("data_redundancy").(string)),
+				Type:           aws.String(d.Get("type").(string)),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CreateBucketConfiguration.Location = expandDirectoryBucketLocationInfo(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating S3 Directory Bucket: %s", input)
+	_, err := conn.CreateBucketWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating S3 Directory Bucket (%s): %w", bucket, err))
+	}
+
+	d.SetId(bucket)
+
+	_, err = tfresource.RetryWhenNotFoundContext(ctx, bucketCreatedTimeout, func() (interface{}, error) {
+		return FindDirectoryBucketByName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for S3 Directory Bucket (%s) create: %w", d.Id(), err))
+	}
+
+	return resourceDirectoryBucketRead(ctx, d, meta)
+}
+
+func resourceDirectoryBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ExpressConn
+
+	_, err := FindDirectoryBucketByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Bucket (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading S3 Directory Bucket (%s): %w", d.Id(), err))
+	}
+
+	arn := meta.(*conns.AWSClient).RegionalARN("s3express", fmt.Sprintf("bucket/%s", d.Id()))
+	d.Set("arn", arn)
+	d.Set("bucket", d.Id())
+
+	// ListDirectoryBuckets only returns bucket names and creation dates.
+	// The location is encoded in the bucket name and the remaining arguments have a single valid value.
+	if _, ok := d.GetOk("data_redundancy"); !ok {
+		d.Set("data_redundancy", s3.DataRedundancySingleAvailabilityZone)
+	}
+	if err := d.Set("location", []interface{}{
+		map[string]interface{}{
+			"name": directoryBucketNameAvailabilityZoneID(d.Id()),
+			"type": s3.LocationTypeAvailabilityZone,
+		},
+	}); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting location: %w", err))
+	}
+	if _, ok := d.GetOk("type"); !ok {
+		d.Set("type", s3.BucketTypeDirectory)
+	}
+
+	return nil
+}
+
+func resourceDirectoryBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ExpressConn
+
+	log.Printf("[DEBUG] Deleting S3 Directory Bucket: %s", d.Id())
+	_, err := conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeBucketNotEmpty) {
+		return diag.FromErr(fmt.Errorf("error deleting S3 Directory Bucket (%s): the bucket must be emptied before it can be deleted: %w", d.Id(), err))
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting S3 Directory Bucket (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceDirectoryBucketCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	bucket := diff.Get("bucket").(string)
+	azID := diff.Get("location.0.name").(string)
+
+	// Either value may be unknown until apply.
+	if bucket == "" || azID == "" {
+		return nil
+	}
+
+	if v := directoryBucketNameAvailabilityZoneID(bucket); v != "" && v != azID {
+		return fmt.Errorf("bucket name %q must include the Availability Zone ID of location.0.name (%s), e.g. bucket-base-name--%s--x-s3", bucket, azID, azID)
+	}
+
+	return nil
+}
+
+func expandDirectoryBucketLocationInfo(tfMap map[string]interface{}) *s3.LocationInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.LocationInfo{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
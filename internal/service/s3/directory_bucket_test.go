@@ -0,0 +1,130 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3DirectoryBucket_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectoryBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryBucketConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryBucketExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "s3express", regexp.MustCompile(`bucket/.+--x-s3$`)),
+					resource.TestCheckResourceAttr(resourceName, "data_redundancy", s3.DataRedundancySingleAvailabilityZone),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "location.0.name", "data.aws_availability_zones.available", "zone_ids.0"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.type", s3.LocationTypeAvailabilityZone),
+					resource.TestCheckResourceAttr(resourceName, "type", s3.BucketTypeDirectory),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryBucket_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectoryBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryBucketConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryBucketExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceDirectoryBucket(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDirectoryBucketDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ExpressConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_bucket" {
+			continue
+		}
+
+		_, err := tfs3.FindDirectoryBucketByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Directory Bucket %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDirectoryBucketExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Directory Bucket ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ExpressConn
+
+		_, err := tfs3.FindDirectoryBucketByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDirectoryBucketConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+locals {
+  location_name = data.aws_availability_zones.available.zone_ids[0]
+  bucket        = "%[1]s--${local.location_name}--x-s3"
+}
+
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
+  }
+}
+`, rName))
+}
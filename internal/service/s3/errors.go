@@ -4,9 +4,12 @@ package s3
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#pkg-constants
 
 const (
+	ErrCodeAccessDenied                         = "AccessDenied"
+	ErrCodeBucketNotEmpty                       = "BucketNotEmpty"
 	ErrCodeNoSuchConfiguration                  = "NoSuchConfiguration"
 	ErrCodeNoSuchCORSConfiguration              = "NoSuchCORSConfiguration"
 	ErrCodeNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
 	ErrCodeNoSuchWebsiteConfiguration           = "NoSuchWebsiteConfiguration"
+	ErrCodeNotFound                             = "NotFound"
 	ErrCodeOperationAborted                     = "OperationAborted"
 )
@@ -0,0 +1,44 @@
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// FindDirectoryBucketByName returns the directory bucket with the specified name.
+// Directory buckets are not returned by ListBuckets and HeadBucket requires the zonal endpoint,
+// so the bucket is looked up with ListDirectoryBuckets on the S3 Express One Zone control plane.
+func FindDirectoryBucketByName(ctx context.Context, conn *s3.S3, name string) (*s3.Bucket, error) {
+	input := &s3.ListDirectoryBucketsInput{}
+	var result *s3.Bucket
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, input, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, bucket := range page.Buckets {
+			if bucket != nil && aws.StringValue(bucket.Name) == name {
+				result = bucket
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
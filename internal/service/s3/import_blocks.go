@@ -0,0 +1,206 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/importblock"
+)
+
+func init() {
+	importblock.Register("s3", listBucketImportBlocks)
+}
+
+// listBucketImportBlocks discovers the buckets in the client's region together with
+// any configuration that is managed by a standalone bucket configuration resource.
+func listBucketImportBlocks(ctx context.Context, client *conns.AWSClient, filter importblock.Filter) ([]importblock.Resource, error) {
+	conn := client.S3Conn
+
+	output, err := conn.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []importblock.Resource
+
+	for _, v := range output.Buckets {
+		bucket := aws.StringValue(v.Name)
+
+		if !filter.Match(bucket) {
+			continue
+		}
+
+		location, err := conn.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
+			Bucket: aws.String(bucket),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Skipping S3 Bucket (%s): error reading location: %s", bucket, err)
+			continue
+		}
+
+		if region := s3.NormalizeBucketLocation(aws.StringValue(location.LocationConstraint)); region != client.Region {
+			continue
+		}
+
+		r, err := listBucketConfigurationImportBlocks(ctx, conn, bucket)
+
+		// e.g. access is denied or the bucket was deleted since it was listed.
+		if err != nil {
+			log.Printf("[WARN] Skipping S3 Bucket (%s): error reading configuration: %s", bucket, err)
+			continue
+		}
+
+		resources = append(resources, importblock.Resource{
+			Type: "aws_s3_bucket",
+			ID:   bucket,
+			Name: bucket,
+			Arguments: map[string]interface{}{
+				"bucket": bucket,
+			},
+		})
+
+		resources = append(resources, r...)
+	}
+
+	return resources, nil
+}
+
+func listBucketConfigurationImportBlocks(ctx context.Context, conn *s3.S3, bucket string) ([]importblock.Resource, error) {
+	var resources []importblock.Resource
+
+	versioning, err := conn.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if versioning.Status != nil {
+		resources = append(resources, importblock.Resource{
+			Type: "aws_s3_bucket_versioning",
+			ID:   CreateResourceID(bucket, ""),
+			Name: bucket,
+			Arguments: map[string]interface{}{
+				"bucket": bucket,
+				"versioning_configuration": map[string]interface{}{
+					"status": aws.StringValue(versioning.Status),
+				},
+			},
+		})
+	}
+
+	website, err := conn.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucket),
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNoSuchWebsiteConfiguration):
+	case err != nil:
+		return nil, err
+	default:
+		arguments := map[string]interface{}{
+			"bucket": bucket,
+		}
+
+		if website.RedirectAllRequestsTo != nil {
+			arguments["redirect_all_requests_to"] = map[string]interface{}{
+				"host_name": aws.StringValue(website.RedirectAllRequestsTo.HostName),
+			}
+		} else if website.IndexDocument != nil {
+			arguments["index_document"] = map[string]interface{}{
+				"suffix": aws.StringValue(website.IndexDocument.Suffix),
+			}
+		}
+
+		resources = append(resources, importblock.Resource{
+			Type:      "aws_s3_bucket_website_configuration",
+			ID:        resourceBucketWebsiteConfigurationCreateResourceID(bucket, ""),
+			Name:      bucket,
+			Arguments: arguments,
+		})
+	}
+
+	cors, err := conn.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucket),
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration):
+	case err != nil:
+		return nil, err
+	case len(cors.CORSRules) > 0:
+		rules := make([]map[string]interface{}, len(cors.CORSRules))
+
+		for i, rule := range cors.CORSRules {
+			rules[i] = map[string]interface{}{
+				"allowed_methods": aws.StringValueSlice(rule.AllowedMethods),
+				"allowed_origins": aws.StringValueSlice(rule.AllowedOrigins),
+			}
+		}
+
+		resources = append(resources, importblock.Resource{
+			Type: "aws_s3_bucket_cors_configuration",
+			ID:   CreateResourceID(bucket, ""),
+			Name: bucket,
+			Arguments: map[string]interface{}{
+				"bucket":    bucket,
+				"cors_rule": rules,
+			},
+		})
+	}
+
+	input := &s3.ListBucketIntelligentTieringConfigurationsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	for {
+		output, err := conn.ListBucketIntelligentTieringConfigurationsWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.IntelligentTieringConfigurationList {
+			name := aws.StringValue(v.Id)
+			arguments := map[string]interface{}{
+				"bucket": bucket,
+				"name":   name,
+			}
+
+			if len(v.Tierings) > 0 {
+				tierings := make([]map[string]interface{}, len(v.Tierings))
+
+				for i, tiering := range v.Tierings {
+					tierings[i] = map[string]interface{}{
+						"access_tier": aws.StringValue(tiering.AccessTier),
+						"days":        aws.Int64Value(tiering.Days),
+					}
+				}
+
+				arguments["tiering"] = tierings
+			}
+
+			resources = append(resources, importblock.Resource{
+				Type:      "aws_s3_bucket_intelligent_tiering_configuration",
+				ID:        BucketIntelligentTieringConfigurationCreateResourceID(bucket, name),
+				Name:      bucket + "_" + name,
+				Arguments: arguments,
+			})
+		}
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.ContinuationToken = output.NextContinuationToken
+	}
+
+	return resources, nil
+}
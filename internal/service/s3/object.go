@@ -276,7 +276,7 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Retry due to S3 eventual consistency
-	tagsRaw, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	tagsRaw, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return ObjectListTags(conn, bucket, key)
 	})
 
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -364,7 +365,7 @@ func resourceObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Retry due to S3 eventual consistency
-	tagsRaw, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	tagsRaw, err := retryWhenBucketNotFound(meta, bucketCreatedTimeout, func() (interface{}, error) {
 		return ObjectListTags(conn, bucket, key)
 	})
 
@@ -625,6 +626,23 @@ func resourceObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
 		input.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
+	// CopyObject is limited to objects of up to 5 GB; larger objects are copied in parts.
+	// The source object is not necessarily readable in this Region, in which case a single operation copy is attempted.
+	source := d.Get("source").(string)
+	sourceObject, err := findObjectCopySource(conn, source, input)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read S3 object copy source (%s), copying in a single operation: %s", source, err)
+	}
+
+	if sourceObject != nil && aws.Int64Value(sourceObject.ContentLength) > objectCopyMaxSingleOperationSize {
+		if err := resourceObjectCopyDoMultipartCopy(d, conn, source, input, sourceObject); err != nil {
+			return fmt.Errorf("error copying S3 object (bucket: %s; key: %s; source: %s): %w", aws.StringValue(input.Bucket), aws.StringValue(input.Key), aws.StringValue(input.CopySource), err)
+		}
+
+		d.SetId(d.Get("key").(string))
+		return resourceObjectRead(d, meta)
+	}
+
 	output, err := conn.CopyObject(input)
 	if err != nil {
 		return fmt.Errorf("error copying S3 object (bucket: %s; key: %s; source: %s): %w", aws.StringValue(input.Bucket), aws.StringValue(input.Key), aws.StringValue(input.CopySource), err)
@@ -650,6 +668,283 @@ func resourceObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
 	return resourceObjectRead(d, meta)
 }
 
+const (
+	// objectCopyMaxSingleOperationSize is the largest object that can be copied with a single CopyObject call.
+	objectCopyMaxSingleOperationSize = 5 * 1024 * 1024 * 1024
+	// objectCopyMultipartPartSize is the default size of each part of a multipart copy.
+	objectCopyMultipartPartSize = 512 * 1024 * 1024
+	// objectCopyMultipartMaxParts is the maximum number of parts in a multipart upload.
+	objectCopyMultipartMaxParts = 10000
+)
+
+// parseObjectCopySource splits a copy source, either "bucket/key" or an access point ARN followed by "/object/key",
+// into its bucket, key and optional version ID.
+func parseObjectCopySource(source string) (string, string, string, error) {
+	var versionID string
+
+	if i := strings.LastIndex(source, "?versionId="); i >= 0 {
+		source, versionID = source[:i], source[i+len("?versionId="):]
+	}
+
+	source = strings.TrimPrefix(source, "/")
+
+	var bucket, key string
+
+	if arn.IsARN(source) {
+		parts := strings.SplitN(source, "/object/", 2)
+
+		if len(parts) == 2 {
+			bucket, key = parts[0], parts[1]
+		}
+	} else {
+		parts := strings.SplitN(source, "/", 2)
+
+		if len(parts) == 2 {
+			bucket, key = parts[0], parts[1]
+		}
+	}
+
+	if bucket == "" || key == "" {
+		return "", "", "", fmt.Errorf("unexpected format for copy source (%s), expected BUCKET/KEY or ACCESS-POINT-ARN/object/KEY", source)
+	}
+
+	return bucket, key, versionID, nil
+}
+
+// findObjectCopySource returns the metadata of the copy source object.
+func findObjectCopySource(conn *s3.S3, source string, copyInput *s3.CopyObjectInput) (*s3.HeadObjectOutput, error) {
+	bucket, key, versionID, err := parseObjectCopySource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &s3.HeadObjectInput{
+		Bucket:               aws.String(bucket),
+		Key:                  aws.String(key),
+		ExpectedBucketOwner:  copyInput.ExpectedSourceBucketOwner,
+		RequestPayer:         copyInput.RequestPayer,
+		SSECustomerAlgorithm: copyInput.CopySourceSSECustomerAlgorithm,
+		SSECustomerKey:       copyInput.CopySourceSSECustomerKey,
+		SSECustomerKeyMD5:    copyInput.CopySourceSSECustomerKeyMD5,
+	}
+
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	return conn.HeadObject(input)
+}
+
+// objectCopyPartRanges returns the inclusive byte ranges of the parts of a multipart copy of an object of the specified size.
+func objectCopyPartRanges(size int64) [][2]int64 {
+	partSize := int64(objectCopyMultipartPartSize)
+
+	if n := (size + partSize - 1) / partSize; n > objectCopyMultipartMaxParts {
+		partSize = (size + objectCopyMultipartMaxParts - 1) / objectCopyMultipartMaxParts
+	}
+
+	var ranges [][2]int64
+
+	for start := int64(0); start < size; start += partSize {
+		end := start + partSize - 1
+
+		if end >= size {
+			end = size - 1
+		}
+
+		ranges = append(ranges, [2]int64{start, end})
+	}
+
+	return ranges
+}
+
+// resourceObjectCopyDoMultipartCopy copies the source object in parts with UploadPartCopy.
+// Unlike CopyObject, a multipart upload does not copy the source object's metadata or tags,
+// so unless they are replaced they are read from the source object and set on the new object.
+func resourceObjectCopyDoMultipartCopy(d *schema.ResourceData, conn *s3.S3, source string, copyInput *s3.CopyObjectInput, sourceObject *s3.HeadObjectOutput) error {
+	input := &s3.CreateMultipartUploadInput{
+		ACL:                       copyInput.ACL,
+		Bucket:                    copyInput.Bucket,
+		BucketKeyEnabled:          copyInput.BucketKeyEnabled,
+		CacheControl:              copyInput.CacheControl,
+		ContentDisposition:        copyInput.ContentDisposition,
+		ContentEncoding:           copyInput.ContentEncoding,
+		ContentLanguage:           copyInput.ContentLanguage,
+		ContentType:               copyInput.ContentType,
+		ExpectedBucketOwner:       copyInput.ExpectedBucketOwner,
+		Expires:                   copyInput.Expires,
+		GrantFullControl:          copyInput.GrantFullControl,
+		GrantRead:                 copyInput.GrantRead,
+		GrantReadACP:              copyInput.GrantReadACP,
+		GrantWriteACP:             copyInput.GrantWriteACP,
+		Key:                       copyInput.Key,
+		Metadata:                  copyInput.Metadata,
+		ObjectLockLegalHoldStatus: copyInput.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            copyInput.ObjectLockMode,
+		ObjectLockRetainUntilDate: copyInput.ObjectLockRetainUntilDate,
+		RequestPayer:              copyInput.RequestPayer,
+		SSECustomerAlgorithm:      copyInput.SSECustomerAlgorithm,
+		SSECustomerKey:            copyInput.SSECustomerKey,
+		SSECustomerKeyMD5:         copyInput.SSECustomerKeyMD5,
+		SSEKMSEncryptionContext:   copyInput.SSEKMSEncryptionContext,
+		SSEKMSKeyId:               copyInput.SSEKMSKeyId,
+		ServerSideEncryption:      copyInput.ServerSideEncryption,
+		StorageClass:              copyInput.StorageClass,
+		Tagging:                   copyInput.Tagging,
+		WebsiteRedirectLocation:   copyInput.WebsiteRedirectLocation,
+	}
+
+	bucket, key, versionID, err := parseObjectCopySource(source)
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(copyInput.MetadataDirective) != s3.MetadataDirectiveReplace {
+		input.CacheControl = sourceObject.CacheControl
+		input.ContentDisposition = sourceObject.ContentDisposition
+		input.ContentEncoding = sourceObject.ContentEncoding
+		input.ContentLanguage = sourceObject.ContentLanguage
+		input.ContentType = sourceObject.ContentType
+		input.Metadata = sourceObject.Metadata
+		input.WebsiteRedirectLocation = sourceObject.WebsiteRedirectLocation
+
+		if v, err := http.ParseTime(aws.StringValue(sourceObject.Expires)); err == nil {
+			input.Expires = aws.Time(v)
+		} else {
+			input.Expires = nil
+		}
+	}
+
+	if aws.StringValue(copyInput.TaggingDirective) != s3.TaggingDirectiveReplace {
+		taggingInput := &s3.GetObjectTaggingInput{
+			Bucket:              aws.String(bucket),
+			Key:                 aws.String(key),
+			ExpectedBucketOwner: copyInput.ExpectedSourceBucketOwner,
+			RequestPayer:        copyInput.RequestPayer,
+		}
+
+		if versionID != "" {
+			taggingInput.VersionId = aws.String(versionID)
+		}
+
+		output, err := conn.GetObjectTagging(taggingInput)
+		if err != nil {
+			return fmt.Errorf("error reading source object tags: %w", err)
+		}
+
+		input.Tagging = nil
+
+		if tags := KeyValueTags(output.TagSet); len(tags) > 0 {
+			input.Tagging = aws.String(tags.UrlEncode())
+		}
+	}
+
+	createOutput, err := conn.CreateMultipartUpload(input)
+	if err != nil {
+		return fmt.Errorf("error creating multipart upload: %w", err)
+	}
+
+	uploadID := createOutput.UploadId
+
+	completedParts, sourceVersionID, err := objectCopyUploadParts(conn, copyInput, uploadID, aws.Int64Value(sourceObject.ContentLength))
+	if err != nil {
+		log.Printf("[DEBUG] Aborting S3 multipart upload (%s)", aws.StringValue(uploadID))
+		_, abortErr := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+			Bucket:              copyInput.Bucket,
+			ExpectedBucketOwner: copyInput.ExpectedBucketOwner,
+			Key:                 copyInput.Key,
+			RequestPayer:        copyInput.RequestPayer,
+			UploadId:            uploadID,
+		})
+
+		if abortErr != nil {
+			log.Printf("[WARN] error aborting S3 multipart upload (%s): %s", aws.StringValue(uploadID), abortErr)
+		}
+
+		return err
+	}
+
+	output, err := conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:               copyInput.Bucket,
+		ExpectedBucketOwner:  copyInput.ExpectedBucketOwner,
+		Key:                  copyInput.Key,
+		MultipartUpload:      &s3.CompletedMultipartUpload{Parts: completedParts},
+		RequestPayer:         copyInput.RequestPayer,
+		SSECustomerAlgorithm: copyInput.SSECustomerAlgorithm,
+		SSECustomerKey:       copyInput.SSECustomerKey,
+		SSECustomerKeyMD5:    copyInput.SSECustomerKeyMD5,
+		UploadId:             uploadID,
+	})
+	if err != nil {
+		return fmt.Errorf("error completing multipart upload (%s): %w", aws.StringValue(uploadID), err)
+	}
+
+	d.Set("customer_algorithm", createOutput.SSECustomerAlgorithm)
+	d.Set("customer_key_md5", createOutput.SSECustomerKeyMD5)
+	d.Set("etag", strings.Trim(aws.StringValue(output.ETag), `"`))
+	d.Set("expiration", output.Expiration)
+	d.Set("kms_encryption_context", createOutput.SSEKMSEncryptionContext)
+	d.Set("kms_key_id", output.SSEKMSKeyId)
+	d.Set("request_charged", output.RequestCharged)
+	d.Set("server_side_encryption", output.ServerSideEncryption)
+	d.Set("source_version_id", sourceVersionID)
+	d.Set("version_id", output.VersionId)
+
+	return nil
+}
+
+// objectCopyUploadParts copies each part of the source object into the specified multipart upload.
+func objectCopyUploadParts(conn *s3.S3, copyInput *s3.CopyObjectInput, uploadID *string, size int64) ([]*s3.CompletedPart, string, error) {
+	var completedParts []*s3.CompletedPart
+	var sourceVersionID string
+
+	for i, r := range objectCopyPartRanges(size) {
+		partNumber := int64(i + 1)
+
+		input := &s3.UploadPartCopyInput{
+			Bucket:                         copyInput.Bucket,
+			CopySource:                     copyInput.CopySource,
+			CopySourceIfMatch:              copyInput.CopySourceIfMatch,
+			CopySourceIfModifiedSince:      copyInput.CopySourceIfModifiedSince,
+			CopySourceIfNoneMatch:          copyInput.CopySourceIfNoneMatch,
+			CopySourceIfUnmodifiedSince:    copyInput.CopySourceIfUnmodifiedSince,
+			CopySourceRange:                aws.String(fmt.Sprintf("bytes=%d-%d", r[0], r[1])),
+			CopySourceSSECustomerAlgorithm: copyInput.CopySourceSSECustomerAlgorithm,
+			CopySourceSSECustomerKey:       copyInput.CopySourceSSECustomerKey,
+			CopySourceSSECustomerKeyMD5:    copyInput.CopySourceSSECustomerKeyMD5,
+			ExpectedBucketOwner:            copyInput.ExpectedBucketOwner,
+			ExpectedSourceBucketOwner:      copyInput.ExpectedSourceBucketOwner,
+			Key:                            copyInput.Key,
+			PartNumber:                     aws.Int64(partNumber),
+			RequestPayer:                   copyInput.RequestPayer,
+			SSECustomerAlgorithm:           copyInput.SSECustomerAlgorithm,
+			SSECustomerKey:                 copyInput.SSECustomerKey,
+			SSECustomerKeyMD5:              copyInput.SSECustomerKeyMD5,
+			UploadId:                       uploadID,
+		}
+
+		log.Printf("[DEBUG] Copying S3 object part %d (%s)", partNumber, aws.StringValue(input.CopySourceRange))
+		output, err := conn.UploadPartCopy(input)
+		if err != nil {
+			return nil, "", fmt.Errorf("error copying part %d: %w", partNumber, err)
+		}
+
+		sourceVersionID = aws.StringValue(output.CopySourceVersionId)
+
+		completedPart := &s3.CompletedPart{
+			PartNumber: aws.Int64(partNumber),
+		}
+
+		if output.CopyPartResult != nil {
+			completedPart.ETag = output.CopyPartResult.ETag
+		}
+
+		completedParts = append(completedParts, completedPart)
+	}
+
+	return completedParts, sourceVersionID, nil
+}
+
 type s3Grants struct {
 	FullControl *string
 	Read        *string
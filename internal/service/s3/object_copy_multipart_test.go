@@ -0,0 +1,111 @@
+package s3
+
+import (
+	"testing"
+)
+
+func TestParseObjectCopySource(t *testing.T) {
+	testCases := []struct {
+		Source            string
+		ExpectedBucket    string
+		ExpectedKey       string
+		ExpectedVersionID string
+		ExpectError       bool
+	}{
+		{
+			Source:         "bucket/key",
+			ExpectedBucket: "bucket",
+			ExpectedKey:    "key",
+		},
+		{
+			Source:         "/bucket/prefix/key",
+			ExpectedBucket: "bucket",
+			ExpectedKey:    "prefix/key",
+		},
+		{
+			Source:            "bucket/key?versionId=abc123",
+			ExpectedBucket:    "bucket",
+			ExpectedKey:       "key",
+			ExpectedVersionID: "abc123",
+		},
+		{
+			Source:         "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point/object/prefix/key", //lintignore:AWSAT003,AWSAT005
+			ExpectedBucket: "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point",                   //lintignore:AWSAT003,AWSAT005
+			ExpectedKey:    "prefix/key",
+		},
+		{
+			Source:      "bucket",
+			ExpectError: true,
+		},
+		{
+			Source:      "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point", //lintignore:AWSAT003,AWSAT005
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		bucket, key, versionID, err := parseObjectCopySource(testCase.Source)
+
+		if testCase.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected error", testCase.Source)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", testCase.Source, err)
+			continue
+		}
+
+		if bucket != testCase.ExpectedBucket || key != testCase.ExpectedKey || versionID != testCase.ExpectedVersionID {
+			t.Errorf("%s: got (%s, %s, %s), expected (%s, %s, %s)", testCase.Source, bucket, key, versionID, testCase.ExpectedBucket, testCase.ExpectedKey, testCase.ExpectedVersionID)
+		}
+	}
+}
+
+func TestObjectCopyPartRanges(t *testing.T) {
+	const gib = 1024 * 1024 * 1024
+
+	testCases := []struct {
+		Size          int64
+		ExpectedParts int
+	}{
+		{
+			Size:          6 * gib,
+			ExpectedParts: 12,
+		},
+		{
+			Size:          6*gib + 1,
+			ExpectedParts: 13,
+		},
+		{
+			Size:          5 * 1024 * gib,
+			ExpectedParts: objectCopyMultipartMaxParts,
+		},
+	}
+
+	for _, testCase := range testCases {
+		ranges := objectCopyPartRanges(testCase.Size)
+
+		if got, want := len(ranges), testCase.ExpectedParts; got != want {
+			t.Errorf("size %d: got %d parts, expected %d", testCase.Size, got, want)
+			continue
+		}
+
+		var next int64
+
+		for _, r := range ranges {
+			if r[0] != next || r[1] < r[0] {
+				t.Errorf("size %d: unexpected range %v", testCase.Size, r)
+			}
+
+			next = r[1] + 1
+		}
+
+		if next != testCase.Size {
+			t.Errorf("size %d: ranges cover %d bytes", testCase.Size, next)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 )
 
@@ -15,3 +16,29 @@ func validBucketLifecycleTimestamp(v interface{}, k string) (ws []string, errors
 
 	return
 }
+
+// directoryBucketNameRegexp matches directory bucket names of the form bucket-base-name--azid--x-s3.
+var directoryBucketNameRegexp = regexp.MustCompile(`^([0-9a-z][0-9a-z-]*[0-9a-z])--([a-z0-9]+-az[0-9]+)--x-s3$`)
+
+func validDirectoryBucketName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 3 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf("%q must contain from 3 to 63 characters", k))
+	}
+
+	if !directoryBucketNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be in the format bucket-base-name--azid--x-s3, using only lowercase alphanumeric characters and hyphens, got: %s", k, value))
+	}
+
+	return
+}
+
+// directoryBucketNameAvailabilityZoneID returns the Availability Zone ID embedded in a directory bucket name.
+func directoryBucketNameAvailabilityZoneID(name string) string {
+	if m := directoryBucketNameRegexp.FindStringSubmatch(name); m != nil {
+		return m[2]
+	}
+
+	return ""
+}
@@ -29,3 +29,45 @@ func TestValidBucketLifecycleTimestamp(t *testing.T) {
 		}
 	}
 }
+
+func TestValidDirectoryBucketName(t *testing.T) {
+	validNames := []string{
+		"example--usw2-az1--x-s3",
+		"my-bucket-1--use1-az4--x-s3",
+		"abc--apne1-az10--x-s3",
+	}
+
+	for _, v := range validNames {
+		_, errors := validDirectoryBucketName(v, "bucket")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid directory bucket name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"example",
+		"example--x-s3",
+		"Example--usw2-az1--x-s3",
+		"example.bucket--usw2-az1--x-s3",
+		"-example--usw2-az1--x-s3",
+		"example--usw2-az1--x-s3-suffix",
+		"a-very-long-bucket-base-name-exceeding-the-limit--usw2-az1--x-s3",
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validDirectoryBucketName(v, "bucket")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid directory bucket name", v)
+		}
+	}
+}
+
+func TestDirectoryBucketNameAvailabilityZoneID(t *testing.T) {
+	if got, want := directoryBucketNameAvailabilityZoneID("example--usw2-az1--x-s3"), "usw2-az1"; got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+
+	if got, want := directoryBucketNameAvailabilityZoneID("example"), ""; got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+}
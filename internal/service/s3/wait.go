@@ -1,17 +1,119 @@
 package s3
 
 import (
+	"context"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
-	bucketCreatedTimeout = 2 * time.Minute
-	propagationTimeout   = 1 * time.Minute
+	bucketCreatedTimeout     = 2 * time.Minute
+	bucketLockDefaultTimeout = 5 * time.Minute
+	propagationTimeout       = 1 * time.Minute
 )
 
-func retryWhenBucketNotFound(f func() (interface{}, error)) (interface{}, error) {
-	return tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, f, s3.ErrCodeNoSuchBucket)
+// retryWhenBucketNotFound retries the specified function while S3 reports that the bucket does not exist,
+// or returns one of the specified additional error codes, within a single timeout.
+// The bucket not found error is not retried if the provider is configured to skip eventual consistency retries.
+func retryWhenBucketNotFound(meta interface{}, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) {
+	if !meta.(*conns.AWSClient).S3SkipEventualConsistencyRetries {
+		codes = append(codes, s3.ErrCodeNoSuchBucket)
+	}
+
+	if len(codes) == 0 {
+		return f()
+	}
+
+	return tfresource.RetryWhenAWSErrCodeEquals(timeout, f, codes...)
+}
+
+// retryWhenNewResourceNotFoundContext retries the specified function, which reads a newly created resource, while S3 returns
+// one of the specified error codes because the bucket or its configuration is not visible yet.
+// Nothing is retried if the resource is not new or the provider is configured to skip eventual consistency retries.
+func retryWhenNewResourceNotFoundContext(ctx context.Context, meta interface{}, d *schema.ResourceData, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) {
+	if !d.IsNewResource() || meta.(*conns.AWSClient).S3SkipEventualConsistencyRetries {
+		return f()
+	}
+
+	return tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, timeout, f, codes...)
+}
+
+// retryWhenNewResourceNotFound retries the specified function, which reads a newly created resource, while S3 returns
+// one of the specified error codes because the bucket or its configuration is not visible yet.
+func retryWhenNewResourceNotFound(meta interface{}, d *schema.ResourceData, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) {
+	return retryWhenNewResourceNotFoundContext(context.Background(), meta, d, timeout, f, codes...)
+}
+
+// retryBucketConfigurationWrite calls the specified function, which writes to the bucket's configuration,
+// while holding a per-bucket lock so that writes from resources managing the same bucket are serialized.
+// The function is retried while S3 reports a conflicting conditional operation (OperationAborted), e.g. from another process.
+// The lock wait and the retries are bounded by the provider's s3_bucket_lock_timeout.
+func retryBucketConfigurationWrite(meta interface{}, bucket string, f func() (interface{}, error)) (interface{}, error) {
+	return retryBucketConfigurationWriteWhen(meta, bucket, f, nil)
+}
+
+// retryBucketConfigurationWriteWhen is retryBucketConfigurationWrite that also retries the function
+// while retryable reports its error as retryable.
+func retryBucketConfigurationWriteWhen(meta interface{}, bucket string, f func() (interface{}, error), retryable tfresource.Retryable) (interface{}, error) {
+	timeout := meta.(*conns.AWSClient).S3BucketLockTimeout
+
+	if timeout == 0 {
+		timeout = bucketLockDefaultTimeout
+	}
+
+	return retryBucketConfigurationWriteWithTimeout(bucket, timeout, f, retryable)
+}
+
+// retryBucketConfigurationWriteWithTimeout is retryBucketConfigurationWriteWhen with the lock wait and the retries
+// together bounded by the specified timeout, e.g. a resource's configured operation timeout.
+func retryBucketConfigurationWriteWithTimeout(bucket string, timeout time.Duration, f func() (interface{}, error), retryable tfresource.Retryable) (interface{}, error) {
+	mutexKey := "s3-bucket-configuration-" + bucket
+	deadline := time.Now().Add(timeout)
+
+	if err := conns.GlobalMutexKV.LockWithTimeout(mutexKey, timeout); err != nil {
+		return nil, err
+	}
+
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	// The retries only get the time left after waiting for the lock.
+	return tfresource.RetryWhen(time.Until(deadline), f, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, ErrCodeOperationAborted) {
+			return true, err
+		}
+
+		if retryable != nil {
+			return retryable(err)
+		}
+
+		return false, err
+	})
+}
+
+// bucketNotFoundRetryable returns a retryable for retryBucketConfigurationWriteWhen that retries S3 reporting
+// that the bucket does not exist for up to the specified timeout after the first such error.
+// Nothing is retried if the provider is configured to skip eventual consistency retries.
+func bucketNotFoundRetryable(meta interface{}, timeout time.Duration) tfresource.Retryable {
+	if meta.(*conns.AWSClient).S3SkipEventualConsistencyRetries {
+		return nil
+	}
+
+	var start time.Time
+
+	return func(err error) (bool, error) {
+		if !tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return false, err
+		}
+
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		return time.Since(start) < timeout, err
+	}
 }
@@ -0,0 +1,237 @@
+package s3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestRetryWhenBucketNotFound(t *testing.T) {
+	testCases := []struct {
+		Name                             string
+		S3SkipEventualConsistencyRetries bool
+		ExpectedCalls                    int
+		ExpectNoSuchBucket               bool
+	}{
+		{
+			Name:          "retries",
+			ExpectedCalls: 2,
+		},
+		{
+			Name:                             "skip eventual consistency retries",
+			S3SkipEventualConsistencyRetries: true,
+			ExpectedCalls:                    1,
+			ExpectNoSuchBucket:               true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			meta := &conns.AWSClient{S3SkipEventualConsistencyRetries: testCase.S3SkipEventualConsistencyRetries}
+			calls := 0
+
+			_, err := retryWhenBucketNotFound(meta, 1*time.Minute, func() (interface{}, error) {
+				calls++
+
+				if calls == 1 {
+					return nil, awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil)
+				}
+
+				return nil, nil
+			})
+
+			if got, want := calls, testCase.ExpectedCalls; got != want {
+				t.Errorf("calls: got %d, want %d", got, want)
+			}
+
+			if testCase.ExpectNoSuchBucket {
+				if !tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+					t.Errorf("expected %s error, got: %v", s3.ErrCodeNoSuchBucket, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestRetryWhenNewResourceNotFound(t *testing.T) {
+	testCases := []struct {
+		Name                             string
+		NewResource                      bool
+		S3SkipEventualConsistencyRetries bool
+		ExpectedCalls                    int
+		ExpectNoSuchBucket               bool
+	}{
+		{
+			Name:          "new resource",
+			NewResource:   true,
+			ExpectedCalls: 2,
+		},
+		{
+			Name:               "existing resource",
+			ExpectedCalls:      1,
+			ExpectNoSuchBucket: true,
+		},
+		{
+			Name:                             "skip eventual consistency retries",
+			NewResource:                      true,
+			S3SkipEventualConsistencyRetries: true,
+			ExpectedCalls:                    1,
+			ExpectNoSuchBucket:               true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			meta := &conns.AWSClient{S3SkipEventualConsistencyRetries: testCase.S3SkipEventualConsistencyRetries}
+			d := ResourceBucket().TestResourceData()
+			if testCase.NewResource {
+				d.MarkNewResource()
+			}
+			calls := 0
+
+			_, err := retryWhenNewResourceNotFound(meta, d, 1*time.Minute, func() (interface{}, error) {
+				calls++
+
+				if calls == 1 {
+					return nil, awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil)
+				}
+
+				return nil, nil
+			}, s3.ErrCodeNoSuchBucket)
+
+			if got, want := calls, testCase.ExpectedCalls; got != want {
+				t.Errorf("calls: got %d, want %d", got, want)
+			}
+
+			if testCase.ExpectNoSuchBucket {
+				if !tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+					t.Errorf("expected %s error, got: %v", s3.ErrCodeNoSuchBucket, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestRetryBucketConfigurationWriteWhen(t *testing.T) {
+	meta := &conns.AWSClient{S3BucketLockTimeout: 1 * time.Minute}
+	errs := []error{
+		awserr.New(ErrCodeOperationAborted, "A conflicting conditional operation is currently in progress against this resource", nil),
+		awserr.New("MalformedPolicy", "Invalid principal in policy", nil),
+		nil,
+	}
+	calls := 0
+
+	_, err := retryBucketConfigurationWriteWhen(meta, "test-bucket", func() (interface{}, error) {
+		err := errs[calls]
+		calls++
+
+		return nil, err
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, "MalformedPolicy") {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if got, want := calls, len(errs); got != want {
+		t.Errorf("calls: got %d, want %d", got, want)
+	}
+}
+
+func TestRetryBucketConfigurationWriteWithTimeoutLockWait(t *testing.T) {
+	const (
+		bucket  = "test-bucket-lock-wait"
+		timeout = 2 * time.Second
+	)
+
+	mutexKey := "s3-bucket-configuration-" + bucket
+	conns.GlobalMutexKV.Lock(mutexKey)
+
+	// Another write to the same bucket holds the lock for most of the timeout.
+	go func() {
+		time.Sleep(timeout * 3 / 4)
+		conns.GlobalMutexKV.Unlock(mutexKey)
+	}()
+
+	start := time.Now()
+
+	_, err := retryBucketConfigurationWriteWithTimeout(bucket, timeout, func() (interface{}, error) {
+		return nil, awserr.New(ErrCodeOperationAborted, "A conflicting conditional operation is currently in progress against this resource", nil)
+	}, nil)
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeOperationAborted) {
+		t.Errorf("expected %s error, got: %v", ErrCodeOperationAborted, err)
+	}
+
+	// Allow for the final attempt made once the retries time out.
+	if got, want := time.Since(start), timeout+timeout/4; got > want {
+		t.Errorf("waited %s, expected at most %s", got, want)
+	}
+}
+
+func TestRetryBucketConfigurationWriteWhenBucketNotFound(t *testing.T) {
+	testCases := []struct {
+		Name                             string
+		S3SkipEventualConsistencyRetries bool
+		ExpectedCalls                    int
+		ExpectNoSuchBucket               bool
+	}{
+		{
+			Name:          "retries",
+			ExpectedCalls: 3,
+		},
+		{
+			Name:                             "skip eventual consistency retries",
+			S3SkipEventualConsistencyRetries: true,
+			ExpectedCalls:                    2,
+			ExpectNoSuchBucket:               true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			meta := &conns.AWSClient{
+				S3BucketLockTimeout:              1 * time.Minute,
+				S3SkipEventualConsistencyRetries: testCase.S3SkipEventualConsistencyRetries,
+			}
+			errs := []error{
+				awserr.New(ErrCodeOperationAborted, "A conflicting conditional operation is currently in progress against this resource", nil),
+				awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil),
+				nil,
+			}
+			calls := 0
+
+			_, err := retryBucketConfigurationWriteWhen(meta, "test-bucket", func() (interface{}, error) {
+				err := errs[calls]
+				calls++
+
+				return nil, err
+			}, bucketNotFoundRetryable(meta, 1*time.Minute))
+
+			if got, want := calls, testCase.ExpectedCalls; got != want {
+				t.Errorf("calls: got %d, want %d", got, want)
+			}
+
+			if testCase.ExpectNoSuchBucket {
+				if !tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+					t.Errorf("expected %s error, got: %v", s3.ErrCodeNoSuchBucket, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package s3control_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3ControlAccessGrant_basic(t *testing.T) {
+	resourceName := "aws_s3control_access_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grant_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grant_id"),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.0.s3_sub_prefix", fmt.Sprintf("%s/*", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "access_grants_location_id", "aws_s3control_access_grants_location.test", "access_grants_location_id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "grant_scope", fmt.Sprintf("s3://%[1]s/*", rName)),
+					resource.TestCheckResourceAttr(resourceName, "grantee.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee.0.grantee_identifier", "aws_iam_user.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "grantee.0.grantee_type", s3control.GranteeTypeIam),
+					resource.TestCheckResourceAttr(resourceName, "permission", s3control.PermissionRead),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessGrant_disappears(t *testing.T) {
+	resourceName := "aws_s3control_access_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3control.ResourceAccessGrant(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_access_grant" {
+			continue
+		}
+
+		accountID, grantID, err := tfs3control.AccessGrantParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3control.FindAccessGrantByTwoPartKey(conn, accountID, grantID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Access Grant %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAccessGrantExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Access Grant ID is set")
+		}
+
+		accountID, grantID, err := tfs3control.AccessGrantParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+		_, err = tfs3control.FindAccessGrantByTwoPartKey(conn, accountID, grantID)
+
+		return err
+	}
+}
+
+func testAccAccessGrantConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantsLocationConfig(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READ"
+
+  access_grants_location_configuration {
+    s3_sub_prefix = "%[1]s/*"
+  }
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = aws_iam_user.test.arn
+  }
+}
+`, rName))
+}
@@ -0,0 +1,134 @@
+package s3control_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3ControlAccessGrantsInstanceResourcePolicy_basic(t *testing.T) {
+	resourceName := "aws_s3control_access_grants_instance_resource_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessGrantsInstanceResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceResourcePolicyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceResourcePolicyExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessGrantsInstanceResourcePolicy_disappears(t *testing.T) {
+	resourceName := "aws_s3control_access_grants_instance_resource_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessGrantsInstanceResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceResourcePolicyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceResourcePolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3control.ResourceAccessGrantsInstanceResourcePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantsInstanceResourcePolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_access_grants_instance_resource_policy" {
+			continue
+		}
+
+		_, err := tfs3control.FindAccessGrantsInstanceResourcePolicyByAccountID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Access Grants Instance Resource Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAccessGrantsInstanceResourcePolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Access Grants Instance Resource Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+		_, err := tfs3control.FindAccessGrantsInstanceResourcePolicyByAccountID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAccessGrantsInstanceResourcePolicyConfig() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3control_access_grants_instance" "test" {}
+
+resource "aws_s3control_access_grants_instance_resource_policy" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Id      = "S3AccessGrantsPolicy"
+    Statement = [{
+      Sid    = "AllowAccessToS3AccessGrants"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action = [
+        "s3:ListAccessGrants",
+        "s3:ListAccessGrantsLocations",
+        "s3:GetDataAccess",
+      ]
+      Resource = aws_s3control_access_grants_instance.test.access_grants_instance_arn
+    }]
+  })
+}
+`
+}
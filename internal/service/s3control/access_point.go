@@ -1,6 +1,7 @@
 package s3control
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -28,6 +30,8 @@ func ResourceAccessPoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAccessPointCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:         schema.TypeString,
@@ -120,6 +124,8 @@ func ResourceAccessPoint() *schema.Resource {
 					},
 				},
 			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 			"vpc_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -142,16 +148,24 @@ func ResourceAccessPoint() *schema.Resource {
 
 func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	accountID := meta.(*conns.AWSClient).AccountID
 	if v, ok := d.GetOk("account_id"); ok {
 		accountID = v.(string)
 	}
 	name := d.Get("name").(string)
+	bucket := d.Get("bucket").(string)
+
+	// The bucket may not have been known at plan time.
+	if arn.IsARN(bucket) && len(d.Get("tags").(map[string]interface{})) > 0 {
+		return fmt.Errorf("error creating S3 Access Point (%s): tags are not supported for S3 on Outposts access points", name)
+	}
 
 	input := &s3control.CreateAccessPointInput{
 		AccountId: aws.String(accountID),
-		Bucket:    aws.String(d.Get("bucket").(string)),
+		Bucket:    aws.String(bucket),
 		Name:      aws.String(name),
 	}
 
@@ -184,6 +198,13 @@ func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(resourceID)
 
+	// Tags are not supported on S3 on Outposts access points.
+	if len(tags) > 0 && !arn.IsARN(name) {
+		if err := resourceUpdateTags(conn, aws.StringValue(output.AccessPointArn), nil, tags); err != nil {
+			return fmt.Errorf("error adding S3 Access Point (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("policy"); ok && v.(string) != "" && v.(string) != "{}" {
 		policy, err := structure.NormalizeJsonString(v.(string))
 
@@ -210,6 +231,8 @@ func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	accountID, name, err := AccessPointParseResourceID(d.Id())
 
@@ -312,6 +335,30 @@ func resourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading S3 Access Point (%s) policy: %w", d.Id(), err)
 	}
 
+	if s3OnOutposts {
+		d.Set("tags", nil)
+		d.Set("tags_all", nil)
+
+		return nil
+	}
+
+	tags, err := resourceListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Access Point (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
 	return nil
 }
 
@@ -357,6 +404,14 @@ func resourceAccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("tags_all") && !arn.IsARN(name) {
+		o, n := d.GetChange("tags_all")
+
+		if err := resourceUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating S3 Access Point (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	return resourceAccessPointRead(d, meta)
 }
 
@@ -388,6 +443,35 @@ func resourceAccessPointDelete(d *schema.ResourceData, meta interface{}) error {
 
 const accessPointResourceIDSeparator = ":"
 
+func resourceAccessPointCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Whether the access point is on S3 on Outposts is only known once the bucket is.
+	if !diff.NewValueKnown("bucket") {
+		if err := verify.SetTagsDiff(ctx, diff, meta); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("tags_all")
+	}
+
+	// Tags are not supported on S3 on Outposts access points, so provider
+	// default_tags must not show up in tags_all either.
+	if arn.IsARN(diff.Get("bucket").(string)) {
+		if len(diff.Get("tags").(map[string]interface{})) > 0 {
+			return fmt.Errorf("tags are not supported for S3 on Outposts access points")
+		}
+
+		if len(diff.Get("tags_all").(map[string]interface{})) > 0 {
+			if err := diff.SetNew("tags_all", map[string]interface{}{}); err != nil {
+				return fmt.Errorf("error setting new tags_all diff: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	return verify.SetTagsDiff(ctx, diff, meta)
+}
+
 func AccessPointCreateResourceID(accessPointARN string) (string, error) {
 	v, err := arn.Parse(accessPointARN)
 
@@ -3,8 +3,15 @@ package s3control
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 const (
-	errCodeNoSuchAccessPoint            = "NoSuchAccessPoint"
-	errCodeNoSuchAccessPointPolicy      = "NoSuchAccessPointPolicy"
-	errCodeNoSuchAsyncRequest           = "NoSuchAsyncRequest"
-	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
+	errCodeAccessGrantsInstanceNotEmptyError                = "AccessGrantsInstanceNotEmptyError"
+	errCodeAccessGrantsInstanceNotExistsError               = "AccessGrantsInstanceNotExistsError"
+	errCodeAccessGrantsInstanceResourcePolicyNotExistsError = "AccessGrantsInstanceResourcePolicyNotExists"
+	errCodeAccessGrantsLocationNotEmptyError                = "AccessGrantsLocationNotEmptyError"
+	errCodeAccessGrantsLocationNotExistsError               = "AccessGrantsLocationNotExistsError"
+	errCodeAccessGrantNotExistsError                        = "AccessGrantNotExistsError"
+	errCodeInvalidIAMRole                                   = "InvalidIamRole"
+	errCodeNoSuchAccessPoint                                = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy                          = "NoSuchAccessPointPolicy"
+	errCodeNoSuchAsyncRequest                               = "NoSuchAsyncRequest"
+	errCodeNoSuchMultiRegionAccessPoint                     = "NoSuchMultiRegionAccessPoint"
 )
@@ -26,6 +26,108 @@ func findPublicAccessBlockConfiguration(conn *s3control.S3Control, accountID str
 	return output.PublicAccessBlockConfiguration, nil
 }
 
+func FindAccessGrantByTwoPartKey(conn *s3control.S3Control, accountID, grantID string) (*s3control.GetAccessGrantOutput, error) {
+	input := &s3control.GetAccessGrantInput{
+		AccessGrantId: aws.String(grantID),
+		AccountId:     aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrant(input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantNotExistsError) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAccessGrantsInstanceByAccountID(conn *s3control.S3Control, accountID string) (*s3control.GetAccessGrantsInstanceOutput, error) {
+	input := &s3control.GetAccessGrantsInstanceInput{
+		AccountId: aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsInstance(input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAccessGrantsInstanceResourcePolicyByAccountID(conn *s3control.S3Control, accountID string) (*s3control.GetAccessGrantsInstanceResourcePolicyOutput, error) {
+	input := &s3control.GetAccessGrantsInstanceResourcePolicyInput{
+		AccountId: aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsInstanceResourcePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantsInstanceResourcePolicyNotExistsError) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.StringValue(output.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAccessGrantsLocationByTwoPartKey(conn *s3control.S3Control, accountID, locationID string) (*s3control.GetAccessGrantsLocationOutput, error) {
+	input := &s3control.GetAccessGrantsLocationInput{
+		AccessGrantsLocationId: aws.String(locationID),
+		AccountId:              aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsLocation(input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantsLocationNotExistsError) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindAccessPointByAccountIDAndName(conn *s3control.S3Control, accountID string, name string) (*s3control.GetAccessPointOutput, error) {
 	input := &s3control.GetAccessPointInput{
 		AccountId: aws.String(accountID),
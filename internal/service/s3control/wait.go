@@ -12,6 +12,9 @@ import (
 )
 
 const (
+	// Maximum amount of time to wait for an Access Grants Instance or Location to become empty before deletion
+	accessGrantsDeleteTimeout = 5 * time.Minute
+
 	// Minimum amount of times to verify change propagation
 	propagationContinuousTargetOccurence = 2
 
@@ -169,7 +169,7 @@ func ResourceEndpointConfiguration() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", ""),
 			},
 
 			"tags":     tftags.TagsSchema(),
@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ScheduleResourceIDSeparator = "/"
+
+func ScheduleCreateResourceID(groupName, scheduleName string) string {
+	parts := []string{groupName, scheduleName}
+	id := strings.Join(parts, ScheduleResourceIDSeparator)
+
+	return id
+}
+
+func ScheduleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, ScheduleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected group-name%[2]sschedule-name", id, ScheduleResourceIDSeparator)
+}
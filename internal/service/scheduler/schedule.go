@@ -0,0 +1,558 @@
+package scheduler
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	scheduleDefaultGroupName = "default"
+)
+
+func ResourceSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceScheduleCreate,
+		Read:   resourceScheduleRead,
+		Update: resourceScheduleUpdate,
+		Delete: resourceScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"flexible_time_window": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_window_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 1440),
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(scheduler.FlexibleTimeWindowMode_Values(), false),
+						},
+					},
+				},
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64),
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64-resource.UniqueIDSuffixLength),
+				ConflictsWith: []string{"name"},
+			},
+			"schedule_expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"schedule_expression_timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      scheduler.ScheduleStateEnabled,
+				ValidateFunc: validation.StringInSlice(scheduler.ScheduleState_Values(), false),
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"dead_letter_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"input": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 8192),
+						},
+						"retry_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum_event_age_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      86400,
+										ValidateFunc: validation.IntBetween(60, 86400),
+									},
+									"maximum_retry_attempts": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      185,
+										ValidateFunc: validation.IntBetween(0, 185),
+									},
+								},
+							},
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &scheduler.CreateScheduleInput{
+		FlexibleTimeWindow: expandFlexibleTimeWindow(d.Get("flexible_time_window").([]interface{})[0].(map[string]interface{})),
+		Name:               aws.String(name),
+		ScheduleExpression: aws.String(d.Get("schedule_expression").(string)),
+		Target:             expandTarget(d.Get("target").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.EndDate = aws.Time(v)
+	}
+
+	groupName := scheduleDefaultGroupName
+	if v, ok := d.GetOk("group_name"); ok {
+		groupName = v.(string)
+		input.GroupName = aws.String(groupName)
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schedule_expression_timezone"); ok {
+		input.ScheduleExpressionTimezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.StartDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		input.State = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Scheduler Schedule: %s", input)
+	_, err := tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateSchedule(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, scheduler.ErrCodeValidationException, "assume the role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating EventBridge Scheduler Schedule (%s): %w", name, err)
+	}
+
+	d.SetId(ScheduleCreateResourceID(groupName, name))
+
+	return resourceScheduleRead(d, meta)
+}
+
+func resourceScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, scheduleName, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindScheduleByTwoPartKey(conn, groupName, scheduleName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Scheduler Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Scheduler Schedule (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	if output.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(output.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	if output.FlexibleTimeWindow != nil {
+		if err := d.Set("flexible_time_window", []interface{}{flattenFlexibleTimeWindow(output.FlexibleTimeWindow)}); err != nil {
+			return fmt.Errorf("error setting flexible_time_window: %w", err)
+		}
+	} else {
+		d.Set("flexible_time_window", nil)
+	}
+	d.Set("group_name", output.GroupName)
+	d.Set("kms_key_arn", output.KmsKeyArn)
+	d.Set("name", output.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("schedule_expression", output.ScheduleExpression)
+	d.Set("schedule_expression_timezone", output.ScheduleExpressionTimezone)
+	if output.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("state", output.State)
+	if output.Target != nil {
+		if err := d.Set("target", []interface{}{flattenTarget(output.Target)}); err != nil {
+			return fmt.Errorf("error setting target: %w", err)
+		}
+	} else {
+		d.Set("target", nil)
+	}
+
+	return nil
+}
+
+func resourceScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, scheduleName, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// UpdateSchedule replaces all of the schedule's properties.
+	input := &scheduler.UpdateScheduleInput{
+		FlexibleTimeWindow: expandFlexibleTimeWindow(d.Get("flexible_time_window").([]interface{})[0].(map[string]interface{})),
+		GroupName:          aws.String(groupName),
+		Name:               aws.String(scheduleName),
+		ScheduleExpression: aws.String(d.Get("schedule_expression").(string)),
+		Target:             expandTarget(d.Get("target").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.EndDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schedule_expression_timezone"); ok {
+		input.ScheduleExpressionTimezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.StartDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		input.State = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating EventBridge Scheduler Schedule: %s", input)
+	_, err = tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.UpdateSchedule(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, scheduler.ErrCodeValidationException, "assume the role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error updating EventBridge Scheduler Schedule (%s): %w", d.Id(), err)
+	}
+
+	return resourceScheduleRead(d, meta)
+}
+
+func resourceScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, scheduleName, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EventBridge Scheduler Schedule: %s", d.Id())
+	_, err = conn.DeleteSchedule(&scheduler.DeleteScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(scheduleName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, scheduler.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EventBridge Scheduler Schedule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFlexibleTimeWindow(tfMap map[string]interface{}) *scheduler.FlexibleTimeWindow {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &scheduler.FlexibleTimeWindow{}
+
+	if v, ok := tfMap["maximum_window_in_minutes"].(int); ok && v != 0 {
+		apiObject.MaximumWindowInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTarget(tfMap map[string]interface{}) *scheduler.Target {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &scheduler.Target{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["input"].(string); ok && v != "" {
+		apiObject.Input = aws.String(v)
+	}
+
+	if v, ok := tfMap["retry_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RetryPolicy = expandRetryPolicy(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDeadLetterConfig(tfMap map[string]interface{}) *scheduler.DeadLetterConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &scheduler.DeadLetterConfig{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRetryPolicy(tfMap map[string]interface{}) *scheduler.RetryPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &scheduler.RetryPolicy{}
+
+	if v, ok := tfMap["maximum_event_age_in_seconds"].(int); ok {
+		apiObject.MaximumEventAgeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok {
+		apiObject.MaximumRetryAttempts = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenFlexibleTimeWindow(apiObject *scheduler.FlexibleTimeWindow) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaximumWindowInMinutes; v != nil {
+		tfMap["maximum_window_in_minutes"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Mode; v != nil {
+		tfMap["mode"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTarget(apiObject *scheduler.Target) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDeadLetterConfig(v)}
+	}
+
+	if v := apiObject.Input; v != nil {
+		tfMap["input"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RetryPolicy; v != nil {
+		tfMap["retry_policy"] = []interface{}{flattenRetryPolicy(v)}
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenDeadLetterConfig(apiObject *scheduler.DeadLetterConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRetryPolicy(apiObject *scheduler.RetryPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaximumEventAgeInSeconds; v != nil {
+		tfMap["maximum_event_age_in_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MaximumRetryAttempts; v != nil {
+		tfMap["maximum_retry_attempts"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,224 @@
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSchedulerScheduleGroup_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "scheduler", fmt.Sprintf("schedule-group/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modification_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", scheduler.ScheduleGroupStateActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerScheduleGroup_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfscheduler.ResourceScheduleGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerScheduleGroup_namePrefix(t *testing.T) {
+	resourceName := "aws_scheduler_schedule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleGroupConfig_namePrefix("tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(resourceName),
+					create.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerScheduleGroup_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccScheduleGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduleGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_scheduler_schedule_group" {
+			continue
+		}
+
+		_, err := tfscheduler.FindScheduleGroupByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EventBridge Scheduler Schedule Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckScheduleGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Scheduler Schedule Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerConn
+
+		_, err := tfscheduler.FindScheduleGroupByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccScheduleGroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccScheduleGroupConfig_namePrefix(namePrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_scheduler_schedule_group" "test" {
+  name_prefix = %[1]q
+}
+`, namePrefix)
+}
+
+func testAccScheduleGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccScheduleGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,296 @@
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSchedulerSchedule_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "scheduler", fmt.Sprintf("schedule/default/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", scheduler.FlexibleTimeWindowModeOff),
+					resource.TestCheckResourceAttr(resourceName, "group_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("default/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "start_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", scheduler.ScheduleStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.dead_letter_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", "185"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfscheduler.ResourceSchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_full(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(scheduler.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, scheduler.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_full(rName, "first", 10, 3600, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "scheduler", fmt.Sprintf("schedule/%[1]s/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2100-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.maximum_window_in_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", scheduler.FlexibleTimeWindowModeFlexible),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_scheduler_schedule_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%[1]s/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "Europe/Amsterdam"),
+					resource.TestCheckResourceAttr(resourceName, "state", scheduler.ScheduleStateDisabled),
+					resource.TestCheckResourceAttr(resourceName, "target.0.dead_letter_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.dead_letter_config.0.arn", "aws_sqs_queue.dlq", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", `{"Hello":"World"}`),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_full(rName, "second", 20, 60, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.maximum_window_in_minutes", "20"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_scheduler_schedule" {
+			continue
+		}
+
+		groupName, scheduleName, err := tfscheduler.ScheduleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfscheduler.FindScheduleByTwoPartKey(conn, groupName, scheduleName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EventBridge Scheduler Schedule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckScheduleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Scheduler Schedule ID is set")
+		}
+
+		groupName, scheduleName, err := tfscheduler.ScheduleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerConn
+
+		_, err = tfscheduler.FindScheduleByTwoPartKey(conn, groupName, scheduleName)
+
+		return err
+	}
+}
+
+func testAccScheduleBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "scheduler.amazonaws.com"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "sqs:SendMessage"
+      Effect   = "Allow"
+      Resource = aws_sqs_queue.test.arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccScheduleConfig(rName string) string {
+	return acctest.ConfigCompose(testAccScheduleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccScheduleConfig_full(rName, description string, maximumWindowInMinutes, maximumEventAgeInSeconds, maximumRetryAttempts int) string {
+	return acctest.ConfigCompose(testAccScheduleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedule" "test" {
+  name       = %[1]q
+  group_name = aws_scheduler_schedule_group.test.name
+
+  description                  = %[2]q
+  end_date                     = "2100-01-01T01:02:03Z"
+  schedule_expression          = "cron(0 9 * * ? *)"
+  schedule_expression_timezone = "Europe/Amsterdam"
+  state                        = "DISABLED"
+
+  flexible_time_window {
+    maximum_window_in_minutes = %[3]d
+    mode                      = "FLEXIBLE"
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+    input    = jsonencode({ Hello = "World" })
+
+    dead_letter_config {
+      arn = aws_sqs_queue.dlq.arn
+    }
+
+    retry_policy {
+      maximum_event_age_in_seconds = %[4]d
+      maximum_retry_attempts       = %[5]d
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description, maximumWindowInMinutes, maximumEventAgeInSeconds, maximumRetryAttempts))
+}
@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
+
+func waitScheduleGroupActive(conn *scheduler.Scheduler, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{scheduler.ScheduleGroupStateActive},
+		Refresh: statusScheduleGroup(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitScheduleGroupDeleted(conn *scheduler.Scheduler, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{scheduler.ScheduleGroupStateDeleting},
+		Target:  []string{},
+		Refresh: statusScheduleGroup(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,179 @@
+package securitylake
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAWSLogSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAWSLogSourceCreate,
+		Read:   resourceAWSLogSourceRead,
+		Delete: resourceAWSLogSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"source_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(securitylake.AwsLogSourceName_Values(), false),
+						},
+						"source_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAWSLogSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	source := expandAWSLogSourceConfiguration(d.Get("source").([]interface{})[0].(map[string]interface{}))
+	name := aws.StringValue(source.SourceName)
+	input := &securitylake.CreateAwsLogSourceInput{
+		Sources: []*securitylake.AwsLogSourceConfiguration{source},
+	}
+
+	log.Printf("[DEBUG] Creating Security Lake AWS Log Source: %s", input)
+	output, err := conn.CreateAwsLogSource(input)
+
+	if err == nil && output != nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", aws.StringValueSlice(output.Failed))
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Security Lake AWS Log Source (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAWSLogSourceRead(d, meta)
+}
+
+func resourceAWSLogSourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	source, err := FindAWSLogSourceByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake AWS Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Lake AWS Log Source (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("source", []interface{}{flattenAWSLogSourceConfiguration(source)}); err != nil {
+		return fmt.Errorf("error setting source: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAWSLogSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	input := &securitylake.DeleteAwsLogSourceInput{
+		Sources: []*securitylake.AwsLogSourceConfiguration{
+			expandAWSLogSourceConfiguration(d.Get("source").([]interface{})[0].(map[string]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting Security Lake AWS Log Source: %s", d.Id())
+	output, err := conn.DeleteAwsLogSource(input)
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err == nil && output != nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", aws.StringValueSlice(output.Failed))
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Security Lake AWS Log Source (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAWSLogSourceConfiguration(tfMap map[string]interface{}) *securitylake.AwsLogSourceConfiguration {
+	apiObject := &securitylake.AwsLogSourceConfiguration{}
+
+	if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Accounts = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["source_name"].(string); ok && v != "" {
+		apiObject.SourceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_version"].(string); ok && v != "" {
+		apiObject.SourceVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAWSLogSourceConfiguration(apiObject *securitylake.AwsLogSourceConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"accounts":       aws.StringValueSlice(apiObject.Accounts),
+		"regions":        aws.StringValueSlice(apiObject.Regions),
+		"source_name":    aws.StringValue(apiObject.SourceName),
+		"source_version": aws.StringValue(apiObject.SourceVersion),
+	}
+
+	return tfMap
+}
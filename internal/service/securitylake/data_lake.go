@@ -0,0 +1,422 @@
+package securitylake
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataLake() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataLakeCreate,
+		Read:   resourceDataLakeRead,
+		Update: resourceDataLakeUpdate,
+		Delete: resourceDataLakeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "S3_MANAGED_KEY",
+									},
+								},
+							},
+						},
+						"lifecycle_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"transition": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"storage_class": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+						"replication_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidRegionName,
+										},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"meta_store_manager_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_bucket_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDataLakeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &securitylake.CreateDataLakeInput{
+		Configurations:          expandDataLakeConfigurations(d.Get("configuration").([]interface{})),
+		MetaStoreManagerRoleArn: aws.String(d.Get("meta_store_manager_role_arn").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Security Lake Data Lake: %s", input)
+	outputRaw, err := tfresource.RetryWhen(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateDataLake(input)
+		},
+		func(err error) (bool, error) {
+			// IAM propagation.
+			if tfawserr.ErrMessageContains(err, securitylake.ErrCodeBadRequestException, "role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Security Lake Data Lake: %w", err)
+	}
+
+	output := outputRaw.(*securitylake.CreateDataLakeOutput)
+
+	if len(output.DataLakes) == 0 || output.DataLakes[0] == nil {
+		return fmt.Errorf("error creating Security Lake Data Lake: empty result")
+	}
+
+	d.SetId(aws.StringValue(output.DataLakes[0].DataLakeArn))
+
+	if _, err := waitDataLakeCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Security Lake Data Lake (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceDataLakeRead(d, meta)
+}
+
+func resourceDataLakeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	dataLake, err := FindDataLakeByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Data Lake (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Lake Data Lake (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", dataLake.DataLakeArn)
+	if err := d.Set("configuration", []interface{}{flattenDataLakeResource(dataLake)}); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("s3_bucket_arn", dataLake.S3BucketArn)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Security Lake Data Lake (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDataLakeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &securitylake.UpdateDataLakeInput{
+			Configurations:          expandDataLakeConfigurations(d.Get("configuration").([]interface{})),
+			MetaStoreManagerRoleArn: aws.String(d.Get("meta_store_manager_role_arn").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Security Lake Data Lake: %s", input)
+		_, err := conn.UpdateDataLake(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Security Lake Data Lake (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitDataLakeUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Security Lake Data Lake (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Security Lake Data Lake (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDataLakeRead(d, meta)
+}
+
+func resourceDataLakeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	region := d.Get("configuration.0.region").(string)
+
+	log.Printf("[DEBUG] Deleting Security Lake Data Lake: %s", d.Id())
+	_, err := conn.DeleteDataLake(&securitylake.DeleteDataLakeInput{
+		Regions: aws.StringSlice([]string{region}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Security Lake Data Lake (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitDataLakeDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Security Lake Data Lake (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataLakeConfigurations(tfList []interface{}) []*securitylake.DataLakeConfiguration {
+	var apiObjects []*securitylake.DataLakeConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securitylake.DataLakeConfiguration{}
+
+		if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.EncryptionConfiguration = &securitylake.DataLakeEncryptionConfiguration{
+				KmsKeyId: aws.String(v[0].(map[string]interface{})["kms_key_id"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["lifecycle_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LifecycleConfiguration = expandDataLakeLifecycleConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		if v, ok := tfMap["replication_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ReplicationConfiguration = expandDataLakeReplicationConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDataLakeLifecycleConfiguration(tfMap map[string]interface{}) *securitylake.DataLakeLifecycleConfiguration {
+	apiObject := &securitylake.DataLakeLifecycleConfiguration{}
+
+	if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Expiration = &securitylake.DataLakeLifecycleExpiration{
+			Days: aws.Int64(int64(v[0].(map[string]interface{})["days"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			apiObject.Transitions = append(apiObject.Transitions, &securitylake.DataLakeLifecycleTransition{
+				Days:         aws.Int64(int64(tfMap["days"].(int))),
+				StorageClass: aws.String(tfMap["storage_class"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandDataLakeReplicationConfiguration(tfMap map[string]interface{}) *securitylake.DataLakeReplicationConfiguration {
+	apiObject := &securitylake.DataLakeReplicationConfiguration{}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataLakeResource(apiObject *securitylake.DataLakeResource) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"region": aws.StringValue(apiObject.Region),
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{map[string]interface{}{
+			"kms_key_id": aws.StringValue(v.KmsKeyId),
+		}}
+	}
+
+	if v := apiObject.LifecycleConfiguration; v != nil && (v.Expiration != nil || len(v.Transitions) > 0) {
+		tfMap["lifecycle_configuration"] = []interface{}{flattenDataLakeLifecycleConfiguration(v)}
+	}
+
+	if v := apiObject.ReplicationConfiguration; v != nil && (len(v.Regions) > 0 || v.RoleArn != nil) {
+		tfMap["replication_configuration"] = []interface{}{map[string]interface{}{
+			"regions":  aws.StringValueSlice(v.Regions),
+			"role_arn": aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDataLakeLifecycleConfiguration(apiObject *securitylake.DataLakeLifecycleConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Expiration; v != nil {
+		tfMap["expiration"] = []interface{}{map[string]interface{}{
+			"days": aws.Int64Value(v.Days),
+		}}
+	}
+
+	var transitions []interface{}
+
+	for _, v := range apiObject.Transitions {
+		if v == nil {
+			continue
+		}
+
+		transitions = append(transitions, map[string]interface{}{
+			"days":          aws.Int64Value(v.Days),
+			"storage_class": aws.StringValue(v.StorageClass),
+		})
+	}
+
+	tfMap["transition"] = transitions
+
+	return tfMap
+}
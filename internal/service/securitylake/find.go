@@ -0,0 +1,156 @@
+package securitylake
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDataLakeByARN(conn *securitylake.SecurityLake, dataLakeARN string) (*securitylake.DataLakeResource, error) {
+	parsedARN, err := arn.Parse(dataLakeARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: aws.StringSlice([]string{parsedARN.Region}),
+	}
+
+	output, err := conn.ListDataLakes(input)
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DataLakes {
+		if aws.StringValue(v.DataLakeArn) == dataLakeARN {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
+
+// FindAWSLogSourceByName returns the accounts, regions and version for which the AWS log source is enabled.
+func FindAWSLogSourceByName(conn *securitylake.SecurityLake, name string) (*securitylake.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []*securitylake.LogSourceResource{{
+			AwsLogSource: &securitylake.AwsLogSourceResource{
+				SourceName: aws.String(name),
+			},
+		}},
+	}
+	var accounts, regions []string
+	var version string
+
+	err := conn.ListLogSourcesPages(input, func(page *securitylake.ListLogSourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, logSource := range page.Sources {
+			if logSource == nil {
+				continue
+			}
+
+			for _, v := range logSource.Sources {
+				if v == nil || v.AwsLogSource == nil || aws.StringValue(v.AwsLogSource.SourceName) != name {
+					continue
+				}
+
+				accounts = appendUnique(accounts, aws.StringValue(logSource.Account))
+				regions = appendUnique(regions, aws.StringValue(logSource.Region))
+				version = aws.StringValue(v.AwsLogSource.SourceVersion)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(regions) == 0 {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	output := &securitylake.AwsLogSourceConfiguration{
+		Accounts:   aws.StringSlice(accounts),
+		Regions:    aws.StringSlice(regions),
+		SourceName: aws.String(name),
+	}
+
+	if version != "" {
+		output.SourceVersion = aws.String(version)
+	}
+
+	return output, nil
+}
+
+func FindSubscriberByID(conn *securitylake.SecurityLake, id string) (*securitylake.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(input)
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func appendUnique(s []string, v string) []string {
+	if v == "" {
+		return s
+	}
+
+	for _, e := range s {
+		if e == v {
+			return s
+		}
+	}
+
+	return append(s, v)
+}
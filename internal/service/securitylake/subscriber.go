@@ -0,0 +1,394 @@
+package securitylake
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSubscriber() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSubscriberCreate,
+		Read:   resourceSubscriberRead,
+		Update: resourceSubscriberUpdate,
+		Delete: resourceSubscriberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(securitylake.AccessType_Values(), false),
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_share_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_share_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_log_source_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(securitylake.AwsLogSourceName_Values(), false),
+									},
+									"source_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"custom_log_source_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"source_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"subscriber_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subscriber_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscriber_identity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(2, 1224),
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+					},
+				},
+			},
+			"subscriber_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subscriber_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSubscriberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("subscriber_name").(string)
+	input := &securitylake.CreateSubscriberInput{
+		Sources:            expandLogSourceResources(d.Get("source").(*schema.Set).List()),
+		SubscriberIdentity: expandAWSIdentity(d.Get("subscriber_identity").([]interface{})),
+		SubscriberName:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("access_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subscriber_description"); ok {
+		input.SubscriberDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Security Lake Subscriber: %s", input)
+	output, err := conn.CreateSubscriber(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Security Lake Subscriber (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Subscriber.SubscriberId))
+
+	if _, err := waitSubscriberCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Security Lake Subscriber (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceSubscriberRead(d, meta)
+}
+
+func resourceSubscriberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	subscriber, err := FindSubscriberByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Subscriber (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Lake Subscriber (%s): %w", d.Id(), err)
+	}
+
+	d.Set("access_types", aws.StringValueSlice(subscriber.AccessTypes))
+	d.Set("arn", subscriber.SubscriberArn)
+	d.Set("resource_share_arn", subscriber.ResourceShareArn)
+	d.Set("resource_share_name", subscriber.ResourceShareName)
+	d.Set("role_arn", subscriber.RoleArn)
+	d.Set("s3_bucket_arn", subscriber.S3BucketArn)
+	if err := d.Set("source", flattenLogSourceResources(subscriber.Sources)); err != nil {
+		return fmt.Errorf("error setting source: %w", err)
+	}
+	d.Set("subscriber_description", subscriber.SubscriberDescription)
+	d.Set("subscriber_endpoint", subscriber.SubscriberEndpoint)
+	if err := d.Set("subscriber_identity", flattenAWSIdentity(subscriber.SubscriberIdentity)); err != nil {
+		return fmt.Errorf("error setting subscriber_identity: %w", err)
+	}
+	d.Set("subscriber_name", subscriber.SubscriberName)
+	d.Set("subscriber_status", subscriber.SubscriberStatus)
+
+	tags, err := ListTags(conn, aws.StringValue(subscriber.SubscriberArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Security Lake Subscriber (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSubscriberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &securitylake.UpdateSubscriberInput{
+			Sources:               expandLogSourceResources(d.Get("source").(*schema.Set).List()),
+			SubscriberDescription: aws.String(d.Get("subscriber_description").(string)),
+			SubscriberId:          aws.String(d.Id()),
+			SubscriberIdentity:    expandAWSIdentity(d.Get("subscriber_identity").([]interface{})),
+			SubscriberName:        aws.String(d.Get("subscriber_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Security Lake Subscriber: %s", input)
+		_, err := conn.UpdateSubscriber(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Security Lake Subscriber (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Security Lake Subscriber (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSubscriberRead(d, meta)
+}
+
+func resourceSubscriberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	log.Printf("[DEBUG] Deleting Security Lake Subscriber: %s", d.Id())
+	_, err := conn.DeleteSubscriber(&securitylake.DeleteSubscriberInput{
+		SubscriberId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Security Lake Subscriber (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAWSIdentity(tfList []interface{}) *securitylake.AwsIdentity {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &securitylake.AwsIdentity{
+		ExternalId: aws.String(tfMap["external_id"].(string)),
+		Principal:  aws.String(tfMap["principal"].(string)),
+	}
+}
+
+func flattenAWSIdentity(apiObject *securitylake.AwsIdentity) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"external_id": aws.StringValue(apiObject.ExternalId),
+		"principal":   aws.StringValue(apiObject.Principal),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandLogSourceResources(tfList []interface{}) []*securitylake.LogSourceResource {
+	var apiObjects []*securitylake.LogSourceResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securitylake.LogSourceResource{}
+
+		if v, ok := tfMap["aws_log_source_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.AwsLogSource = &securitylake.AwsLogSourceResource{
+				SourceName: aws.String(tfMap["source_name"].(string)),
+			}
+
+			if v, ok := tfMap["source_version"].(string); ok && v != "" {
+				apiObject.AwsLogSource.SourceVersion = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["custom_log_source_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.CustomLogSource = &securitylake.CustomLogSourceResource{
+				SourceName: aws.String(tfMap["source_name"].(string)),
+			}
+
+			if v, ok := tfMap["source_version"].(string); ok && v != "" {
+				apiObject.CustomLogSource.SourceVersion = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLogSourceResources(apiObjects []*securitylake.LogSourceResource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AwsLogSource; v != nil {
+			tfMap["aws_log_source_resource"] = []interface{}{map[string]interface{}{
+				"source_name":    aws.StringValue(v.SourceName),
+				"source_version": aws.StringValue(v.SourceVersion),
+			}}
+		}
+
+		if v := apiObject.CustomLogSource; v != nil {
+			tfMap["custom_log_source_resource"] = []interface{}{map[string]interface{}{
+				"source_name":    aws.StringValue(v.SourceName),
+				"source_version": aws.StringValue(v.SourceVersion),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
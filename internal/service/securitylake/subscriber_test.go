@@ -0,0 +1,148 @@
+package securitylake_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSecurityLakeSubscriber_basic(t *testing.T) {
+	resourceName := "aws_securitylake_subscriber.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, securitylake.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSubscriberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_types.*", securitylake.AccessTypeS3),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "source.*", map[string]string{
+						"aws_log_source_resource.#":             "1",
+						"aws_log_source_resource.0.source_name": securitylake.AwsLogSourceNameRoute53,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_identity.0.external_id", "example"),
+					resource.TestCheckResourceAttrPair(resourceName, "subscriber_identity.0.principal", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "subscriber_status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSubscriberConfig_basic(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecurityLakeSubscriber_disappears(t *testing.T) {
+	resourceName := "aws_securitylake_subscriber.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, securitylake.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSubscriberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecuritylake.ResourceSubscriber(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriberDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securitylake_subscriber" {
+			continue
+		}
+
+		_, err := tfsecuritylake.FindSubscriberByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Lake Subscriber %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSubscriberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Lake Subscriber ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+		_, err := tfsecuritylake.FindSubscriberByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSubscriberConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccAWSLogSourceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_securitylake_subscriber" "test" {
+  subscriber_name        = %[1]q
+  subscriber_description = %[2]q
+  access_types           = ["S3"]
+
+  source {
+    aws_log_source_resource {
+      source_name = aws_securitylake_aws_log_source.test.source[0].source_name
+    }
+  }
+
+  subscriber_identity {
+    external_id = "example"
+    principal   = data.aws_caller_identity.current.account_id
+  }
+}
+`, rName, description))
+}
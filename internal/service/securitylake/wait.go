@@ -0,0 +1,91 @@
+package securitylake
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
+
+// waitDataLakeCreated waits for a data lake to become active, which Security Lake reports as a COMPLETED create status.
+func waitDataLakeCreated(conn *securitylake.SecurityLake, arn string, timeout time.Duration) (*securitylake.DataLakeResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securitylake.DataLakeStatusInitialized, securitylake.DataLakeStatusPending},
+		Target:  []string{securitylake.DataLakeStatusCompleted},
+		Refresh: statusDataLakeCreate(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*securitylake.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(conn *securitylake.SecurityLake, arn string, timeout time.Duration) (*securitylake.DataLakeResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securitylake.DataLakeStatusInitialized, securitylake.DataLakeStatusPending},
+		Target:  []string{securitylake.DataLakeStatusCompleted},
+		Refresh: statusDataLakeUpdate(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*securitylake.DataLakeResource); ok {
+		if status := output.UpdateStatus; status != nil && status.Exception != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(status.Exception.Code), aws.StringValue(status.Exception.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(conn *securitylake.SecurityLake, arn string, timeout time.Duration) (*securitylake.DataLakeResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securitylake.DataLakeStatusInitialized, securitylake.DataLakeStatusPending, securitylake.DataLakeStatusCompleted},
+		Target:  []string{},
+		Refresh: statusDataLakeCreate(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*securitylake.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberCreated(conn *securitylake.SecurityLake, id string, timeout time.Duration) (*securitylake.SubscriberResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securitylake.SubscriberStatusPending},
+		Target:  []string{securitylake.SubscriberStatusActive, securitylake.SubscriberStatusReady},
+		Refresh: statusSubscriber(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*securitylake.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
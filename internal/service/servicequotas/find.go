@@ -96,3 +96,25 @@ func findServiceQuotaByID(conn *servicequotas.ServiceQuotas, serviceCode, quotaC
 
 	return output.Quota, nil
 }
+
+// FindServiceQuotaValueByName returns the value of the named service quota that applies to the account.
+// The AWS default value is returned if the quota has not been adjusted.
+func FindServiceQuotaValueByName(conn *servicequotas.ServiceQuotas, serviceCode, quotaName string) (float64, error) {
+	defaultQuota, err := findServiceQuotaDefaultByName(conn, serviceCode, quotaName)
+
+	if err != nil {
+		return 0, err
+	}
+
+	serviceQuota, err := findServiceQuotaByID(conn, serviceCode, aws.StringValue(defaultQuota.QuotaCode))
+
+	if tfresource.NotFound(err) {
+		return aws.Float64Value(defaultQuota.Value), nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return aws.Float64Value(serviceQuota.Value), nil
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -76,13 +75,7 @@ func resourceActiveReceiptRuleSetRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("rule_set_name", response.Metadata.Name)
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("receipt-rule-set/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("ses", fmt.Sprintf("receipt-rule-set/%s", d.Id()))
 	d.Set("arn", arn)
 
 	return nil
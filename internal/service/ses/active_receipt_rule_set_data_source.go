@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -39,13 +38,7 @@ func dataSourceActiveReceiptRuleSetRead(d *schema.ResourceData, meta interface{}
 	name := aws.StringValue(data.Metadata.Name)
 	d.SetId(name)
 	d.Set("rule_set_name", name)
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("receipt-rule-set/%s", name),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("ses", fmt.Sprintf("receipt-rule-set/%s", name))
 	d.Set("arn", arn)
 
 	return nil
@@ -6,7 +6,6 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -85,13 +84,7 @@ func resourceDomainIdentityRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("identity/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("ses", fmt.Sprintf("identity/%s", d.Id()))
 	d.Set("arn", arn)
 	d.Set("verification_token", verificationAttrs.VerificationToken)
 	return nil
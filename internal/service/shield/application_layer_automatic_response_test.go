@@ -0,0 +1,143 @@
+package shield_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+)
+
+func TestAccShieldApplicationLayerAutomaticResponse_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_application_layer_automatic_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, shield.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckApplicationLayerAutomaticResponseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldApplicationLayerAutomaticResponseConfig(rName, tfshield.ApplicationLayerAutomaticResponseActionCount),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", tfshield.ApplicationLayerAutomaticResponseActionCount),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_lb.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShieldApplicationLayerAutomaticResponseConfig(rName, tfshield.ApplicationLayerAutomaticResponseActionBlock),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", tfshield.ApplicationLayerAutomaticResponseActionBlock),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationLayerAutomaticResponseDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_application_layer_automatic_response" {
+			continue
+		}
+
+		resp, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ResourceArn: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if config := resp.Protection.ApplicationLayerAutomaticResponseConfiguration; config != nil && aws.StringValue(config.Status) == shield.ApplicationLayerAutomaticResponseStatusEnabled {
+			return fmt.Errorf("Shield Application Layer Automatic Response %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationLayerAutomaticResponseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		resp, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ResourceArn: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if config := resp.Protection.ApplicationLayerAutomaticResponseConfiguration; config == nil || aws.StringValue(config.Status) != shield.ApplicationLayerAutomaticResponseStatusEnabled {
+			return fmt.Errorf("Shield Application Layer Automatic Response %s not enabled", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccShieldApplicationLayerAutomaticResponseConfig(rName, action string) string {
+	return acctest.ConfigCompose(testAccShieldProtectionAlbConfig(rName), fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+
+  # Shield Advanced adds a managed rule group to the web ACL.
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_lb.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+
+resource "aws_shield_application_layer_automatic_response" "test" {
+  resource_arn = aws_shield_protection.test.resource_arn
+  action       = %[2]q
+
+  depends_on = [aws_wafv2_web_acl_association.test]
+}
+`, rName, action))
+}
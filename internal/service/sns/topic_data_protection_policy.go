@@ -0,0 +1,122 @@
+package sns
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/jsonutil"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTopicDataProtectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTopicDataProtectionPolicyUpsert,
+		Read:   resourceTopicDataProtectionPolicyRead,
+		Update: resourceTopicDataProtectionPolicyUpsert,
+		Delete: resourceTopicDataProtectionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: jsonutil.SuppressEquivalentDiffs,
+				StateFunc:        jsonutil.NormalizeStateFunc,
+			},
+		},
+	}
+}
+
+func resourceTopicDataProtectionPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	policy, err := jsonutil.NormalizeString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	arn := d.Get("arn").(string)
+	input := &sns.PutDataProtectionPolicyInput{
+		DataProtectionPolicy: aws.String(policy),
+		ResourceArn:          aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Putting SNS Topic Data Protection Policy: %s", input)
+	_, err = conn.PutDataProtectionPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting SNS Topic Data Protection Policy (%s): %w", arn, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(arn)
+	}
+
+	return resourceTopicDataProtectionPolicyRead(d, meta)
+}
+
+func resourceTopicDataProtectionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	output, err := FindDataProtectionPolicyByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SNS Topic Data Protection Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SNS Topic Data Protection Policy (%s): %w", d.Id(), err)
+	}
+
+	policy, err := jsonutil.NormalizeString(output)
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", output, err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceTopicDataProtectionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	// Putting an empty policy removes it.
+	log.Printf("[DEBUG] Deleting SNS Topic Data Protection Policy: %s", d.Id())
+	_, err := conn.PutDataProtectionPolicy(&sns.PutDataProtectionPolicyInput{
+		DataProtectionPolicy: aws.String(""),
+		ResourceArn:          aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException, sns.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SNS Topic Data Protection Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
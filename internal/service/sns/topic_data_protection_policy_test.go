@@ -0,0 +1,145 @@
+package sns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSNSTopicDataProtectionPolicy_basic(t *testing.T) {
+	resourceName := "aws_sns_topic_data_protection_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDataProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicDataProtectionPolicyConfig(rName, "first policy"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicDataProtectionPolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicDataProtectionPolicyConfig(rName, "second policy"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicDataProtectionPolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopicDataProtectionPolicy_disappears(t *testing.T) {
+	resourceName := "aws_sns_topic_data_protection_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDataProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicDataProtectionPolicyConfig(rName, "first policy"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicDataProtectionPolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsns.ResourceTopicDataProtectionPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTopicDataProtectionPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sns_topic_data_protection_policy" {
+			continue
+		}
+
+		_, err := tfsns.FindDataProtectionPolicyByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SNS Topic Data Protection Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTopicDataProtectionPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SNS Topic Data Protection Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+
+		_, err := tfsns.FindDataProtectionPolicyByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccTopicDataProtectionPolicyConfig(rName, description string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_data_protection_policy" "test" {
+  arn = aws_sns_topic.test.arn
+
+  policy = jsonencode({
+    Name        = %[1]q
+    Description = %[2]q
+    Version     = "2021-06-01"
+    Statement = [{
+      Sid           = "DenyInboundEmailAddresses"
+      DataDirection = "Inbound"
+      Principal     = ["*"]
+      DataIdentifier = [
+        "arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/EmailAddress",
+      ]
+      Operation = {
+        Deny = {}
+      }
+    }]
+  })
+}
+`, rName, description)
+}
@@ -36,3 +36,25 @@ func FIFOThroughputLimit_Values() []string {
 		FIFOThroughputLimitPerQueue,
 	}
 }
+
+const (
+	RedrivePermissionAllowAll = "allowAll"
+	RedrivePermissionByQueue  = "byQueue"
+	RedrivePermissionDenyAll  = "denyAll"
+)
+
+func RedrivePermission_Values() []string {
+	return []string{
+		RedrivePermissionAllowAll,
+		RedrivePermissionByQueue,
+		RedrivePermissionDenyAll,
+	}
+}
+
+const (
+	MessageMoveTaskStatusCancelled  = "CANCELLED"
+	MessageMoveTaskStatusCancelling = "CANCELLING"
+	MessageMoveTaskStatusCompleted  = "COMPLETED"
+	MessageMoveTaskStatusFailed     = "FAILED"
+	MessageMoveTaskStatusRunning    = "RUNNING"
+)
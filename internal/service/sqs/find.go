@@ -73,3 +73,43 @@ func FindQueuePolicyByURL(conn *sqs.SQS, url string) (string, error) {
 
 	return aws.StringValue(v), nil
 }
+
+// FindMessageMoveTaskByTwoPartKey returns the message movement task with the specified handle.
+// Only the most recent tasks for a source queue are reported by the API.
+func FindMessageMoveTaskByTwoPartKey(conn *sqs.SQS, sourceARN, taskHandle string) (*sqs.ListMessageMoveTasksResultEntry, error) {
+	input := &sqs.ListMessageMoveTasksInput{
+		MaxResults: aws.Int64(10),
+		SourceArn:  aws.String(sourceARN),
+	}
+
+	output, err := conn.ListMessageMoveTasks(input)
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist, sqs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	for _, v := range output.Results {
+		if aws.StringValue(v.TaskHandle) == taskHandle {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,25 @@
+package sqs
+
+import (
+	"fmt"
+	"strings"
+)
+
+const QueueRedriveTaskResourceIDSeparator = ","
+
+func QueueRedriveTaskCreateResourceID(sourceARN, taskHandle string) string {
+	parts := []string{sourceARN, taskHandle}
+	id := strings.Join(parts, QueueRedriveTaskResourceIDSeparator)
+
+	return id
+}
+
+func QueueRedriveTaskParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, QueueRedriveTaskResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected source-arn%[2]stask-handle", id, QueueRedriveTaskResourceIDSeparator)
+}
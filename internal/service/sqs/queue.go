@@ -2,9 +2,11 @@ package sqs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
@@ -16,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/jsonutil"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -110,22 +114,43 @@ var (
 			Default:  DefaultQueueReceiveMessageWaitTimeSeconds,
 		},
 		"redrive_allow_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.StringIsJSON,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: jsonutil.SuppressEquivalentDiffs,
+			StateFunc:        jsonutil.NormalizeStateFunc,
+			ConflictsWith:    []string{"redrive_allow_policy_config"},
+		},
+		"redrive_allow_policy_config": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"redrive_allow_policy"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"redrive_permission": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(RedrivePermission_Values(), false),
+					},
+					"source_queue_arns": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						MaxItems: 10,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
 			},
 		},
 		"redrive_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.StringIsJSON,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: jsonutil.SuppressEquivalentDiffs,
+			StateFunc:        jsonutil.NormalizeStateFunc,
 		},
 		"sqs_managed_sse_enabled": {
 			Type:          schema.TypeBool,
@@ -163,7 +188,7 @@ var (
 		"redrive_policy":                    sqs.QueueAttributeNameRedrivePolicy,
 		"sqs_managed_sse_enabled":           sqs.QueueAttributeNameSqsManagedSseEnabled,
 		"visibility_timeout_seconds":        sqs.QueueAttributeNameVisibilityTimeout,
-	}, queueSchema).WithIAMPolicyAttribute("policy")
+	}, queueSchema).WithIAMPolicyAttribute("policy").WithJSONAttributes("redrive_allow_policy", "redrive_policy")
 )
 
 func ResourceQueue() *schema.Resource {
@@ -207,6 +232,16 @@ func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if v, ok := d.GetOk("redrive_allow_policy_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy, err := expandRedriveAllowPolicy(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return err
+		}
+
+		attributes[sqs.QueueAttributeNameRedriveAllowPolicy] = policy
+	}
+
 	input.Attributes = aws.StringMap(attributes)
 
 	if len(tags) > 0 {
@@ -291,6 +326,23 @@ func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// The redrive allow policy is reported in whichever form it was configured.
+	if v := d.Get("redrive_allow_policy_config").([]interface{}); len(v) > 0 && v[0] != nil {
+		tfList, err := flattenRedriveAllowPolicy(output[sqs.QueueAttributeNameRedriveAllowPolicy])
+
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("redrive_allow_policy_config", tfList); err != nil {
+			return fmt.Errorf("error setting redrive_allow_policy_config: %w", err)
+		}
+
+		d.Set("redrive_allow_policy", "")
+	} else {
+		d.Set("redrive_allow_policy_config", nil)
+	}
+
 	// Backwards compatibility: https://github.com/hashicorp/terraform-provider-aws/issues/19786.
 	if d.Get("kms_data_key_reuse_period_seconds").(int) == 0 {
 		d.Set("kms_data_key_reuse_period_seconds", DefaultQueueKMSDataKeyReusePeriodSeconds)
@@ -342,6 +394,20 @@ func resourceQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
+		if d.HasChange("redrive_allow_policy_config") {
+			if v, ok := d.GetOk("redrive_allow_policy_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				policy, err := expandRedriveAllowPolicy(v.([]interface{})[0].(map[string]interface{}))
+
+				if err != nil {
+					return err
+				}
+
+				attributes[sqs.QueueAttributeNameRedriveAllowPolicy] = policy
+			} else if d.Get("redrive_allow_policy").(string) == "" {
+				attributes[sqs.QueueAttributeNameRedriveAllowPolicy] = ""
+			}
+		}
+
 		input := &sqs.SetQueueAttributesInput{
 			Attributes: aws.StringMap(attributes),
 			QueueUrl:   aws.String(d.Id()),
@@ -438,3 +504,46 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 
 	return nil
 }
+
+type redriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission"`
+	SourceQueueARNs   []string `json:"sourceQueueArns,omitempty"`
+}
+
+func expandRedriveAllowPolicy(tfMap map[string]interface{}) (string, error) {
+	apiObject := redriveAllowPolicy{
+		RedrivePermission: tfMap["redrive_permission"].(string),
+	}
+
+	if v, ok := tfMap["source_queue_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SourceQueueARNs = aws.StringValueSlice(flex.ExpandStringSet(v))
+		sort.Strings(apiObject.SourceQueueARNs)
+	}
+
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", fmt.Errorf("error marshaling SQS Queue redrive allow policy: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func flattenRedriveAllowPolicy(policy string) ([]interface{}, error) {
+	if policy == "" {
+		return nil, nil
+	}
+
+	var apiObject redriveAllowPolicy
+
+	if err := json.Unmarshal([]byte(policy), &apiObject); err != nil {
+		return nil, fmt.Errorf("error unmarshaling SQS Queue redrive allow policy (%s): %w", policy, err)
+	}
+
+	tfMap := map[string]interface{}{
+		"redrive_permission": apiObject.RedrivePermission,
+		"source_queue_arns":  flex.FlattenStringSet(aws.StringSlice(apiObject.SourceQueueARNs)),
+	}
+
+	return []interface{}{tfMap}, nil
+}
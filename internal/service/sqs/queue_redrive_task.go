@@ -0,0 +1,203 @@
+package sqs
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceQueueRedriveTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceQueueRedriveTaskCreate,
+		Read:   resourceQueueRedriveTaskRead,
+		Delete: resourceQueueRedriveTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"approximate_number_of_messages_moved": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"approximate_number_of_messages_to_move": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"destination_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_number_of_messages_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"started_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_handle": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceQueueRedriveTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	sourceARN := d.Get("source_arn").(string)
+	input := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(sourceARN),
+	}
+
+	if v, ok := d.GetOk("destination_arn"); ok {
+		input.DestinationArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_number_of_messages_per_second"); ok {
+		input.MaxNumberOfMessagesPerSecond = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Starting SQS Queue Redrive Task: %s", input)
+	output, err := conn.StartMessageMoveTask(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting SQS Queue Redrive Task (%s): %w", sourceARN, err)
+	}
+
+	taskHandle := aws.StringValue(output.TaskHandle)
+	d.SetId(QueueRedriveTaskCreateResourceID(sourceARN, taskHandle))
+
+	if _, err := waitMessageMoveTaskCompleted(conn, sourceARN, taskHandle, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Task (%s) complete: %w", d.Id(), err)
+	}
+
+	return resourceQueueRedriveTaskRead(d, meta)
+}
+
+func resourceQueueRedriveTaskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	sourceARN, taskHandle, err := QueueRedriveTaskParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindMessageMoveTaskByTwoPartKey(conn, sourceARN, taskHandle)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// Only the most recent tasks are reported for a queue.
+		// Keep the last known state of an aged-out task rather than starting it again.
+		var nfe *resource.NotFoundError
+		if errors.As(err, &nfe) && nfe.LastError == nil && d.Get("task_handle").(string) != "" {
+			log.Printf("[WARN] SQS Queue Redrive Task (%s) no longer reported, keeping last known state", d.Id())
+			return nil
+		}
+
+		log.Printf("[WARN] SQS Queue Redrive Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue Redrive Task (%s): %w", d.Id(), err)
+	}
+
+	d.Set("approximate_number_of_messages_moved", output.ApproximateNumberOfMessagesMoved)
+	d.Set("approximate_number_of_messages_to_move", output.ApproximateNumberOfMessagesToMove)
+	d.Set("destination_arn", output.DestinationArn)
+	d.Set("failure_reason", output.FailureReason)
+	d.Set("max_number_of_messages_per_second", output.MaxNumberOfMessagesPerSecond)
+	d.Set("source_arn", output.SourceArn)
+	if v := output.StartedTimestamp; v != nil {
+		d.Set("started_timestamp", time.UnixMilli(aws.Int64Value(v)).UTC().Format(time.RFC3339))
+	} else {
+		d.Set("started_timestamp", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("task_handle", output.TaskHandle)
+
+	return nil
+}
+
+func resourceQueueRedriveTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	sourceARN, taskHandle, err := QueueRedriveTaskParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindMessageMoveTaskByTwoPartKey(conn, sourceARN, taskHandle)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue Redrive Task (%s): %w", d.Id(), err)
+	}
+
+	// Finished tasks cannot be deleted; they are simply removed from state.
+	if aws.StringValue(output.Status) != MessageMoveTaskStatusRunning {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling SQS Queue Redrive Task: %s", d.Id())
+	_, err = conn.CancelMessageMoveTask(&sqs.CancelMessageMoveTaskInput{
+		TaskHandle: aws.String(taskHandle),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling SQS Queue Redrive Task (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitMessageMoveTaskCancelled(conn, sourceARN, taskHandle, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Task (%s) cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
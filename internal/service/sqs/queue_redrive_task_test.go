@@ -0,0 +1,140 @@
+package sqs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+)
+
+func TestAccSQSQueueRedriveTask_basic(t *testing.T) {
+	resourceName := "aws_sqs_queue_redrive_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveTaskConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueRedriveTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "approximate_number_of_messages_moved", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "max_number_of_messages_per_second", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_sqs_queue.dlq", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfsqs.MessageMoveTaskStatusCompleted),
+					resource.TestCheckResourceAttrSet(resourceName, "task_handle"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedriveTask_maxNumberOfMessagesPerSecond(t *testing.T) {
+	resourceName := "aws_sqs_queue_redrive_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveTaskConfig_maxNumberOfMessagesPerSecond(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueRedriveTaskExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "max_number_of_messages_per_second", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfsqs.MessageMoveTaskStatusCompleted),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQueueRedriveTaskExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SQS Queue Redrive Task ID is set")
+		}
+
+		sourceARN, taskHandle, err := tfsqs.QueueRedriveTaskParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+
+		_, err = tfsqs.FindMessageMoveTaskByTwoPartKey(conn, sourceARN, taskHandle)
+
+		return err
+	}
+}
+
+func testAccQueueRedriveTaskConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 3
+  })
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+
+  redrive_allow_policy_config {
+    redrive_permission = "allowAll"
+  }
+}
+`, rName)
+}
+
+func testAccQueueRedriveTaskConfig(rName string) string {
+	return acctest.ConfigCompose(testAccQueueRedriveTaskConfig_base(rName), `
+resource "aws_sqs_queue_redrive_task" "test" {
+  source_arn = aws_sqs_queue.dlq.arn
+
+  depends_on = [aws_sqs_queue.test]
+}
+`)
+}
+
+func testAccQueueRedriveTaskConfig_maxNumberOfMessagesPerSecond(rName string, maxNumberOfMessagesPerSecond int) string {
+	return acctest.ConfigCompose(testAccQueueRedriveTaskConfig_base(rName), fmt.Sprintf(`
+resource "aws_sqs_queue_redrive_task" "test" {
+  source_arn                        = aws_sqs_queue.dlq.arn
+  destination_arn                   = aws_sqs_queue.test.arn
+  max_number_of_messages_per_second = %[1]d
+}
+`, maxNumberOfMessagesPerSecond))
+}
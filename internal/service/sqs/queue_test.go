@@ -507,6 +507,82 @@ func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_redriveAllowPolicyConfig(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedriveAllowPolicyConfigBlockConfig(rName, tfsqs.RedrivePermissionByQueue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.0.redrive_permission", tfsqs.RedrivePermissionByQueue),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.0.source_queue_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "redrive_allow_policy_config.0.source_queue_arns.*", "aws_sqs_queue.dlq", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API returns a single JSON policy, so on import it is read into
+				// redrive_allow_policy and the configured form cannot be known.
+				ImportStateVerifyIgnore: []string{"redrive_allow_policy", "redrive_allow_policy_config"},
+			},
+			{
+				Config: testAccRedriveAllowPolicyConfigBlockConfig(rName, tfsqs.RedrivePermissionDenyAll),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.0.redrive_permission", tfsqs.RedrivePermissionDenyAll),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.0.source_queue_arns.#", "0"),
+				),
+			},
+			{
+				Config: testAccRedriveAllowPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_allow_policy"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_redriveAllowPolicyKeyOrdering(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedriveAllowPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+				),
+			},
+			{
+				Config:   testAccRedriveAllowPolicyReorderedConfig(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_fifoQueue(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
@@ -1076,6 +1152,44 @@ resource "aws_sqs_queue" "dlq" {
 `, rName)
 }
 
+func testAccRedriveAllowPolicyReorderedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                       = "%[1]s-1"
+  delay_seconds              = 0
+  visibility_timeout_seconds = 300
+
+  redrive_allow_policy = jsonencode({
+    sourceQueueArns   = [aws_sqs_queue.dlq.arn]
+    redrivePermission = "byQueue"
+  })
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-2"
+}
+`, rName)
+}
+
+func testAccRedriveAllowPolicyConfigBlockConfig(rName, redrivePermission string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                       = "%[1]s-1"
+  delay_seconds              = 0
+  visibility_timeout_seconds = 300
+
+  redrive_allow_policy_config {
+    redrive_permission = %[2]q
+    source_queue_arns  = %[2]q == "byQueue" ? [aws_sqs_queue.dlq.arn] : null
+  }
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-2"
+}
+`, rName, redrivePermission)
+}
+
 func testAccFIFOQueueConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -311,13 +310,7 @@ func resourceDocumentRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", doc.Name)
 	d.Set("owner", doc.Owner)
 	d.Set("platform_types", flex.FlattenStringList(doc.PlatformTypes))
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "ssm",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("document/%s", *doc.Name),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("ssm", fmt.Sprintf("document/%s", *doc.Name))
 	if err := d.Set("arn", arn); err != nil {
 		return fmt.Errorf("Error setting arn error: %#v", err)
 	}
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -72,13 +71,7 @@ func dataDocumentRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(resp.Name))
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "ssm",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("document/%s", aws.StringValue(resp.Name)),
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("ssm", fmt.Sprintf("document/%s", aws.StringValue(resp.Name)))
 
 	d.Set("arn", arn)
 	d.Set("name", resp.Name)
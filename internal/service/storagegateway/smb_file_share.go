@@ -128,7 +128,7 @@ func ResourceSMBFileShare() *schema.Resource {
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", ""),
 				RequiredWith: []string{"kms_encrypted"},
 			},
 			"location_arn": {
@@ -175,7 +175,7 @@ func ResourceSMBFileShare() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"smb_acl_enabled": {
 				Type:     schema.TypeBool,
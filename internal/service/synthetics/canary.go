@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,7 +59,7 @@ func ResourceCanary() *schema.Resource {
 									"kms_key_arn": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("kms", ""),
 									},
 								},
 							},
@@ -362,13 +361,7 @@ func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading Synthetics Canary (%s): %w", d.Id(), err)
 	}
 
-	canaryArn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   synthetics.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("canary:%s", aws.StringValue(canary.Name)),
-	}.String()
+	canaryArn := meta.(*conns.AWSClient).RegionalARN(synthetics.ServiceName, fmt.Sprintf("canary:%s", aws.StringValue(canary.Name)))
 	d.Set("arn", canaryArn)
 	d.Set("artifact_s3_location", canary.ArtifactS3Location)
 	d.Set("engine_arn", canary.EngineArn)
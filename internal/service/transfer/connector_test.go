@@ -0,0 +1,332 @@
+package transfer_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccConnector_basic(t *testing.T) {
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	publicKey, privateKey, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorSFTPConfig(rName, publicKey, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "access_role", "aws_iam_role.test", "arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "transfer", regexp.MustCompile(`connector/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "as2_config.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "connector_id"),
+					resource.TestCheckResourceAttr(resourceName, "logging_role", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "security_policy_name"),
+					resource.TestMatchResourceAttr(resourceName, "service_managed_egress_ip_addresses.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "sftp_config.0.user_secret_id", "aws_secretsmanager_secret.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "test_connection", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_connection"},
+			},
+		},
+	})
+}
+
+func testAccConnector_disappears(t *testing.T) {
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	publicKey, privateKey, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorSFTPConfig(rName, publicKey, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tftransfer.ResourceConnector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConnector_trustedHostKeys(t *testing.T) {
+	var conf transfer.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	publicKey, privateKey, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	hostPublicKey, hostPrivateKey, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorSFTPTrustedHostKeysConfig(rName, publicKey, privateKey, hostPublicKey, hostPrivateKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "test_connection", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_connection"},
+			},
+		},
+	})
+}
+
+func testAccConnector_testConnectionFailure(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	publicKey, privateKey, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectorSFTPUnreachableConfig(rName, publicKey, privateKey),
+				ExpectError: regexp.MustCompile(`error testing Transfer Connector \(.+\) connection`),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorExists(n string, v *transfer.DescribedConnector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer Connector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+
+		output, err := tftransfer.FindConnectorByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConnectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transfer_connector" {
+			continue
+		}
+
+		_, err := tftransfer.FindConnectorByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transfer Connector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConnectorBaseConfig(rName, publicKey, privateKey string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "transfer.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:*",
+        "secretsmanager:GetSecretValue"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_transfer_user" "test" {
+  server_id = aws_transfer_server.test.id
+  user_name = "tftestuser"
+  role      = aws_iam_role.test.arn
+}
+
+resource "aws_transfer_ssh_key" "test" {
+  server_id = aws_transfer_server.test.id
+  user_name = aws_transfer_user.test.user_name
+  body      = %[2]q
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+
+  secret_string = jsonencode({
+    Username   = aws_transfer_user.test.user_name
+    PrivateKey = %[3]q
+  })
+}
+`, rName, publicKey, privateKey)
+}
+
+func testAccConnectorSFTPConfig(rName, publicKey, privateKey string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName, publicKey, privateKey), `
+resource "aws_transfer_server" "test" {
+  identity_provider_type = "SERVICE_MANAGED"
+}
+
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+  url         = "sftp://${aws_transfer_server.test.endpoint}"
+
+  sftp_config {
+    user_secret_id = aws_secretsmanager_secret.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test, aws_transfer_ssh_key.test]
+}
+`)
+}
+
+func testAccConnectorSFTPTrustedHostKeysConfig(rName, publicKey, privateKey, hostPublicKey, hostPrivateKey string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName, publicKey, privateKey), fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  identity_provider_type = "SERVICE_MANAGED"
+  host_key               = %[2]q
+}
+
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+  url         = "sftp://${aws_transfer_server.test.endpoint}"
+
+  sftp_config {
+    trusted_host_keys = [%[1]q]
+    user_secret_id    = aws_secretsmanager_secret.test.arn
+  }
+
+  test_connection = true
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test, aws_transfer_ssh_key.test]
+}
+`, hostPublicKey, hostPrivateKey))
+}
+
+func testAccConnectorSFTPUnreachableConfig(rName, publicKey, privateKey string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName, publicKey, privateKey), `
+resource "aws_transfer_server" "test" {
+  identity_provider_type = "SERVICE_MANAGED"
+}
+
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+  url         = "sftp://unreachable.invalid"
+
+  sftp_config {
+    user_secret_id = aws_secretsmanager_secret.test.arn
+  }
+
+  test_connection = true
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test]
+}
+`)
+}
@@ -34,6 +34,31 @@ func FindAccessByServerIDAndExternalID(conn *transfer.Transfer, serverID, extern
 	return output.Access, nil
 }
 
+func FindConnectorByID(conn *transfer.Transfer, id string) (*transfer.DescribedConnector, error) {
+	input := &transfer.DescribeConnectorInput{
+		ConnectorId: aws.String(id),
+	}
+
+	output, err := conn.DescribeConnector(input)
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Connector == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Connector, nil
+}
+
 func FindServerByID(conn *transfer.Transfer, id string) (*transfer.DescribedServer, error) {
 	input := &transfer.DescribeServerInput{
 		ServerId: aws.String(id),
@@ -12,6 +12,12 @@ func TestAccTransfer_serial(t *testing.T) {
 			"S3Basic":    testAccAccess_s3_basic,
 			"S3Policy":   testAccAccess_s3_policy,
 		},
+		"Connector": {
+			"basic":                 testAccConnector_basic,
+			"disappears":            testAccConnector_disappears,
+			"TestConnectionFailure": testAccConnector_testConnectionFailure,
+			"TrustedHostKeys":       testAccConnector_trustedHostKeys,
+		},
 		"Server": {
 			"basic":                         testAccServer_basic,
 			"disappears":                    testAccServer_disappears,
@@ -0,0 +1,349 @@
+package verifiedpermissions
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Cedar schemas are validated locally so that mistakes are reported at plan time rather than by PutSchema.
+// Only the JSON schema format is supported. The checks cover the document structure and that every type,
+// entity type and action reference can be resolved; they do not replace the service-side validation.
+
+const cedarNamespaceSeparator = "::"
+
+var cedarIdentifierRegexp = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+
+var cedarPrimitiveTypes = map[string]bool{
+	"Boolean": true,
+	"Long":    true,
+	"String":  true,
+}
+
+var cedarExtensionTypes = map[string]bool{
+	"datetime": true,
+	"decimal":  true,
+	"duration": true,
+	"ipaddr":   true,
+}
+
+type cedarNamespace struct {
+	Actions     map[string]cedarAction     `json:"actions"`
+	CommonTypes map[string]json.RawMessage `json:"commonTypes"`
+	EntityTypes map[string]cedarEntityType `json:"entityTypes"`
+}
+
+type cedarEntityType struct {
+	MemberOfTypes []string        `json:"memberOfTypes"`
+	Shape         json.RawMessage `json:"shape"`
+}
+
+type cedarAction struct {
+	AppliesTo *cedarAppliesTo        `json:"appliesTo"`
+	MemberOf  []cedarActionReference `json:"memberOf"`
+}
+
+type cedarAppliesTo struct {
+	Context        json.RawMessage `json:"context"`
+	PrincipalTypes []string        `json:"principalTypes"`
+	ResourceTypes  []string        `json:"resourceTypes"`
+}
+
+type cedarActionReference struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type cedarType struct {
+	Attributes map[string]json.RawMessage `json:"attributes"`
+	Element    json.RawMessage            `json:"element"`
+	Name       string                     `json:"name"`
+	Required   *bool                      `json:"required"`
+	Type       string                     `json:"type"`
+}
+
+type cedarSchemaValidator struct {
+	actions     map[string]bool
+	commonTypes map[string]bool
+	entityTypes map[string]bool
+	namespaces  map[string]cedarNamespace
+}
+
+// ValidateCedarJSONSchema checks that a Cedar schema in JSON format is well-formed and internally consistent.
+func ValidateCedarJSONSchema(s string) error {
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return fmt.Errorf("schema must be a JSON object: %w", err)
+	}
+
+	v := &cedarSchemaValidator{
+		actions:     make(map[string]bool),
+		commonTypes: make(map[string]bool),
+		entityTypes: make(map[string]bool),
+		namespaces:  make(map[string]cedarNamespace),
+	}
+
+	for _, name := range sortedKeys(raw) {
+		if name != "" {
+			for _, part := range strings.Split(name, cedarNamespaceSeparator) {
+				if !cedarIdentifierRegexp.MatchString(part) {
+					return fmt.Errorf("invalid namespace name %q", name)
+				}
+			}
+		}
+
+		namespace, err := decodeCedarNamespace(name, raw[name])
+
+		if err != nil {
+			return err
+		}
+
+		v.namespaces[name] = namespace
+
+		for entityType := range namespace.EntityTypes {
+			if !cedarIdentifierRegexp.MatchString(entityType) {
+				return fmt.Errorf("namespace %q: invalid entity type name %q", name, entityType)
+			}
+
+			v.entityTypes[qualifyCedarName(name, entityType)] = true
+		}
+
+		for commonType := range namespace.CommonTypes {
+			if !cedarIdentifierRegexp.MatchString(commonType) {
+				return fmt.Errorf("namespace %q: invalid common type name %q", name, commonType)
+			}
+
+			if cedarPrimitiveTypes[commonType] || commonType == "Record" || commonType == "Set" || commonType == "Entity" || commonType == "Extension" {
+				return fmt.Errorf("namespace %q: common type name %q is reserved", name, commonType)
+			}
+
+			v.commonTypes[qualifyCedarName(name, commonType)] = true
+		}
+
+		for action := range namespace.Actions {
+			v.actions[qualifyCedarName(name, "Action")+cedarNamespaceSeparator+action] = true
+		}
+	}
+
+	for _, name := range sortedKeys(v.namespaces) {
+		if err := v.validateNamespace(name, v.namespaces[name]); err != nil {
+			return fmt.Errorf("namespace %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeCedarNamespace(name string, raw json.RawMessage) (cedarNamespace, error) {
+	var keys map[string]json.RawMessage
+
+	if err := json.Unmarshal(raw, &keys); err != nil {
+		return cedarNamespace{}, fmt.Errorf("namespace %q must be a JSON object: %w", name, err)
+	}
+
+	for key := range keys {
+		switch key {
+		case "actions", "commonTypes", "entityTypes":
+		default:
+			return cedarNamespace{}, fmt.Errorf("namespace %q: unexpected key %q", name, key)
+		}
+	}
+
+	for _, key := range []string{"actions", "entityTypes"} {
+		if _, ok := keys[key]; !ok {
+			return cedarNamespace{}, fmt.Errorf("namespace %q: missing required key %q", name, key)
+		}
+	}
+
+	var namespace cedarNamespace
+
+	if err := json.Unmarshal(raw, &namespace); err != nil {
+		return cedarNamespace{}, fmt.Errorf("namespace %q: %w", name, err)
+	}
+
+	return namespace, nil
+}
+
+func (v *cedarSchemaValidator) validateNamespace(name string, namespace cedarNamespace) error {
+	for _, commonType := range sortedKeys(namespace.CommonTypes) {
+		if err := v.validateType(name, namespace.CommonTypes[commonType]); err != nil {
+			return fmt.Errorf("common type %q: %w", commonType, err)
+		}
+	}
+
+	for _, entityType := range sortedKeys(namespace.EntityTypes) {
+		tfEntityType := namespace.EntityTypes[entityType]
+
+		for _, memberOf := range tfEntityType.MemberOfTypes {
+			if _, ok := v.resolveEntityType(name, memberOf); !ok {
+				return fmt.Errorf("entity type %q: undeclared entity type %q in memberOfTypes", entityType, memberOf)
+			}
+		}
+
+		if len(tfEntityType.Shape) > 0 {
+			if err := v.validateRecordType(name, tfEntityType.Shape); err != nil {
+				return fmt.Errorf("entity type %q shape: %w", entityType, err)
+			}
+		}
+	}
+
+	for _, action := range sortedKeys(namespace.Actions) {
+		tfAction := namespace.Actions[action]
+
+		for _, memberOf := range tfAction.MemberOf {
+			actionType := memberOf.Type
+
+			if actionType == "" {
+				actionType = qualifyCedarName(name, "Action")
+			}
+
+			if !v.actions[actionType+cedarNamespaceSeparator+memberOf.ID] {
+				return fmt.Errorf("action %q: undeclared action %q in memberOf", action, memberOf.ID)
+			}
+		}
+
+		if appliesTo := tfAction.AppliesTo; appliesTo != nil {
+			for _, entityType := range appliesTo.PrincipalTypes {
+				if _, ok := v.resolveEntityType(name, entityType); !ok {
+					return fmt.Errorf("action %q: undeclared entity type %q in principalTypes", action, entityType)
+				}
+			}
+
+			for _, entityType := range appliesTo.ResourceTypes {
+				if _, ok := v.resolveEntityType(name, entityType); !ok {
+					return fmt.Errorf("action %q: undeclared entity type %q in resourceTypes", action, entityType)
+				}
+			}
+
+			if len(appliesTo.Context) > 0 {
+				if err := v.validateRecordType(name, appliesTo.Context); err != nil {
+					return fmt.Errorf("action %q context: %w", action, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRecordType checks that raw is a Record type or a reference to a common type.
+func (v *cedarSchemaValidator) validateRecordType(namespace string, raw json.RawMessage) error {
+	var t cedarType
+
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return err
+	}
+
+	if t.Type != "Record" {
+		if _, ok := v.resolveCommonType(namespace, t.Type); !ok {
+			return fmt.Errorf("must be a Record type, got %q", t.Type)
+		}
+	}
+
+	return v.validateType(namespace, raw)
+}
+
+func (v *cedarSchemaValidator) validateType(namespace string, raw json.RawMessage) error {
+	var t cedarType
+
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return err
+	}
+
+	switch t.Type {
+	case "":
+		return fmt.Errorf("missing type")
+	case "Boolean", "Long", "String":
+	case "Set":
+		if len(t.Element) == 0 {
+			return fmt.Errorf("Set type is missing element")
+		}
+
+		if err := v.validateType(namespace, t.Element); err != nil {
+			return fmt.Errorf("Set element: %w", err)
+		}
+	case "Record":
+		for _, attribute := range sortedKeys(t.Attributes) {
+			if err := v.validateType(namespace, t.Attributes[attribute]); err != nil {
+				return fmt.Errorf("attribute %q: %w", attribute, err)
+			}
+		}
+	case "Entity":
+		if _, ok := v.resolveEntityType(namespace, t.Name); !ok {
+			return fmt.Errorf("undeclared entity type %q", t.Name)
+		}
+	case "Extension":
+		if !cedarExtensionTypes[t.Name] {
+			return fmt.Errorf("unknown extension type %q", t.Name)
+		}
+	case "EntityOrCommon":
+		if cedarPrimitiveTypes[t.Name] {
+			break
+		}
+
+		if _, ok := v.resolveCommonType(namespace, t.Name); ok {
+			break
+		}
+
+		if _, ok := v.resolveEntityType(namespace, t.Name); !ok {
+			return fmt.Errorf("undeclared type %q", t.Name)
+		}
+	default:
+		if _, ok := v.resolveCommonType(namespace, t.Type); !ok {
+			return fmt.Errorf("undeclared type %q", t.Type)
+		}
+	}
+
+	return nil
+}
+
+func (v *cedarSchemaValidator) resolveEntityType(namespace, name string) (string, bool) {
+	return resolveCedarName(v.entityTypes, namespace, name)
+}
+
+func (v *cedarSchemaValidator) resolveCommonType(namespace, name string) (string, bool) {
+	return resolveCedarName(v.commonTypes, namespace, name)
+}
+
+// resolveCedarName resolves a possibly unqualified name first in the current namespace, then in the empty namespace.
+func resolveCedarName(declared map[string]bool, namespace, name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+
+	if strings.Contains(name, cedarNamespaceSeparator) {
+		return name, declared[name]
+	}
+
+	if qualified := qualifyCedarName(namespace, name); declared[qualified] {
+		return qualified, true
+	}
+
+	return name, declared[name]
+}
+
+func qualifyCedarName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + cedarNamespaceSeparator + name
+}
+
+// sortedKeys returns the keys of a map with string keys in sorted order, so that validation errors are deterministic.
+func sortedKeys(m interface{}) []string {
+	v := reflect.ValueOf(m)
+	keys := make([]string, 0, v.Len())
+
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
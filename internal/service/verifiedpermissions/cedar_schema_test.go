@@ -0,0 +1,130 @@
+package verifiedpermissions_test
+
+import (
+	"strings"
+	"testing"
+
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+)
+
+func TestValidateCedarJSONSchema(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Schema        string
+		ExpectedError string
+	}{
+		{
+			Name: "valid",
+			Schema: `{
+  "PhotoApp": {
+    "commonTypes": {
+      "PersonType": {"type": "Record", "attributes": {"age": {"type": "Long"}, "name": {"type": "String", "required": false}}}
+    },
+    "entityTypes": {
+      "User": {"memberOfTypes": ["UserGroup"], "shape": {"type": "PersonType"}},
+      "UserGroup": {},
+      "Photo": {"shape": {"type": "Record", "attributes": {"owner": {"type": "Entity", "name": "User"}, "tags": {"type": "Set", "element": {"type": "String"}}, "source": {"type": "Extension", "name": "ipaddr"}}}}
+    },
+    "actions": {
+      "viewPhoto": {
+        "memberOf": [{"id": "readOnly"}],
+        "appliesTo": {"principalTypes": ["User"], "resourceTypes": ["PhotoApp::Photo"], "context": {"type": "Record", "attributes": {"authenticated": {"type": "Boolean"}}}}
+      },
+      "readOnly": {}
+    }
+  }
+}`,
+		},
+		{
+			Name:   "empty namespace",
+			Schema: `{"": {"entityTypes": {"User": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["User"]}}}}}`,
+		},
+		{
+			Name:          "not an object",
+			Schema:        `[]`,
+			ExpectedError: "schema must be a JSON object",
+		},
+		{
+			Name:          "invalid namespace name",
+			Schema:        `{"Photo App": {"entityTypes": {}, "actions": {}}}`,
+			ExpectedError: `invalid namespace name "Photo App"`,
+		},
+		{
+			Name:          "missing actions",
+			Schema:        `{"App": {"entityTypes": {}}}`,
+			ExpectedError: `missing required key "actions"`,
+		},
+		{
+			Name:          "unexpected key",
+			Schema:        `{"App": {"entityTypes": {}, "actions": {}, "entities": {}}}`,
+			ExpectedError: `unexpected key "entities"`,
+		},
+		{
+			Name:          "undeclared memberOfTypes",
+			Schema:        `{"App": {"entityTypes": {"User": {"memberOfTypes": ["Group"]}}, "actions": {}}}`,
+			ExpectedError: `undeclared entity type "Group" in memberOfTypes`,
+		},
+		{
+			Name:          "shape not a record",
+			Schema:        `{"App": {"entityTypes": {"User": {"shape": {"type": "String"}}}, "actions": {}}}`,
+			ExpectedError: `must be a Record type`,
+		},
+		{
+			Name:          "undeclared attribute type",
+			Schema:        `{"App": {"entityTypes": {"User": {"shape": {"type": "Record", "attributes": {"address": {"type": "Address"}}}}}, "actions": {}}}`,
+			ExpectedError: `attribute "address": undeclared type "Address"`,
+		},
+		{
+			Name:          "set without element",
+			Schema:        `{"App": {"entityTypes": {"User": {"shape": {"type": "Record", "attributes": {"tags": {"type": "Set"}}}}}, "actions": {}}}`,
+			ExpectedError: `Set type is missing element`,
+		},
+		{
+			Name:          "unknown extension",
+			Schema:        `{"App": {"entityTypes": {"User": {"shape": {"type": "Record", "attributes": {"ip": {"type": "Extension", "name": "inet"}}}}}, "actions": {}}}`,
+			ExpectedError: `unknown extension type "inet"`,
+		},
+		{
+			Name:          "undeclared principal type",
+			Schema:        `{"App": {"entityTypes": {"Photo": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Photo"]}}}}}`,
+			ExpectedError: `undeclared entity type "User" in principalTypes`,
+		},
+		{
+			Name:          "undeclared resource type in other namespace",
+			Schema:        `{"App": {"entityTypes": {"User": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Other::Photo"]}}}}}`,
+			ExpectedError: `undeclared entity type "Other::Photo" in resourceTypes`,
+		},
+		{
+			Name:          "undeclared parent action",
+			Schema:        `{"App": {"entityTypes": {}, "actions": {"view": {"memberOf": [{"id": "read"}]}}}}`,
+			ExpectedError: `undeclared action "read" in memberOf`,
+		},
+		{
+			Name:          "reserved common type name",
+			Schema:        `{"App": {"entityTypes": {}, "actions": {}, "commonTypes": {"String": {"type": "Long"}}}}`,
+			ExpectedError: `common type name "String" is reserved`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := tfverifiedpermissions.ValidateCedarJSONSchema(testCase.Schema)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.ExpectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
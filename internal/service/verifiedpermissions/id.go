@@ -0,0 +1,49 @@
+package verifiedpermissions
+
+import (
+	"fmt"
+	"strings"
+)
+
+const policyStoreChildResourceIDSeparator = ","
+
+func policyStoreChildCreateResourceID(policyStoreID, childID string) string {
+	parts := []string{policyStoreID, childID}
+	id := strings.Join(parts, policyStoreChildResourceIDSeparator)
+
+	return id
+}
+
+func policyStoreChildParseResourceID(id, childName string) (string, string, error) {
+	parts := strings.Split(id, policyStoreChildResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected policy-store-id%[2]s%[3]s", id, policyStoreChildResourceIDSeparator, childName)
+}
+
+func IdentitySourceCreateResourceID(policyStoreID, identitySourceID string) string {
+	return policyStoreChildCreateResourceID(policyStoreID, identitySourceID)
+}
+
+func IdentitySourceParseResourceID(id string) (string, string, error) {
+	return policyStoreChildParseResourceID(id, "identity-source-id")
+}
+
+func PolicyCreateResourceID(policyStoreID, policyID string) string {
+	return policyStoreChildCreateResourceID(policyStoreID, policyID)
+}
+
+func PolicyParseResourceID(id string) (string, string, error) {
+	return policyStoreChildParseResourceID(id, "policy-id")
+}
+
+func PolicyTemplateCreateResourceID(policyStoreID, policyTemplateID string) string {
+	return policyStoreChildCreateResourceID(policyStoreID, policyTemplateID)
+}
+
+func PolicyTemplateParseResourceID(id string) (string, string, error) {
+	return policyStoreChildParseResourceID(id, "policy-template-id")
+}
@@ -0,0 +1,286 @@
+package verifiedpermissions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIdentitySource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentitySourceCreate,
+		Read:   resourceIdentitySourceRead,
+		Update: resourceIdentitySourceUpdate,
+		Delete: resourceIdentitySourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cognito_user_pool_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_ids": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1000,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+									"group_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"group_entity_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 200),
+												},
+											},
+										},
+									},
+									"user_pool_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"identity_source_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_entity_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+		},
+	}
+}
+
+func resourceIdentitySourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		Configuration: expandIdentitySourceConfiguration(d.Get("configuration").([]interface{})),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	if v, ok := d.GetOk("principal_entity_type"); ok {
+		input.PrincipalEntityType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Verified Permissions Identity Source: %s", input)
+	output, err := conn.CreateIdentitySource(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Verified Permissions Identity Source for Policy Store (%s): %w", policyStoreID, err)
+	}
+
+	d.SetId(IdentitySourceCreateResourceID(policyStoreID, aws.StringValue(output.IdentitySourceId)))
+
+	return resourceIdentitySourceRead(d, meta)
+}
+
+func resourceIdentitySourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, identitySourceID, err := IdentitySourceParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindIdentitySourceByTwoPartKey(conn, policyStoreID, identitySourceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Identity Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Verified Permissions Identity Source (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", flattenIdentitySourceConfigurationDetail(output.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("identity_source_id", output.IdentitySourceId)
+	d.Set("policy_store_id", output.PolicyStoreId)
+	d.Set("principal_entity_type", output.PrincipalEntityType)
+
+	return nil
+}
+
+func resourceIdentitySourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, identitySourceID, err := IdentitySourceParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &verifiedpermissions.UpdateIdentitySourceInput{
+		IdentitySourceId:    aws.String(identitySourceID),
+		PolicyStoreId:       aws.String(policyStoreID),
+		UpdateConfiguration: expandIdentitySourceUpdateConfiguration(d.Get("configuration").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("principal_entity_type"); ok {
+		input.PrincipalEntityType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Verified Permissions Identity Source: %s", input)
+	_, err = conn.UpdateIdentitySource(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Verified Permissions Identity Source (%s): %w", d.Id(), err)
+	}
+
+	return resourceIdentitySourceRead(d, meta)
+}
+
+func resourceIdentitySourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, identitySourceID, err := IdentitySourceParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Identity Source: %s", d.Id())
+	_, err = conn.DeleteIdentitySource(&verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: aws.String(identitySourceID),
+		PolicyStoreId:    aws.String(policyStoreID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Verified Permissions Identity Source (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIdentitySourceConfiguration(tfList []interface{}) *verifiedpermissions.Configuration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &verifiedpermissions.Configuration{}
+
+	if v, ok := tfMap["cognito_user_pool_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CognitoUserPoolConfiguration = &verifiedpermissions.CognitoUserPoolConfiguration{
+			UserPoolArn: aws.String(tfMap["user_pool_arn"].(string)),
+		}
+
+		if v, ok := tfMap["client_ids"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CognitoUserPoolConfiguration.ClientIds = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CognitoUserPoolConfiguration.GroupConfiguration = &verifiedpermissions.CognitoGroupConfiguration{
+				GroupEntityType: aws.String(v[0].(map[string]interface{})["group_entity_type"].(string)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandIdentitySourceUpdateConfiguration(tfList []interface{}) *verifiedpermissions.UpdateConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &verifiedpermissions.UpdateConfiguration{}
+
+	if v, ok := tfMap["cognito_user_pool_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CognitoUserPoolConfiguration = &verifiedpermissions.UpdateCognitoUserPoolConfiguration{
+			ClientIds:   flex.ExpandStringList(tfMap["client_ids"].([]interface{})),
+			UserPoolArn: aws.String(tfMap["user_pool_arn"].(string)),
+		}
+
+		if v, ok := tfMap["group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CognitoUserPoolConfiguration.GroupConfiguration = &verifiedpermissions.UpdateCognitoGroupConfiguration{
+				GroupEntityType: aws.String(v[0].(map[string]interface{})["group_entity_type"].(string)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenIdentitySourceConfigurationDetail(apiObject *verifiedpermissions.ConfigurationDetail) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CognitoUserPoolConfiguration; v != nil {
+		tfMapCognito := map[string]interface{}{
+			"client_ids":    aws.StringValueSlice(v.ClientIds),
+			"user_pool_arn": aws.StringValue(v.UserPoolArn),
+		}
+
+		if v := v.GroupConfiguration; v != nil && v.GroupEntityType != nil {
+			tfMapCognito["group_configuration"] = []interface{}{map[string]interface{}{
+				"group_entity_type": aws.StringValue(v.GroupEntityType),
+			}}
+		}
+
+		tfMap["cognito_user_pool_configuration"] = []interface{}{tfMapCognito}
+	}
+
+	return []interface{}{tfMap}
+}
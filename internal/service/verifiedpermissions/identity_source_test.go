@@ -0,0 +1,168 @@
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedPermissionsIdentitySource_basic(t *testing.T) {
+	resourceName := "aws_verifiedpermissions_identity_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckIdentitySourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentitySourceConfig(rName, "User"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentitySourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.cognito_user_pool_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.cognito_user_pool_configuration.0.client_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.cognito_user_pool_configuration.0.client_ids.0", "aws_cognito_user_pool_client.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.cognito_user_pool_configuration.0.group_configuration.0.group_entity_type", "UserGroup"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.cognito_user_pool_configuration.0.user_pool_arn", "aws_cognito_user_pool.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_source_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_entity_type", "User"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIdentitySourceConfig(rName, "Employee"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentitySourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "principal_entity_type", "Employee"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsIdentitySource_disappears(t *testing.T) {
+	resourceName := "aws_verifiedpermissions_identity_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckIdentitySourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentitySourceConfig(rName, "User"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentitySourceExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfverifiedpermissions.ResourceIdentitySource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentitySourceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedpermissions_identity_source" {
+			continue
+		}
+
+		policyStoreID, identitySourceID, err := tfverifiedpermissions.IdentitySourceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfverifiedpermissions.FindIdentitySourceByTwoPartKey(conn, policyStoreID, identitySourceID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Permissions Identity Source %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIdentitySourceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Permissions Identity Source ID is set")
+		}
+
+		policyStoreID, identitySourceID, err := tfverifiedpermissions.IdentitySourceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+		_, err = tfverifiedpermissions.FindIdentitySourceByTwoPartKey(conn, policyStoreID, identitySourceID)
+
+		return err
+	}
+}
+
+func testAccIdentitySourceConfig(rName, principalEntityType string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_identity_source" "test" {
+  policy_store_id       = aws_verifiedpermissions_policy_store.test.id
+  principal_entity_type = %[2]q
+
+  configuration {
+    cognito_user_pool_configuration {
+      user_pool_arn = aws_cognito_user_pool.test.arn
+      client_ids    = [aws_cognito_user_pool_client.test.id]
+
+      group_configuration {
+        group_entity_type = "UserGroup"
+      }
+    }
+  }
+}
+`, rName, principalEntityType)
+}
@@ -0,0 +1,318 @@
+package verifiedpermissions
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePolicyCreate,
+		Read:   resourcePolicyRead,
+		Update: resourcePolicyUpdate,
+		Delete: resourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"static": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"definition.0.static", "definition.0.template_linked"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"description": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 150),
+									},
+									"statement": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 10000),
+									},
+								},
+							},
+						},
+						"template_linked": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"definition.0.static", "definition.0.template_linked"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_template_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"principal": entityIdentifierSchema(),
+									"resource":  entityIdentifierSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func entityIdentifierSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"entity_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+				"entity_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+		},
+	}
+}
+
+func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	input := &verifiedpermissions.CreatePolicyInput{
+		Definition:    expandPolicyDefinition(d.Get("definition").([]interface{})),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	log.Printf("[DEBUG] Creating Verified Permissions Policy: %s", input)
+	output, err := conn.CreatePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Verified Permissions Policy for Policy Store (%s): %w", policyStoreID, err)
+	}
+
+	d.SetId(PolicyCreateResourceID(policyStoreID, aws.StringValue(output.PolicyId)))
+
+	return resourcePolicyRead(d, meta)
+}
+
+func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, policyID, err := PolicyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindPolicyByTwoPartKey(conn, policyStoreID, policyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Verified Permissions Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	if err := d.Set("definition", flattenPolicyDefinitionDetail(output.Definition)); err != nil {
+		return fmt.Errorf("error setting definition: %w", err)
+	}
+	d.Set("policy_id", output.PolicyId)
+	d.Set("policy_store_id", output.PolicyStoreId)
+	d.Set("policy_type", output.PolicyType)
+
+	return nil
+}
+
+func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, policyID, err := PolicyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Only static policies can be updated. Template-linked policies are replaced.
+	input := &verifiedpermissions.UpdatePolicyInput{
+		Definition: &verifiedpermissions.UpdatePolicyDefinition{
+			Static: &verifiedpermissions.UpdateStaticPolicyDefinition{
+				Statement: aws.String(d.Get("definition.0.static.0.statement").(string)),
+			},
+		},
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	if v, ok := d.GetOk("definition.0.static.0.description"); ok {
+		input.Definition.Static.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Verified Permissions Policy: %s", input)
+	_, err = conn.UpdatePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Verified Permissions Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourcePolicyRead(d, meta)
+}
+
+func resourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, policyID, err := PolicyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Policy: %s", d.Id())
+	_, err = conn.DeletePolicy(&verifiedpermissions.DeletePolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Verified Permissions Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPolicyDefinition(tfList []interface{}) *verifiedpermissions.PolicyDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &verifiedpermissions.PolicyDefinition{}
+
+	if v, ok := tfMap["static"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Static = &verifiedpermissions.StaticPolicyDefinition{
+			Statement: aws.String(tfMap["statement"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Static.Description = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["template_linked"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.TemplateLinked = &verifiedpermissions.TemplateLinkedPolicyDefinition{
+			PolicyTemplateId: aws.String(tfMap["policy_template_id"].(string)),
+			Principal:        expandEntityIdentifier(tfMap["principal"].([]interface{})),
+			Resource:         expandEntityIdentifier(tfMap["resource"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandEntityIdentifier(tfList []interface{}) *verifiedpermissions.EntityIdentifier {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &verifiedpermissions.EntityIdentifier{
+		EntityId:   aws.String(tfMap["entity_id"].(string)),
+		EntityType: aws.String(tfMap["entity_type"].(string)),
+	}
+}
+
+func flattenPolicyDefinitionDetail(apiObject *verifiedpermissions.PolicyDefinitionDetail) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Static; v != nil {
+		tfMap["static"] = []interface{}{map[string]interface{}{
+			"description": aws.StringValue(v.Description),
+			"statement":   aws.StringValue(v.Statement),
+		}}
+	}
+
+	if v := apiObject.TemplateLinked; v != nil {
+		tfMap["template_linked"] = []interface{}{map[string]interface{}{
+			"policy_template_id": aws.StringValue(v.PolicyTemplateId),
+			"principal":          flattenEntityIdentifier(v.Principal),
+			"resource":           flattenEntityIdentifier(v.Resource),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEntityIdentifier(apiObject *verifiedpermissions.EntityIdentifier) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"entity_id":   aws.StringValue(apiObject.EntityId),
+		"entity_type": aws.StringValue(apiObject.EntityType),
+	}
+
+	return []interface{}{tfMap}
+}
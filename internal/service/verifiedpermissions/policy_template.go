@@ -0,0 +1,164 @@
+package verifiedpermissions
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePolicyTemplateCreate,
+		Read:   resourcePolicyTemplateRead,
+		Update: resourcePolicyTemplateUpdate,
+		Delete: resourcePolicyTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 150),
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+			},
+		},
+	}
+}
+
+func resourcePolicyTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	input := &verifiedpermissions.CreatePolicyTemplateInput{
+		PolicyStoreId: aws.String(policyStoreID),
+		Statement:     aws.String(d.Get("statement").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Verified Permissions Policy Template: %s", input)
+	output, err := conn.CreatePolicyTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Verified Permissions Policy Template for Policy Store (%s): %w", policyStoreID, err)
+	}
+
+	d.SetId(PolicyTemplateCreateResourceID(policyStoreID, aws.StringValue(output.PolicyTemplateId)))
+
+	return resourcePolicyTemplateRead(d, meta)
+}
+
+func resourcePolicyTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, policyTemplateID, err := PolicyTemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindPolicyTemplateByTwoPartKey(conn, policyStoreID, policyTemplateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Policy Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Verified Permissions Policy Template (%s): %w", d.Id(), err)
+	}
+
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("policy_store_id", output.PolicyStoreId)
+	d.Set("policy_template_id", output.PolicyTemplateId)
+	d.Set("statement", output.Statement)
+
+	return nil
+}
+
+func resourcePolicyTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, policyTemplateID, err := PolicyTemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &verifiedpermissions.UpdatePolicyTemplateInput{
+		PolicyStoreId:    aws.String(policyStoreID),
+		PolicyTemplateId: aws.String(policyTemplateID),
+		Statement:        aws.String(d.Get("statement").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Verified Permissions Policy Template: %s", input)
+	_, err = conn.UpdatePolicyTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Verified Permissions Policy Template (%s): %w", d.Id(), err)
+	}
+
+	return resourcePolicyTemplateRead(d, meta)
+}
+
+func resourcePolicyTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID, policyTemplateID, err := PolicyTemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Policy Template: %s", d.Id())
+	_, err = conn.DeletePolicyTemplate(&verifiedpermissions.DeletePolicyTemplateInput{
+		PolicyStoreId:    aws.String(policyStoreID),
+		PolicyTemplateId: aws.String(policyTemplateID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Verified Permissions Policy Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedPermissionsPolicyTemplate_basic(t *testing.T) {
+	resourceName := "aws_verifiedpermissions_policy_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateConfig("first", "view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "statement", `permit (principal == ?principal, action == Action::"view", resource == ?resource);`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyTemplateConfig("second", "edit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "statement", `permit (principal == ?principal, action == Action::"edit", resource == ?resource);`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyTemplate_disappears(t *testing.T) {
+	resourceName := "aws_verifiedpermissions_policy_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, verifiedpermissions.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateConfig("first", "view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfverifiedpermissions.ResourcePolicyTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedpermissions_policy_template" {
+			continue
+		}
+
+		policyStoreID, policyTemplateID, err := tfverifiedpermissions.PolicyTemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfverifiedpermissions.FindPolicyTemplateByTwoPartKey(conn, policyStoreID, policyTemplateID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Permissions Policy Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPolicyTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Permissions Policy Template ID is set")
+		}
+
+		policyStoreID, policyTemplateID, err := tfverifiedpermissions.PolicyTemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsConn
+
+		_, err = tfverifiedpermissions.FindPolicyTemplateByTwoPartKey(conn, policyStoreID, policyTemplateID)
+
+		return err
+	}
+}
+
+func testAccPolicyTemplateConfig(description, action string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  description     = %[1]q
+  statement       = "permit (principal == ?principal, action == Action::\"%[2]s\", resource == ?resource);"
+}
+`, description, action)
+}
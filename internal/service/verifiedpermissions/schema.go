@@ -0,0 +1,152 @@
+package verifiedpermissions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/jsonutil"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// emptySchema is the schema of a policy store that has no schema.
+const emptySchema = "{}"
+
+func ResourceSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSchemaPut,
+		Read:   resourceSchemaRead,
+		Update: resourceSchemaPut,
+		Delete: resourceSchemaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validCedarJSONSchema,
+							DiffSuppressFunc: jsonutil.SuppressEquivalentDiffs,
+							StateFunc:        jsonutil.NormalizeStateFunc,
+						},
+					},
+				},
+			},
+			"namespaces": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSchemaPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	value, err := jsonutil.NormalizeString(d.Get("definition.0.value").(string))
+
+	if err != nil {
+		return fmt.Errorf("schema (%s) is invalid JSON: %w", d.Get("definition.0.value").(string), err)
+	}
+
+	input := &verifiedpermissions.PutSchemaInput{
+		Definition: &verifiedpermissions.SchemaDefinition{
+			CedarJson: aws.String(value),
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	log.Printf("[DEBUG] Putting Verified Permissions Schema: %s", input)
+	_, err = conn.PutSchema(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Verified Permissions Schema (%s): %w", policyStoreID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(policyStoreID)
+	}
+
+	return resourceSchemaRead(d, meta)
+}
+
+func resourceSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	output, err := FindSchemaByPolicyStoreID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Schema (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Verified Permissions Schema (%s): %w", d.Id(), err)
+	}
+
+	value, err := jsonutil.NormalizeString(aws.StringValue(output.Schema))
+
+	if err != nil {
+		return fmt.Errorf("schema (%s) is invalid JSON: %w", aws.StringValue(output.Schema), err)
+	}
+
+	if err := d.Set("definition", []interface{}{map[string]interface{}{"value": value}}); err != nil {
+		return fmt.Errorf("error setting definition: %w", err)
+	}
+	d.Set("namespaces", aws.StringValueSlice(output.Namespaces))
+	d.Set("policy_store_id", output.PolicyStoreId)
+
+	return nil
+}
+
+func resourceSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Schema: %s", d.Id())
+	_, err := conn.PutSchema(&verifiedpermissions.PutSchemaInput{
+		Definition: &verifiedpermissions.SchemaDefinition{
+			CedarJson: aws.String(emptySchema),
+		},
+		PolicyStoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Verified Permissions Schema (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func validCedarJSONSchema(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if err := ValidateCedarJSONSchema(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid Cedar schema: %w", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,125 @@
+package vpclattice
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAuthPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuthPolicyPut,
+		Read:   resourceAuthPolicyRead,
+		Update: resourceAuthPolicyPut,
+		Delete: resourceAuthPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAuthPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	resourceID := d.Get("resource_identifier").(string)
+	input := &vpclattice.PutAuthPolicyInput{
+		Policy:             aws.String(policy),
+		ResourceIdentifier: aws.String(resourceID),
+	}
+
+	log.Printf("[DEBUG] Putting VPC Lattice Auth Policy: %s", input)
+	_, err = conn.PutAuthPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting VPC Lattice Auth Policy (%s): %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceAuthPolicyRead(d, meta)
+}
+
+func resourceAuthPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	output, err := FindAuthPolicyByResourceIdentifier(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Auth Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Lattice Auth Policy (%s): %w", d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("resource_identifier", d.Id())
+	d.Set("state", output.State)
+
+	return nil
+}
+
+func resourceAuthPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[DEBUG] Deleting VPC Lattice Auth Policy: %s", d.Id())
+	_, err := conn.DeleteAuthPolicy(&vpclattice.DeleteAuthPolicyInput{
+		ResourceIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Lattice Auth Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
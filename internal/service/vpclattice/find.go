@@ -0,0 +1,212 @@
+package vpclattice
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindServiceNetworkByID(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkOutput, error) {
+	input := &vpclattice.GetServiceNetworkInput{
+		ServiceNetworkIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetServiceNetwork(input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindServiceByID(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceOutput, error) {
+	input := &vpclattice.GetServiceInput{
+		ServiceIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetService(input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindListenerByTwoPartKey(conn *vpclattice.VPCLattice, serviceID string, listenerID string) (*vpclattice.GetListenerOutput, error) {
+	input := &vpclattice.GetListenerInput{
+		ServiceIdentifier:  aws.String(serviceID),
+		ListenerIdentifier: aws.String(listenerID),
+	}
+
+	output, err := conn.GetListener(input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindListenerRuleByThreePartKey(conn *vpclattice.VPCLattice, serviceID string, listenerID string, ruleID string) (*vpclattice.GetRuleOutput, error) {
+	input := &vpclattice.GetRuleInput{
+		ServiceIdentifier:  aws.String(serviceID),
+		ListenerIdentifier: aws.String(listenerID),
+		RuleIdentifier:     aws.String(ruleID),
+	}
+
+	output, err := conn.GetRule(input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTargetGroupByID(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetTargetGroupOutput, error) {
+	input := &vpclattice.GetTargetGroupInput{
+		TargetGroupIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetTargetGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindServiceNetworkServiceAssociationByID(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
+	input := &vpclattice.GetServiceNetworkServiceAssociationInput{
+		ServiceNetworkServiceAssociationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetServiceNetworkServiceAssociation(input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindServiceNetworkVPCAssociationByID(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	input := &vpclattice.GetServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetServiceNetworkVpcAssociation(input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAuthPolicyByResourceIdentifier(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetAuthPolicyOutput, error) {
+	input := &vpclattice.GetAuthPolicyInput{
+		ResourceIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetAuthPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
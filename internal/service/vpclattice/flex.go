@@ -0,0 +1,462 @@
+package vpclattice
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+)
+
+func expandRuleAction(tfMap map[string]interface{}) *vpclattice.RuleAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.RuleAction{}
+
+	if v, ok := tfMap["fixed_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FixedResponse = expandFixedResponseAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["forward"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Forward = expandForwardAction(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandFixedResponseAction(tfMap map[string]interface{}) *vpclattice.FixedResponseAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.FixedResponseAction{}
+
+	if v, ok := tfMap["status_code"].(int); ok && v != 0 {
+		apiObject.StatusCode = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandForwardAction(tfMap map[string]interface{}) *vpclattice.ForwardAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.ForwardAction{}
+
+	if v, ok := tfMap["target_groups"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TargetGroups = expandWeightedTargetGroups(v)
+	}
+
+	return apiObject
+}
+
+func expandWeightedTargetGroups(tfList []interface{}) []*vpclattice.WeightedTargetGroup {
+	var apiObjects []*vpclattice.WeightedTargetGroup
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &vpclattice.WeightedTargetGroup{}
+
+		if v, ok := tfMap["target_group_identifier"].(string); ok && v != "" {
+			apiObject.TargetGroupIdentifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["weight"].(int); ok {
+			apiObject.Weight = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRuleAction(apiObject *vpclattice.RuleAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FixedResponse; v != nil {
+		tfMap["fixed_response"] = []interface{}{map[string]interface{}{
+			"status_code": aws.Int64Value(v.StatusCode),
+		}}
+	}
+
+	if v := apiObject.Forward; v != nil {
+		tfMap["forward"] = []interface{}{map[string]interface{}{
+			"target_groups": flattenWeightedTargetGroups(v.TargetGroups),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenWeightedTargetGroups(apiObjects []*vpclattice.WeightedTargetGroup) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"target_group_identifier": aws.StringValue(apiObject.TargetGroupIdentifier),
+			"weight":                  aws.Int64Value(apiObject.Weight),
+		})
+	}
+
+	return tfList
+}
+
+func expandRuleMatch(tfMap map[string]interface{}) *vpclattice.RuleMatch {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.RuleMatch{}
+
+	if v, ok := tfMap["http_match"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpMatch = expandHTTPMatch(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandHTTPMatch(tfMap map[string]interface{}) *vpclattice.HttpMatch {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.HttpMatch{}
+
+	if v, ok := tfMap["header_matches"].([]interface{}); ok && len(v) > 0 {
+		apiObject.HeaderMatches = expandHeaderMatches(v)
+	}
+
+	if v, ok := tfMap["method"].(string); ok && v != "" {
+		apiObject.Method = aws.String(v)
+	}
+
+	if v, ok := tfMap["path_match"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PathMatch = expandPathMatch(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandHeaderMatches(tfList []interface{}) []*vpclattice.HeaderMatch {
+	var apiObjects []*vpclattice.HeaderMatch
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &vpclattice.HeaderMatch{}
+
+		if v, ok := tfMap["case_sensitive"].(bool); ok {
+			apiObject.CaseSensitive = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["match"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Match = expandHeaderMatchType(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandHeaderMatchType(tfMap map[string]interface{}) *vpclattice.HeaderMatchType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.HeaderMatchType{}
+
+	if v, ok := tfMap["contains"].(string); ok && v != "" {
+		apiObject.Contains = aws.String(v)
+	}
+
+	if v, ok := tfMap["exact"].(string); ok && v != "" {
+		apiObject.Exact = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPathMatch(tfMap map[string]interface{}) *vpclattice.PathMatch {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.PathMatch{}
+
+	if v, ok := tfMap["case_sensitive"].(bool); ok {
+		apiObject.CaseSensitive = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["match"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Match = &vpclattice.PathMatchType{}
+
+		if v, ok := tfMap["exact"].(string); ok && v != "" {
+			apiObject.Match.Exact = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.Match.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenRuleMatch(apiObject *vpclattice.RuleMatch) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HttpMatch; v != nil {
+		tfMap["http_match"] = []interface{}{flattenHTTPMatch(v)}
+	}
+
+	return tfMap
+}
+
+func flattenHTTPMatch(apiObject *vpclattice.HttpMatch) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"method": aws.StringValue(apiObject.Method),
+	}
+
+	if v := apiObject.HeaderMatches; v != nil {
+		tfMap["header_matches"] = flattenHeaderMatches(v)
+	}
+
+	if v := apiObject.PathMatch; v != nil {
+		tfMap["path_match"] = []interface{}{flattenPathMatch(v)}
+	}
+
+	return tfMap
+}
+
+func flattenHeaderMatches(apiObjects []*vpclattice.HeaderMatch) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"case_sensitive": aws.BoolValue(apiObject.CaseSensitive),
+			"name":           aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.Match; v != nil {
+			tfMap["match"] = []interface{}{map[string]interface{}{
+				"contains": aws.StringValue(v.Contains),
+				"exact":    aws.StringValue(v.Exact),
+				"prefix":   aws.StringValue(v.Prefix),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPathMatch(apiObject *vpclattice.PathMatch) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"case_sensitive": aws.BoolValue(apiObject.CaseSensitive),
+	}
+
+	if v := apiObject.Match; v != nil {
+		tfMap["match"] = []interface{}{map[string]interface{}{
+			"exact":  aws.StringValue(v.Exact),
+			"prefix": aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return tfMap
+}
+
+func expandTargetGroupConfig(tfMap map[string]interface{}) *vpclattice.TargetGroupConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.TargetGroupConfig{}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandHealthCheckConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["ip_address_type"].(string); ok && v != "" {
+		apiObject.IpAddressType = aws.String(v)
+	}
+
+	if v, ok := tfMap["lambda_event_structure_version"].(string); ok && v != "" {
+		apiObject.LambdaEventStructureVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["protocol_version"].(string); ok && v != "" {
+		apiObject.ProtocolVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_identifier"].(string); ok && v != "" {
+		apiObject.VpcIdentifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHealthCheckConfig(tfMap map[string]interface{}) *vpclattice.HealthCheckConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.HealthCheckConfig{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["health_check_interval_seconds"].(int); ok && v != 0 {
+		apiObject.HealthCheckIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["health_check_timeout_seconds"].(int); ok && v != 0 {
+		apiObject.HealthCheckTimeoutSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["healthy_threshold_count"].(int); ok && v != 0 {
+		apiObject.HealthyThresholdCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["matcher"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["value"].(string); ok && v != "" {
+			apiObject.Matcher = &vpclattice.Matcher{
+				HttpCode: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["path"].(string); ok && v != "" {
+		apiObject.Path = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["protocol_version"].(string); ok && v != "" {
+		apiObject.ProtocolVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["unhealthy_threshold_count"].(int); ok && v != 0 {
+		apiObject.UnhealthyThresholdCount = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenTargetGroupConfig(apiObject *vpclattice.TargetGroupConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ip_address_type":                aws.StringValue(apiObject.IpAddressType),
+		"lambda_event_structure_version": aws.StringValue(apiObject.LambdaEventStructureVersion),
+		"port":                           aws.Int64Value(apiObject.Port),
+		"protocol":                       aws.StringValue(apiObject.Protocol),
+		"protocol_version":               aws.StringValue(apiObject.ProtocolVersion),
+		"vpc_identifier":                 aws.StringValue(apiObject.VpcIdentifier),
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap["health_check"] = []interface{}{flattenHealthCheckConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenHealthCheckConfig(apiObject *vpclattice.HealthCheckConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":                       aws.BoolValue(apiObject.Enabled),
+		"health_check_interval_seconds": aws.Int64Value(apiObject.HealthCheckIntervalSeconds),
+		"health_check_timeout_seconds":  aws.Int64Value(apiObject.HealthCheckTimeoutSeconds),
+		"healthy_threshold_count":       aws.Int64Value(apiObject.HealthyThresholdCount),
+		"path":                          aws.StringValue(apiObject.Path),
+		"port":                          aws.Int64Value(apiObject.Port),
+		"protocol":                      aws.StringValue(apiObject.Protocol),
+		"protocol_version":              aws.StringValue(apiObject.ProtocolVersion),
+		"unhealthy_threshold_count":     aws.Int64Value(apiObject.UnhealthyThresholdCount),
+	}
+
+	if v := apiObject.Matcher; v != nil {
+		tfMap["matcher"] = []interface{}{map[string]interface{}{
+			"value": aws.StringValue(v.HttpCode),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDNSEntry(apiObject *vpclattice.DnsEntry) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"domain_name":    aws.StringValue(apiObject.DomainName),
+		"hosted_zone_id": aws.StringValue(apiObject.HostedZoneId),
+	}
+}
@@ -0,0 +1,44 @@
+package vpclattice
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ListenerResourceIDSeparator = ","
+
+func ListenerCreateResourceID(serviceID, listenerID string) string {
+	parts := []string{serviceID, listenerID}
+	id := strings.Join(parts, ListenerResourceIDSeparator)
+
+	return id
+}
+
+func ListenerParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, ListenerResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected service-id%[2]slistener-id", id, ListenerResourceIDSeparator)
+}
+
+const ListenerRuleResourceIDSeparator = ","
+
+func ListenerRuleCreateResourceID(serviceID, listenerID, ruleID string) string {
+	parts := []string{serviceID, listenerID, ruleID}
+	id := strings.Join(parts, ListenerRuleResourceIDSeparator)
+
+	return id
+}
+
+func ListenerRuleParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ListenerRuleResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected service-id%[2]slistener-id%[2]srule-id", id, ListenerRuleResourceIDSeparator)
+}
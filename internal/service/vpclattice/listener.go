@@ -0,0 +1,299 @@
+package vpclattice
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceListenerCreate,
+		Read:   resourceListenerRead,
+		Update: resourceListenerUpdate,
+		Delete: resourceListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_action": ruleActionSchema("default_action"),
+			"listener_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(vpclattice.ListenerProtocol_Values(), false),
+			},
+			"service_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// ruleActionSchema returns the schema shared by listener default actions and listener rule actions.
+func ruleActionSchema(attribute string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fixed_response": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{attribute + ".0.fixed_response", attribute + ".0.forward"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"status_code": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(100, 599),
+							},
+						},
+					},
+				},
+				"forward": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{attribute + ".0.fixed_response", attribute + ".0.forward"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"target_groups": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 10,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"target_group_identifier": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"weight": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      100,
+											ValidateFunc: validation.IntBetween(0, 999),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &vpclattice.CreateListenerInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		Name:              aws.String(name),
+		Protocol:          aws.String(d.Get("protocol").(string)),
+		ServiceIdentifier: aws.String(d.Get("service_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("default_action"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DefaultAction = expandRuleAction(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Listener: %s", input)
+	output, err := conn.CreateListener(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Lattice Listener (%s): %w", name, err)
+	}
+
+	d.SetId(ListenerCreateResourceID(aws.StringValue(output.ServiceId), aws.StringValue(output.Id)))
+
+	return resourceListenerRead(d, meta)
+}
+
+func resourceListenerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	serviceID, listenerID, err := ListenerParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindListenerByTwoPartKey(conn, serviceID, listenerID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Lattice Listener (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	if output.DefaultAction != nil {
+		if err := d.Set("default_action", []interface{}{flattenRuleAction(output.DefaultAction)}); err != nil {
+			return fmt.Errorf("error setting default_action: %w", err)
+		}
+	} else {
+		d.Set("default_action", nil)
+	}
+	d.Set("listener_id", output.Id)
+	d.Set("name", output.Name)
+	d.Set("port", output.Port)
+	d.Set("protocol", output.Protocol)
+	d.Set("service_arn", output.ServiceArn)
+	d.Set("service_id", output.ServiceId)
+	if _, ok := d.GetOk("service_identifier"); !ok {
+		d.Set("service_identifier", output.ServiceId)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for VPC Lattice Listener (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChange("default_action") {
+		serviceID, listenerID, err := ListenerParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &vpclattice.UpdateListenerInput{
+			ListenerIdentifier: aws.String(listenerID),
+			ServiceIdentifier:  aws.String(serviceID),
+		}
+
+		if v, ok := d.GetOk("default_action"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DefaultAction = expandRuleAction(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating VPC Lattice Listener: %s", input)
+		_, err = conn.UpdateListener(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating VPC Lattice Listener (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Lattice Listener (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceListenerRead(d, meta)
+}
+
+func resourceListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	serviceID, listenerID, err := ListenerParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting VPC Lattice Listener: %s", d.Id())
+	_, err = conn.DeleteListener(&vpclattice.DeleteListenerInput{
+		ListenerIdentifier: aws.String(listenerID),
+		ServiceIdentifier:  aws.String(serviceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Lattice Listener (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
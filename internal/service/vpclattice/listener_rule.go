@@ -0,0 +1,348 @@
+package vpclattice
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceListenerRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceListenerRuleCreate,
+		Read:   resourceListenerRuleRead,
+		Update: resourceListenerRuleUpdate,
+		Delete: resourceListenerRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": ruleActionSchema("action"),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"listener_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"match": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_match": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header_matches": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 5,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"case_sensitive": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"match": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"contains": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 200),
+															},
+															"exact": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 200),
+															},
+															"prefix": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 200),
+															},
+														},
+													},
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 40),
+												},
+											},
+										},
+									},
+									"method": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"path_match": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"case_sensitive": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"match": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"exact": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 200),
+															},
+															"prefix": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 200),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceListenerRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	serviceID := d.Get("service_identifier").(string)
+	listenerID := d.Get("listener_identifier").(string)
+	name := d.Get("name").(string)
+	input := &vpclattice.CreateRuleInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		ListenerIdentifier: aws.String(listenerID),
+		Name:               aws.String(name),
+		Priority:           aws.Int64(int64(d.Get("priority").(int))),
+		ServiceIdentifier:  aws.String(serviceID),
+	}
+
+	if v, ok := d.GetOk("action"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Action = expandRuleAction(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("match"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Match = expandRuleMatch(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Listener Rule: %s", input)
+	output, err := conn.CreateRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Lattice Listener Rule (%s): %w", name, err)
+	}
+
+	d.SetId(ListenerRuleCreateResourceID(serviceID, listenerID, aws.StringValue(output.Id)))
+
+	return resourceListenerRuleRead(d, meta)
+}
+
+func resourceListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	serviceID, listenerID, ruleID, err := ListenerRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindListenerRuleByThreePartKey(conn, serviceID, listenerID, ruleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Listener Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Lattice Listener Rule (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	if output.Action != nil {
+		if err := d.Set("action", []interface{}{flattenRuleAction(output.Action)}); err != nil {
+			return fmt.Errorf("error setting action: %w", err)
+		}
+	} else {
+		d.Set("action", nil)
+	}
+	d.Set("arn", arn)
+	d.Set("listener_identifier", listenerID)
+	if output.Match != nil {
+		if err := d.Set("match", []interface{}{flattenRuleMatch(output.Match)}); err != nil {
+			return fmt.Errorf("error setting match: %w", err)
+		}
+	} else {
+		d.Set("match", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("priority", output.Priority)
+	d.Set("rule_id", output.Id)
+	d.Set("service_identifier", serviceID)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for VPC Lattice Listener Rule (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceListenerRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		serviceID, listenerID, ruleID, err := ListenerRuleParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &vpclattice.UpdateRuleInput{
+			ListenerIdentifier: aws.String(listenerID),
+			RuleIdentifier:     aws.String(ruleID),
+			ServiceIdentifier:  aws.String(serviceID),
+		}
+
+		if d.HasChange("action") {
+			if v, ok := d.GetOk("action"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Action = expandRuleAction(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("match") {
+			if v, ok := d.GetOk("match"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Match = expandRuleMatch(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("priority") {
+			input.Priority = aws.Int64(int64(d.Get("priority").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating VPC Lattice Listener Rule: %s", input)
+		_, err = conn.UpdateRule(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating VPC Lattice Listener Rule (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Lattice Listener Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceListenerRuleRead(d, meta)
+}
+
+func resourceListenerRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	serviceID, listenerID, ruleID, err := ListenerRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting VPC Lattice Listener Rule: %s", d.Id())
+	_, err = conn.DeleteRule(&vpclattice.DeleteRuleInput{
+		ListenerIdentifier: aws.String(listenerID),
+		RuleIdentifier:     aws.String(ruleID),
+		ServiceIdentifier:  aws.String(serviceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Lattice Listener Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package vpclattice_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCLatticeListenerRule_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(vpclattice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckListenerRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "vpc-lattice", regexp.MustCompile(`service/svc-.+/listener/listener-.+/rule/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.fixed_response.0.status_code", "404"),
+					resource.TestCheckResourceAttr(resourceName, "match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.path_match.0.match.0.prefix", "/example"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeListenerRule_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(vpclattice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckListenerRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfvpclattice.ResourceListenerRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeListenerRule_priority(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(vpclattice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckListenerRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccListenerRuleConfig(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckListenerRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpclattice_listener_rule" {
+			continue
+		}
+
+		serviceID, listenerID, ruleID, err := tfvpclattice.ListenerRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfvpclattice.FindListenerRuleByThreePartKey(conn, serviceID, listenerID, ruleID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("VPC Lattice Listener Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckListenerRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Listener Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+		serviceID, listenerID, ruleID, err := tfvpclattice.ListenerRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfvpclattice.FindListenerRuleByThreePartKey(conn, serviceID, listenerID, ruleID)
+
+		return err
+	}
+}
+
+func testAccListenerRuleConfig(rName string, priority int) string {
+	return acctest.ConfigCompose(testAccListenerConfig(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener_rule" "test" {
+  name                = %[1]q
+  listener_identifier = aws_vpclattice_listener.test.listener_id
+  service_identifier  = aws_vpclattice_service.test.id
+  priority            = %[2]d
+
+  match {
+    http_match {
+      path_match {
+        match {
+          prefix = "/example"
+        }
+      }
+    }
+  }
+
+  action {
+    fixed_response {
+      status_code = 404
+    }
+  }
+}
+`, rName, priority))
+}
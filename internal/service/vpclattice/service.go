@@ -0,0 +1,237 @@
+package vpclattice
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceCreate,
+		Read:   resourceServiceRead,
+		Update: resourceServiceUpdate,
+		Delete: resourceServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(vpclattice.AuthType_Values(), false),
+			},
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"custom_domain_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+			"dns_entry": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 40),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &vpclattice.CreateServiceInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("auth_type"); ok {
+		input.AuthType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("certificate_arn"); ok {
+		input.CertificateArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_domain_name"); ok {
+		input.CustomDomainName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Service: %s", input)
+	output, err := conn.CreateService(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Lattice Service (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitServiceCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for VPC Lattice Service (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceServiceRead(d, meta)
+}
+
+func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindServiceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Service (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Lattice Service (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("auth_type", output.AuthType)
+	d.Set("certificate_arn", output.CertificateArn)
+	d.Set("custom_domain_name", output.CustomDomainName)
+	if output.DnsEntry != nil {
+		if err := d.Set("dns_entry", []interface{}{flattenDNSEntry(output.DnsEntry)}); err != nil {
+			return fmt.Errorf("error setting dns_entry: %w", err)
+		}
+	} else {
+		d.Set("dns_entry", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for VPC Lattice Service (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &vpclattice.UpdateServiceInput{
+			ServiceIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("auth_type") {
+			input.AuthType = aws.String(d.Get("auth_type").(string))
+		}
+
+		if d.HasChange("certificate_arn") {
+			input.CertificateArn = aws.String(d.Get("certificate_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Updating VPC Lattice Service: %s", input)
+		_, err := conn.UpdateService(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating VPC Lattice Service (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Lattice Service (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceRead(d, meta)
+}
+
+func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[DEBUG] Deleting VPC Lattice Service: %s", d.Id())
+	_, err := conn.DeleteService(&vpclattice.DeleteServiceInput{
+		ServiceIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Lattice Service (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitServiceDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for VPC Lattice Service (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
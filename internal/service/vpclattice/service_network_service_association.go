@@ -0,0 +1,202 @@
+package vpclattice
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceNetworkServiceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceNetworkServiceAssociationCreate,
+		Read:   resourceServiceNetworkServiceAssociationRead,
+		Update: resourceServiceNetworkServiceAssociationUpdate,
+		Delete: resourceServiceNetworkServiceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_entry": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_network_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceNetworkServiceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &vpclattice.CreateServiceNetworkServiceAssociationInput{
+		ClientToken:              aws.String(resource.UniqueId()),
+		ServiceIdentifier:        aws.String(d.Get("service_identifier").(string)),
+		ServiceNetworkIdentifier: aws.String(d.Get("service_network_identifier").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Service Network Service Association: %s", input)
+	output, err := conn.CreateServiceNetworkServiceAssociation(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Lattice Service Network Service Association: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitServiceNetworkServiceAssociationCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for VPC Lattice Service Network Service Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceServiceNetworkServiceAssociationRead(d, meta)
+}
+
+func resourceServiceNetworkServiceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindServiceNetworkServiceAssociationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Service Network Service Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Lattice Service Network Service Association (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("created_by", output.CreatedBy)
+	d.Set("custom_domain_name", output.CustomDomainName)
+	if output.DnsEntry != nil {
+		if err := d.Set("dns_entry", []interface{}{flattenDNSEntry(output.DnsEntry)}); err != nil {
+			return fmt.Errorf("error setting dns_entry: %w", err)
+		}
+	} else {
+		d.Set("dns_entry", nil)
+	}
+	if _, ok := d.GetOk("service_identifier"); !ok {
+		d.Set("service_identifier", output.ServiceId)
+	}
+	if _, ok := d.GetOk("service_network_identifier"); !ok {
+		d.Set("service_network_identifier", output.ServiceNetworkId)
+	}
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for VPC Lattice Service Network Service Association (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceServiceNetworkServiceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Lattice Service Network Service Association (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceNetworkServiceAssociationRead(d, meta)
+}
+
+func resourceServiceNetworkServiceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[DEBUG] Deleting VPC Lattice Service Network Service Association: %s", d.Id())
+	_, err := conn.DeleteServiceNetworkServiceAssociation(&vpclattice.DeleteServiceNetworkServiceAssociationInput{
+		ServiceNetworkServiceAssociationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Lattice Service Network Service Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitServiceNetworkServiceAssociationDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for VPC Lattice Service Network Service Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
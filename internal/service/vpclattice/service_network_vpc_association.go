@@ -0,0 +1,202 @@
+package vpclattice
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceNetworkVPCAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceNetworkVPCAssociationCreate,
+		Read:   resourceServiceNetworkVPCAssociationRead,
+		Update: resourceServiceNetworkVPCAssociationUpdate,
+		Delete: resourceServiceNetworkVPCAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_network_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceNetworkVPCAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &vpclattice.CreateServiceNetworkVpcAssociationInput{
+		ClientToken:              aws.String(resource.UniqueId()),
+		ServiceNetworkIdentifier: aws.String(d.Get("service_network_identifier").(string)),
+		VpcIdentifier:            aws.String(d.Get("vpc_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Service Network VPC Association: %s", input)
+	output, err := conn.CreateServiceNetworkVpcAssociation(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Lattice Service Network VPC Association: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitServiceNetworkVPCAssociationCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for VPC Lattice Service Network VPC Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceServiceNetworkVPCAssociationRead(d, meta)
+}
+
+func resourceServiceNetworkVPCAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindServiceNetworkVPCAssociationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Service Network VPC Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Lattice Service Network VPC Association (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("created_by", output.CreatedBy)
+	d.Set("security_group_ids", aws.StringValueSlice(output.SecurityGroupIds))
+	if _, ok := d.GetOk("service_network_identifier"); !ok {
+		d.Set("service_network_identifier", output.ServiceNetworkId)
+	}
+	d.Set("status", output.Status)
+	d.Set("vpc_identifier", output.VpcId)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for VPC Lattice Service Network VPC Association (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceServiceNetworkVPCAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChange("security_group_ids") {
+		input := &vpclattice.UpdateServiceNetworkVpcAssociationInput{
+			SecurityGroupIds:                       flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+			ServiceNetworkVpcAssociationIdentifier: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating VPC Lattice Service Network VPC Association: %s", input)
+		_, err := conn.UpdateServiceNetworkVpcAssociation(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating VPC Lattice Service Network VPC Association (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitServiceNetworkVPCAssociationUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for VPC Lattice Service Network VPC Association (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Lattice Service Network VPC Association (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceNetworkVPCAssociationRead(d, meta)
+}
+
+func resourceServiceNetworkVPCAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[DEBUG] Deleting VPC Lattice Service Network VPC Association: %s", d.Id())
+	_, err := conn.DeleteServiceNetworkVpcAssociation(&vpclattice.DeleteServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Lattice Service Network VPC Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitServiceNetworkVPCAssociationDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for VPC Lattice Service Network VPC Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
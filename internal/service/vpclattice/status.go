@@ -0,0 +1,72 @@
+package vpclattice
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusService(conn *vpclattice.VPCLattice, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusTargetGroup(conn *vpclattice.VPCLattice, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTargetGroupByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusServiceNetworkServiceAssociation(conn *vpclattice.VPCLattice, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceNetworkServiceAssociationByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusServiceNetworkVPCAssociation(conn *vpclattice.VPCLattice, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceNetworkVPCAssociationByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
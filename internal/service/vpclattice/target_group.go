@@ -0,0 +1,323 @@
+package vpclattice
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTargetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTargetGroupCreate,
+		Read:   resourceTargetGroupRead,
+		Update: resourceTargetGroupUpdate,
+		Delete: resourceTargetGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"health_check": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"health_check_interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(5, 300),
+									},
+									"health_check_timeout_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 120),
+									},
+									"healthy_threshold_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+									"matcher": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"value": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(vpclattice.TargetGroupProtocol_Values(), false),
+									},
+									"protocol_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(vpclattice.HealthCheckProtocolVersion_Values(), false),
+									},
+									"unhealthy_threshold_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+								},
+							},
+						},
+						"ip_address_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(vpclattice.IpAddressType_Values(), false),
+						},
+						"lambda_event_structure_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(vpclattice.LambdaEventStructureVersion_Values(), false),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(vpclattice.TargetGroupProtocol_Values(), false),
+						},
+						"protocol_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(vpclattice.TargetGroupProtocolVersion_Values(), false),
+						},
+						"vpc_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(vpclattice.TargetGroupType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &vpclattice.CreateTargetGroupInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+		Type:        aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Config = expandTargetGroupConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Target Group: %s", input)
+	output, err := conn.CreateTargetGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Lattice Target Group (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitTargetGroupCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for VPC Lattice Target Group (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceTargetGroupRead(d, meta)
+}
+
+func resourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindTargetGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Target Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Lattice Target Group (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	if output.Config != nil {
+		if err := d.Set("config", []interface{}{flattenTargetGroupConfig(output.Config)}); err != nil {
+			return fmt.Errorf("error setting config: %w", err)
+		}
+	} else {
+		d.Set("config", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("type", output.Type)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for VPC Lattice Target Group (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChange("config.0.health_check") {
+		input := &vpclattice.UpdateTargetGroupInput{
+			TargetGroupIdentifier: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("config.0.health_check"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.HealthCheck = expandHealthCheckConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if input.HealthCheck != nil {
+			log.Printf("[DEBUG] Updating VPC Lattice Target Group: %s", input)
+			_, err := conn.UpdateTargetGroup(input)
+
+			if err != nil {
+				return fmt.Errorf("error updating VPC Lattice Target Group (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Lattice Target Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTargetGroupRead(d, meta)
+}
+
+func resourceTargetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[DEBUG] Deleting VPC Lattice Target Group: %s", d.Id())
+	_, err := conn.DeleteTargetGroup(&vpclattice.DeleteTargetGroupInput{
+		TargetGroupIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Lattice Target Group (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitTargetGroupDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for VPC Lattice Target Group (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
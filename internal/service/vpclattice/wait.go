@@ -0,0 +1,194 @@
+package vpclattice
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	serviceCreatedTimeout                          = 10 * time.Minute
+	serviceDeletedTimeout                          = 10 * time.Minute
+	targetGroupCreatedTimeout                      = 10 * time.Minute
+	targetGroupDeletedTimeout                      = 10 * time.Minute
+	serviceNetworkServiceAssociationCreatedTimeout = 10 * time.Minute
+	serviceNetworkServiceAssociationDeletedTimeout = 10 * time.Minute
+	serviceNetworkVPCAssociationCreatedTimeout     = 10 * time.Minute
+	serviceNetworkVPCAssociationUpdatedTimeout     = 10 * time.Minute
+	serviceNetworkVPCAssociationDeletedTimeout     = 10 * time.Minute
+)
+
+func waitServiceCreated(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceStatusCreateInProgress},
+		Target:  []string{vpclattice.ServiceStatusActive},
+		Refresh: statusService(conn, id),
+		Timeout: serviceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceDeleted(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceStatusDeleteInProgress, vpclattice.ServiceStatusActive},
+		Target:  []string{},
+		Refresh: statusService(conn, id),
+		Timeout: serviceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTargetGroupCreated(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetTargetGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.TargetGroupStatusCreateInProgress},
+		Target:  []string{vpclattice.TargetGroupStatusActive},
+		Refresh: statusTargetGroup(conn, id),
+		Timeout: targetGroupCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetTargetGroupOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTargetGroupDeleted(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetTargetGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.TargetGroupStatusDeleteInProgress, vpclattice.TargetGroupStatusActive},
+		Target:  []string{},
+		Refresh: statusTargetGroup(conn, id),
+		Timeout: targetGroupDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetTargetGroupOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkServiceAssociationCreated(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkServiceAssociationStatusCreateInProgress},
+		Target:  []string{vpclattice.ServiceNetworkServiceAssociationStatusActive},
+		Refresh: statusServiceNetworkServiceAssociation(conn, id),
+		Timeout: serviceNetworkServiceAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkServiceAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkServiceAssociationDeleted(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkServiceAssociationStatusDeleteInProgress, vpclattice.ServiceNetworkServiceAssociationStatusActive},
+		Target:  []string{},
+		Refresh: statusServiceNetworkServiceAssociation(conn, id),
+		Timeout: serviceNetworkServiceAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkServiceAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkVPCAssociationCreated(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkVpcAssociationStatusCreateInProgress},
+		Target:  []string{vpclattice.ServiceNetworkVpcAssociationStatusActive},
+		Refresh: statusServiceNetworkVPCAssociation(conn, id),
+		Timeout: serviceNetworkVPCAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkVPCAssociationUpdated(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkVpcAssociationStatusUpdateInProgress},
+		Target:  []string{vpclattice.ServiceNetworkVpcAssociationStatusActive},
+		Refresh: statusServiceNetworkVPCAssociation(conn, id),
+		Timeout: serviceNetworkVPCAssociationUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkVPCAssociationDeleted(conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkVpcAssociationStatusDeleteInProgress, vpclattice.ServiceNetworkVpcAssociationStatusActive},
+		Target:  []string{},
+		Refresh: statusServiceNetworkVPCAssociation(conn, id),
+		Timeout: serviceNetworkVPCAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -150,12 +149,7 @@ func resourceRateBasedRuleRead(d *schema.ResourceData, meta interface{}) error {
 		predicates = append(predicates, predicate)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "waf",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("ratebasedrule/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).GlobalARN("waf", fmt.Sprintf("ratebasedrule/%s", d.Id()))
 	d.Set("arn", arn)
 
 	tagList, err := ListTags(conn, arn)
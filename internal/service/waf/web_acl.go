@@ -6,7 +6,6 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -177,12 +176,7 @@ func resourceWebACLCreate(d *schema.ResourceData, meta interface{}) error {
 	resp := out.(*waf.CreateWebACLOutput)
 	d.SetId(aws.StringValue(resp.WebACL.WebACLId))
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "waf",
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("webacl/%s", d.Id()),
-	}.String()
+	arn := meta.(*conns.AWSClient).GlobalARN("waf", fmt.Sprintf("webacl/%s", d.Id()))
 
 	loggingConfiguration := d.Get("logging_configuration").([]interface{})
 	if len(loggingConfiguration) == 1 {
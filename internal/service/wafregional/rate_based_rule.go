@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -151,13 +150,7 @@ func resourceRateBasedRuleRead(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 
-	arn := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("ratebasedrule/%s", d.Id()),
-		Service:   "waf-regional",
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("waf-regional", fmt.Sprintf("ratebasedrule/%s", d.Id()))
 	d.Set("arn", arn)
 
 	tagList, err := ListTags(conn, arn)
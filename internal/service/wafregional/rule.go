@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -129,13 +128,7 @@ func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	arn := arn.ARN{
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
-		Resource:  fmt.Sprintf("rule/%s", d.Id()),
-		Service:   "waf-regional",
-	}.String()
+	arn := meta.(*conns.AWSClient).RegionalARN("waf-regional", fmt.Sprintf("rule/%s", d.Id()))
 	d.Set("arn", arn)
 
 	tags, err := ListTags(conn, arn)
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -207,13 +206,7 @@ func resourceWebACLCreate(d *schema.ResourceData, meta interface{}) error {
 	// The WAF API currently omits this, but use it when it becomes available
 	webACLARN := aws.StringValue(resp.WebACL.WebACLArn)
 	if webACLARN == "" {
-		webACLARN = arn.ARN{
-			AccountID: meta.(*conns.AWSClient).AccountID,
-			Partition: meta.(*conns.AWSClient).Partition,
-			Region:    meta.(*conns.AWSClient).Region,
-			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
-			Service:   "waf-regional",
-		}.String()
+		webACLARN = meta.(*conns.AWSClient).RegionalARN("waf-regional", fmt.Sprintf("webacl/%s", d.Id()))
 	}
 
 	loggingConfiguration := d.Get("logging_configuration").([]interface{})
@@ -278,13 +271,7 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 	// The WAF API currently omits this, but use it when it becomes available
 	webACLARN := aws.StringValue(resp.WebACL.WebACLArn)
 	if webACLARN == "" {
-		webACLARN = arn.ARN{
-			AccountID: meta.(*conns.AWSClient).AccountID,
-			Partition: meta.(*conns.AWSClient).Partition,
-			Region:    meta.(*conns.AWSClient).Region,
-			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
-			Service:   "waf-regional",
-		}.String()
+		webACLARN = meta.(*conns.AWSClient).RegionalARN("waf-regional", fmt.Sprintf("webacl/%s", d.Id()))
 	}
 	d.Set("arn", webACLARN)
 
@@ -0,0 +1,46 @@
+package wafv2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindWebACL(conn *wafv2.WAFV2, id, name, scope string) (*wafv2.GetWebACLOutput, error) {
+	input := &wafv2.GetWebACLInput{
+		Id:    aws.String(id),
+		Name:  aws.String(name),
+		Scope: aws.String(scope),
+	}
+
+	output, err := conn.GetWebACL(input)
+
+	if tfawserr.ErrCodeEquals(err, wafv2.ErrCodeWAFNonexistentItemException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebACL == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindWebACLByARN(conn *wafv2.WAFV2, arn string) (*wafv2.GetWebACLOutput, error) {
+	id, name, scope, err := WebACLParseARN(arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FindWebACL(conn, id, name, scope)
+}
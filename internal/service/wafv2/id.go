@@ -0,0 +1,57 @@
+package wafv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+const webACLRuleResourceIDSeparator = ","
+
+func WebACLRuleCreateResourceID(webACLARN, ruleName string) string {
+	parts := []string{webACLARN, ruleName}
+	id := strings.Join(parts, webACLRuleResourceIDSeparator)
+
+	return id
+}
+
+func WebACLRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, webACLRuleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WEBACLARN%[2]sRULENAME", id, webACLRuleResourceIDSeparator)
+}
+
+// WebACLParseARN returns the ID, name and scope of the web ACL with the specified ARN.
+// Web ACL ARNs have the form arn:PARTITION:wafv2:REGION:ACCOUNT:SCOPE/webacl/NAME/ID.
+func WebACLParseARN(v string) (string, string, string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 4 || parts[1] != "webacl" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", fmt.Errorf("unexpected format for WAFv2 WebACL ARN (%s)", v)
+	}
+
+	var scope string
+
+	switch parts[0] {
+	case "global":
+		scope = wafv2.ScopeCloudfront
+	case "regional":
+		scope = wafv2.ScopeRegional
+	default:
+		return "", "", "", fmt.Errorf("unexpected scope (%s) in WAFv2 WebACL ARN (%s)", parts[0], v)
+	}
+
+	return parts[3], parts[2], scope, nil
+}
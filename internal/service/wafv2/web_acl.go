@@ -1,6 +1,7 @@
 package wafv2
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -96,46 +98,22 @@ func ResourceWebACL() *schema.Resource {
 				}, false),
 			},
 			"rule": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"rule_json"},
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"action": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"allow": allowConfigSchema(),
-									"block": blockConfigSchema(),
-									"count": countConfigSchema(),
-								},
-							},
-						},
-						"name": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringLenBetween(1, 128),
-						},
-						"override_action": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"count": emptySchema(),
-									"none":  emptySchema(),
-								},
-							},
-						},
-						"priority": {
-							Type:     schema.TypeInt,
-							Required: true,
-						},
-						"rule_label":        ruleLabelsSchema(),
-						"statement":         webACLRootStatementSchema(webACLRootStatementSchemaLevel),
-						"visibility_config": visibilityConfigSchema(),
-					},
+					Schema: webACLRuleSchema(),
+				},
+			},
+			"rule_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"rule"},
+				ValidateFunc:     validWebACLRulesJSON,
+				DiffSuppressFunc: suppressEquivalentWebACLRulesJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					rules, _ := normalizeWebACLRulesJSON(v.(string))
+					return rules
 				},
 			},
 			"tags":              tftags.TagsSchema(),
@@ -161,6 +139,16 @@ func resourceWebACLCreate(d *schema.ResourceData, meta interface{}) error {
 		VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("rule_json"); ok {
+		rules, err := expandWebACLRulesJSON(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("Error expanding WAFv2 WebACL rule_json: %w", err)
+		}
+
+		params.Rules = rules
+	}
+
 	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
 		params.CustomResponseBodies = expandCustomResponseBodies(v.(*schema.Set).List())
 	}
@@ -241,8 +229,21 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting default_action: %w", err)
 	}
 
-	if err := d.Set("rule", flattenWebACLRules(resp.WebACL.Rules)); err != nil {
-		return fmt.Errorf("Error setting rule: %w", err)
+	if _, ok := d.GetOk("rule_json"); ok {
+		rules, err := flattenWebACLRulesJSON(resp.WebACL.Rules)
+
+		if err != nil {
+			return fmt.Errorf("Error setting rule_json: %w", err)
+		}
+
+		d.Set("rule", nil)
+		d.Set("rule_json", rules)
+	} else {
+		if err := d.Set("rule", flattenWebACLRules(resp.WebACL.Rules)); err != nil {
+			return fmt.Errorf("Error setting rule: %w", err)
+		}
+
+		d.Set("rule_json", nil)
 	}
 
 	if err := d.Set("visibility_config", flattenVisibilityConfig(resp.WebACL.VisibilityConfig)); err != nil {
@@ -272,7 +273,7 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 func resourceWebACLUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WAFV2Conn
 
-	if d.HasChanges("custom_response_body", "default_action", "description", "rule", "visibility_config") {
+	if d.HasChanges("custom_response_body", "default_action", "description", "rule", "rule_json", "visibility_config") {
 		u := &wafv2.UpdateWebACLInput{
 			Id:               aws.String(d.Id()),
 			Name:             aws.String(d.Get("name").(string)),
@@ -283,6 +284,16 @@ func resourceWebACLUpdate(d *schema.ResourceData, meta interface{}) error {
 			VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 		}
 
+		if v, ok := d.GetOk("rule_json"); ok {
+			rules, err := expandWebACLRulesJSON(v.(string))
+
+			if err != nil {
+				return fmt.Errorf("Error expanding WAFv2 WebACL rule_json: %w", err)
+			}
+
+			u.Rules = rules
+		}
+
 		if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
 			u.CustomResponseBodies = expandCustomResponseBodies(v.(*schema.Set).List())
 		}
@@ -365,6 +376,46 @@ func resourceWebACLDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func webACLRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"action": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allow": allowConfigSchema(),
+					"block": blockConfigSchema(),
+					"count": countConfigSchema(),
+				},
+			},
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+		"override_action": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"count": emptySchema(),
+					"none":  emptySchema(),
+				},
+			},
+		},
+		"priority": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"rule_label":        ruleLabelsSchema(),
+		"statement":         webACLRootStatementSchema(webACLRootStatementSchemaLevel),
+		"visibility_config": visibilityConfigSchema(),
+	}
+}
+
 func webACLRootStatementSchema(level int) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -515,6 +566,68 @@ func expandWebACLRules(l []interface{}) []*wafv2.Rule {
 	return rules
 }
 
+// expandWebACLRulesJSON decodes rules in the WAFv2 API JSON format.
+func expandWebACLRulesJSON(v string) ([]*wafv2.Rule, error) {
+	var rules []*wafv2.Rule
+
+	if err := json.Unmarshal([]byte(v), &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// flattenWebACLRulesJSON encodes rules in the WAFv2 API JSON format, omitting unset fields.
+func flattenWebACLRulesJSON(rules []*wafv2.Rule) (string, error) {
+	if rules == nil {
+		rules = []*wafv2.Rule{}
+	}
+
+	b, err := jsonutil.BuildJSON(rules)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// normalizeWebACLRulesJSON round-trips rules JSON through the API types so that
+// whitespace, key order and explicit nulls do not cause differences.
+func normalizeWebACLRulesJSON(v string) (string, error) {
+	rules, err := expandWebACLRulesJSON(v)
+
+	if err != nil {
+		return v, err
+	}
+
+	return flattenWebACLRulesJSON(rules)
+}
+
+func suppressEquivalentWebACLRulesJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	normalizedOld, err := normalizeWebACLRulesJSON(old)
+
+	if err != nil {
+		return false
+	}
+
+	normalizedNew, err := normalizeWebACLRulesJSON(new)
+
+	if err != nil {
+		return false
+	}
+
+	return verify.JSONBytesEqual([]byte(normalizedOld), []byte(normalizedNew))
+}
+
+func validWebACLRulesJSON(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandWebACLRulesJSON(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid WAFv2 rules JSON: %w", k, err))
+	}
+
+	return
+}
+
 func expandWebACLRule(m map[string]interface{}) *wafv2.Rule {
 	if m == nil {
 		return nil
@@ -0,0 +1,268 @@
+package wafv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWebACLRule() *schema.Resource {
+	s := webACLRuleSchema()
+
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+	s["web_acl_arn"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidARN,
+	}
+
+	return &schema.Resource{
+		Create: resourceWebACLRuleCreate,
+		Read:   resourceWebACLRuleRead,
+		Update: resourceWebACLRuleUpdate,
+		Delete: resourceWebACLRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceWebACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WAFV2Conn
+
+	webACLARN := d.Get("web_acl_arn").(string)
+	rule := expandWebACLRuleResourceData(d)
+	ruleName := aws.StringValue(rule.Name)
+	id := WebACLRuleCreateResourceID(webACLARN, ruleName)
+
+	err := modifyWebACLRules(conn, webACLARN, func(rules []*wafv2.Rule) ([]*wafv2.Rule, error) {
+		if findWebACLRuleByName(rules, ruleName) != nil {
+			return nil, fmt.Errorf("rule (%s) already exists", ruleName)
+		}
+
+		return append(rules, rule), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating WAFv2 WebACL Rule (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceWebACLRuleRead(d, meta)
+}
+
+func resourceWebACLRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WAFV2Conn
+
+	webACLARN, ruleName, err := WebACLRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindWebACLByARN(conn, webACLARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WAFv2 WebACL (%s) not found, removing from state", webACLARN)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 WebACL (%s): %w", webACLARN, err)
+	}
+
+	rule := findWebACLRuleByName(output.WebACL.Rules, ruleName)
+
+	if rule == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading WAFv2 WebACL Rule (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] WAFv2 WebACL Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("action", flattenRuleAction(rule.Action)); err != nil {
+		return fmt.Errorf("error setting action: %w", err)
+	}
+
+	d.Set("name", rule.Name)
+
+	if err := d.Set("override_action", flattenOverrideAction(rule.OverrideAction)); err != nil {
+		return fmt.Errorf("error setting override_action: %w", err)
+	}
+
+	d.Set("priority", rule.Priority)
+
+	if err := d.Set("rule_label", flattenRuleLabels(rule.RuleLabels)); err != nil {
+		return fmt.Errorf("error setting rule_label: %w", err)
+	}
+
+	if err := d.Set("statement", flattenWebACLRootStatement(rule.Statement)); err != nil {
+		return fmt.Errorf("error setting statement: %w", err)
+	}
+
+	if err := d.Set("visibility_config", flattenVisibilityConfig(rule.VisibilityConfig)); err != nil {
+		return fmt.Errorf("error setting visibility_config: %w", err)
+	}
+
+	d.Set("web_acl_arn", webACLARN)
+
+	return nil
+}
+
+func resourceWebACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WAFV2Conn
+
+	webACLARN := d.Get("web_acl_arn").(string)
+	rule := expandWebACLRuleResourceData(d)
+	ruleName := aws.StringValue(rule.Name)
+
+	err := modifyWebACLRules(conn, webACLARN, func(rules []*wafv2.Rule) ([]*wafv2.Rule, error) {
+		for i, v := range rules {
+			if aws.StringValue(v.Name) == ruleName {
+				rules[i] = rule
+
+				return rules, nil
+			}
+		}
+
+		return nil, &resource.NotFoundError{
+			Message: fmt.Sprintf("rule (%s) not found", ruleName),
+		}
+	})
+
+	if err != nil {
+		return fmt.Errorf("error updating WAFv2 WebACL Rule (%s): %w", d.Id(), err)
+	}
+
+	return resourceWebACLRuleRead(d, meta)
+}
+
+func resourceWebACLRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WAFV2Conn
+
+	webACLARN, ruleName, err := WebACLRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting WAFv2 WebACL Rule: %s", d.Id())
+	err = modifyWebACLRules(conn, webACLARN, func(rules []*wafv2.Rule) ([]*wafv2.Rule, error) {
+		for i, v := range rules {
+			if aws.StringValue(v.Name) == ruleName {
+				return append(rules[:i], rules[i+1:]...), nil
+			}
+		}
+
+		// Nothing to do.
+		return nil, nil
+	})
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WAFv2 WebACL Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWebACLRuleResourceData(d *schema.ResourceData) *wafv2.Rule {
+	return expandWebACLRule(map[string]interface{}{
+		"action":            d.Get("action"),
+		"name":              d.Get("name"),
+		"override_action":   d.Get("override_action"),
+		"priority":          d.Get("priority"),
+		"rule_label":        d.Get("rule_label"),
+		"statement":         d.Get("statement"),
+		"visibility_config": d.Get("visibility_config"),
+	})
+}
+
+func findWebACLRuleByName(rules []*wafv2.Rule, name string) *wafv2.Rule {
+	for _, v := range rules {
+		if aws.StringValue(v.Name) == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// modifyWebACLRules applies f to the web ACL's current rules and writes the result back.
+// The web ACL's lock token provides optimistic concurrency: if the web ACL is modified
+// between the read and the write, the whole read-modify-write is retried.
+// f returning a nil slice and no error means no update is required.
+func modifyWebACLRules(conn *wafv2.WAFV2, webACLARN string, f func([]*wafv2.Rule) ([]*wafv2.Rule, error)) error {
+	id, name, scope, err := WebACLParseARN(webACLARN)
+
+	if err != nil {
+		return err
+	}
+
+	conns.GlobalMutexKV.Lock(webACLARN)
+	defer conns.GlobalMutexKV.Unlock(webACLARN)
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(webACLUpdateTimeout, func() (interface{}, error) {
+		output, err := FindWebACL(conn, id, name, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rules, err := f(output.WebACL.Rules)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if rules == nil {
+			return nil, nil
+		}
+
+		webACL := output.WebACL
+		input := &wafv2.UpdateWebACLInput{
+			AssociationConfig:    webACL.AssociationConfig,
+			CaptchaConfig:        webACL.CaptchaConfig,
+			ChallengeConfig:      webACL.ChallengeConfig,
+			CustomResponseBodies: webACL.CustomResponseBodies,
+			DefaultAction:        webACL.DefaultAction,
+			Description:          webACL.Description,
+			Id:                   aws.String(id),
+			LockToken:            output.LockToken,
+			Name:                 aws.String(name),
+			Rules:                rules,
+			Scope:                aws.String(scope),
+			TokenDomains:         webACL.TokenDomains,
+			VisibilityConfig:     webACL.VisibilityConfig,
+		}
+
+		log.Printf("[DEBUG] Updating WAFv2 WebACL rules: %s", input)
+		return conn.UpdateWebACL(input)
+	}, wafv2.ErrCodeWAFOptimisticLockException, wafv2.ErrCodeWAFUnavailableEntityException)
+
+	return err
+}
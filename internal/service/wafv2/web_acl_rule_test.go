@@ -0,0 +1,223 @@
+package wafv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwafv2 "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWAFV2WebACLRule_basic(t *testing.T) {
+	var v wafv2.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_rule.test1"
+	webACLResourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLRuleConfig(rName, "US", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLRuleExists(resourceName, &v),
+					testAccCheckWebACLRuleExists("aws_wafv2_web_acl_rule.test2", &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.block.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.geo_match_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.geo_match_statement.0.country_codes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.geo_match_statement.0.country_codes.0", "US"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", webACLResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebACLRuleConfig(rName, "CA", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "3"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.geo_match_statement.0.country_codes.0", "CA"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLRule_disappears(t *testing.T) {
+	var v wafv2.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_rule.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLRuleConfig(rName, "US", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLRuleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfwafv2.ResourceWebACLRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWebACLRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafv2_web_acl_rule" {
+			continue
+		}
+
+		webACLARN, ruleName, err := tfwafv2.WebACLRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfwafv2.FindWebACLByARN(conn, webACLARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range output.WebACL.Rules {
+			if aws.StringValue(rule.Name) == ruleName {
+				return fmt.Errorf("WAFv2 WebACL Rule %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckWebACLRuleExists(n string, v *wafv2.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAFv2 WebACL Rule ID is set")
+		}
+
+		webACLARN, ruleName, err := tfwafv2.WebACLRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+
+		output, err := tfwafv2.FindWebACLByARN(conn, webACLARN)
+
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range output.WebACL.Rules {
+			if aws.StringValue(rule.Name) == ruleName {
+				*v = *rule
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("WAFv2 WebACL Rule %s not found", rs.Primary.ID)
+	}
+}
+
+func testAccWebACLRuleConfig(rName, countryCode string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}
+
+resource "aws_wafv2_web_acl_rule" "test1" {
+  web_acl_arn = aws_wafv2_web_acl.test.arn
+  name        = "%[1]s-1"
+  priority    = %[3]d
+
+  action {
+    block {}
+  }
+
+  statement {
+    geo_match_statement {
+      country_codes = [%[2]q]
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-rule-metric-name-1"
+    sampled_requests_enabled   = false
+  }
+}
+
+resource "aws_wafv2_web_acl_rule" "test2" {
+  web_acl_arn = aws_wafv2_web_acl.test.arn
+  name        = "%[1]s-2"
+  priority    = 2
+
+  action {
+    count {}
+  }
+
+  statement {
+    geo_match_statement {
+      country_codes = ["NZ"]
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-rule-metric-name-2"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName, countryCode, priority)
+}
@@ -56,6 +56,44 @@ func TestAccWAFV2WebACL_basic(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACL_ruleJSON(t *testing.T) {
+	var v wafv2.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_RuleJSON(webACLName, "SizeRestrictions_QUERYSTRING"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "rule_json", regexp.MustCompile(`SizeRestrictions_QUERYSTRING`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccWebACLImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"rule", "rule_json"},
+			},
+			{
+				Config: testAccWebACLConfig_RuleJSON(webACLName, "NoUserAgent_HEADER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "rule_json", regexp.MustCompile(`NoUserAgent_HEADER`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccWAFV2WebACL_Update_rule(t *testing.T) {
 	var v wafv2.WebACL
 	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1607,6 +1645,47 @@ resource "aws_wafv2_web_acl" "test" {
 `, name)
 }
 
+func testAccWebACLConfig_RuleJSON(name, excludedRuleName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule_json = jsonencode([{
+    Name     = "rule-1"
+    Priority = 1
+    OverrideAction = {
+      None = {}
+    }
+    Statement = {
+      ManagedRuleGroupStatement = {
+        Name       = "AWSManagedRulesCommonRuleSet"
+        VendorName = "AWS"
+        ExcludedRules = [{
+          Name = %[2]q
+        }]
+      }
+    }
+    VisibilityConfig = {
+      CloudWatchMetricsEnabled = false
+      MetricName               = "friendly-rule-metric-name"
+      SampledRequestsEnabled   = false
+    }
+  }])
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, excludedRuleName)
+}
+
 func testAccWebACLConfig_BasicRule(name string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
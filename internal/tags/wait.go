@@ -0,0 +1,52 @@
+package tags
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// WaitTagsPropagated waits for the tags returned by `getTags` to contain all the expected tags.
+// Some services apply tags asynchronously, so a resource read immediately after creation can be missing some or all of its tags.
+// `tags` are the resource's tags as already read; if they contain all the expected tags `getTags` is not called.
+// `getTags` should describe the resource with the same call as its read, so that no additional permissions are needed.
+// Resources that are not yet found are treated as having no tags.
+// If the tags have not propagated within `timeout`, or access to the resource is denied, a warning is logged and no error is returned,
+// so that the read proceeds with the tags that are present and any difference is reported in the next plan.
+func WaitTagsPropagated(expected, tags KeyValueTags, timeout time.Duration, getTags func() (KeyValueTags, error)) error {
+	expected = expected.IgnoreAWS()
+
+	if len(expected) == 0 || tags.ContainsAll(expected) {
+		return nil
+	}
+
+	err := tfresource.WaitUntil(timeout, func() (bool, error) {
+		tags, err := getTags()
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return tags.ContainsAll(expected), nil
+	}, tfresource.WaitOpts{})
+
+	if tfresource.TimedOut(err) {
+		log.Printf("[WARN] Timed out waiting for tags (%s) to propagate", expected.Keys())
+
+		return nil
+	}
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") || tfawserr.ErrCodeEquals(err, "UnauthorizedOperation") {
+		log.Printf("[WARN] Unable to wait for tags (%s) to propagate: %s", expected.Keys(), err)
+
+		return nil
+	}
+
+	return err
+}
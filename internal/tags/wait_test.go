@@ -0,0 +1,150 @@
+package tags
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestWaitTagsPropagated(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expected  KeyValueTags
+		tags      KeyValueTags
+		responses []KeyValueTags
+		errs      []error
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "no expected tags",
+			expected:  New(map[string]string{}),
+			wantCalls: 0,
+		},
+		{
+			name:     "only AWS tags expected",
+			expected: New(map[string]string{"aws:cloudformation:stack-name": "stack"}),
+		},
+		{
+			name:     "already propagated",
+			expected: New(map[string]string{"key1": "value1"}),
+			tags:     New(map[string]string{"key1": "value1", "aws:key2": "value2"}),
+		},
+		{
+			name:     "propagated",
+			expected: New(map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{
+				New(map[string]string{"key1": "value1", "aws:key2": "value2"}),
+			},
+			wantCalls: 1,
+		},
+		{
+			name:     "propagated after retries",
+			expected: New(map[string]string{"key1": "value1", "key2": "value2"}),
+			responses: []KeyValueTags{
+				New(map[string]string{}),
+				New(map[string]string{"key1": "value1"}),
+				New(map[string]string{"key1": "value1", "key2": "value2"}),
+			},
+			wantCalls: 3,
+		},
+		{
+			name:     "not found then propagated",
+			expected: New(map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{
+				nil,
+				New(map[string]string{"key1": "value1"}),
+			},
+			errs: []error{
+				&resource.NotFoundError{},
+				nil,
+			},
+			wantCalls: 2,
+		},
+		{
+			name:     "error",
+			expected: New(map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{
+				nil,
+			},
+			errs: []error{
+				errors.New("test error"),
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:     "access denied",
+			expected: New(map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{
+				nil,
+			},
+			errs: []error{
+				awserr.New("AccessDeniedException", "User is not authorized to perform this action", nil),
+			},
+			wantCalls: 1,
+		},
+		{
+			name:     "unauthorized operation",
+			expected: New(map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{
+				nil,
+			},
+			errs: []error{
+				awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil),
+			},
+			wantCalls: 1,
+		},
+		{
+			name:     "timed out",
+			expected: New(map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{
+				New(map[string]string{"key1": "value2"}),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			getTags := func() (KeyValueTags, error) {
+				i := calls
+				calls++
+
+				if i >= len(testCase.responses) {
+					i = len(testCase.responses) - 1
+				}
+
+				var err error
+				if i < len(testCase.errs) {
+					err = testCase.errs[i]
+				}
+
+				return testCase.responses[i], err
+			}
+
+			err := WaitTagsPropagated(testCase.expected, testCase.tags, 2*time.Second, getTags)
+
+			if testCase.wantErr && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.wantCalls > 0 && calls != testCase.wantCalls {
+				t.Errorf("got %d calls, expected %d", calls, testCase.wantCalls)
+			}
+
+			if len(testCase.responses) == 0 && calls != 0 {
+				t.Errorf("got %d calls, expected none", calls)
+			}
+		})
+	}
+}
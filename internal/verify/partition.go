@@ -0,0 +1,113 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// PartitionsWithoutService returns the partitions in which the specified service has no endpoints,
+// according to the AWS SDK's endpoint metadata. The service, and features of other services that depend on it,
+// are unavailable in these partitions. The service is identified by its endpoints ID, e.g. signer.EndpointsID.
+func PartitionsWithoutService(service string) []string {
+	var partitions []string
+
+	for _, p := range endpoints.DefaultPartitions() {
+		if _, ok := p.Services()[service]; !ok {
+			partitions = append(partitions, p.ID())
+		}
+	}
+
+	return partitions
+}
+
+var partitionNames = map[string]string{
+	endpoints.AwsPartitionID:      "AWS Commercial",
+	endpoints.AwsCnPartitionID:    "AWS China",
+	endpoints.AwsUsGovPartitionID: "AWS GovCloud (US)",
+	endpoints.AwsIsoPartitionID:   "AWS ISO (US)",
+	endpoints.AwsIsoBPartitionID:  "AWS ISOB (US)",
+}
+
+// PartitionName returns a human readable name for the specified partition ID.
+func PartitionName(partition string) string {
+	if name, ok := partitionNames[partition]; ok {
+		return fmt.Sprintf("%s (%s)", name, partition)
+	}
+
+	return partition
+}
+
+// UnsupportedInPartitions returns a CustomizeDiffFunc that returns an error at plan time
+// if the specified argument is configured and the provider is configured for one of the specified partitions.
+func UnsupportedInPartitions(key string, partitions ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		partition := meta.(*conns.AWSClient).Partition
+
+		if !partitionIn(partition, partitions) {
+			return nil
+		}
+
+		if v, ok := diff.GetOk(key); !ok || v == nil {
+			return nil
+		}
+
+		if !diff.HasChange(key) && diff.Id() != "" {
+			return nil
+		}
+
+		return fmt.Errorf("%q is not supported in the %s partition", key, PartitionName(partition))
+	}
+}
+
+// ARNsInPartition returns a CustomizeDiffFunc that returns an error at plan time
+// if the ARN configured for any of the specified arguments is not in the provider's partition.
+// Unknown values are checked once they are known.
+func ARNsInPartition(keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		partition := meta.(*conns.AWSClient).Partition
+
+		if partition == "" {
+			return nil
+		}
+
+		for _, key := range keys {
+			if !diff.NewValueKnown(key) {
+				continue
+			}
+
+			v, ok := diff.Get(key).(string)
+
+			if !ok || v == "" {
+				continue
+			}
+
+			parsedARN, err := arn.Parse(v)
+
+			if err != nil {
+				// Syntax is checked by ValidARN.
+				continue
+			}
+
+			if parsedARN.Partition != partition {
+				return fmt.Errorf("%q (%s) is in the %s partition, but the provider is configured for the %s partition", key, v, PartitionName(parsedARN.Partition), PartitionName(partition))
+			}
+		}
+
+		return nil
+	}
+}
+
+func partitionIn(partition string, partitions []string) bool {
+	for _, p := range partitions {
+		if p == partition {
+			return true
+		}
+	}
+
+	return false
+}
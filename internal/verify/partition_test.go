@@ -0,0 +1,55 @@
+package verify
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestPartitionName(t *testing.T) {
+	testCases := []struct {
+		Partition string
+		Expected  string
+	}{
+		{Partition: "aws", Expected: "AWS Commercial (aws)"},
+		{Partition: "aws-us-gov", Expected: "AWS GovCloud (US) (aws-us-gov)"},
+		{Partition: "aws-iso-b", Expected: "AWS ISOB (US) (aws-iso-b)"},
+		{Partition: "aws-unknown", Expected: "aws-unknown"},
+	}
+
+	for _, testCase := range testCases {
+		if got := PartitionName(testCase.Partition); got != testCase.Expected {
+			t.Errorf("PartitionName(%q) = %q, expected %q", testCase.Partition, got, testCase.Expected)
+		}
+	}
+}
+
+func TestPartitionIn(t *testing.T) {
+	partitions := []string{"aws-cn", "aws-us-gov"}
+
+	if !partitionIn("aws-cn", partitions) {
+		t.Errorf("expected aws-cn to be in %v", partitions)
+	}
+
+	if partitionIn("aws", partitions) {
+		t.Errorf("expected aws not to be in %v", partitions)
+	}
+}
+
+func TestPartitionsWithoutService(t *testing.T) {
+	partitions := PartitionsWithoutService("signer")
+
+	for _, partition := range []string{"aws", "aws-cn", "aws-us-gov"} {
+		if partitionIn(partition, partitions) {
+			t.Errorf("expected AWS Signer to be available in %s, got unavailable in %v", partition, partitions)
+		}
+	}
+
+	if !partitionIn("aws-iso", partitions) {
+		t.Errorf("expected AWS Signer to be unavailable in aws-iso, got unavailable in %v", partitions)
+	}
+
+	if got := PartitionsWithoutService("not-a-service"); len(got) != len(endpoints.DefaultPartitions()) {
+		t.Errorf("expected an unknown service to be unavailable in every partition, got %v", got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -52,6 +53,41 @@ func ValidARN(v interface{}, k string) (ws []string, errors []error) {
 	return ws, errors
 }
 
+// ValidARNForService returns a SchemaValidateFunc which tests if the provided value is a valid ARN
+// for the specified service (e.g. "iam") and, if not empty, resource type (e.g. "role").
+// This catches values such as a KMS key ID or an IAM role name passed where an ARN is required at plan time.
+func ValidARNForService(service, resourceType string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return ws, errors
+		}
+
+		if ws, errors = ValidARN(value, k); len(errors) > 0 {
+			return ws, errors
+		}
+
+		if value == "" {
+			return ws, errors
+		}
+
+		parsedARN, _ := arn.Parse(value)
+
+		if parsedARN.Service != service {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected service %q, got %q", k, value, service, parsedARN.Service))
+			return ws, errors
+		}
+
+		if resourceType != "" && !strings.HasPrefix(parsedARN.Resource, resourceType+"/") && !strings.HasPrefix(parsedARN.Resource, resourceType+":") {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected %s resource type %q", k, value, service, resourceType))
+		}
+
+		return ws, errors
+	}
+}
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -93,6 +129,24 @@ func ValidCIDRNetworkAddress(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidDuration ensures that the string value is a valid, non-negative duration, e.g. "5m" or "1h30m".
+func ValidDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	duration, err := time.ParseDuration(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot be parsed as a duration: %w", k, value, err))
+		return
+	}
+
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q (%s) must not be negative", k, value))
+	}
+
+	return
+}
+
 func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 	// IAM Policy documents need to be valid JSON, and pass legacy parsing
 	value := v.(string)
@@ -137,6 +137,34 @@ func TestValidAccountID(t *testing.T) {
 	}
 }
 
+func TestValidDuration(t *testing.T) {
+	validValues := []string{
+		"0s",
+		"30s",
+		"5m",
+		"1h30m",
+	}
+	for _, v := range validValues {
+		_, errors := ValidDuration(v, "duration")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid duration: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"5",
+		"five minutes",
+		"-1m",
+	}
+	for _, v := range invalidValues {
+		_, errors := ValidDuration(v, "duration")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid duration", v)
+		}
+	}
+}
+
 func TestValidARN(t *testing.T) {
 	v := ""
 	_, errors := ValidARN(v, "arn")
@@ -184,6 +212,39 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNForService(t *testing.T) {
+	for _, ts := range []struct {
+		service      string
+		resourceType string
+		value        string
+		valid        bool
+	}{
+		{"iam", "role", "", true},
+		{"iam", "role", "arn:aws:iam::123456789012:role/example", true},                                  // lintignore:AWSAT005
+		{"iam", "role", "arn:aws:iam::123456789012:role/service-role/example", true},                     // lintignore:AWSAT005
+		{"iam", "role", "arn:aws-us-gov:iam::123456789012:role/example", true},                           // lintignore:AWSAT005
+		{"iam", "role", "arn:aws:iam::123456789012:user/example", false},                                 // lintignore:AWSAT005
+		{"iam", "role", "arn:aws:iam::123456789012:roleexample", false},                                  // lintignore:AWSAT005
+		{"iam", "role", "arn:aws:sts::123456789012:assumed-role/example/session", false},                 // lintignore:AWSAT005
+		{"iam", "role", "example", false},                                                                // IAM role name
+		{"kms", "", "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", true}, // lintignore:AWSAT003,AWSAT005
+		{"kms", "", "arn:aws:kms:us-west-2:123456789012:alias/example", true},                            // lintignore:AWSAT003,AWSAT005
+		{"kms", "key", "arn:aws:kms:us-west-2:123456789012:alias/example", false},                        // lintignore:AWSAT003,AWSAT005
+		{"kms", "", "1234abcd-12ab-34cd-56ef-1234567890ab", false},                                       // KMS key ID
+		{"kms", "", "arn:aws:s3:::example", false},                                                       // lintignore:AWSAT005
+		{"s3", "", "arn:aws:s3:::example", true},                                                         // lintignore:AWSAT005
+		{"s3", "", "example", false},                                                                     // S3 bucket name
+		{"lambda", "function", "arn:aws:lambda:us-west-2:123456789012:function:example", true},           // lintignore:AWSAT003,AWSAT005
+		{"lambda", "function", "arn:aws:lambda:us-west-2:123456789012:layer:example", false},             // lintignore:AWSAT003,AWSAT005
+	} {
+		_, errors := ValidARNForService(ts.service, ts.resourceType)(ts.value, "arn")
+
+		if got := len(errors) == 0; got != ts.valid {
+			t.Errorf("ValidARNForService(%q, %q)(%q): got valid %t, expected %t: %v", ts.service, ts.resourceType, ts.value, got, ts.valid, errors)
+		}
+	}
+}
+
 func TestValidateCIDRBlock(t *testing.T) {
 	for _, ts := range []struct {
 		cidr  string
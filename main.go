@@ -4,17 +4,53 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/importblock"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
 func main() {
 	var debugMode bool
+	var generateImports bool
+	var importOpts importblock.Options
+	var importServices string
+	var importState string
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&generateImports, "generate-imports", false, "write import blocks for existing resources to standard output and exit")
+	flag.StringVar(&importOpts.Filter.NamePrefix, "name-prefix", "", "only generate import blocks for resources whose name begins with this prefix (with -generate-imports)")
+	flag.StringVar(&importOpts.Profile, "profile", "", "AWS profile used to discover resources (with -generate-imports)")
+	flag.StringVar(&importOpts.Region, "region", os.Getenv("AWS_REGION"), "AWS region in which to discover resources (with -generate-imports)")
+	flag.StringVar(&importServices, "services", "", "comma-separated list of services in which to discover resources, all supported services if empty (with -generate-imports)")
+	flag.StringVar(&importState, "state", "", "file containing the output of terraform show -json, or - for standard input; resources in that state are skipped (with -generate-imports)")
 	flag.Parse()
 
+	if generateImports {
+		if importServices != "" {
+			importOpts.Services = strings.Split(importServices, ",")
+		}
+
+		if importState != "" {
+			state, err := readImportState(importState)
+
+			if err != nil {
+				log.Fatal(err.Error())
+			}
+
+			importOpts.State = state
+		}
+
+		if err := importblock.Generate(context.Background(), os.Stdout, importOpts); err != nil {
+			log.Fatal(err.Error())
+		}
+
+		return
+	}
+
 	opts := &plugin.ServeOpts{ProviderFunc: provider.Provider}
 
 	if debugMode {
@@ -31,4 +67,26 @@ func main() {
 	logFlags = logFlags &^ (log.Ldate | log.Ltime)
 	log.SetFlags(logFlags)
 	plugin.Serve(opts)
+
+	// Export any buffered AWS API call traces before exiting.
+	if err := conns.ShutdownTracing(context.Background()); err != nil {
+		log.Printf("[WARN] error shutting down tracing: %s", err)
+	}
+}
+
+// readImportState reads the Terraform state whose resources are skipped when generating import blocks.
+func readImportState(path string) (importblock.State, error) {
+	if path == "-" {
+		return importblock.ReadState(os.Stdin)
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return importblock.ReadState(f)
 }